@@ -2,27 +2,42 @@ package main
 
 import (
 	"log"
+	"strings"
 	"time"
+	// Embed the timezone database, quiet hours of subscriptions are given in IANA timezones
+	_ "time/tzdata"
 
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/dashboard"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/deadletter"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/escalation"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/health"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/project"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/telegram"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/webhook"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/webhooksubscription"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/repository/memory"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/repository/postgres"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/config"
+	botService "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/bot/service"
 	bs "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/service"
 	dashboardService "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/dashboard/service"
+	notificationDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	notificationPort "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
 	notificationService "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/sender"
 	subscription "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/subscription"
 	ps "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/project/service"
+	webhookPort "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/provider"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/provider/github"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/provider/gitlab"
 	ws "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/service"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/server/app"
 	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/crypto"
 	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/database"
 	loggerPkg "github.com/dewisartika8/cicd-status-notifier-bot/pkg/logger"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/scheduler"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/workerpool"
 )
 
 func main() {
@@ -59,9 +74,18 @@ func main() {
 	// Initialize repositories
 	projectRepo := postgres.NewProjectRepository(db)
 	buildEventRepo := postgres.NewBuildEventRepository(db)
+	buildTransitionRepo := postgres.NewBuildStatusTransitionRepository(db)
+	buildJobRepo := postgres.NewBuildJobRepository(db)
 	webhookEventRepo := postgres.NewWebhookEventRepository(db)
 	telegramSubscriptionRepo := postgres.NewTelegramSubscriptionRepository(db)
 	notificationLogRepo := postgres.NewNotificationLogRepository(db)
+	retryConfigRepo := postgres.NewRetryConfigurationRepository(db)
+	deadLetterRepo := postgres.NewDeadLetterRepository(db)
+	deliveryQueueRepo := postgres.NewDeliveryQueueRepository(db)
+	webhookSubscriptionRepo := postgres.NewWebhookSubscriptionRepository(db)
+	notificationTemplateRepo := postgres.NewNotificationTemplateRepository(db)
+	escalationPolicyRepo := postgres.NewEscalationPolicyRepository(db)
+	escalationRepo := postgres.NewEscalationRepository(db)
 
 	// Initialize dashboard-specific repositories
 	dashboardBuildEventRepo := postgres.NewDashboardBuildEventRepository(db)
@@ -76,6 +100,8 @@ func main() {
 	})
 	buildService := bs.NewBuildEventService(bs.Dep{
 		BuildEventRepo: buildEventRepo,
+		TransitionRepo: buildTransitionRepo,
+		JobRepo:        buildJobRepo,
 	})
 
 	// Initialize dashboard service
@@ -94,21 +120,128 @@ func main() {
 	// Initialize notification services
 	notificationSender := notificationService.NewNotificationSenderService(sender.Dep{
 		TelegramBotToken: cfg.Telegram.BotToken,
-		EmailConfig:      sender.EmailConfig{}, // Empty config for now
-		SlackConfig:      sender.SlackConfig{}, // Empty config for now
-		Logger:           logger,
+		EmailConfig: sender.EmailConfig{
+			SMTPHost:     cfg.Email.SMTPHost,
+			SMTPPort:     cfg.Email.SMTPPort,
+			SMTPUsername: cfg.Email.Username,
+			SMTPPassword: cfg.Email.Password,
+			FromEmail:    cfg.Email.FromEmail,
+			FromName:     cfg.Email.FromName,
+			Security:     sender.EmailSecurity(strings.ToLower(cfg.Email.Security)),
+			Timeout:      cfg.Email.Timeout,
+		},
+		SlackConfig: sender.SlackConfig{
+			WebhookURL: cfg.Slack.WebhookURL,
+			BotToken:   cfg.Slack.BotToken,
+		},
+		Logger: logger,
 	})
 
+	// Delivery channels for channels the notification sender does not handle
+	var deliveryChannels []notificationPort.DeliveryChannel
+	if cfg.Teams.Enabled {
+		deliveryChannels = append(deliveryChannels, notificationService.NewTeamsChannel(sender.TeamsConfig{
+			Timeout: cfg.Teams.Timeout,
+		}, logger))
+	}
+	if cfg.Discord.Enabled {
+		deliveryChannels = append(deliveryChannels, notificationService.NewDiscordChannel(sender.DiscordConfig{
+			Timeout: cfg.Discord.Timeout,
+		}, logger))
+	}
+
 	notificationLogService := notificationService.NewNotificationLogService(notificationService.NotificationLogDep{
 		NotificationRepo:         notificationLogRepo,
 		TelegramSubscriptionRepo: telegramSubscriptionRepo,
 		NotificationSender:       notificationSender,
+		RetryConfigRepo:          retryConfigRepo,
+		DeadLetterRepo:           deadLetterRepo,
+		WebhookSubscriptionRepo:  webhookSubscriptionRepo,
+		DeliveryChannels:         deliveryChannels,
+		EditTelegramMessages:     cfg.Telegram.EditMessages,
+		TelegramActions:          cfg.Telegram.InlineActions,
+		CoalesceWindow:           cfg.Telegram.CoalesceWindow,
+		BuildEventRepo:           buildEventRepo,
+		BuildTransitions:         buildService,
+		ProjectRepo:              projectRepo,
+		DeliveryQueueRepo:        deliveryQueueRepo,
 		Logger:                   logger,
 	})
 
+	// Notify project email recipients, Slack channels, Teams and Discord webhooks only for configured channels.
+	// Webhook subscriptions need no server configuration and are always notified.
+	projectChannels := []notificationDomain.NotificationChannel{notificationDomain.NotificationChannelWebhook}
+	if cfg.Email.Enabled() {
+		projectChannels = append(projectChannels, notificationDomain.NotificationChannelEmail)
+	}
+	if cfg.Slack.Enabled() {
+		projectChannels = append(projectChannels, notificationDomain.NotificationChannelSlack)
+	}
+	if cfg.Teams.Enabled {
+		projectChannels = append(projectChannels, notificationDomain.NotificationChannelTeams)
+	}
+	if cfg.Discord.Enabled {
+		projectChannels = append(projectChannels, notificationDomain.NotificationChannelDiscord)
+	}
+
+	// Webhook subscriptions with a digest mode get their notifications batched
+	channelNotificationService := notificationService.NewChannelNotificationService(notificationService.NotificationLogDep{
+		NotificationRepo:        notificationLogRepo,
+		WebhookSubscriptionRepo: webhookSubscriptionRepo,
+		Logger:                  logger,
+	})
+
+	webhookSubscriptionService := notificationService.NewWebhookSubscriptionService(notificationService.WebhookSubscriptionDep{
+		WebhookSubscriptionRepo: webhookSubscriptionRepo,
+		Logger:                  logger,
+	})
+
+	// Render notifications through the templates stored for each event and channel
+	notificationFormatter := notificationService.NewNotificationFormatterService(notificationService.NotificationFormatterDep{
+		TemplateRepo: notificationTemplateRepo,
+		Logger:       logger,
+	})
+
+	// Escalate branches that keep failing straight to the escalation chat and email recipients
+	escalationService := notificationService.NewEscalationService(notificationService.EscalationDep{
+		EscalationPolicyRepo: escalationPolicyRepo,
+		EscalationRepo:       escalationRepo,
+		BuildEventRepo:       buildEventRepo,
+		NotificationSender:   notificationSender,
+		ProjectRepo:          projectRepo,
+		Logger:               logger,
+	})
+
+	// Deliver queued notifications through the delivery channels, retried under the retry configuration of their channel
+	deliveryService := notificationService.NewNotificationDeliveryService(
+		deliveryQueueRepo,
+		memory.NewInMemoryRateLimiter(),
+		notificationService.NewRetryService(notificationService.RetryDep{
+			RetryRepo: retryConfigRepo,
+			Logger:    logger,
+		}),
+	)
+	for _, channel := range deliveryChannels {
+		if err := deliveryService.RegisterDeliveryChannel(channel); err != nil {
+			logger.Fatalf("Delivery channel error: %v", err)
+		}
+	}
+
+	deadLetterService := notificationService.NewDeadLetterService(notificationService.DeadLetterDep{
+		DeadLetterRepo:   deadLetterRepo,
+		NotificationRepo: notificationLogRepo,
+		Logger:           logger,
+	})
+
 	// Initialize crypto components
 	signatureVerifier := crypto.NewGitHubSignatureVerifier()
 
+	// Initialize CI provider adapters
+	ciProviders := provider.NewRegistry(
+		github.NewProvider(signatureVerifier),
+		gitlab.NewProvider(crypto.NewGitLabTokenVerifier(), cfg.GitLab.WebhookSecret),
+	)
+
 	// Initialize webhook service
 	webhookService := ws.NewWebhookService(ws.Dep{
 		WebhookEventRepo:       webhookEventRepo,
@@ -116,28 +249,103 @@ func main() {
 		BuildService:           buildService,
 		NotificationLogService: notificationLogService,
 		SignatureVerifier:      signatureVerifier,
+		Providers:              ciProviders,
+		NotificationFormatter:  notificationFormatter,
+		ChannelNotifications:   channelNotificationService,
+		ProjectChannels:        projectChannels,
+		WebhookSubscriptions:   webhookSubscriptionService,
+		BuildTransitions:       buildService,
 	})
 
-	// Initialize handlers
+	// Process webhooks in the background so deliveries are acknowledged right away
+	var webhookWorkers *workerpool.Pool
+	var webhookDispatcher webhookPort.WebhookDispatcher
+	if cfg.WebhookProcessing.Async {
+		webhookWorkers, err = workerpool.New(workerpool.Config{
+			Workers:   cfg.WebhookProcessing.Workers,
+			QueueSize: cfg.WebhookProcessing.QueueSize,
+		}, logger)
+		if err != nil {
+			logger.Fatalf("Webhook worker pool error: %v", err)
+		}
+		webhookDispatcher = ws.NewWebhookDispatcher(ws.DispatcherDep{
+			WebhookService: webhookService,
+			Pool:           webhookWorkers,
+			Logger:         logger,
+		})
+	}
+
+	// Initialize background jobs
+	var jobScheduler *scheduler.Scheduler
 	healthHandler := health.NewHealthHandler(logger)
+	if cfg.Scheduler.Enabled {
+		jobScheduler, err = app.NewScheduler(app.SchedulerDep{
+			Config:                 cfg.Scheduler,
+			NotificationLogService: notificationLogService,
+			WebhookService:         webhookService,
+			WebhookDispatcher:      webhookDispatcher,
+			EscalationService:      escalationService,
+			DeliveryService:        deliveryService,
+			Logger:                 logger,
+		})
+		if err != nil {
+			logger.Fatalf("Scheduler error: %v", err)
+		}
+		healthHandler = health.NewHealthHandlerWithScheduler(logger, jobScheduler)
+	}
+
+	// Initialize handlers
 	projectHandler := project.NewProjectHandler(project.ProjectHandlerDep{
 		ProjectService: projectService,
 		Logger:         logger,
 	})
-	webhookHandler := webhook.NewWebhookHandler(webhookService, logger)
-	telegramHandler := telegram.NewTelegramHandler(cfg, telegramSubscriptionService, logger)
+	webhookHandler := webhook.NewWebhookHandlerWithProviders(webhookService, ciProviders, logger)
+	if webhookDispatcher != nil {
+		webhookHandler = webhook.NewAsyncWebhookHandler(webhookService, ciProviders, webhookDispatcher, logger)
+	}
+	callbackActionService := botService.NewCallbackActionService(botService.CallbackActionDep{
+		BuildService:             buildService,
+		TelegramSubscriptionRepo: telegramSubscriptionRepo,
+		NotificationRepo:         notificationLogRepo,
+		NotificationSender:       notificationSender,
+		Escalations:              escalationService,
+		Logger:                   logger,
+	})
+	botSubscriptionService := botService.NewSubscriptionService(botService.SubscriptionDep{
+		ProjectRepo:              projectRepo,
+		TelegramSubscriptionRepo: telegramSubscriptionRepo,
+		Logger:                   logger,
+	})
+	telegramHandler := telegram.NewTelegramHandler(cfg, telegramSubscriptionService, botSubscriptionService, callbackActionService, logger)
 	dashboardHandler := dashboard.NewHandler(dashboardSvc)
+	deadLetterHandler := deadletter.NewDeadLetterHandler(deadletter.DeadLetterHandlerDep{
+		DeadLetterService: deadLetterService,
+		Logger:            logger,
+	})
+	webhookSubscriptionHandler := webhooksubscription.NewWebhookSubscriptionHandler(webhooksubscription.WebhookSubscriptionHandlerDep{
+		WebhookSubscriptionService: webhookSubscriptionService,
+		Logger:                     logger,
+	})
+	escalationHandler := escalation.NewEscalationHandler(escalation.EscalationHandlerDep{
+		EscalationService: escalationService,
+		Logger:            logger,
+	})
 
 	// run APP in http server
 	// inject all usecases here
 	appService := app.Init(app.Dep{
-		AppConfig:        cfg,
-		HealthHandler:    healthHandler,
-		ProjectHandler:   projectHandler,
-		WebhookHandler:   webhookHandler,
-		TelegramHandler:  telegramHandler,
-		DashboardHandler: dashboardHandler,
-		Logger:           logger,
+		AppConfig:                  cfg,
+		HealthHandler:              healthHandler,
+		ProjectHandler:             projectHandler,
+		WebhookHandler:             webhookHandler,
+		TelegramHandler:            telegramHandler,
+		DashboardHandler:           dashboardHandler,
+		DeadLetterHandler:          deadLetterHandler,
+		WebhookSubscriptionHandler: webhookSubscriptionHandler,
+		EscalationHandler:          escalationHandler,
+		Scheduler:                  jobScheduler,
+		WebhookWorkers:             webhookWorkers,
+		Logger:                     logger,
 	})
 	appService.Run() // start http server
 }
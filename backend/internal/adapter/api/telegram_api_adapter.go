@@ -47,6 +47,13 @@ func (t *TelegramAPIAdapter) SendMessageWithMarkdown(chatID int64, text string)
 	return err
 }
 
+// AnswerCallbackQuery answers the press of an inline keyboard button with a short notice
+func (t *TelegramAPIAdapter) AnswerCallbackQuery(callbackQueryID, text string) error {
+	callback := tgbotapi.NewCallback(callbackQueryID, text)
+	_, err := t.bot.Request(callback)
+	return err
+}
+
 // SetWebhook sets the webhook URL
 func (t *TelegramAPIAdapter) SetWebhook(webhookURL string) error {
 	webhook, err := tgbotapi.NewWebhook(webhookURL + "/api/v1/telegram/webhook")
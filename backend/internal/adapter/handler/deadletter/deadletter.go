@@ -0,0 +1,24 @@
+package deadletter
+
+import (
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	"github.com/sirupsen/logrus"
+)
+
+// DeadLetterHandlerDep represents the dependencies of the dead-letter HTTP handler
+type DeadLetterHandlerDep struct {
+	DeadLetterService port.DeadLetterService
+	Logger            *logrus.Logger
+}
+
+// Handler struct for organizing handler dependencies
+type Handler struct {
+	DeadLetterHandlerDep
+}
+
+// NewDeadLetterHandler creates a new dead-letter handler instance
+func NewDeadLetterHandler(d DeadLetterHandlerDep) *Handler {
+	return &Handler{
+		DeadLetterHandlerDep: d,
+	}
+}
@@ -0,0 +1,213 @@
+package deadletter
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Default page size of dead-letter listings
+const defaultListLimit = 20
+
+// Constants for error messages and responses
+const (
+	// Error messages
+	ErrorInvalidDeadLetterID = "Invalid dead-letter ID"
+	ErrorInvalidFilters      = "Invalid filters"
+	ErrorInternalServer      = "Internal server error"
+
+	// Success messages
+	MessageDeadLettersRetrievedSuccessfully = "Dead-lettered notifications retrieved successfully"
+	MessageDeadLetterRetrievedSuccessfully  = "Dead-lettered notification retrieved successfully"
+	MessageDeadLetterRequeuedSuccessfully   = "Dead-lettered notification requeued successfully"
+	MessageDeadLetterDiscardedSuccessfully  = "Dead-lettered notification discarded successfully"
+
+	// Log messages
+	LogFilterValidationFailed    = "Filter validation failed"
+	LogFailedToListDeadLetters   = "Failed to list dead-lettered notifications"
+	LogFailedToGetDeadLetter     = "Failed to get dead-lettered notification"
+	LogFailedToRequeueDeadLetter = "Failed to requeue dead-lettered notification"
+	LogFailedToDiscardDeadLetter = "Failed to discard dead-lettered notification"
+	LogDeadLettersListed         = "Dead-lettered notifications listed"
+)
+
+// HTTP Routing registerer
+func (h *Handler) RegisterRoutes(r fiber.Router) {
+	deadLetters := r.Group("/dead-letters")
+
+	deadLetters.Get("/", h.ListDeadLetters)
+	deadLetters.Get("/:id", h.GetDeadLetter)
+	deadLetters.Post("/:id/requeue", h.RequeueDeadLetter)
+	deadLetters.Post("/:id/discard", h.DiscardDeadLetter)
+}
+
+// ListDeadLetters lists dead-lettered notifications with filtering and pagination
+func (h *Handler) ListDeadLetters(c *fiber.Ctx) error {
+	ctx := context.Background()
+
+	filters := h.parseListFilters(c)
+
+	validator := validator.New()
+	if err := validator.Struct(&filters); err != nil {
+		h.Logger.WithError(err).Error(LogFilterValidationFailed)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   ErrorInvalidFilters,
+			"details": err.Error(),
+		})
+	}
+
+	deadLetters, err := h.DeadLetterService.ListDeadLetters(ctx, filters)
+	if err != nil {
+		h.Logger.WithError(err).Error(LogFailedToListDeadLetters)
+		return h.handleError(c, err)
+	}
+
+	total, err := h.DeadLetterService.CountDeadLetters(ctx, filters)
+	if err != nil {
+		h.Logger.WithError(err).Error(LogFailedToListDeadLetters)
+		return h.handleError(c, err)
+	}
+
+	h.Logger.WithField("count", len(deadLetters)).Info(LogDeadLettersListed)
+
+	return c.JSON(fiber.Map{
+		"message": MessageDeadLettersRetrievedSuccessfully,
+		"data": dto.DeadLetterListResponse{
+			DeadLetters: dto.ToDeadLetterResponseList(deadLetters),
+			Total:       total,
+			Limit:       *filters.Limit,
+			Offset:      *filters.Offset,
+		},
+	})
+}
+
+// GetDeadLetter retrieves a dead-lettered notification with its error history
+func (h *Handler) GetDeadLetter(c *fiber.Ctx) error {
+	ctx := context.Background()
+
+	id, err := value_objects.NewIDFromString(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidDeadLetterID,
+		})
+	}
+
+	deadLetter, err := h.DeadLetterService.GetDeadLetter(ctx, id)
+	if err != nil {
+		h.Logger.WithError(err).WithField("dead_letter_id", id.String()).Error(LogFailedToGetDeadLetter)
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message": MessageDeadLetterRetrievedSuccessfully,
+		"data":    dto.ToDeadLetterResponse(deadLetter),
+	})
+}
+
+// RequeueDeadLetter sends a dead-lettered notification back for delivery
+func (h *Handler) RequeueDeadLetter(c *fiber.Ctx) error {
+	ctx := context.Background()
+
+	id, err := value_objects.NewIDFromString(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidDeadLetterID,
+		})
+	}
+
+	deadLetter, err := h.DeadLetterService.RequeueDeadLetter(ctx, id)
+	if err != nil {
+		h.Logger.WithError(err).WithField("dead_letter_id", id.String()).Error(LogFailedToRequeueDeadLetter)
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message": MessageDeadLetterRequeuedSuccessfully,
+		"data":    dto.ToDeadLetterResponse(deadLetter),
+	})
+}
+
+// DiscardDeadLetter gives up on a dead-lettered notification
+func (h *Handler) DiscardDeadLetter(c *fiber.Ctx) error {
+	ctx := context.Background()
+
+	id, err := value_objects.NewIDFromString(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidDeadLetterID,
+		})
+	}
+
+	deadLetter, err := h.DeadLetterService.DiscardDeadLetter(ctx, id)
+	if err != nil {
+		h.Logger.WithError(err).WithField("dead_letter_id", id.String()).Error(LogFailedToDiscardDeadLetter)
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message": MessageDeadLetterDiscardedSuccessfully,
+		"data":    dto.ToDeadLetterResponse(deadLetter),
+	})
+}
+
+// Helper methods
+
+func (h *Handler) parseListFilters(c *fiber.Ctx) dto.ListDeadLetterFilters {
+	limit := defaultListLimit
+	offset := 0
+	filters := dto.ListDeadLetterFilters{
+		Limit:  &limit,
+		Offset: &offset,
+	}
+
+	if statusStr := c.Query("status"); statusStr != "" {
+		status := domain.DeadLetterStatus(statusStr)
+		filters.Status = &status
+	}
+
+	if channelStr := c.Query("channel"); channelStr != "" {
+		channel := domain.NotificationChannel(channelStr)
+		filters.Channel = &channel
+	}
+
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if parsed, err := strconv.Atoi(limitStr); err == nil {
+			limit = parsed
+		}
+	}
+
+	if offsetStr := c.Query("offset"); offsetStr != "" {
+		if parsed, err := strconv.Atoi(offsetStr); err == nil {
+			offset = parsed
+		}
+	}
+
+	return filters
+}
+
+func (h *Handler) handleError(c *fiber.Ctx, err error) error {
+	var domainErr exception.DomainError
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code {
+		case domain.ErrCodeDeadLetterNotFound, domain.ErrCodeNotificationLogNotFound:
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": domainErr.Message,
+			})
+		case domain.ErrCodeDeadLetterAlreadyResolved, domain.ErrCodeInvalidNotificationStatus:
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"error": domainErr.Message,
+			})
+		}
+	}
+
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error": ErrorInternalServer,
+	})
+}
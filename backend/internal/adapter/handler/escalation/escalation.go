@@ -0,0 +1,24 @@
+package escalation
+
+import (
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	"github.com/sirupsen/logrus"
+)
+
+// EscalationHandlerDep represents the dependencies of the escalation HTTP handler
+type EscalationHandlerDep struct {
+	EscalationService port.EscalationService
+	Logger            *logrus.Logger
+}
+
+// Handler struct for organizing handler dependencies
+type Handler struct {
+	EscalationHandlerDep
+}
+
+// NewEscalationHandler creates a new escalation handler instance
+func NewEscalationHandler(d EscalationHandlerDep) *Handler {
+	return &Handler{
+		EscalationHandlerDep: d,
+	}
+}
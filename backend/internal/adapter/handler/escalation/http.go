@@ -0,0 +1,289 @@
+package escalation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Constants for error messages and responses
+const (
+	// Error messages
+	ErrorInvalidProjectID   = "Invalid project ID"
+	ErrorInvalidPolicyID    = "Invalid escalation policy ID"
+	ErrorInvalidRequestBody = "Invalid request body"
+	ErrorValidationFailed   = "Validation failed"
+	ErrorInternalServer     = "Internal server error"
+
+	// Success messages
+	MessagePolicyCreatedSuccessfully           = "Escalation policy created successfully"
+	MessagePoliciesRetrievedSuccessfully       = "Escalation policies retrieved successfully"
+	MessagePolicyRetrievedSuccessfully         = "Escalation policy retrieved successfully"
+	MessagePolicyUpdatedSuccessfully           = "Escalation policy updated successfully"
+	MessagePolicyDeletedSuccessfully           = "Escalation policy deleted successfully"
+	MessageEscalationsRetrievedSuccessfully    = "Escalations retrieved successfully"
+	MessageEscalationsAcknowledgedSuccessfully = "Escalations acknowledged successfully"
+
+	// Log messages
+	LogFailedToParseRequestBody       = "Failed to parse request body"
+	LogRequestValidationFailed        = "Request validation failed"
+	LogFailedToCreatePolicy           = "Failed to create escalation policy"
+	LogFailedToListPolicies           = "Failed to list escalation policies"
+	LogFailedToGetPolicy              = "Failed to get escalation policy"
+	LogFailedToUpdatePolicy           = "Failed to update escalation policy"
+	LogFailedToDeletePolicy           = "Failed to delete escalation policy"
+	LogFailedToListEscalations        = "Failed to list escalations"
+	LogFailedToAcknowledgeEscalations = "Failed to acknowledge escalations"
+)
+
+// HTTP Routing registerer
+func (h *Handler) RegisterRoutes(r fiber.Router) {
+	r.Post("/projects/:projectId/escalation-policies", h.CreateEscalationPolicy)
+	r.Get("/projects/:projectId/escalation-policies", h.ListEscalationPolicies)
+	r.Get("/projects/:projectId/escalations", h.ListEscalations)
+	r.Post("/projects/:projectId/escalations/acknowledge", h.AcknowledgeEscalations)
+
+	policies := r.Group("/escalation-policies")
+
+	policies.Get("/:id", h.GetEscalationPolicy)
+	policies.Put("/:id", h.UpdateEscalationPolicy)
+	policies.Delete("/:id", h.DeleteEscalationPolicy)
+}
+
+// CreateEscalationPolicy creates an escalation policy for a branch of a project
+func (h *Handler) CreateEscalationPolicy(c *fiber.Ctx) error {
+	ctx := context.Background()
+
+	projectID, err := value_objects.NewIDFromString(c.Params("projectId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidProjectID,
+		})
+	}
+
+	var req dto.CreateEscalationPolicyRequest
+	if err := c.BodyParser(&req); err != nil {
+		h.Logger.WithError(err).Error(LogFailedToParseRequestBody)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidRequestBody,
+		})
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(&req); err != nil {
+		h.Logger.WithError(err).Error(LogRequestValidationFailed)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   ErrorValidationFailed,
+			"details": err.Error(),
+		})
+	}
+
+	policy, err := h.EscalationService.CreateEscalationPolicy(ctx, projectID, req)
+	if err != nil {
+		h.Logger.WithError(err).WithField("project_id", projectID.String()).Error(LogFailedToCreatePolicy)
+		return h.handleError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": MessagePolicyCreatedSuccessfully,
+		"data":    dto.ToEscalationPolicyResponse(policy),
+	})
+}
+
+// ListEscalationPolicies lists the escalation policies of a project
+func (h *Handler) ListEscalationPolicies(c *fiber.Ctx) error {
+	ctx := context.Background()
+
+	projectID, err := value_objects.NewIDFromString(c.Params("projectId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidProjectID,
+		})
+	}
+
+	policies, err := h.EscalationService.GetEscalationPoliciesByProject(ctx, projectID)
+	if err != nil {
+		h.Logger.WithError(err).WithField("project_id", projectID.String()).Error(LogFailedToListPolicies)
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message": MessagePoliciesRetrievedSuccessfully,
+		"data":    dto.ToEscalationPolicyResponseList(policies),
+	})
+}
+
+// GetEscalationPolicy retrieves an escalation policy
+func (h *Handler) GetEscalationPolicy(c *fiber.Ctx) error {
+	ctx := context.Background()
+
+	id, err := value_objects.NewIDFromString(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidPolicyID,
+		})
+	}
+
+	policy, err := h.EscalationService.GetEscalationPolicy(ctx, id)
+	if err != nil {
+		h.Logger.WithError(err).WithField("policy_id", id.String()).Error(LogFailedToGetPolicy)
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message": MessagePolicyRetrievedSuccessfully,
+		"data":    dto.ToEscalationPolicyResponse(policy),
+	})
+}
+
+// UpdateEscalationPolicy updates the branch, steps, targets or state of an escalation policy
+func (h *Handler) UpdateEscalationPolicy(c *fiber.Ctx) error {
+	ctx := context.Background()
+
+	id, err := value_objects.NewIDFromString(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidPolicyID,
+		})
+	}
+
+	var req dto.UpdateEscalationPolicyRequest
+	if err := c.BodyParser(&req); err != nil {
+		h.Logger.WithError(err).Error(LogFailedToParseRequestBody)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidRequestBody,
+		})
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(&req); err != nil {
+		h.Logger.WithError(err).Error(LogRequestValidationFailed)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   ErrorValidationFailed,
+			"details": err.Error(),
+		})
+	}
+
+	policy, err := h.EscalationService.UpdateEscalationPolicy(ctx, id, req)
+	if err != nil {
+		h.Logger.WithError(err).WithField("policy_id", id.String()).Error(LogFailedToUpdatePolicy)
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message": MessagePolicyUpdatedSuccessfully,
+		"data":    dto.ToEscalationPolicyResponse(policy),
+	})
+}
+
+// DeleteEscalationPolicy deletes an escalation policy
+func (h *Handler) DeleteEscalationPolicy(c *fiber.Ctx) error {
+	ctx := context.Background()
+
+	id, err := value_objects.NewIDFromString(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidPolicyID,
+		})
+	}
+
+	if err := h.EscalationService.DeleteEscalationPolicy(ctx, id); err != nil {
+		h.Logger.WithError(err).WithField("policy_id", id.String()).Error(LogFailedToDeletePolicy)
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message": MessagePolicyDeletedSuccessfully,
+	})
+}
+
+// ListEscalations lists the escalations of a project whose branch is still failing
+func (h *Handler) ListEscalations(c *fiber.Ctx) error {
+	ctx := context.Background()
+
+	projectID, err := value_objects.NewIDFromString(c.Params("projectId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidProjectID,
+		})
+	}
+
+	escalations, err := h.EscalationService.GetOpenEscalations(ctx, projectID)
+	if err != nil {
+		h.Logger.WithError(err).WithField("project_id", projectID.String()).Error(LogFailedToListEscalations)
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message": MessageEscalationsRetrievedSuccessfully,
+		"data":    dto.ToEscalationResponseList(escalations),
+	})
+}
+
+// AcknowledgeEscalations stops escalating the failing branches of a project
+func (h *Handler) AcknowledgeEscalations(c *fiber.Ctx) error {
+	ctx := context.Background()
+
+	projectID, err := value_objects.NewIDFromString(c.Params("projectId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidProjectID,
+		})
+	}
+
+	var req dto.AcknowledgeEscalationsRequest
+	if err := c.BodyParser(&req); err != nil {
+		h.Logger.WithError(err).Error(LogFailedToParseRequestBody)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidRequestBody,
+		})
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(&req); err != nil {
+		h.Logger.WithError(err).Error(LogRequestValidationFailed)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   ErrorValidationFailed,
+			"details": err.Error(),
+		})
+	}
+
+	escalations, err := h.EscalationService.AcknowledgeEscalations(ctx, projectID, req.Branch, req.AcknowledgedBy)
+	if err != nil {
+		h.Logger.WithError(err).WithField("project_id", projectID.String()).Error(LogFailedToAcknowledgeEscalations)
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message": MessageEscalationsAcknowledgedSuccessfully,
+		"data":    dto.ToEscalationResponseList(escalations),
+	})
+}
+
+// Helper methods
+
+func (h *Handler) handleError(c *fiber.Ctx, err error) error {
+	var domainErr exception.DomainError
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code {
+		case domain.ErrCodeEscalationPolicyNotFound:
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": domainErr.Message,
+			})
+		case domain.ErrCodeInvalidEscalationPolicy, domain.ErrCodeInvalidProjectID, domain.ErrCodeInvalidTelegramChatID:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": domainErr.Message,
+			})
+		}
+	}
+
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error": ErrorInternalServer,
+	})
+}
@@ -1,9 +1,19 @@
 package health
 
-import "github.com/sirupsen/logrus"
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/scheduler"
+)
+
+// JobStatusReporter reports the last-run status of background jobs
+type JobStatusReporter interface {
+	Statuses() []scheduler.JobStatus
+}
 
 type HealthHandler struct {
-	Logger *logrus.Logger
+	Logger    *logrus.Logger
+	Scheduler JobStatusReporter
 }
 
 func NewHealthHandler(logger *logrus.Logger) *HealthHandler {
@@ -11,3 +21,11 @@ func NewHealthHandler(logger *logrus.Logger) *HealthHandler {
 		Logger: logger,
 	}
 }
+
+// NewHealthHandlerWithScheduler creates a health handler that also reports background job status
+func NewHealthHandlerWithScheduler(logger *logrus.Logger, reporter JobStatusReporter) *HealthHandler {
+	return &HealthHandler{
+		Logger:    logger,
+		Scheduler: reporter,
+	}
+}
@@ -4,12 +4,15 @@ import (
 	"time"
 
 	"github.com/gofiber/fiber/v2"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/scheduler"
 )
 
 // HTTP Routing registerer
 func (h *HealthHandler) RegisterRoutes(r fiber.Router) {
 	r.Get("/", h.GetHealthStatus)
 	r.Get("/health", h.CheckHealth)
+	r.Get("/health/jobs", h.GetJobStatuses)
 }
 
 // GetHealthStatus returns the health status of the service
@@ -30,3 +33,18 @@ func (h *HealthHandler) CheckHealth(c *fiber.Ctx) error {
 		"timestamp": time.Now().UTC(),
 	})
 }
+
+// GetJobStatuses returns the last-run status of every background job
+func (h *HealthHandler) GetJobStatuses(c *fiber.Ctx) error {
+	if h.Scheduler == nil {
+		return c.JSON(fiber.Map{
+			"enabled": false,
+			"jobs":    []scheduler.JobStatus{},
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"enabled": true,
+		"jobs":    h.Scheduler.Statuses(),
+	})
+}
@@ -20,19 +20,26 @@ type TelegramHandler struct {
 	telegramAPI         port.TelegramAPI
 }
 
-func NewTelegramHandler(cfg *config.AppConfig, subscriptionService notificationPort.TelegramSubscriptionService, logger *logrus.Logger) *TelegramHandler {
+func NewTelegramHandler(
+	cfg *config.AppConfig,
+	subscriptionService notificationPort.TelegramSubscriptionService,
+	botSubscriptions port.SubscriptionService,
+	callbackActions port.CallbackActionService,
+	logger *logrus.Logger,
+) *TelegramHandler {
 	// Initialize clean architecture components
 	telegramAPI := api.NewTelegramAPIAdapter(cfg)
 	commandValidator := domain.NewCommandValidator()
 	commandRouter := domain.NewCommandRouter()
 
 	// Create bot service
-	botService := service.NewBotService(
+	botService := service.NewBotServiceWithCallbackActions(
 		telegramAPI,
 		commandValidator,
 		commandRouter,
 		nil, // projectService - to be implemented
-		nil, // subscriptionService - separate implementation
+		botSubscriptions,
+		callbackActions,
 	)
 
 	// Create handlers
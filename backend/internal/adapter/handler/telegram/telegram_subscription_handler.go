@@ -1,14 +1,17 @@
 package telegram
 
 import (
+	"errors"
 	"strconv"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/sirupsen/logrus"
 
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/bot/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
 )
 
 // Error message constants
@@ -35,6 +38,9 @@ const (
 	ErrFailedToGetSubStats      = "Failed to get subscription stats"
 	ErrInvalidIsActiveParam     = "Invalid is_active parameter"
 	ErrIsActiveMustBeBoolean    = "is_active must be a boolean (true/false)"
+	ErrInvalidFilter            = "Invalid subscription filter"
+	ErrInvalidDigestMode        = "Invalid digest mode"
+	ErrInvalidQuietHours        = "Invalid quiet hours"
 
 	// Log message constants
 	LogInvalidProjectIDFormat      = "Invalid project ID format"
@@ -109,8 +115,27 @@ func (h *TelegramSubscriptionHandler) CreateSubscription(c *fiber.Ctx) error {
 		})
 	}
 
+	filter, err := req.Filter.ToDomain()
+	if err != nil {
+		return h.invalidFilter(c, err)
+	}
+
+	digestMode, err := domain.ParseDigestMode(req.DigestMode)
+	if err != nil {
+		return h.invalidRequest(c, ErrInvalidDigestMode, err)
+	}
+
+	var quietHours domain.QuietHours
+	if req.QuietHours != nil {
+		if quietHours, err = req.QuietHours.ToDomain(); err != nil {
+			return h.invalidRequest(c, ErrInvalidQuietHours, err)
+		}
+	}
+
 	// Create subscription
-	subscription, err := h.subscriptionService.CreateTelegramSubscription(c.Context(), projectID, req.ChatID)
+	subscription, err := h.subscriptionService.CreateTelegramSubscription(
+		c.Context(), projectID, req.ChatID, filter, digestMode, quietHours, req.TransitionsOnly,
+	)
 	if err != nil {
 		h.logger.WithError(err).Error(LogFailedToCreateSub)
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
@@ -119,14 +144,7 @@ func (h *TelegramSubscriptionHandler) CreateSubscription(c *fiber.Ctx) error {
 		})
 	}
 
-	response := dto.TelegramSubscriptionResponse{
-		ID:        subscription.ID().String(),
-		ProjectID: subscription.ProjectID().String(),
-		ChatID:    subscription.ChatID(),
-		IsActive:  subscription.IsActive(),
-		CreatedAt: subscription.CreatedAt().Unix(),
-		UpdatedAt: subscription.UpdatedAt().Unix(),
-	}
+	response := dto.ToTelegramSubscriptionResponse(subscription)
 
 	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
 		"message": MsgSubCreatedSuccessfully,
@@ -162,14 +180,7 @@ func (h *TelegramSubscriptionHandler) GetSubscriptionByID(c *fiber.Ctx) error {
 		})
 	}
 
-	response := dto.TelegramSubscriptionResponse{
-		ID:        subscription.ID().String(),
-		ProjectID: subscription.ProjectID().String(),
-		ChatID:    subscription.ChatID(),
-		IsActive:  subscription.IsActive(),
-		CreatedAt: subscription.CreatedAt().Unix(),
-		UpdatedAt: subscription.UpdatedAt().Unix(),
-	}
+	response := dto.ToTelegramSubscriptionResponse(subscription)
 
 	return c.JSON(fiber.Map{
 		"message": MsgSubRetrievedSuccessfully,
@@ -207,14 +218,7 @@ func (h *TelegramSubscriptionHandler) GetSubscriptionsByProject(c *fiber.Ctx) er
 
 	responses := make([]dto.TelegramSubscriptionResponse, len(subscriptions))
 	for i, subscription := range subscriptions {
-		responses[i] = dto.TelegramSubscriptionResponse{
-			ID:        subscription.ID().String(),
-			ProjectID: subscription.ProjectID().String(),
-			ChatID:    subscription.ChatID(),
-			IsActive:  subscription.IsActive(),
-			CreatedAt: subscription.CreatedAt().Unix(),
-			UpdatedAt: subscription.UpdatedAt().Unix(),
-		}
+		responses[i] = dto.ToTelegramSubscriptionResponse(subscription)
 	}
 
 	return c.JSON(fiber.Map{
@@ -251,7 +255,36 @@ func (h *TelegramSubscriptionHandler) UpdateSubscription(c *fiber.Ctx) error {
 		})
 	}
 
-	subscription, err := h.subscriptionService.UpdateTelegramSubscription(c.Context(), subscriptionID, nil, &req.IsActive)
+	var filter *domain.SubscriptionFilter
+	if req.Filter != nil {
+		f, err := req.Filter.ToDomain()
+		if err != nil {
+			return h.invalidFilter(c, err)
+		}
+		filter = &f
+	}
+
+	var digestMode *domain.DigestMode
+	if req.DigestMode != nil {
+		mode, err := domain.ParseDigestMode(*req.DigestMode)
+		if err != nil {
+			return h.invalidRequest(c, ErrInvalidDigestMode, err)
+		}
+		digestMode = &mode
+	}
+
+	var quietHours *domain.QuietHours
+	if req.QuietHours != nil {
+		q, err := req.QuietHours.ToDomain()
+		if err != nil {
+			return h.invalidRequest(c, ErrInvalidQuietHours, err)
+		}
+		quietHours = &q
+	}
+
+	subscription, err := h.subscriptionService.UpdateTelegramSubscription(
+		c.Context(), subscriptionID, nil, req.IsActive, filter, digestMode, quietHours, req.TransitionsOnly,
+	)
 	if err != nil {
 		h.logger.WithError(err).Error(LogFailedToUpdateSub)
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
@@ -260,14 +293,7 @@ func (h *TelegramSubscriptionHandler) UpdateSubscription(c *fiber.Ctx) error {
 		})
 	}
 
-	response := dto.TelegramSubscriptionResponse{
-		ID:        subscription.ID().String(),
-		ProjectID: subscription.ProjectID().String(),
-		ChatID:    subscription.ChatID(),
-		IsActive:  subscription.IsActive(),
-		CreatedAt: subscription.CreatedAt().Unix(),
-		UpdatedAt: subscription.UpdatedAt().Unix(),
-	}
+	response := dto.ToTelegramSubscriptionResponse(subscription)
 
 	return c.JSON(fiber.Map{
 		"message": MsgSubUpdatedSuccessfully,
@@ -321,14 +347,7 @@ func (h *TelegramSubscriptionHandler) GetActiveSubscriptions(c *fiber.Ctx) error
 
 	responses := make([]dto.TelegramSubscriptionResponse, len(subscriptions))
 	for i, subscription := range subscriptions {
-		responses[i] = dto.TelegramSubscriptionResponse{
-			ID:        subscription.ID().String(),
-			ProjectID: subscription.ProjectID().String(),
-			ChatID:    subscription.ChatID(),
-			IsActive:  subscription.IsActive(),
-			CreatedAt: subscription.CreatedAt().Unix(),
-			UpdatedAt: subscription.UpdatedAt().Unix(),
-		}
+		responses[i] = dto.ToTelegramSubscriptionResponse(subscription)
 	}
 
 	return c.JSON(fiber.Map{
@@ -389,3 +408,22 @@ func (h *TelegramSubscriptionHandler) GetSubscriptionStats(c *fiber.Ctx) error {
 		"data":    stats,
 	})
 }
+
+// invalidFilter responds to a subscription filter that failed validation
+func (h *TelegramSubscriptionHandler) invalidFilter(c *fiber.Ctx, err error) error {
+	return h.invalidRequest(c, ErrInvalidFilter, err)
+}
+
+// invalidRequest answers a request with an invalid field, showing the message of domain errors
+func (h *TelegramSubscriptionHandler) invalidRequest(c *fiber.Ctx, title string, err error) error {
+	message := err.Error()
+	var domainErr exception.DomainError
+	if errors.As(err, &domainErr) {
+		message = domainErr.Message
+	}
+
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error":   title,
+		"message": message,
+	})
+}
@@ -1,13 +1,15 @@
 package webhook
 
 import (
-	"encoding/json"
+	"errors"
 	"strconv"
 
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/dto"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/provider"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
 	"github.com/gofiber/fiber/v2"
 	"github.com/sirupsen/logrus"
 )
@@ -15,24 +17,39 @@ import (
 // WebhookHandler handles webhook-related HTTP requests
 type WebhookHandler struct {
 	webhookService port.WebhookService
+	providers      port.ProviderRegistry
+	dispatcher     port.WebhookDispatcher // Optional, processes webhooks in the background
 	logger         *logrus.Logger
 }
 
-// NewWebhookHandler creates a new webhook handler
+// NewWebhookHandler creates a new webhook handler using the built-in CI provider adapters
 func NewWebhookHandler(webhookService port.WebhookService, logger *logrus.Logger) *WebhookHandler {
+	return NewWebhookHandlerWithProviders(webhookService, provider.NewDefaultRegistry(""), logger)
+}
+
+// NewWebhookHandlerWithProviders creates a new webhook handler using the given CI provider adapters
+func NewWebhookHandlerWithProviders(webhookService port.WebhookService, providers port.ProviderRegistry, logger *logrus.Logger) *WebhookHandler {
 	return &WebhookHandler{
 		webhookService: webhookService,
+		providers:      providers,
 		logger:         logger,
 	}
 }
 
+// NewAsyncWebhookHandler creates a new webhook handler that stores incoming webhooks
+// and leaves their processing to the given dispatcher
+func NewAsyncWebhookHandler(webhookService port.WebhookService, providers port.ProviderRegistry, dispatcher port.WebhookDispatcher, logger *logrus.Logger) *WebhookHandler {
+	h := NewWebhookHandlerWithProviders(webhookService, providers, logger)
+	h.dispatcher = dispatcher
+	return h
+}
+
 // Error messages
 const (
 	ErrorProjectIDRequired        = "project_id is required"
 	ErrorInvalidProjectIDFormat   = "invalid project_id format"
-	ErrorMissingSignatureHeader   = "missing X-Hub-Signature-256 header"
-	ErrorMissingEventTypeHeader   = "missing X-GitHub-Event header"
 	ErrorUnsupportedEventType     = "unsupported event type: "
+	ErrorUnsupportedProvider      = "unsupported CI provider: "
 	ErrorEmptyRequestBody         = "empty request body"
 	ErrorInvalidJSONPayload       = "invalid JSON payload"
 	ErrorInvalidWebhookSignature  = "invalid webhook signature"
@@ -43,17 +60,23 @@ const (
 	ErrorInvalidEventIDFormat     = "invalid event_id format"
 	ErrorWebhookEventNotFound     = "webhook event not found"
 	ErrorFailedToGetWebhookEvents = "failed to get webhook events"
+	ErrorWebhookQueueFull         = "webhook queue is full, retry later"
 )
 
 // Success messages
 const (
 	MessageWebhookProcessedSuccessfully = "webhook processed successfully"
+	MessageWebhookQueued                = "webhook accepted for processing"
 )
 
+// RetryAfterQueueFull is the Retry-After value, in seconds, sent when the webhook queue is full
+const RetryAfterQueueFull = "30"
+
 // Log messages
 const (
 	LogFailedToProcessWebhook       = "Failed to process webhook"
 	LogWebhookProcessedSuccessfully = "Webhook processed successfully"
+	LogWebhookQueued                = "Webhook queued for processing"
 	LogFailedToGetWebhookEvents     = "Failed to get webhook events"
 	LogFailedToGetWebhookEvent      = "Failed to get webhook event"
 )
@@ -63,6 +86,12 @@ func (h *WebhookHandler) RegisterRoutes(r fiber.Router) {
 	// GitHub webhook endpoint
 	r.Post("/github/:projectId", h.ProcessGitHubWebhook)
 
+	// GitLab webhook endpoint
+	r.Post("/gitlab/:projectId", h.ProcessGitLabWebhook)
+
+	// Webhook endpoint for any other registered CI provider
+	r.Post("/:provider/:projectId", h.ProcessProviderWebhook)
+
 	// Webhook events endpoints
 	r.Get("/events/:projectId", h.GetWebhookEvents)
 	r.Get("/events/:projectId/:eventId", h.GetWebhookEvent)
@@ -70,127 +99,7 @@ func (h *WebhookHandler) RegisterRoutes(r fiber.Router) {
 
 // ProcessGitHubWebhook handles incoming GitHub webhook requests
 func (h *WebhookHandler) ProcessGitHubWebhook(c *fiber.Ctx) error {
-	// Extract project ID from URL parameters
-	projectIDStr := c.Params("projectId")
-	if projectIDStr == "" {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": ErrorProjectIDRequired,
-		})
-	}
-
-	projectID, err := value_objects.NewIDFromString(projectIDStr)
-	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": ErrorInvalidProjectIDFormat,
-		})
-	}
-
-	// Extract signature from headers
-	signature := c.Get("X-Hub-Signature-256")
-	if signature == "" {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"error": ErrorMissingSignatureHeader,
-		})
-	}
-
-	// Extract event type from headers
-	eventTypeStr := c.Get("X-GitHub-Event")
-	if eventTypeStr == "" {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": ErrorMissingEventTypeHeader,
-		})
-	}
-
-	// Validate and convert event type
-	eventType := domain.WebhookEventType(eventTypeStr)
-	if !h.isValidEventType(eventType) {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": ErrorUnsupportedEventType + eventTypeStr,
-		})
-	}
-
-	// Extract delivery ID (optional but recommended for idempotency)
-	deliveryID := c.Get("X-GitHub-Delivery")
-
-	// Get request body
-	body := c.Body()
-	if len(body) == 0 {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": ErrorEmptyRequestBody,
-		})
-	}
-
-	// Parse payload
-	var payload dto.GitHubActionsPayload
-	if err := json.Unmarshal(body, &payload); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": ErrorInvalidJSONPayload,
-		})
-	}
-
-	// Create process webhook request
-	processReq := dto.ProcessWebhookRequest{
-		ProjectID:  projectID,
-		EventType:  eventType,
-		Signature:  signature,
-		DeliveryID: deliveryID,
-		Body:       body,
-		Payload:    payload,
-	}
-
-	// Process webhook
-	webhookEvent, err := h.webhookService.ProcessWebhook(c.Context(), processReq)
-	if err != nil {
-		h.logger.Error(LogFailedToProcessWebhook, map[string]interface{}{
-			"project_id":  projectIDStr,
-			"event_type":  eventTypeStr,
-			"delivery_id": deliveryID,
-			"error":       err.Error(),
-		})
-
-		// Handle different error types
-		switch err.(type) {
-		case interface{ Code() string }:
-			domainErr := err.(interface{ Code() string })
-			switch domainErr.Code() {
-			case domain.WebhookErrInvalidSignature:
-				return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-					"error": ErrorInvalidWebhookSignature,
-				})
-			case domain.WebhookErrProjectNotFound:
-				return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
-					"error": ErrorProjectNotFound,
-				})
-			case domain.WebhookErrInvalidPayload:
-				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-					"error": ErrorInvalidWebhookPayload,
-				})
-			default:
-				return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-					"error": ErrorInternalServerError,
-				})
-			}
-		default:
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-				"error": ErrorInternalServerError,
-			})
-		}
-	}
-
-	// Log successful processing
-	h.logger.Info(LogWebhookProcessedSuccessfully, map[string]interface{}{
-		"project_id":       projectIDStr,
-		"event_type":       eventTypeStr,
-		"delivery_id":      deliveryID,
-		"webhook_event_id": webhookEvent.ID().String(),
-	})
-
-	// Return response
-	response := dto.ToWebhookEventResponse(webhookEvent)
-	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
-		"message": MessageWebhookProcessedSuccessfully,
-		"data":    response,
-	})
+	return h.processProviderWebhook(c, domain.ProviderGitHub)
 }
 
 // GetWebhookEvents retrieves webhook events for a project
@@ -288,12 +197,41 @@ func (h *WebhookHandler) GetWebhookEvent(c *fiber.Ctx) error {
 	})
 }
 
-// isValidEventType checks if the event type is supported
-func (h *WebhookHandler) isValidEventType(eventType domain.WebhookEventType) bool {
-	switch eventType {
-	case domain.WorkflowRunEvent, domain.PushEvent, domain.PullRequestEvent:
-		return true
+// respondWithProcessError maps webhook processing errors to HTTP responses
+func (h *WebhookHandler) respondWithProcessError(c *fiber.Ctx, err error) error {
+	// Handle domain errors by code
+	var domainErr exception.DomainError
+	if !errors.As(err, &domainErr) {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": ErrorInternalServerError,
+		})
+	}
+
+	switch domainErr.Code {
+	case domain.WebhookErrInvalidSignature:
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": ErrorInvalidWebhookSignature,
+		})
+	case domain.WebhookErrProjectNotFound:
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": ErrorProjectNotFound,
+		})
+	case domain.WebhookErrInvalidPayload:
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidWebhookPayload,
+		})
+	case domain.WebhookErrUnknownProvider:
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": domainErr.Message,
+		})
+	case domain.WebhookErrQueueFull:
+		c.Set(fiber.HeaderRetryAfter, RetryAfterQueueFull)
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": ErrorWebhookQueueFull,
+		})
 	default:
-		return false
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": ErrorInternalServerError,
+		})
 	}
 }
@@ -0,0 +1,168 @@
+package webhook
+
+import (
+	"errors"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ProcessGitLabWebhook handles incoming GitLab webhook requests
+func (h *WebhookHandler) ProcessGitLabWebhook(c *fiber.Ctx) error {
+	return h.processProviderWebhook(c, domain.ProviderGitLab)
+}
+
+// ProcessProviderWebhook handles incoming webhook requests for the CI provider named in the URL
+func (h *WebhookHandler) ProcessProviderWebhook(c *fiber.Ctx) error {
+	return h.processProviderWebhook(c, domain.Provider(c.Params("provider")))
+}
+
+// processProviderWebhook parses, validates and processes a webhook through its CI provider adapter
+func (h *WebhookHandler) processProviderWebhook(c *fiber.Ctx, providerName domain.Provider) error {
+	ciProvider, err := h.providers.Get(providerName)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": ErrorUnsupportedProvider + string(providerName),
+		})
+	}
+
+	// Extract project ID from URL parameters
+	projectIDStr := c.Params("projectId")
+	if projectIDStr == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorProjectIDRequired,
+		})
+	}
+
+	projectID, err := value_objects.NewIDFromString(projectIDStr)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidProjectIDFormat,
+		})
+	}
+
+	// Extract signature, event type and delivery ID from headers
+	headers, err := ciProvider.ParseHeaders(func(key string) string { return c.Get(key) })
+	if err != nil {
+		return h.respondWithHeaderError(c, headers, err)
+	}
+
+	// Get request body
+	body := c.Body()
+	if len(body) == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorEmptyRequestBody,
+		})
+	}
+
+	// Parse payload
+	payload, err := ciProvider.DecodePayload(headers.EventType, body)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidJSONPayload,
+		})
+	}
+
+	// Create process webhook request
+	processReq := dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		Provider:   ciProvider.Name(),
+		EventType:  headers.EventType,
+		Signature:  headers.Signature,
+		DeliveryID: headers.DeliveryID,
+		Body:       body,
+		Payload:    payload,
+	}
+
+	if h.dispatcher != nil {
+		return h.acceptWebhook(c, processReq, headers)
+	}
+
+	// Process webhook
+	webhookEvent, err := h.webhookService.ProcessWebhook(c.Context(), processReq)
+	if err != nil {
+		h.logFailedWebhook(processReq, headers, err)
+		return h.respondWithProcessError(c, err)
+	}
+
+	// Log successful processing
+	h.logger.Info(LogWebhookProcessedSuccessfully, map[string]interface{}{
+		"provider":         processReq.Provider,
+		"project_id":       processReq.ProjectID.String(),
+		"event_type":       headers.EventName,
+		"delivery_id":      headers.DeliveryID,
+		"webhook_event_id": webhookEvent.ID().String(),
+	})
+
+	// Return response
+	response := dto.ToWebhookEventResponse(webhookEvent)
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"message": MessageWebhookProcessedSuccessfully,
+		"data":    response,
+	})
+}
+
+// acceptWebhook stores a webhook and hands it to the dispatcher, responding before it is processed
+func (h *WebhookHandler) acceptWebhook(c *fiber.Ctx, processReq dto.ProcessWebhookRequest, headers dto.WebhookHeaders) error {
+	webhookEvent, err := h.webhookService.AcceptWebhook(c.Context(), processReq)
+	if err == nil {
+		err = h.dispatcher.Dispatch(webhookEvent)
+	}
+	if err != nil {
+		h.logFailedWebhook(processReq, headers, err)
+		return h.respondWithProcessError(c, err)
+	}
+
+	h.logger.Info(LogWebhookQueued, map[string]interface{}{
+		"provider":         processReq.Provider,
+		"project_id":       processReq.ProjectID.String(),
+		"event_type":       headers.EventName,
+		"delivery_id":      headers.DeliveryID,
+		"webhook_event_id": webhookEvent.ID().String(),
+	})
+
+	response := dto.ToWebhookEventResponse(webhookEvent)
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"message": MessageWebhookQueued,
+		"data":    response,
+	})
+}
+
+// logFailedWebhook logs a webhook that could not be stored or processed
+func (h *WebhookHandler) logFailedWebhook(processReq dto.ProcessWebhookRequest, headers dto.WebhookHeaders, err error) {
+	h.logger.Error(LogFailedToProcessWebhook, map[string]interface{}{
+		"provider":    processReq.Provider,
+		"project_id":  processReq.ProjectID.String(),
+		"event_type":  headers.EventName,
+		"delivery_id": headers.DeliveryID,
+		"error":       err.Error(),
+	})
+}
+
+// respondWithHeaderError maps header validation errors from a CI provider adapter to HTTP responses
+func (h *WebhookHandler) respondWithHeaderError(c *fiber.Ctx, headers dto.WebhookHeaders, err error) error {
+	var domainErr exception.DomainError
+	if !errors.As(err, &domainErr) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	switch domainErr.Code {
+	case domain.WebhookErrMissingSignature:
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": domainErr.Message,
+		})
+	case domain.WebhookErrInvalidEvent:
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorUnsupportedEventType + headers.EventName,
+		})
+	default:
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": domainErr.Message,
+		})
+	}
+}
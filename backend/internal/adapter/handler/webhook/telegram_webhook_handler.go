@@ -1,6 +1,7 @@
 package webhook
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"strings"
@@ -43,6 +44,13 @@ func (h *TelegramWebhookHandler) HandleTelegramWebhook(c *fiber.Ctx) error {
 		}
 	}
 
+	// Handle inline keyboard buttons
+	if update.CallbackQuery != nil {
+		if err := h.handleCallback(update.CallbackQuery); err != nil {
+			log.Printf("Error handling callback query: %v", err)
+		}
+	}
+
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"status": "ok",
 	})
@@ -67,3 +75,21 @@ func (h *TelegramWebhookHandler) handleCommand(msg *tgbotapi.Message) error {
 	// Handle command through bot service
 	return h.botService.HandleCommand(nil, ctx)
 }
+
+// handleCallback processes presses of inline keyboard buttons
+func (h *TelegramWebhookHandler) handleCallback(query *tgbotapi.CallbackQuery) error {
+	ctx := &domain.CallbackContext{
+		QueryID:  query.ID,
+		Data:     query.Data,
+		UserID:   query.From.ID,
+		Username: query.From.UserName,
+	}
+	if query.Message != nil {
+		ctx.ChatID = query.Message.Chat.ID
+		ctx.MessageID = query.Message.MessageID
+		ctx.MessageText = query.Message.Text
+	}
+
+	// Handle callback through bot service, which authorizes it against the chat
+	return h.botService.HandleCallback(context.Background(), ctx)
+}
@@ -0,0 +1,248 @@
+package webhooksubscription
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Constants for error messages and responses
+const (
+	// Error messages
+	ErrorInvalidProjectID      = "Invalid project ID"
+	ErrorInvalidSubscriptionID = "Invalid webhook subscription ID"
+	ErrorInvalidRequestBody    = "Invalid request body"
+	ErrorValidationFailed      = "Validation failed"
+	ErrorInternalServer        = "Internal server error"
+
+	// Success messages
+	MessageSubscriptionCreatedSuccessfully       = "Webhook subscription created successfully"
+	MessageSubscriptionsRetrievedSuccessfully    = "Webhook subscriptions retrieved successfully"
+	MessageSubscriptionRetrievedSuccessfully     = "Webhook subscription retrieved successfully"
+	MessageSubscriptionUpdatedSuccessfully       = "Webhook subscription updated successfully"
+	MessageSubscriptionSecretRotatedSuccessfully = "Webhook subscription secret rotated successfully"
+	MessageSubscriptionDeletedSuccessfully       = "Webhook subscription deleted successfully"
+
+	// Log messages
+	LogFailedToParseRequestBody   = "Failed to parse request body"
+	LogRequestValidationFailed    = "Request validation failed"
+	LogFailedToCreateSubscription = "Failed to create webhook subscription"
+	LogFailedToListSubscriptions  = "Failed to list webhook subscriptions"
+	LogFailedToGetSubscription    = "Failed to get webhook subscription"
+	LogFailedToUpdateSubscription = "Failed to update webhook subscription"
+	LogFailedToRotateSecret       = "Failed to rotate webhook subscription secret"
+	LogFailedToDeleteSubscription = "Failed to delete webhook subscription"
+)
+
+// HTTP Routing registerer
+func (h *Handler) RegisterRoutes(r fiber.Router) {
+	r.Post("/projects/:projectId/webhook-subscriptions", h.CreateWebhookSubscription)
+	r.Get("/projects/:projectId/webhook-subscriptions", h.ListWebhookSubscriptions)
+
+	subscriptions := r.Group("/webhook-subscriptions")
+
+	subscriptions.Get("/:id", h.GetWebhookSubscription)
+	subscriptions.Put("/:id", h.UpdateWebhookSubscription)
+	subscriptions.Delete("/:id", h.DeleteWebhookSubscription)
+	subscriptions.Post("/:id/rotate-secret", h.RotateWebhookSubscriptionSecret)
+}
+
+// CreateWebhookSubscription subscribes an endpoint to the notifications of a project.
+// The response is the only place the signing secret is returned besides secret rotation.
+func (h *Handler) CreateWebhookSubscription(c *fiber.Ctx) error {
+	ctx := context.Background()
+
+	projectID, err := value_objects.NewIDFromString(c.Params("projectId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidProjectID,
+		})
+	}
+
+	var req dto.CreateWebhookSubscriptionRequest
+	if err := c.BodyParser(&req); err != nil {
+		h.Logger.WithError(err).Error(LogFailedToParseRequestBody)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidRequestBody,
+		})
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(&req); err != nil {
+		h.Logger.WithError(err).Error(LogRequestValidationFailed)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   ErrorValidationFailed,
+			"details": err.Error(),
+		})
+	}
+
+	subscription, err := h.WebhookSubscriptionService.CreateWebhookSubscription(ctx, projectID, req)
+	if err != nil {
+		h.Logger.WithError(err).WithField("project_id", projectID.String()).Error(LogFailedToCreateSubscription)
+		return h.handleError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": MessageSubscriptionCreatedSuccessfully,
+		"data":    dto.ToWebhookSubscriptionResponseWithSecret(subscription),
+	})
+}
+
+// ListWebhookSubscriptions lists the webhook subscriptions of a project
+func (h *Handler) ListWebhookSubscriptions(c *fiber.Ctx) error {
+	ctx := context.Background()
+
+	projectID, err := value_objects.NewIDFromString(c.Params("projectId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidProjectID,
+		})
+	}
+
+	subscriptions, err := h.WebhookSubscriptionService.GetWebhookSubscriptionsByProject(ctx, projectID)
+	if err != nil {
+		h.Logger.WithError(err).WithField("project_id", projectID.String()).Error(LogFailedToListSubscriptions)
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message": MessageSubscriptionsRetrievedSuccessfully,
+		"data":    dto.ToWebhookSubscriptionResponseList(subscriptions),
+	})
+}
+
+// GetWebhookSubscription retrieves a webhook subscription
+func (h *Handler) GetWebhookSubscription(c *fiber.Ctx) error {
+	ctx := context.Background()
+
+	id, err := value_objects.NewIDFromString(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidSubscriptionID,
+		})
+	}
+
+	subscription, err := h.WebhookSubscriptionService.GetWebhookSubscription(ctx, id)
+	if err != nil {
+		h.Logger.WithError(err).WithField("subscription_id", id.String()).Error(LogFailedToGetSubscription)
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message": MessageSubscriptionRetrievedSuccessfully,
+		"data":    dto.ToWebhookSubscriptionResponse(subscription),
+	})
+}
+
+// UpdateWebhookSubscription updates the endpoint, format or state of a webhook subscription
+func (h *Handler) UpdateWebhookSubscription(c *fiber.Ctx) error {
+	ctx := context.Background()
+
+	id, err := value_objects.NewIDFromString(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidSubscriptionID,
+		})
+	}
+
+	var req dto.UpdateWebhookSubscriptionRequest
+	if err := c.BodyParser(&req); err != nil {
+		h.Logger.WithError(err).Error(LogFailedToParseRequestBody)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidRequestBody,
+		})
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(&req); err != nil {
+		h.Logger.WithError(err).Error(LogRequestValidationFailed)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   ErrorValidationFailed,
+			"details": err.Error(),
+		})
+	}
+
+	subscription, err := h.WebhookSubscriptionService.UpdateWebhookSubscription(ctx, id, req)
+	if err != nil {
+		h.Logger.WithError(err).WithField("subscription_id", id.String()).Error(LogFailedToUpdateSubscription)
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message": MessageSubscriptionUpdatedSuccessfully,
+		"data":    dto.ToWebhookSubscriptionResponse(subscription),
+	})
+}
+
+// RotateWebhookSubscriptionSecret replaces the signing secret of a webhook subscription
+func (h *Handler) RotateWebhookSubscriptionSecret(c *fiber.Ctx) error {
+	ctx := context.Background()
+
+	id, err := value_objects.NewIDFromString(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidSubscriptionID,
+		})
+	}
+
+	subscription, err := h.WebhookSubscriptionService.RotateWebhookSubscriptionSecret(ctx, id)
+	if err != nil {
+		h.Logger.WithError(err).WithField("subscription_id", id.String()).Error(LogFailedToRotateSecret)
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message": MessageSubscriptionSecretRotatedSuccessfully,
+		"data":    dto.ToWebhookSubscriptionResponseWithSecret(subscription),
+	})
+}
+
+// DeleteWebhookSubscription deletes a webhook subscription
+func (h *Handler) DeleteWebhookSubscription(c *fiber.Ctx) error {
+	ctx := context.Background()
+
+	id, err := value_objects.NewIDFromString(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": ErrorInvalidSubscriptionID,
+		})
+	}
+
+	if err := h.WebhookSubscriptionService.DeleteWebhookSubscription(ctx, id); err != nil {
+		h.Logger.WithError(err).WithField("subscription_id", id.String()).Error(LogFailedToDeleteSubscription)
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message": MessageSubscriptionDeletedSuccessfully,
+	})
+}
+
+// Helper methods
+
+func (h *Handler) handleError(c *fiber.Ctx, err error) error {
+	var domainErr exception.DomainError
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code {
+		case domain.ErrCodeWebhookSubscriptionNotFound:
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": domainErr.Message,
+			})
+		case domain.ErrCodeInvalidWebhookSubscription, domain.ErrCodeInvalidProjectID,
+			domain.ErrCodeInvalidQuietHours, domain.ErrCodeInvalidSubscriptionFilter:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": domainErr.Message,
+			})
+		}
+	}
+
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error": ErrorInternalServer,
+	})
+}
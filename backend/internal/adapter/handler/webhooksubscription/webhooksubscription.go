@@ -0,0 +1,24 @@
+package webhooksubscription
+
+import (
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	"github.com/sirupsen/logrus"
+)
+
+// WebhookSubscriptionHandlerDep represents the dependencies of the webhook subscription HTTP handler
+type WebhookSubscriptionHandlerDep struct {
+	WebhookSubscriptionService port.WebhookSubscriptionService
+	Logger                     *logrus.Logger
+}
+
+// Handler struct for organizing handler dependencies
+type Handler struct {
+	WebhookSubscriptionHandlerDep
+}
+
+// NewWebhookSubscriptionHandler creates a new webhook subscription handler instance
+func NewWebhookSubscriptionHandler(d WebhookSubscriptionHandlerDep) *Handler {
+	return &Handler{
+		WebhookSubscriptionHandlerDep: d,
+	}
+}
@@ -2,7 +2,6 @@ package memory
 
 import (
 	"context"
-	"errors"
 	"sort"
 	"sync"
 	"time"
@@ -90,7 +89,39 @@ func (r *inMemoryDeliveryQueueRepository) GetPendingByPriority(ctx context.Conte
 	return pending, nil
 }
 
-func (r *inMemoryDeliveryQueueRepository) GetFailedNotifications(ctx context.Context, limit int) ([]*domain.QueuedNotification, error) {
+func (r *inMemoryDeliveryQueueRepository) ClaimPending(ctx context.Context, kind domain.QueueKind, limit int, visibilityTimeout time.Duration) ([]*domain.QueuedNotification, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := time.Now()
+	var claimable []*domain.QueuedNotification
+
+	for _, notification := range r.notifications {
+		if notification.Kind == kind && notification.IsClaimable(now) {
+			claimable = append(claimable, notification)
+		}
+	}
+
+	// Sort by priority (higher priority first) and schedule time
+	sort.Slice(claimable, func(i, j int) bool {
+		if claimable[i].Priority == claimable[j].Priority {
+			return claimable[i].ScheduledAt.Before(claimable[j].ScheduledAt)
+		}
+		return claimable[i].Priority > claimable[j].Priority
+	})
+
+	if len(claimable) > limit {
+		claimable = claimable[:limit]
+	}
+
+	for _, notification := range claimable {
+		notification.Claim(visibilityTimeout)
+	}
+
+	return claimable, nil
+}
+
+func (r *inMemoryDeliveryQueueRepository) GetFailedNotifications(ctx context.Context, kind domain.QueueKind, limit int) ([]*domain.QueuedNotification, error) {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
@@ -98,7 +129,7 @@ func (r *inMemoryDeliveryQueueRepository) GetFailedNotifications(ctx context.Con
 	count := 0
 
 	for _, notification := range r.notifications {
-		if (notification.Status == domain.DeliveryStatusFailed || notification.Status == domain.DeliveryStatusRetrying) &&
+		if notification.Kind == kind && (notification.Status == domain.DeliveryStatusFailed || notification.Status == domain.DeliveryStatusRetrying) &&
 			notification.IsRetryable() && count < limit {
 			failed = append(failed, notification)
 			count++
@@ -132,6 +163,7 @@ func (r *inMemoryDeliveryQueueRepository) UpdateStatus(ctx context.Context, id v
 
 	notification.Status = status
 	notification.LastError = errorMessage
+	notification.LockedUntil = nil
 	notification.UpdatedAt = time.Now()
 
 	if status == domain.DeliveryStatusFailed {
@@ -195,4 +227,4 @@ func (r *inMemoryDeliveryQueueRepository) GetQueueStats(ctx context.Context) (ma
 	return stats, nil
 }
 
-var ErrNotificationNotFound = errors.New("notification not found")
+var ErrNotificationNotFound = domain.ErrQueuedNotificationNotFound
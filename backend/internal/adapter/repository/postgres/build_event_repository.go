@@ -36,6 +36,9 @@ func (r *buildEventRepositoryImpl) Create(ctx context.Context, buildEvent *domai
 	model.FromEntity(buildEvent)
 
 	if err := r.db.WithContext(ctx).Create(&model).Error; err != nil {
+		if buildEvent.RunID() != "" && isUniqueConstraintError(err) {
+			return exception.ErrBuildEventRunExists
+		}
 		return err
 	}
 
@@ -55,6 +58,23 @@ func (r *buildEventRepositoryImpl) GetByID(ctx context.Context, id value_objects
 	return model.ToEntity(), nil
 }
 
+// GetByRunID retrieves the build event tracking a CI provider run within a project
+func (r *buildEventRepositoryImpl) GetByRunID(ctx context.Context, projectID value_objects.ID, runID string) (*domain.BuildEvent, error) {
+	var model domain.BuildEventModel
+	if err := r.db.WithContext(ctx).
+		Where(queryByProjectID, projectID.Value()).
+		Where(queryByRunID, runID).
+		Order(orderByCreatedAtDesc).
+		First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrBuildEventNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
+
 // GetByProjectID retrieves build events for a specific project
 func (r *buildEventRepositoryImpl) GetByProjectID(ctx context.Context, projectID value_objects.ID, filters dto.ListBuildEventFilters) ([]*domain.BuildEvent, error) {
 	query := r.db.WithContext(ctx).Where(queryByProjectID, projectID.Value())
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
+	"gorm.io/gorm"
+)
+
+// buildJobRepositoryImpl implements the BuildJobRepository interface
+type buildJobRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewBuildJobRepository creates a new build job repository
+func NewBuildJobRepository(db *gorm.DB) port.BuildJobRepository {
+	if db == nil {
+		panic("database connection cannot be nil")
+	}
+	return &buildJobRepositoryImpl{db: db}
+}
+
+// Create stores a new build job
+func (r *buildJobRepositoryImpl) Create(ctx context.Context, job *domain.BuildJob) error {
+	if job == nil {
+		return errors.New("build job cannot be nil")
+	}
+
+	var model domain.BuildJobModel
+	model.FromEntity(job)
+
+	return r.db.WithContext(ctx).Omit("BuildEvent").Create(&model).Error
+}
+
+// Update updates an existing build job
+func (r *buildJobRepositoryImpl) Update(ctx context.Context, job *domain.BuildJob) error {
+	if job == nil {
+		return errors.New("build job cannot be nil")
+	}
+
+	var model domain.BuildJobModel
+	model.FromEntity(job)
+
+	result := r.db.WithContext(ctx).
+		Omit("BuildEvent").
+		Where(queryByID, job.ID().Value()).
+		Updates(&model)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return exception.ErrBuildJobNotFound
+	}
+
+	return nil
+}
+
+// GetByJobID retrieves a job of a build event by its CI provider job identifier
+func (r *buildJobRepositoryImpl) GetByJobID(ctx context.Context, buildEventID value_objects.ID, jobID string) (*domain.BuildJob, error) {
+	var model domain.BuildJobModel
+	if err := r.db.WithContext(ctx).
+		Where(queryByBuildEventID, buildEventID.Value()).
+		Where(queryByJobID, jobID).
+		First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, exception.ErrBuildJobNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToEntity(), nil
+}
+
+// GetByBuildEventID retrieves the jobs of a build event in creation order
+func (r *buildJobRepositoryImpl) GetByBuildEventID(ctx context.Context, buildEventID value_objects.ID) ([]*domain.BuildJob, error) {
+	var models []domain.BuildJobModel
+	if err := r.db.WithContext(ctx).
+		Where(queryByBuildEventID, buildEventID.Value()).
+		Order("created_at ASC").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*domain.BuildJob, len(models))
+	for i, model := range models {
+		jobs[i] = model.ToEntity()
+	}
+	return jobs, nil
+}
@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"gorm.io/gorm"
+)
+
+// buildStatusTransitionRepositoryImpl implements the BuildStatusTransitionRepository interface
+type buildStatusTransitionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewBuildStatusTransitionRepository creates a new build status transition repository
+func NewBuildStatusTransitionRepository(db *gorm.DB) port.BuildStatusTransitionRepository {
+	if db == nil {
+		panic("database connection cannot be nil")
+	}
+	return &buildStatusTransitionRepositoryImpl{db: db}
+}
+
+// Create stores a new status transition
+func (r *buildStatusTransitionRepositoryImpl) Create(ctx context.Context, transition *domain.BuildStatusTransition) error {
+	if transition == nil {
+		return errors.New("build status transition cannot be nil")
+	}
+
+	var model domain.BuildStatusTransitionModel
+	model.FromEntity(transition)
+
+	return r.db.WithContext(ctx).Omit("BuildEvent").Create(&model).Error
+}
+
+// GetByBuildEventID retrieves the transitions of a build event in chronological order
+func (r *buildStatusTransitionRepositoryImpl) GetByBuildEventID(ctx context.Context, buildEventID value_objects.ID) ([]*domain.BuildStatusTransition, error) {
+	var models []domain.BuildStatusTransitionModel
+	if err := r.db.WithContext(ctx).
+		Where(queryByBuildEventID, buildEventID.Value()).
+		Order("occurred_at ASC").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	transitions := make([]*domain.BuildStatusTransition, len(models))
+	for i, model := range models {
+		transitions[i] = model.ToEntity()
+	}
+	return transitions, nil
+}
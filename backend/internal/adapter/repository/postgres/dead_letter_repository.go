@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"gorm.io/gorm"
+)
+
+// DeadLetterRepository implements the dead-letter repository interface
+type DeadLetterRepository struct {
+	db *gorm.DB
+}
+
+// NewDeadLetterRepository creates a new Postgres-backed dead-letter repository
+func NewDeadLetterRepository(db *gorm.DB) port.DeadLetterRepository {
+	return &DeadLetterRepository{
+		db: db,
+	}
+}
+
+// Create stores a notification moved to the dead-letter queue
+func (r *DeadLetterRepository) Create(ctx context.Context, deadLetter *domain.DeadLetterNotification) error {
+	model := &domain.DeadLetterModel{}
+	model.FromEntity(deadLetter)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return fmt.Errorf("failed to create dead-lettered notification: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a dead-lettered notification by ID
+func (r *DeadLetterRepository) GetByID(ctx context.Context, id value_objects.ID) (*domain.DeadLetterNotification, error) {
+	var model domain.DeadLetterModel
+
+	err := r.db.WithContext(ctx).Where(queryByID, id.Value()).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrDeadLetterNotFound
+		}
+		return nil, fmt.Errorf("failed to get dead-lettered notification: %w", err)
+	}
+
+	return model.ToEntity(), nil
+}
+
+// List retrieves dead-lettered notifications, newest first
+func (r *DeadLetterRepository) List(ctx context.Context, filters dto.ListDeadLetterFilters) ([]*domain.DeadLetterNotification, error) {
+	var models []domain.DeadLetterModel
+
+	query := r.applyFilters(r.db.WithContext(ctx), filters).Order(orderByCreatedAtDesc)
+	if filters.Limit != nil {
+		query = query.Limit(*filters.Limit)
+	}
+	if filters.Offset != nil {
+		query = query.Offset(*filters.Offset)
+	}
+
+	if err := query.Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list dead-lettered notifications: %w", err)
+	}
+
+	deadLetters := make([]*domain.DeadLetterNotification, len(models))
+	for i := range models {
+		deadLetters[i] = models[i].ToEntity()
+	}
+
+	return deadLetters, nil
+}
+
+// Count returns the number of dead-lettered notifications matching the filters
+func (r *DeadLetterRepository) Count(ctx context.Context, filters dto.ListDeadLetterFilters) (int64, error) {
+	var count int64
+
+	query := r.applyFilters(r.db.WithContext(ctx).Model(&domain.DeadLetterModel{}), filters)
+	if err := query.Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count dead-lettered notifications: %w", err)
+	}
+
+	return count, nil
+}
+
+// Update saves changes to a dead-lettered notification
+func (r *DeadLetterRepository) Update(ctx context.Context, deadLetter *domain.DeadLetterNotification) error {
+	model := &domain.DeadLetterModel{}
+	model.FromEntity(deadLetter)
+	model.UpdatedAt = time.Now()
+
+	result := r.db.WithContext(ctx).
+		Model(&domain.DeadLetterModel{}).
+		Where(queryByID, model.ID).
+		Select("*").
+		Omit("id", "created_at").
+		Updates(model)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update dead-lettered notification: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrDeadLetterNotFound
+	}
+
+	return nil
+}
+
+// applyFilters applies the listing filters to the query
+func (r *DeadLetterRepository) applyFilters(query *gorm.DB, filters dto.ListDeadLetterFilters) *gorm.DB {
+	if filters.Status != nil {
+		query = query.Where(queryByStatus, string(*filters.Status))
+	}
+
+	if filters.Channel != nil {
+		query = query.Where(queryByChannel, string(*filters.Channel))
+	}
+
+	return query
+}
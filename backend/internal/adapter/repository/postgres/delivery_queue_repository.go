@@ -0,0 +1,283 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"gorm.io/gorm"
+)
+
+// Delivery queue query constants
+const (
+	queryDueForDelivery  = "status = ? AND scheduled_at <= ?"
+	queryRetryable       = "kind = ? AND status IN ? AND attempt_count < max_attempts"
+	queryUpdatedAtBefore = "updated_at < ?"
+	orderByPriorityDesc  = "priority DESC, created_at ASC"
+	orderByCreatedAtAsc  = "created_at ASC"
+)
+
+// claimPendingQuery claims due notifications of one kind for a worker. Rows locked by a
+// concurrent claim are skipped, so several bot instances can share one queue.
+// Processing rows whose lock expired or was never set belong to a worker that
+// died mid-delivery and are claimed again.
+const claimPendingQuery = `
+WITH claimable AS (
+	SELECT id FROM notification_delivery_queue
+	WHERE kind = ?
+	  AND ((status IN ('pending', 'retrying') AND scheduled_at <= NOW())
+	   OR (status = 'processing' AND (locked_until < NOW() OR locked_until IS NULL)))
+	ORDER BY priority DESC, scheduled_at ASC
+	LIMIT ?
+	FOR UPDATE SKIP LOCKED
+)
+UPDATE notification_delivery_queue AS q
+SET status = 'processing',
+	locked_until = NOW() + (? * INTERVAL '1 millisecond'),
+	updated_at = NOW()
+FROM claimable
+WHERE q.id = claimable.id
+RETURNING q.*`
+
+// DeliveryQueueRepository implements the delivery queue repository interface
+type DeliveryQueueRepository struct {
+	db *gorm.DB
+}
+
+// NewDeliveryQueueRepository creates a new Postgres-backed delivery queue repository
+func NewDeliveryQueueRepository(db *gorm.DB) port.DeliveryQueueRepository {
+	return &DeliveryQueueRepository{
+		db: db,
+	}
+}
+
+// Create saves a new queued notification
+func (r *DeliveryQueueRepository) Create(ctx context.Context, notification *domain.QueuedNotification) error {
+	model := &domain.DeliveryQueueModel{}
+	model.FromEntity(notification)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return fmt.Errorf("failed to create queued notification: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a queued notification by ID
+func (r *DeliveryQueueRepository) GetByID(ctx context.Context, id value_objects.ID) (*domain.QueuedNotification, error) {
+	var model domain.DeliveryQueueModel
+
+	err := r.db.WithContext(ctx).Where(queryByID, id.Value()).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrQueuedNotificationNotFound
+		}
+		return nil, fmt.Errorf("failed to get queued notification: %w", err)
+	}
+
+	return model.ToEntity(), nil
+}
+
+// GetPendingNotifications retrieves pending notifications ready for processing
+func (r *DeliveryQueueRepository) GetPendingNotifications(ctx context.Context, limit int) ([]*domain.QueuedNotification, error) {
+	var models []domain.DeliveryQueueModel
+
+	err := r.db.WithContext(ctx).
+		Where(queryByStatus, string(domain.DeliveryStatusPending)).
+		Order(orderByCreatedAtAsc).
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending notifications: %w", err)
+	}
+
+	return toQueuedNotifications(models), nil
+}
+
+// GetPendingByPriority retrieves due pending notifications ordered by priority
+func (r *DeliveryQueueRepository) GetPendingByPriority(ctx context.Context, limit int) ([]*domain.QueuedNotification, error) {
+	var models []domain.DeliveryQueueModel
+
+	err := r.db.WithContext(ctx).
+		Where(queryDueForDelivery, string(domain.DeliveryStatusPending), time.Now()).
+		Order(orderByPriorityDesc).
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending notifications by priority: %w", err)
+	}
+
+	return toQueuedNotifications(models), nil
+}
+
+// ClaimPending atomically marks up to limit due notifications of the kind as processing and returns them
+func (r *DeliveryQueueRepository) ClaimPending(ctx context.Context, kind domain.QueueKind, limit int, visibilityTimeout time.Duration) ([]*domain.QueuedNotification, error) {
+	var models []domain.DeliveryQueueModel
+
+	err := r.db.WithContext(ctx).
+		Raw(claimPendingQuery, string(kind), limit, visibilityTimeout.Milliseconds()).
+		Scan(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim pending notifications: %w", err)
+	}
+
+	// RETURNING does not keep the claim order
+	sort.SliceStable(models, func(i, j int) bool {
+		if models[i].Priority == models[j].Priority {
+			return models[i].ScheduledAt.Before(models[j].ScheduledAt)
+		}
+		return models[i].Priority > models[j].Priority
+	})
+
+	return toQueuedNotifications(models), nil
+}
+
+// GetFailedNotifications retrieves failed notifications of the kind that can be retried
+func (r *DeliveryQueueRepository) GetFailedNotifications(ctx context.Context, kind domain.QueueKind, limit int) ([]*domain.QueuedNotification, error) {
+	var models []domain.DeliveryQueueModel
+
+	statuses := []string{string(domain.DeliveryStatusFailed), string(domain.DeliveryStatusRetrying)}
+	err := r.db.WithContext(ctx).
+		Where(queryRetryable, string(kind), statuses).
+		Order(orderByCreatedAtAsc).
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get failed notifications: %w", err)
+	}
+
+	return toQueuedNotifications(models), nil
+}
+
+// Update saves changes to an existing queued notification
+func (r *DeliveryQueueRepository) Update(ctx context.Context, notification *domain.QueuedNotification) error {
+	notification.UpdatedAt = time.Now()
+
+	model := &domain.DeliveryQueueModel{}
+	model.FromEntity(notification)
+
+	// Select all columns so cleared fields such as last_error and locked_until are written
+	result := r.db.WithContext(ctx).
+		Model(&domain.DeliveryQueueModel{}).
+		Where(queryByID, model.ID).
+		Select("*").
+		Omit("id", "created_at").
+		Updates(model)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update queued notification: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrQueuedNotificationNotFound
+	}
+
+	return nil
+}
+
+// UpdateStatus updates only the status and error message of a notification,
+// releasing its claim. Failed deliveries count as an attempt.
+func (r *DeliveryQueueRepository) UpdateStatus(ctx context.Context, id value_objects.ID, status domain.DeliveryStatus, errorMessage string) error {
+	updates := map[string]interface{}{
+		"status":       string(status),
+		"last_error":   errorMessage,
+		"locked_until": nil,
+		"updated_at":   time.Now(),
+	}
+	if status == domain.DeliveryStatusFailed {
+		updates["attempt_count"] = gorm.Expr("attempt_count + 1")
+	}
+
+	result := r.db.WithContext(ctx).
+		Model(&domain.DeliveryQueueModel{}).
+		Where(queryByID, id.Value()).
+		Updates(updates)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update queued notification status: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrQueuedNotificationNotFound
+	}
+
+	return nil
+}
+
+// Delete removes a queued notification
+func (r *DeliveryQueueRepository) Delete(ctx context.Context, id value_objects.ID) error {
+	result := r.db.WithContext(ctx).Where(queryByID, id.Value()).Delete(&domain.DeliveryQueueModel{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete queued notification: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrQueuedNotificationNotFound
+	}
+
+	return nil
+}
+
+// DeleteProcessedNotifications removes successfully delivered notifications older than specified duration
+func (r *DeliveryQueueRepository) DeleteProcessedNotifications(ctx context.Context, olderThan time.Duration) error {
+	err := r.db.WithContext(ctx).
+		Where(queryByStatus, string(domain.DeliveryStatusDelivered)).
+		Where(queryUpdatedAtBefore, time.Now().Add(-olderThan)).
+		Delete(&domain.DeliveryQueueModel{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete processed notifications: %w", err)
+	}
+
+	return nil
+}
+
+// GetPendingCount returns the count of pending notifications
+func (r *DeliveryQueueRepository) GetPendingCount(ctx context.Context) (int64, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).
+		Model(&domain.DeliveryQueueModel{}).
+		Where(queryByStatus, string(domain.DeliveryStatusPending)).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count pending notifications: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetQueueStats returns queue statistics by status
+func (r *DeliveryQueueRepository) GetQueueStats(ctx context.Context) (map[string]int64, error) {
+	var rows []struct {
+		Status string
+		Count  int64
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&domain.DeliveryQueueModel{}).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get queue stats: %w", err)
+	}
+
+	stats := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		stats[row.Status] = row.Count
+	}
+
+	return stats, nil
+}
+
+// toQueuedNotifications converts delivery queue models to domain entities
+func toQueuedNotifications(models []domain.DeliveryQueueModel) []*domain.QueuedNotification {
+	notifications := make([]*domain.QueuedNotification, len(models))
+	for i := range models {
+		notifications[i] = models[i].ToEntity()
+	}
+	return notifications
+}
@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"gorm.io/gorm"
+)
+
+// EscalationPolicyRepository implements the escalation policy repository interface
+type EscalationPolicyRepository struct {
+	db *gorm.DB
+}
+
+// NewEscalationPolicyRepository creates a new Postgres-backed escalation policy repository
+func NewEscalationPolicyRepository(db *gorm.DB) port.EscalationPolicyRepository {
+	return &EscalationPolicyRepository{
+		db: db,
+	}
+}
+
+// Create creates a new escalation policy
+func (r *EscalationPolicyRepository) Create(ctx context.Context, policy *domain.EscalationPolicy) error {
+	model := &domain.EscalationPolicyModel{}
+	model.FromEntity(policy)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return fmt.Errorf("failed to create escalation policy: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves an escalation policy by its ID
+func (r *EscalationPolicyRepository) GetByID(ctx context.Context, id value_objects.ID) (*domain.EscalationPolicy, error) {
+	var model domain.EscalationPolicyModel
+
+	err := r.db.WithContext(ctx).Where(queryByID, id.Value()).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrEscalationPolicyNotFound
+		}
+		return nil, fmt.Errorf("failed to get escalation policy: %w", err)
+	}
+
+	return model.ToEntity(), nil
+}
+
+// GetByProjectID retrieves the escalation policies of a project, oldest first
+func (r *EscalationPolicyRepository) GetByProjectID(ctx context.Context, projectID value_objects.ID) ([]*domain.EscalationPolicy, error) {
+	return r.find(r.db.WithContext(ctx).Where(queryByProjectID, projectID.Value()))
+}
+
+// GetActive retrieves a page of the active escalation policies of all projects, oldest first
+func (r *EscalationPolicyRepository) GetActive(ctx context.Context, limit, offset int) ([]*domain.EscalationPolicy, error) {
+	return r.find(r.db.WithContext(ctx).Where(queryByIsActive, true).Limit(limit).Offset(offset))
+}
+
+// Update updates an existing escalation policy
+func (r *EscalationPolicyRepository) Update(ctx context.Context, policy *domain.EscalationPolicy) error {
+	model := &domain.EscalationPolicyModel{}
+	model.FromEntity(policy)
+	model.UpdatedAt = time.Now()
+
+	result := r.db.WithContext(ctx).
+		Model(&domain.EscalationPolicyModel{}).
+		Where(queryByID, model.ID).
+		Select("*").
+		Omit("id", "project_id", "created_at").
+		Updates(model)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update escalation policy: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrEscalationPolicyNotFound
+	}
+
+	return nil
+}
+
+// Delete deletes an escalation policy by its ID, its escalations are removed by the foreign key
+func (r *EscalationPolicyRepository) Delete(ctx context.Context, id value_objects.ID) error {
+	result := r.db.WithContext(ctx).Where(queryByID, id.Value()).Delete(&domain.EscalationPolicyModel{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete escalation policy: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrEscalationPolicyNotFound
+	}
+
+	return nil
+}
+
+// find lists the policies matching a query
+func (r *EscalationPolicyRepository) find(query *gorm.DB) ([]*domain.EscalationPolicy, error) {
+	var models []domain.EscalationPolicyModel
+
+	if err := query.Order(orderByCreatedAtAsc).Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list escalation policies: %w", err)
+	}
+
+	policies := make([]*domain.EscalationPolicy, len(models))
+	for i := range models {
+		policies[i] = models[i].ToEntity()
+	}
+
+	return policies, nil
+}
@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"gorm.io/gorm"
+)
+
+const (
+	queryByPolicyID   = "policy_id = ?"
+	queryIsUnresolved = "resolved_at IS NULL"
+)
+
+// EscalationRepository implements the escalation repository interface
+type EscalationRepository struct {
+	db *gorm.DB
+}
+
+// NewEscalationRepository creates a new Postgres-backed escalation repository
+func NewEscalationRepository(db *gorm.DB) port.EscalationRepository {
+	return &EscalationRepository{
+		db: db,
+	}
+}
+
+// Create creates a new escalation
+func (r *EscalationRepository) Create(ctx context.Context, escalation *domain.Escalation) error {
+	model := &domain.EscalationModel{}
+	model.FromEntity(escalation)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return fmt.Errorf("failed to create escalation: %w", err)
+	}
+
+	return nil
+}
+
+// GetOpenByPolicyID retrieves the unresolved escalation of a policy
+func (r *EscalationRepository) GetOpenByPolicyID(ctx context.Context, policyID value_objects.ID) (*domain.Escalation, error) {
+	var model domain.EscalationModel
+
+	err := r.db.WithContext(ctx).
+		Where(queryByPolicyID, policyID.Value()).
+		Where(queryIsUnresolved).
+		Order(orderByCreatedAtDesc).
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrEscalationNotFound
+		}
+		return nil, fmt.Errorf("failed to get escalation: %w", err)
+	}
+
+	return model.ToEntity(), nil
+}
+
+// GetOpenByProjectID retrieves the unresolved escalations of a project, oldest first
+func (r *EscalationRepository) GetOpenByProjectID(ctx context.Context, projectID value_objects.ID) ([]*domain.Escalation, error) {
+	var models []domain.EscalationModel
+
+	err := r.db.WithContext(ctx).
+		Where(queryByProjectID, projectID.Value()).
+		Where(queryIsUnresolved).
+		Order(orderByCreatedAtAsc).
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list escalations: %w", err)
+	}
+
+	escalations := make([]*domain.Escalation, len(models))
+	for i := range models {
+		escalations[i] = models[i].ToEntity()
+	}
+
+	return escalations, nil
+}
+
+// Update updates an existing escalation
+func (r *EscalationRepository) Update(ctx context.Context, escalation *domain.Escalation) error {
+	model := &domain.EscalationModel{}
+	model.FromEntity(escalation)
+	model.UpdatedAt = time.Now()
+
+	// Select all columns so zero values, e.g. an empty acknowledged_by, are saved too
+	result := r.db.WithContext(ctx).
+		Model(&domain.EscalationModel{}).
+		Where(queryByID, model.ID).
+		Select("*").
+		Omit("id", "policy_id", "project_id", "created_at").
+		Updates(model)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update escalation: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrEscalationNotFound
+	}
+
+	return nil
+}
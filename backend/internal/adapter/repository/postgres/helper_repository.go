@@ -10,6 +10,8 @@ const (
 	queryByRecipient     = "recipient = ?"
 	queryByStatus        = "status = ?"
 	queryByBranch        = "branch = ?"
+	queryByRunID         = "run_id = ?"
+	queryByJobID         = "job_id = ?"
 	queryByDeliveryID    = "delivery_id = ?"
 	queryByTemplateType  = "template_type = ?"
 	queryByChannel       = "channel = ?"
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
@@ -121,7 +122,13 @@ func (r *NotificationLogRepository) Update(ctx context.Context, log *domain.Noti
 	model := &domain.NotificationLogModel{}
 	model.FromEntity(log)
 
-	result := r.db.WithContext(ctx).Model(model).Where(queryByID, model.ID).Updates(model)
+	// Select all columns so reset fields such as retry_count and error_message are written
+	result := r.db.WithContext(ctx).
+		Model(model).
+		Where(queryByID, model.ID).
+		Select("*").
+		Omit("id", "created_at").
+		Updates(model)
 	if result.Error != nil {
 		return fmt.Errorf("failed to update notification log: %w", result.Error)
 	}
@@ -151,7 +158,7 @@ func (r *NotificationLogRepository) Delete(ctx context.Context, id value_objects
 func (r *NotificationLogRepository) GetFailedNotifications(ctx context.Context, limit int) ([]*domain.NotificationLog, error) {
 	var models []domain.NotificationLogModel
 
-	query := r.db.WithContext(ctx).Where(queryByStatus, "FAILED")
+	query := r.db.WithContext(ctx).Where(queryByStatus, string(domain.NotificationStatusFailed))
 	if limit > 0 {
 		query = query.Limit(limit)
 	}
@@ -173,7 +180,7 @@ func (r *NotificationLogRepository) GetFailedNotifications(ctx context.Context,
 func (r *NotificationLogRepository) GetPendingNotifications(ctx context.Context, limit int) ([]*domain.NotificationLog, error) {
 	var models []domain.NotificationLogModel
 
-	query := r.db.WithContext(ctx).Where(queryByStatus, "PENDING")
+	query := r.db.WithContext(ctx).Where(queryByStatus, string(domain.NotificationStatusPending))
 	if limit > 0 {
 		query = query.Limit(limit)
 	}
@@ -191,6 +198,61 @@ func (r *NotificationLogRepository) GetPendingNotifications(ctx context.Context,
 	return logs, nil
 }
 
+// GetDueDigestNotifications retrieves batched notifications whose digest is due, oldest first
+func (r *NotificationLogRepository) GetDueDigestNotifications(ctx context.Context, before time.Time, limit int) ([]*domain.NotificationLog, error) {
+	var models []domain.NotificationLogModel
+
+	query := r.db.WithContext(ctx).
+		Where(queryByStatus, string(domain.NotificationStatusBatched)).
+		Where("digest_at <= ?", before).
+		Order("digest_at ASC, created_at ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	err := query.Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due digest notifications: %w", err)
+	}
+
+	logs := make([]*domain.NotificationLog, len(models))
+	for i, model := range models {
+		logs[i] = model.ToEntity()
+	}
+
+	return logs, nil
+}
+
+// GetDeferredByCommit retrieves the deferred notifications of a channel recipient about builds of a commit, oldest first.
+// Notification logs do not store the commit, it is joined from their build events.
+func (r *NotificationLogRepository) GetDeferredByCommit(
+	ctx context.Context,
+	projectID value_objects.ID,
+	commitSHA string,
+	channel domain.NotificationChannel,
+	recipient string,
+) ([]*domain.NotificationLog, error) {
+	var models []domain.NotificationLogModel
+
+	err := r.db.WithContext(ctx).
+		Joins("JOIN build_events ON build_events.id = notification_logs.build_event_id").
+		Where("build_events.project_id = ? AND build_events.commit_sha = ?", projectID.String(), commitSHA).
+		Where("notification_logs.channel = ? AND notification_logs.recipient = ?", string(channel), recipient).
+		Where("notification_logs.status = ?", string(domain.NotificationStatusDeferred)).
+		Order("notification_logs.created_at ASC").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deferred notifications by commit: %w", err)
+	}
+
+	logs := make([]*domain.NotificationLog, len(models))
+	for i, model := range models {
+		logs[i] = model.ToEntity()
+	}
+
+	return logs, nil
+}
+
 // Count returns the total number of notification logs matching the criteria
 func (r *NotificationLogRepository) Count(ctx context.Context, projectID *value_objects.ID, status *domain.NotificationStatus) (int64, error) {
 	var count int64
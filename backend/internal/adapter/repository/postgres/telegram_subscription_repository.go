@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -14,12 +15,22 @@ import (
 
 // TelegramSubscriptionModel represents the GORM model for telegram subscriptions
 type TelegramSubscriptionModel struct {
-	ID        uuid.UUID `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
-	ProjectID uuid.UUID `gorm:"not null;type:uuid"`
-	ChatID    int64     `gorm:"not null"`
-	IsActive  bool      `gorm:"not null;default:true"`
-	CreatedAt time.Time `gorm:"type:timestamp with time zone;default:now()"`
-	UpdatedAt time.Time `gorm:"type:timestamp with time zone;default:now()"`
+	ID                 uuid.UUID       `gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	ProjectID          uuid.UUID       `gorm:"not null;type:uuid"`
+	ChatID             int64           `gorm:"not null"`
+	EventTypes         json.RawMessage `gorm:"type:jsonb;not null;default:'[]'"`
+	Statuses           json.RawMessage `gorm:"type:jsonb;not null;default:'[]'"`
+	BranchPatterns     json.RawMessage `gorm:"type:jsonb;not null;default:'[]'"`
+	IsActive           bool            `gorm:"not null;default:true"`
+	MutedUntil         *time.Time      `gorm:"type:timestamp with time zone"`
+	DigestMode         string          `gorm:"type:varchar(10);not null;default:'instant'"`
+	QuietHoursTimezone string          `gorm:"type:varchar(64);not null;default:'UTC'"`
+	QuietHoursStart    string          `gorm:"type:varchar(5);not null;default:''"`
+	QuietHoursEnd      string          `gorm:"type:varchar(5);not null;default:''"`
+	QuietHoursBypass   json.RawMessage `gorm:"type:jsonb;not null;default:'{}'"`
+	TransitionsOnly    bool            `gorm:"not null;default:false"`
+	CreatedAt          time.Time       `gorm:"type:timestamp with time zone;default:now()"`
+	UpdatedAt          time.Time       `gorm:"type:timestamp with time zone;default:now()"`
 }
 
 // TableName returns the table name for the TelegramSubscriptionModel
@@ -40,12 +51,19 @@ func (tsm *TelegramSubscriptionModel) ToEntity() (*domain.TelegramSubscription,
 	}
 
 	return domain.RestoreTelegramSubscription(domain.RestoreTelegramSubscriptionParams{
-		ID:        id,
-		ProjectID: projectID,
-		ChatID:    tsm.ChatID,
-		IsActive:  tsm.IsActive,
-		CreatedAt: value_objects.NewTimestampFromTime(tsm.CreatedAt),
-		UpdatedAt: value_objects.NewTimestampFromTime(tsm.UpdatedAt),
+		ID:              id,
+		ProjectID:       projectID,
+		ChatID:          tsm.ChatID,
+		EventTypes:      domain.UnmarshalFilterValues(tsm.EventTypes),
+		Statuses:        domain.UnmarshalFilterValues(tsm.Statuses),
+		BranchPatterns:  domain.UnmarshalFilterValues(tsm.BranchPatterns),
+		IsActive:        tsm.IsActive,
+		MutedUntil:      tsm.MutedUntil,
+		DigestMode:      domain.DigestMode(tsm.DigestMode),
+		QuietHours:      domain.RestoreQuietHours(tsm.QuietHoursTimezone, tsm.QuietHoursStart, tsm.QuietHoursEnd, tsm.QuietHoursBypass),
+		TransitionsOnly: tsm.TransitionsOnly,
+		CreatedAt:       value_objects.NewTimestampFromTime(tsm.CreatedAt),
+		UpdatedAt:       value_objects.NewTimestampFromTime(tsm.UpdatedAt),
 	}), nil
 }
 
@@ -60,7 +78,14 @@ func (tsm *TelegramSubscriptionModel) FromEntity(entity *domain.TelegramSubscrip
 	}
 
 	tsm.ChatID = entity.ChatID()
+	tsm.EventTypes = domain.MarshalFilterValues(entity.EventTypes())
+	tsm.Statuses = domain.MarshalFilterValues(entity.Statuses())
+	tsm.BranchPatterns = domain.MarshalFilterValues(entity.BranchPatterns())
 	tsm.IsActive = entity.IsActive()
+	tsm.MutedUntil = entity.MutedUntil()
+	tsm.DigestMode = string(entity.DigestMode())
+	tsm.QuietHoursTimezone, tsm.QuietHoursStart, tsm.QuietHoursEnd, tsm.QuietHoursBypass = domain.MarshalQuietHours(entity.QuietHours())
+	tsm.TransitionsOnly = entity.TransitionsOnly()
 	tsm.CreatedAt = entity.CreatedAt().ToTime()
 	tsm.UpdatedAt = entity.UpdatedAt().ToTime()
 }
@@ -186,7 +211,8 @@ func (r *TelegramSubscriptionRepository) Update(ctx context.Context, subscriptio
 	model := &TelegramSubscriptionModel{}
 	model.FromEntity(subscription)
 
-	result := r.db.WithContext(ctx).Model(model).Where(queryTelegramByID, subscription.ID().String()).Updates(model)
+	// Select all columns so cleared values, e.g. turning transitions only off, are written as well
+	result := r.db.WithContext(ctx).Model(model).Where(queryTelegramByID, subscription.ID().String()).Select("*").Updates(model)
 	if result.Error != nil {
 		return fmt.Errorf("failed to update telegram subscription: %w", result.Error)
 	}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
@@ -144,11 +145,11 @@ func (r *webhookEventRepository) ExistsByDeliveryID(ctx context.Context, deliver
 	return count > 0, nil
 }
 
-// GetUnprocessedEvents retrieves unprocessed webhook events
-func (r *webhookEventRepository) GetUnprocessedEvents(ctx context.Context, limit int) ([]*domain.WebhookEvent, error) {
+// GetUnprocessedEvents retrieves unprocessed webhook events received before the given time, oldest first
+func (r *webhookEventRepository) GetUnprocessedEvents(ctx context.Context, receivedBefore time.Time, limit int) ([]*domain.WebhookEvent, error) {
 	var models []domain.WebhookEventModel
 	err := r.db.WithContext(ctx).
-		Where("processed_at IS NULL").
+		Where("processed_at IS NULL AND created_at < ?", receivedBefore).
 		Order("created_at ASC").
 		Limit(limit).
 		Find(&models).Error
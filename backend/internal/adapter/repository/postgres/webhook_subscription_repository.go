@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"gorm.io/gorm"
+)
+
+// WebhookSubscriptionRepository implements the webhook subscription repository interface
+type WebhookSubscriptionRepository struct {
+	db *gorm.DB
+}
+
+// NewWebhookSubscriptionRepository creates a new Postgres-backed webhook subscription repository
+func NewWebhookSubscriptionRepository(db *gorm.DB) port.WebhookSubscriptionRepository {
+	return &WebhookSubscriptionRepository{
+		db: db,
+	}
+}
+
+// Create creates a new webhook subscription
+func (r *WebhookSubscriptionRepository) Create(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	model := &domain.WebhookSubscriptionModel{}
+	model.FromEntity(subscription)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a webhook subscription by its ID
+func (r *WebhookSubscriptionRepository) GetByID(ctx context.Context, id value_objects.ID) (*domain.WebhookSubscription, error) {
+	var model domain.WebhookSubscriptionModel
+
+	err := r.db.WithContext(ctx).Where(queryByID, id.Value()).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrWebhookSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("failed to get webhook subscription: %w", err)
+	}
+
+	return model.ToEntity(), nil
+}
+
+// GetByProjectID retrieves the webhook subscriptions of a project, oldest first
+func (r *WebhookSubscriptionRepository) GetByProjectID(ctx context.Context, projectID value_objects.ID) ([]*domain.WebhookSubscription, error) {
+	return r.find(r.db.WithContext(ctx).Where(queryByProjectID, projectID.Value()))
+}
+
+// GetActiveByProjectID retrieves the active webhook subscriptions of a project
+func (r *WebhookSubscriptionRepository) GetActiveByProjectID(ctx context.Context, projectID value_objects.ID) ([]*domain.WebhookSubscription, error) {
+	return r.find(r.db.WithContext(ctx).Where(queryByProjectID, projectID.Value()).Where(queryByIsActive, true))
+}
+
+// Update updates an existing webhook subscription
+func (r *WebhookSubscriptionRepository) Update(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	model := &domain.WebhookSubscriptionModel{}
+	model.FromEntity(subscription)
+	model.UpdatedAt = time.Now()
+
+	result := r.db.WithContext(ctx).
+		Model(&domain.WebhookSubscriptionModel{}).
+		Where(queryByID, model.ID).
+		Select("*").
+		Omit("id", "project_id", "created_at").
+		Updates(model)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update webhook subscription: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrWebhookSubscriptionNotFound
+	}
+
+	return nil
+}
+
+// Delete deletes a webhook subscription by its ID
+func (r *WebhookSubscriptionRepository) Delete(ctx context.Context, id value_objects.ID) error {
+	result := r.db.WithContext(ctx).Where(queryByID, id.Value()).Delete(&domain.WebhookSubscriptionModel{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete webhook subscription: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrWebhookSubscriptionNotFound
+	}
+
+	return nil
+}
+
+// find lists the subscriptions matching a query
+func (r *WebhookSubscriptionRepository) find(query *gorm.DB) ([]*domain.WebhookSubscription, error) {
+	var models []domain.WebhookSubscriptionModel
+
+	if err := query.Order(orderByCreatedAtAsc).Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+
+	subscriptions := make([]*domain.WebhookSubscription, len(models))
+	for i := range models {
+		subscriptions[i] = models[i].ToEntity()
+	}
+
+	return subscriptions, nil
+}
@@ -2,6 +2,8 @@ package config
 
 import (
 	"fmt"
+	"net/mail"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
@@ -22,6 +24,13 @@ const (
 	EnvGitHubWebhookSecret = "GITHUB_WEBHOOK_SECRET"
 	EnvGitLabWebhookSecret = "GITLAB_WEBHOOK_SECRET"
 	EnvLogLevel            = "LOG_LEVEL"
+	EnvSMTPHost            = "SMTP_HOST"
+	EnvSMTPPort            = "SMTP_PORT"
+	EnvSMTPUsername        = "SMTP_USERNAME"
+	EnvSMTPPassword        = "SMTP_PASSWORD"
+	EnvSMTPFromEmail       = "SMTP_FROM_EMAIL"
+	EnvSlackBotToken       = "SLACK_BOT_TOKEN"
+	EnvSlackWebhookURL     = "SLACK_WEBHOOK_URL"
 	EnvEnvironment         = "ENVIRONMENT"
 )
 
@@ -47,6 +56,38 @@ const (
 	DefaultLogFormat   = "json"
 	DefaultLogOutput   = "stdout"
 	DefaultLogFilePath = "logs/app.log"
+
+	DefaultSchedulerEnabled             = true
+	DefaultPendingNotificationsInterval = 30 * time.Second
+	DefaultFailedNotificationsInterval  = 2 * time.Minute
+	DefaultUnprocessedWebhooksInterval  = 5 * time.Minute
+	DefaultSchedulerBatchSize           = 50
+	DefaultNotificationDigestsInterval  = time.Minute
+	// DefaultNotificationDigestsBatchSize is larger so a digest is rarely split across runs
+	DefaultNotificationDigestsBatchSize  = 500
+	DefaultDeferredNotificationsInterval = 15 * time.Second
+	DefaultEscalationsInterval           = time.Minute
+	DefaultQueuedNotificationsInterval   = 15 * time.Second
+
+	DefaultWebhookProcessingAsync           = true
+	DefaultWebhookProcessingWorkers         = 4
+	DefaultWebhookProcessingQueueSize       = 100
+	DefaultWebhookProcessingShutdownTimeout = 30 * time.Second
+
+	DefaultEmailSMTPPort = 587
+	DefaultEmailSecurity = "starttls"
+	DefaultEmailFromName = "CI/CD Status Notifier"
+	DefaultEmailTimeout  = 30 * time.Second
+
+	DefaultTeamsEnabled = true
+	DefaultTeamsTimeout = 30 * time.Second
+
+	DefaultDiscordEnabled = true
+	DefaultDiscordTimeout = 30 * time.Second
+
+	DefaultTelegramEditMessages   = true
+	DefaultTelegramInlineActions  = true
+	DefaultTelegramCoalesceWindow = 30 * time.Second
 )
 
 // ConfigValidationError represents configuration validation errors
@@ -105,6 +146,12 @@ type ServerConfig struct {
 type TelegramConfig struct {
 	BotToken   string `mapstructure:"bot_token" yaml:"bot_token"`
 	WebhookURL string `mapstructure:"webhook_url" yaml:"webhook_url"`
+	// EditMessages updates the message sent for a run as its status changes instead of sending a new one
+	EditMessages bool `mapstructure:"edit_messages" yaml:"edit_messages"`
+	// InlineActions adds buttons to build notifications, e.g. to acknowledge a build or mute its project
+	InlineActions bool `mapstructure:"inline_actions" yaml:"inline_actions"`
+	// CoalesceWindow holds notifications back to merge the builds of one commit into a single message per chat, 0 disables it
+	CoalesceWindow time.Duration `mapstructure:"coalesce_window" yaml:"coalesce_window"`
 }
 
 // GitHubConfig holds GitHub webhook configuration
@@ -125,15 +172,92 @@ type LoggingConfig struct {
 	FilePath string `mapstructure:"file_path" yaml:"file_path"`
 }
 
+// SchedulerJobConfig holds the schedule of a background job
+type SchedulerJobConfig struct {
+	Interval  time.Duration `mapstructure:"interval" yaml:"interval"`
+	BatchSize int           `mapstructure:"batch_size" yaml:"batch_size"`
+}
+
+// SchedulerConfig holds background job scheduler configuration
+type SchedulerConfig struct {
+	Enabled               bool               `mapstructure:"enabled" yaml:"enabled"`
+	PendingNotifications  SchedulerJobConfig `mapstructure:"pending_notifications" yaml:"pending_notifications"`
+	FailedNotifications   SchedulerJobConfig `mapstructure:"failed_notifications" yaml:"failed_notifications"`
+	UnprocessedWebhooks   SchedulerJobConfig `mapstructure:"unprocessed_webhooks" yaml:"unprocessed_webhooks"`
+	NotificationDigests   SchedulerJobConfig `mapstructure:"notification_digests" yaml:"notification_digests"`
+	DeferredNotifications SchedulerJobConfig `mapstructure:"deferred_notifications" yaml:"deferred_notifications"`
+	Escalations           SchedulerJobConfig `mapstructure:"escalations" yaml:"escalations"`
+	QueuedNotifications   SchedulerJobConfig `mapstructure:"queued_notifications" yaml:"queued_notifications"`
+}
+
+// WebhookProcessingConfig holds the background webhook worker pool configuration
+type WebhookProcessingConfig struct {
+	Async           bool          `mapstructure:"async" yaml:"async"`
+	Workers         int           `mapstructure:"workers" yaml:"workers"`
+	QueueSize       int           `mapstructure:"queue_size" yaml:"queue_size"`
+	ShutdownTimeout time.Duration `mapstructure:"shutdown_timeout" yaml:"shutdown_timeout"`
+}
+
+// EmailConfig holds SMTP configuration for email notifications.
+// Email delivery is disabled while smtp_host is empty.
+type EmailConfig struct {
+	SMTPHost  string        `mapstructure:"smtp_host" yaml:"smtp_host"`
+	SMTPPort  int           `mapstructure:"smtp_port" yaml:"smtp_port"`
+	Username  string        `mapstructure:"username" yaml:"username"`
+	Password  string        `mapstructure:"password" yaml:"password"`
+	FromEmail string        `mapstructure:"from_email" yaml:"from_email"`
+	FromName  string        `mapstructure:"from_name" yaml:"from_name"`
+	Security  string        `mapstructure:"security" yaml:"security"`
+	Timeout   time.Duration `mapstructure:"timeout" yaml:"timeout"`
+}
+
+// Enabled checks if an SMTP server is configured
+func (c EmailConfig) Enabled() bool {
+	return c.SMTPHost != ""
+}
+
+// SlackConfig holds Slack configuration for Slack notifications.
+// Slack delivery is disabled while neither a bot token nor a webhook URL is set.
+type SlackConfig struct {
+	BotToken   string `mapstructure:"bot_token" yaml:"bot_token"`
+	WebhookURL string `mapstructure:"webhook_url" yaml:"webhook_url"`
+}
+
+// Enabled checks if Slack credentials are configured
+func (c SlackConfig) Enabled() bool {
+	return c.BotToken != "" || c.WebhookURL != ""
+}
+
+// TeamsConfig holds Microsoft Teams configuration.
+// Webhook URLs are configured per project, so only delivery settings live here.
+type TeamsConfig struct {
+	Enabled bool          `mapstructure:"enabled" yaml:"enabled"`
+	Timeout time.Duration `mapstructure:"timeout" yaml:"timeout"`
+}
+
+// DiscordConfig holds Discord configuration.
+// Webhook URLs are configured per project, so only delivery settings live here.
+type DiscordConfig struct {
+	Enabled bool          `mapstructure:"enabled" yaml:"enabled"`
+	Timeout time.Duration `mapstructure:"timeout" yaml:"timeout"`
+}
+
 // AppConfig holds all application configuration
 type AppConfig struct {
-	Environment string         `mapstructure:"environment" yaml:"environment"`
-	Server      ServerConfig   `mapstructure:"server" yaml:"server"`
-	Database    DatabaseConfig `mapstructure:"database" yaml:"database"`
-	Telegram    TelegramConfig `mapstructure:"telegram" yaml:"telegram"`
-	GitHub      GitHubConfig   `mapstructure:"github" yaml:"github"`
-	GitLab      GitLabConfig   `mapstructure:"gitlab" yaml:"gitlab"`
-	Logging     LoggingConfig  `mapstructure:"logging" yaml:"logging"`
+	Environment string          `mapstructure:"environment" yaml:"environment"`
+	Server      ServerConfig    `mapstructure:"server" yaml:"server"`
+	Database    DatabaseConfig  `mapstructure:"database" yaml:"database"`
+	Telegram    TelegramConfig  `mapstructure:"telegram" yaml:"telegram"`
+	GitHub      GitHubConfig    `mapstructure:"github" yaml:"github"`
+	GitLab      GitLabConfig    `mapstructure:"gitlab" yaml:"gitlab"`
+	Logging     LoggingConfig   `mapstructure:"logging" yaml:"logging"`
+	Scheduler   SchedulerConfig `mapstructure:"scheduler" yaml:"scheduler"`
+	Email       EmailConfig     `mapstructure:"email" yaml:"email"`
+	Slack       SlackConfig     `mapstructure:"slack" yaml:"slack"`
+	Teams       TeamsConfig     `mapstructure:"teams" yaml:"teams"`
+	Discord     DiscordConfig   `mapstructure:"discord" yaml:"discord"`
+
+	WebhookProcessing WebhookProcessingConfig `mapstructure:"webhook_processing" yaml:"webhook_processing"`
 }
 
 // Load implements ConfigLoader interface
@@ -201,6 +325,12 @@ func (loader *ViperConfigLoader) overrideWithEnvVars(cfg *AppConfig) {
 		EnvGitHubWebhookSecret: &cfg.GitHub.WebhookSecret,
 		EnvGitLabWebhookSecret: &cfg.GitLab.WebhookSecret,
 		EnvLogLevel:            &cfg.Logging.Level,
+		EnvSMTPHost:            &cfg.Email.SMTPHost,
+		EnvSMTPUsername:        &cfg.Email.Username,
+		EnvSMTPPassword:        &cfg.Email.Password,
+		EnvSMTPFromEmail:       &cfg.Email.FromEmail,
+		EnvSlackBotToken:       &cfg.Slack.BotToken,
+		EnvSlackWebhookURL:     &cfg.Slack.WebhookURL,
 	}
 
 	for envKey, configField := range envOverrides {
@@ -215,6 +345,12 @@ func (loader *ViperConfigLoader) overrideWithEnvVars(cfg *AppConfig) {
 			cfg.Server.Port = port
 		}
 	}
+
+	if value := os.Getenv(EnvSMTPPort); value != "" {
+		if port, err := strconv.Atoi(value); err == nil {
+			cfg.Email.SMTPPort = port
+		}
+	}
 }
 
 // LoadConfig loads configuration using the default ViperConfigLoader
@@ -250,6 +386,52 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("telegram.bot_token", "")
 	v.SetDefault("telegram.webhook_url", "")
 
+	v.SetDefault("scheduler.enabled", DefaultSchedulerEnabled)
+	v.SetDefault("scheduler.pending_notifications.interval", DefaultPendingNotificationsInterval)
+	v.SetDefault("scheduler.pending_notifications.batch_size", DefaultSchedulerBatchSize)
+	v.SetDefault("scheduler.failed_notifications.interval", DefaultFailedNotificationsInterval)
+	v.SetDefault("scheduler.failed_notifications.batch_size", DefaultSchedulerBatchSize)
+	v.SetDefault("scheduler.unprocessed_webhooks.interval", DefaultUnprocessedWebhooksInterval)
+	v.SetDefault("scheduler.unprocessed_webhooks.batch_size", DefaultSchedulerBatchSize)
+	v.SetDefault("scheduler.notification_digests.interval", DefaultNotificationDigestsInterval)
+	v.SetDefault("scheduler.notification_digests.batch_size", DefaultNotificationDigestsBatchSize)
+	v.SetDefault("scheduler.deferred_notifications.interval", DefaultDeferredNotificationsInterval)
+	v.SetDefault("scheduler.deferred_notifications.batch_size", DefaultSchedulerBatchSize)
+	v.SetDefault("scheduler.escalations.interval", DefaultEscalationsInterval)
+	v.SetDefault("scheduler.escalations.batch_size", DefaultSchedulerBatchSize)
+	v.SetDefault("scheduler.queued_notifications.interval", DefaultQueuedNotificationsInterval)
+	v.SetDefault("scheduler.queued_notifications.batch_size", DefaultSchedulerBatchSize)
+
+	// Set defaults for background webhook processing
+	v.SetDefault("webhook_processing.async", DefaultWebhookProcessingAsync)
+	v.SetDefault("webhook_processing.workers", DefaultWebhookProcessingWorkers)
+	v.SetDefault("webhook_processing.queue_size", DefaultWebhookProcessingQueueSize)
+	v.SetDefault("webhook_processing.shutdown_timeout", DefaultWebhookProcessingShutdownTimeout)
+
+	// Set defaults for Telegram delivery
+	v.SetDefault("telegram.edit_messages", DefaultTelegramEditMessages)
+	v.SetDefault("telegram.inline_actions", DefaultTelegramInlineActions)
+	v.SetDefault("telegram.coalesce_window", DefaultTelegramCoalesceWindow)
+
+	// Set defaults for email delivery (disabled until an SMTP host is set)
+	v.SetDefault("email.smtp_host", "")
+	v.SetDefault("email.smtp_port", DefaultEmailSMTPPort)
+	v.SetDefault("email.security", DefaultEmailSecurity)
+	v.SetDefault("email.from_name", DefaultEmailFromName)
+	v.SetDefault("email.timeout", DefaultEmailTimeout)
+
+	// Set defaults for Slack delivery (disabled until credentials are set)
+	v.SetDefault("slack.bot_token", "")
+	v.SetDefault("slack.webhook_url", "")
+
+	// Set defaults for Teams delivery
+	v.SetDefault("teams.enabled", DefaultTeamsEnabled)
+	v.SetDefault("teams.timeout", DefaultTeamsTimeout)
+
+	// Set defaults for Discord delivery
+	v.SetDefault("discord.enabled", DefaultDiscordEnabled)
+	v.SetDefault("discord.timeout", DefaultDiscordTimeout)
+
 	// Set defaults for webhook secrets (empty by default)
 	v.SetDefault("github.webhook_secret", "")
 	v.SetDefault("gitlab.webhook_secret", "")
@@ -279,6 +461,35 @@ func validateConfig(cfg *AppConfig) error {
 		validationErrors = append(validationErrors, err)
 	}
 
+	// Validate scheduler configuration
+	if err := validateSchedulerConfig(&cfg.Scheduler); err != nil {
+		validationErrors = append(validationErrors, err)
+	}
+
+	if err := validateWebhookProcessingConfig(&cfg.WebhookProcessing); err != nil {
+		validationErrors = append(validationErrors, err)
+	}
+
+	// Validate email configuration
+	if err := validateEmailConfig(&cfg.Email); err != nil {
+		validationErrors = append(validationErrors, err)
+	}
+
+	// Validate slack configuration
+	if err := validateSlackConfig(&cfg.Slack); err != nil {
+		validationErrors = append(validationErrors, err)
+	}
+
+	// Validate teams configuration
+	if err := validateTeamsConfig(&cfg.Teams); err != nil {
+		validationErrors = append(validationErrors, err)
+	}
+
+	// Validate discord configuration
+	if err := validateDiscordConfig(&cfg.Discord); err != nil {
+		validationErrors = append(validationErrors, err)
+	}
+
 	if len(validationErrors) > 0 {
 		return combineErrors(validationErrors)
 	}
@@ -314,6 +525,13 @@ func validateTelegramConfig(cfg *TelegramConfig) error {
 		}
 	}
 
+	if cfg.CoalesceWindow < 0 {
+		return ConfigValidationError{
+			Field:   "telegram.coalesce_window",
+			Message: "must not be negative",
+		}
+	}
+
 	return nil
 }
 
@@ -373,6 +591,149 @@ func validateLoggingConfig(cfg *LoggingConfig) error {
 	return nil
 }
 
+// validateSchedulerConfig validates scheduler configuration
+func validateSchedulerConfig(cfg *SchedulerConfig) error {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	jobs := map[string]SchedulerJobConfig{
+		"scheduler.pending_notifications":  cfg.PendingNotifications,
+		"scheduler.failed_notifications":   cfg.FailedNotifications,
+		"scheduler.unprocessed_webhooks":   cfg.UnprocessedWebhooks,
+		"scheduler.notification_digests":   cfg.NotificationDigests,
+		"scheduler.deferred_notifications": cfg.DeferredNotifications,
+		"scheduler.escalations":            cfg.Escalations,
+		"scheduler.queued_notifications":   cfg.QueuedNotifications,
+	}
+
+	for field, job := range jobs {
+		if job.Interval <= 0 {
+			return ConfigValidationError{
+				Field:   field + ".interval",
+				Message: "must be greater than 0",
+			}
+		}
+		if job.BatchSize <= 0 {
+			return ConfigValidationError{
+				Field:   field + ".batch_size",
+				Message: "must be greater than 0",
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateWebhookProcessingConfig validates background webhook processing configuration
+func validateWebhookProcessingConfig(cfg *WebhookProcessingConfig) error {
+	if !cfg.Async {
+		return nil
+	}
+
+	if cfg.Workers <= 0 {
+		return ConfigValidationError{
+			Field:   "webhook_processing.workers",
+			Message: "must be greater than 0",
+		}
+	}
+
+	if cfg.QueueSize <= 0 {
+		return ConfigValidationError{
+			Field:   "webhook_processing.queue_size",
+			Message: "must be greater than 0",
+		}
+	}
+
+	if cfg.ShutdownTimeout <= 0 {
+		return ConfigValidationError{
+			Field:   "webhook_processing.shutdown_timeout",
+			Message: "must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// validateEmailConfig validates email configuration
+func validateEmailConfig(cfg *EmailConfig) error {
+	if !cfg.Enabled() {
+		return nil
+	}
+
+	if cfg.SMTPPort <= 0 || cfg.SMTPPort > 65535 {
+		return ConfigValidationError{
+			Field:   "email.smtp_port",
+			Message: "must be a valid port",
+		}
+	}
+
+	if _, err := mail.ParseAddress(cfg.FromEmail); err != nil {
+		return ConfigValidationError{
+			Field:   "email.from_email",
+			Message: "must be a valid email address",
+		}
+	}
+
+	validSecurity := []string{"starttls", "tls", "none"}
+	if !contains(validSecurity, strings.ToLower(cfg.Security)) {
+		return ConfigValidationError{
+			Field:   "email.security",
+			Message: fmt.Sprintf("must be one of: %s", strings.Join(validSecurity, ", ")),
+		}
+	}
+
+	if cfg.Timeout <= 0 {
+		return ConfigValidationError{
+			Field:   "email.timeout",
+			Message: "must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// validateSlackConfig validates slack configuration
+func validateSlackConfig(cfg *SlackConfig) error {
+	if cfg.WebhookURL == "" {
+		return nil
+	}
+
+	webhookURL, err := url.Parse(cfg.WebhookURL)
+	if err != nil || webhookURL.Scheme != "https" || webhookURL.Host == "" {
+		return ConfigValidationError{
+			Field:   "slack.webhook_url",
+			Message: "must be an https URL",
+		}
+	}
+
+	return nil
+}
+
+// validateTeamsConfig validates teams configuration
+func validateTeamsConfig(cfg *TeamsConfig) error {
+	if cfg.Enabled && cfg.Timeout <= 0 {
+		return ConfigValidationError{
+			Field:   "teams.timeout",
+			Message: "must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// validateDiscordConfig validates discord configuration
+func validateDiscordConfig(cfg *DiscordConfig) error {
+	if cfg.Enabled && cfg.Timeout <= 0 {
+		return ConfigValidationError{
+			Field:   "discord.timeout",
+			Message: "must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
 // combineErrors combines multiple errors into a single error
 func combineErrors(errors []error) error {
 	var messages []string
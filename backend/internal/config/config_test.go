@@ -61,6 +61,9 @@ environment: "development"
 	assert.NoError(t, err)
 	assert.Equal(t, 8081, cfg.Server.Port)
 	assert.Equal(t, "dummy-token", cfg.Telegram.BotToken)
+	assert.Equal(t, DefaultTelegramEditMessages, cfg.Telegram.EditMessages)
+	assert.Equal(t, DefaultTelegramInlineActions, cfg.Telegram.InlineActions)
+	assert.Equal(t, DefaultTelegramCoalesceWindow, cfg.Telegram.CoalesceWindow)
 }
 
 func TestLoadConfigMissingServerPort(t *testing.T) {
@@ -112,3 +115,178 @@ func TestLoadConfigMissingTelegramToken(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "config validation failed")
 }
+
+func TestLoadConfigSchedulerDefaultsAndValidation(t *testing.T) {
+	// Backup original config
+	if _, err := os.Stat(testConfigPath); err == nil {
+		_ = os.Rename(testConfigPath, testConfigBackup)
+		defer func() {
+			_ = os.Remove(testConfigPath)
+			_ = os.Rename(testConfigBackup, testConfigPath)
+		}()
+	} else {
+		defer os.Remove(testConfigPath)
+	}
+
+	// Setup: create config without scheduler section
+	content := []byte(`telegram:
+  bot_token: "dummy-token"
+`)
+	_ = os.WriteFile(testConfigPath, content, 0644)
+
+	viper.Reset()
+
+	cfg, err := LoadConfig()
+	assert.NoError(t, err)
+	assert.True(t, cfg.Scheduler.Enabled)
+	assert.Equal(t, DefaultPendingNotificationsInterval, cfg.Scheduler.PendingNotifications.Interval)
+	assert.Equal(t, DefaultSchedulerBatchSize, cfg.Scheduler.UnprocessedWebhooks.BatchSize)
+	assert.Equal(t, DefaultNotificationDigestsInterval, cfg.Scheduler.NotificationDigests.Interval)
+	assert.Equal(t, DefaultNotificationDigestsBatchSize, cfg.Scheduler.NotificationDigests.BatchSize)
+	assert.Equal(t, DefaultDeferredNotificationsInterval, cfg.Scheduler.DeferredNotifications.Interval)
+	assert.Equal(t, DefaultSchedulerBatchSize, cfg.Scheduler.DeferredNotifications.BatchSize)
+	assert.Equal(t, DefaultEscalationsInterval, cfg.Scheduler.Escalations.Interval)
+	assert.Equal(t, DefaultSchedulerBatchSize, cfg.Scheduler.Escalations.BatchSize)
+	assert.Equal(t, DefaultQueuedNotificationsInterval, cfg.Scheduler.QueuedNotifications.Interval)
+	assert.Equal(t, DefaultSchedulerBatchSize, cfg.Scheduler.QueuedNotifications.BatchSize)
+	assert.True(t, cfg.WebhookProcessing.Async)
+	assert.Equal(t, DefaultWebhookProcessingWorkers, cfg.WebhookProcessing.Workers)
+	assert.Equal(t, DefaultWebhookProcessingQueueSize, cfg.WebhookProcessing.QueueSize)
+
+	// Setup: create config with an invalid batch size
+	content = []byte(`telegram:
+  bot_token: "dummy-token"
+scheduler:
+  failed_notifications:
+    interval: "1m"
+    batch_size: 0
+`)
+	_ = os.WriteFile(testConfigPath, content, 0644)
+
+	viper.Reset()
+
+	_, err = LoadConfig()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "scheduler.failed_notifications.batch_size")
+
+	// Setup: create config with an invalid webhook worker count
+	content = []byte(`telegram:
+  bot_token: "dummy-token"
+webhook_processing:
+  workers: 0
+`)
+	_ = os.WriteFile(testConfigPath, content, 0644)
+
+	viper.Reset()
+
+	_, err = LoadConfig()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "webhook_processing.workers")
+}
+
+func TestLoadConfigEmailDefaultsAndValidation(t *testing.T) {
+	// Backup original config
+	if _, err := os.Stat(testConfigPath); err == nil {
+		_ = os.Rename(testConfigPath, testConfigBackup)
+		defer func() {
+			_ = os.Remove(testConfigPath)
+			_ = os.Rename(testConfigBackup, testConfigPath)
+		}()
+	} else {
+		defer os.Remove(testConfigPath)
+	}
+
+	// Setup: create config with only an SMTP host and sender
+	content := []byte(`telegram:
+  bot_token: "dummy-token"
+email:
+  smtp_host: "smtp.example.com"
+  from_email: "ci@example.com"
+`)
+	_ = os.WriteFile(testConfigPath, content, 0644)
+
+	viper.Reset()
+
+	cfg, err := LoadConfig()
+	assert.NoError(t, err)
+	assert.True(t, cfg.Email.Enabled())
+	assert.Equal(t, DefaultEmailSMTPPort, cfg.Email.SMTPPort)
+	assert.Equal(t, DefaultEmailSecurity, cfg.Email.Security)
+	assert.Equal(t, DefaultEmailTimeout, cfg.Email.Timeout)
+
+	// Setup: create config with an unsupported security mode
+	content = []byte(`telegram:
+  bot_token: "dummy-token"
+email:
+  smtp_host: "smtp.example.com"
+  from_email: "ci@example.com"
+  security: "ssl"
+`)
+	_ = os.WriteFile(testConfigPath, content, 0644)
+
+	viper.Reset()
+
+	_, err = LoadConfig()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "email.security")
+
+	// Setup: create config without a sender address
+	content = []byte(`telegram:
+  bot_token: "dummy-token"
+email:
+  smtp_host: "smtp.example.com"
+`)
+	_ = os.WriteFile(testConfigPath, content, 0644)
+
+	viper.Reset()
+
+	_, err = LoadConfig()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "email.from_email")
+}
+
+func TestLoadConfigSlackEnabledAndValidation(t *testing.T) {
+	// Backup original config
+	if _, err := os.Stat(testConfigPath); err == nil {
+		_ = os.Rename(testConfigPath, testConfigBackup)
+		defer func() {
+			_ = os.Remove(testConfigPath)
+			_ = os.Rename(testConfigBackup, testConfigPath)
+		}()
+	} else {
+		defer os.Remove(testConfigPath)
+	}
+
+	// Setup: create config with only a Slack bot token
+	content := []byte(`telegram:
+  bot_token: "dummy-token"
+slack:
+  bot_token: "xoxb-test"
+`)
+	_ = os.WriteFile(testConfigPath, content, 0644)
+
+	viper.Reset()
+
+	cfg, err := LoadConfig()
+	assert.NoError(t, err)
+	assert.True(t, cfg.Slack.Enabled())
+	assert.Equal(t, "xoxb-test", cfg.Slack.BotToken)
+	assert.True(t, cfg.Teams.Enabled)
+	assert.Equal(t, DefaultTeamsTimeout, cfg.Teams.Timeout)
+	assert.True(t, cfg.Discord.Enabled)
+	assert.Equal(t, DefaultDiscordTimeout, cfg.Discord.Timeout)
+
+	// Setup: create config with a plain http webhook URL
+	content = []byte(`telegram:
+  bot_token: "dummy-token"
+slack:
+  webhook_url: "http://hooks.slack.com/services/T000/B000/XXX"
+`)
+	_ = os.WriteFile(testConfigPath, content, 0644)
+
+	viper.Reset()
+
+	_, err = LoadConfig()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "slack.webhook_url")
+}
@@ -0,0 +1,20 @@
+package domain
+
+import "errors"
+
+// Callback errors shown to the user who pressed a button
+var (
+	ErrCallbackNotAuthorized = errors.New("this chat is not subscribed to the project")
+	ErrCallbackUnavailable   = errors.New("actions are not available")
+)
+
+// CallbackContext represents the press of an inline keyboard button
+type CallbackContext struct {
+	QueryID     string
+	Data        string
+	UserID      int64
+	ChatID      int64
+	MessageID   int
+	MessageText string // Plain text of the message the button belongs to
+	Username    string
+}
@@ -0,0 +1,10 @@
+package domain
+
+import "errors"
+
+// Subscription errors shown to the user who sent a subscription command
+var (
+	ErrSubscriptionProjectNotFound = errors.New("project not found")
+	ErrNotSubscribed               = errors.New("this chat is not subscribed to the project")
+	ErrInvalidSubscriptionFilter   = errors.New("filters must be given as key=value, keys are events, status, branches and digest")
+)
@@ -62,10 +62,14 @@ type BuildInfo struct {
 
 // SubscribeCommandRequest represents a subscribe command request
 type SubscribeCommandRequest struct {
-	ProjectName string `json:"project_name"`
-	ChatID      int64  `json:"chat_id"`
-	UserID      int64  `json:"user_id"`
-	Username    string `json:"username"`
+	ProjectName string                     `json:"project_name"`
+	ChatID      int64                      `json:"chat_id"`
+	UserID      int64                      `json:"user_id"`
+	Username    string                     `json:"username"`
+	Filter      TelegramSubscriptionFilter `json:"filter"`
+	DigestMode  string                     `json:"digest_mode"`
+	// TransitionsOnly limits build notifications to builds that turn a branch red or green
+	TransitionsOnly bool `json:"transitions_only"`
 }
 
 // SubscribeCommandResponse represents the response for /subscribe command
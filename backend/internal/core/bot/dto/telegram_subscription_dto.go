@@ -1,24 +1,118 @@
 package dto
 
+import "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+
+// TelegramSubscriptionFilter selects the build events a subscription is notified about.
+// Empty lists match every value.
+type TelegramSubscriptionFilter struct {
+	EventTypes []string `json:"event_types"`
+	Statuses   []string `json:"statuses"`
+	Branches   []string `json:"branches"` // Glob patterns, e.g. "release/*"
+}
+
+// ToDomain converts the filter to a validated domain filter
+func (f TelegramSubscriptionFilter) ToDomain() (domain.SubscriptionFilter, error) {
+	return domain.NewSubscriptionFilter(f.EventTypes, f.Statuses, f.Branches)
+}
+
+// TelegramQuietHours is a daily window in a timezone during which notifications are deferred until it ends.
+// Build events passing the bypass filter are still sent right away, an empty bypass filter lets none through.
+type TelegramQuietHours struct {
+	Timezone string                     `json:"timezone"` // IANA timezone, UTC when empty
+	Start    string                     `json:"start"`    // HH:MM
+	End      string                     `json:"end"`      // HH:MM, before start when the window spans midnight
+	Bypass   TelegramSubscriptionFilter `json:"bypass"`
+}
+
+// ToDomain converts the quiet hours to validated domain quiet hours
+func (q TelegramQuietHours) ToDomain() (domain.QuietHours, error) {
+	bypass, err := q.Bypass.ToDomain()
+	if err != nil {
+		return domain.QuietHours{}, err
+	}
+	return domain.NewQuietHours(q.Timezone, q.Start, q.End, bypass)
+}
+
 // CreateTelegramSubscriptionRequest represents the request to create a telegram subscription
 type CreateTelegramSubscriptionRequest struct {
-	ProjectID string `json:"project_id" validate:"required,uuid"`
-	ChatID    int64  `json:"chat_id" validate:"required"`
+	ProjectID  string                     `json:"project_id" validate:"required,uuid"`
+	ChatID     int64                      `json:"chat_id" validate:"required"`
+	Filter     TelegramSubscriptionFilter `json:"filter"`
+	DigestMode string                     `json:"digest_mode,omitempty"` // instant (default), hourly or daily
+	QuietHours *TelegramQuietHours        `json:"quiet_hours,omitempty"`
+	// TransitionsOnly limits build notifications to builds that turn a branch red or green
+	TransitionsOnly bool `json:"transitions_only,omitempty"`
 }
 
-// UpdateTelegramSubscriptionRequest represents the request to update a telegram subscription
+// UpdateTelegramSubscriptionRequest represents the request to update a telegram subscription.
+// Fields left out are not changed, a given filter or quiet hours replace the current ones.
+// Quiet hours without start and end remove them.
 type UpdateTelegramSubscriptionRequest struct {
-	IsActive bool `json:"is_active"`
+	IsActive        *bool                       `json:"is_active,omitempty"`
+	Filter          *TelegramSubscriptionFilter `json:"filter,omitempty"`
+	DigestMode      *string                     `json:"digest_mode,omitempty"`
+	QuietHours      *TelegramQuietHours         `json:"quiet_hours,omitempty"`
+	TransitionsOnly *bool                       `json:"transitions_only,omitempty"`
 }
 
 // TelegramSubscriptionResponse represents the response for telegram subscription operations
 type TelegramSubscriptionResponse struct {
-	ID        string `json:"id"`
-	ProjectID string `json:"project_id"`
-	ChatID    int64  `json:"chat_id"`
-	IsActive  bool   `json:"is_active"`
-	CreatedAt int64  `json:"created_at"`
-	UpdatedAt int64  `json:"updated_at"`
+	ID              string                     `json:"id"`
+	ProjectID       string                     `json:"project_id"`
+	ChatID          int64                      `json:"chat_id"`
+	IsActive        bool                       `json:"is_active"`
+	Filter          TelegramSubscriptionFilter `json:"filter"`
+	DigestMode      string                     `json:"digest_mode"`
+	QuietHours      *TelegramQuietHours        `json:"quiet_hours"`
+	TransitionsOnly bool                       `json:"transitions_only"`
+	CreatedAt       int64                      `json:"created_at"`
+	UpdatedAt       int64                      `json:"updated_at"`
+}
+
+// ToTelegramSubscriptionResponse converts a domain telegram subscription to a response DTO
+func ToTelegramSubscriptionResponse(subscription *domain.TelegramSubscription) TelegramSubscriptionResponse {
+	return TelegramSubscriptionResponse{
+		ID:        subscription.ID().String(),
+		ProjectID: subscription.ProjectID().String(),
+		ChatID:    subscription.ChatID(),
+		IsActive:  subscription.IsActive(),
+		Filter: TelegramSubscriptionFilter{
+			EventTypes: nonNilFilterValues(subscription.EventTypes()),
+			Statuses:   nonNilFilterValues(subscription.Statuses()),
+			Branches:   nonNilFilterValues(subscription.BranchPatterns()),
+		},
+		DigestMode:      string(subscription.DigestMode()),
+		QuietHours:      toTelegramQuietHours(subscription.QuietHours()),
+		TransitionsOnly: subscription.TransitionsOnly(),
+		CreatedAt:       subscription.CreatedAt().Unix(),
+		UpdatedAt:       subscription.UpdatedAt().Unix(),
+	}
+}
+
+// toTelegramQuietHours converts domain quiet hours to a response DTO, nil without quiet hours
+func toTelegramQuietHours(quietHours domain.QuietHours) *TelegramQuietHours {
+	if quietHours.IsEmpty() {
+		return nil
+	}
+
+	return &TelegramQuietHours{
+		Timezone: quietHours.Timezone,
+		Start:    quietHours.Start,
+		End:      quietHours.End,
+		Bypass: TelegramSubscriptionFilter{
+			EventTypes: nonNilFilterValues(quietHours.Bypass.EventTypes),
+			Statuses:   nonNilFilterValues(quietHours.Bypass.Statuses),
+			Branches:   nonNilFilterValues(quietHours.Bypass.BranchPatterns),
+		},
+	}
+}
+
+// nonNilFilterValues renders missing filter lists as empty JSON arrays
+func nonNilFilterValues(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
 }
 
 // TelegramSubscriptionStatsResponse represents subscription statistics
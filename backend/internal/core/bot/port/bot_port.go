@@ -12,6 +12,9 @@ type BotService interface {
 	// Command handling
 	HandleCommand(ctx context.Context, commandCtx *domain.CommandContext) error
 
+	// Inline keyboard handling
+	HandleCallback(ctx context.Context, callbackCtx *domain.CallbackContext) error
+
 	// Basic commands
 	HandleStartCommand(ctx context.Context, req *dto.StartCommandRequest) (*dto.StartCommandResponse, error)
 	HandleHelpCommand(ctx context.Context, req *HelpCommandRequest) (*dto.HelpCommandResponse, error)
@@ -36,6 +39,7 @@ type BotService interface {
 type TelegramAPI interface {
 	SendMessage(chatID int64, text string) error
 	SendMessageWithMarkdown(chatID int64, text string) error
+	AnswerCallbackQuery(callbackQueryID, text string) error
 	SetWebhook(webhookURL string) error
 	DeleteWebhook() error
 }
@@ -52,6 +56,12 @@ type CommandRouter interface {
 	RouteCommand(ctx *domain.CommandContext) error
 }
 
+// CallbackActionService interface defines the contract for the actions of inline keyboard buttons
+type CallbackActionService interface {
+	// HandleCallback performs the action of a pressed button and returns the notice shown to the user
+	HandleCallback(ctx context.Context, callbackCtx *domain.CallbackContext) (notice string, err error)
+}
+
 // WebhookHandler interface defines the contract for webhook handling
 type WebhookHandler interface {
 	HandleTelegramWebhook(ctx context.Context, payload []byte) error
@@ -87,10 +97,14 @@ type Project struct {
 }
 
 type Subscription struct {
-	ID          string   `json:"id"`
-	ProjectName string   `json:"project_name"`
-	ChatID      int64    `json:"chat_id"`
-	UserID      int64    `json:"user_id"`
-	EventTypes  []string `json:"event_types"`
-	IsActive    bool     `json:"is_active"`
+	ID              string   `json:"id"`
+	ProjectName     string   `json:"project_name"`
+	ChatID          int64    `json:"chat_id"`
+	UserID          int64    `json:"user_id"`
+	EventTypes      []string `json:"event_types"`
+	Statuses        []string `json:"statuses"`
+	Branches        []string `json:"branches"`
+	DigestMode      string   `json:"digest_mode"`
+	TransitionsOnly bool     `json:"transitions_only"`
+	IsActive        bool     `json:"is_active"`
 }
@@ -2,11 +2,14 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/bot/domain"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/bot/dto"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/bot/port"
+	notificationDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
 )
 
 // BotServiceImpl implements the BotService interface
@@ -16,6 +19,7 @@ type BotServiceImpl struct {
 	commandRouter       port.CommandRouter
 	projectService      port.ProjectService
 	subscriptionService port.SubscriptionService
+	callbackActions     port.CallbackActionService
 }
 
 // NewBotService creates a new bot service instance
@@ -25,6 +29,18 @@ func NewBotService(
 	commandRouter port.CommandRouter,
 	projectService port.ProjectService,
 	subscriptionService port.SubscriptionService,
+) port.BotService {
+	return NewBotServiceWithCallbackActions(telegramAPI, commandValidator, commandRouter, projectService, subscriptionService, nil)
+}
+
+// NewBotServiceWithCallbackActions creates a new bot service instance that handles the inline buttons of notifications
+func NewBotServiceWithCallbackActions(
+	telegramAPI port.TelegramAPI,
+	commandValidator port.CommandValidator,
+	commandRouter port.CommandRouter,
+	projectService port.ProjectService,
+	subscriptionService port.SubscriptionService,
+	callbackActions port.CallbackActionService,
 ) port.BotService {
 	service := &BotServiceImpl{
 		telegramAPI:         telegramAPI,
@@ -32,6 +48,7 @@ func NewBotService(
 		commandRouter:       commandRouter,
 		projectService:      projectService,
 		subscriptionService: subscriptionService,
+		callbackActions:     callbackActions,
 	}
 
 	// Register command handlers
@@ -61,6 +78,28 @@ func (bs *BotServiceImpl) HandleCommand(ctx context.Context, commandCtx *domain.
 	return bs.commandRouter.RouteCommand(commandCtx)
 }
 
+// HandleCallback handles presses of inline keyboard buttons.
+// The query is always answered, otherwise Telegram keeps showing a progress indicator on the button.
+func (bs *BotServiceImpl) HandleCallback(ctx context.Context, callbackCtx *domain.CallbackContext) error {
+	if bs.callbackActions == nil {
+		return bs.telegramAPI.AnswerCallbackQuery(callbackCtx.QueryID, fmt.Sprintf("❌ Error: %s", domain.ErrCallbackUnavailable.Error()))
+	}
+
+	notice, err := bs.callbackActions.HandleCallback(ctx, callbackCtx)
+	if err != nil {
+		errorMsg := "❌ Action failed. Please try again."
+		if errors.Is(err, domain.ErrCallbackNotAuthorized) {
+			errorMsg = fmt.Sprintf("❌ Error: %s", err.Error())
+		}
+		if answerErr := bs.telegramAPI.AnswerCallbackQuery(callbackCtx.QueryID, errorMsg); answerErr != nil {
+			return fmt.Errorf("failed to answer callback query: %w", answerErr)
+		}
+		return err
+	}
+
+	return bs.telegramAPI.AnswerCallbackQuery(callbackCtx.QueryID, notice)
+}
+
 // HandleStartCommand handles /start command
 func (bs *BotServiceImpl) HandleStartCommand(ctx context.Context, req *dto.StartCommandRequest) (*dto.StartCommandResponse, error) {
 	welcomeText := fmt.Sprintf(`🎉 *Welcome to CICD Status Notifier Bot!*
@@ -101,13 +140,15 @@ func (bs *BotServiceImpl) HandleHelpCommand(ctx context.Context, req *port.HelpC
 • */status all* - Get status for all projects
 
 🔔 *Notification Commands:*
-• */subscribe* <project> - Subscribe to project notifications
+• */subscribe* <project> [filters] - Subscribe to project notifications
 • */unsubscribe* <project> - Unsubscribe from project notifications
 • */list* - List your subscribed projects
 
 *Usage Examples:*
 • ` + "`/status my-app`" + ` - Get status for "my-app" project
 • ` + "`/subscribe my-app`" + ` - Subscribe to "my-app" notifications
+• ` + "`/subscribe my-app status=failed branches=main,release/*`" + ` - Only failed builds of main and release branches
+• ` + "`/subscribe my-app digest=daily`" + ` - One digest a day at midnight UTC (` + "`digest=hourly`" + ` sends one at the end of each UTC hour)
 • ` + "`/unsubscribe my-app`" + ` - Unsubscribe from "my-app"
 
 *Need more help?* Contact your system administrator.`
@@ -120,13 +161,15 @@ func (bs *BotServiceImpl) HandleHelpCommand(ctx context.Context, req *port.HelpC
 		{Command: "/start", Description: "Welcome message and quick introduction", Usage: "/start", Category: "Basic"},
 		{Command: "/help", Description: "Show this help message", Usage: "/help", Category: "Basic"},
 		{Command: "/status", Description: "Get current pipeline status", Usage: "/status [project]", Category: "Pipeline"},
-		{Command: "/subscribe", Description: "Subscribe to project notifications", Usage: "/subscribe <project>", Category: "Notification"},
+		{Command: "/subscribe", Description: "Subscribe to project notifications, digests cover UTC hours and days", Usage: "/subscribe <project> [events=...] [status=...] [branches=...] [digest=hourly|daily] [transitions=only]", Category: "Notification"},
 		{Command: "/unsubscribe", Description: "Unsubscribe from project notifications", Usage: "/unsubscribe <project>", Category: "Notification"},
 	}
 
 	examples := []dto.UsageExample{
 		{Command: "/status my-app", Description: "Get status for 'my-app' project"},
 		{Command: "/subscribe my-app", Description: "Subscribe to 'my-app' notifications"},
+		{Command: "/subscribe my-app status=failed branches=main,release/*", Description: "Only failed builds of main and release branches of 'my-app'"},
+		{Command: "/subscribe my-app digest=daily", Description: "One digest of 'my-app' builds a day, sent at midnight UTC"},
 		{Command: "/unsubscribe my-app", Description: "Unsubscribe from 'my-app'"},
 	}
 
@@ -160,8 +203,24 @@ func (bs *BotServiceImpl) HandleStatusCommand(ctx context.Context, req *dto.Stat
 
 // HandleSubscribeCommand handles /subscribe command
 func (bs *BotServiceImpl) HandleSubscribeCommand(ctx context.Context, req *dto.SubscribeCommandRequest) (*dto.SubscribeCommandResponse, error) {
-	// For now, return a mock response. This will be connected to real subscription service later
-	response := fmt.Sprintf("🔔 Successfully subscribed to notifications for project: *%s*", req.ProjectName)
+	filter, err := req.Filter.ToDomain()
+	if err != nil {
+		return nil, bs.sendSubscriptionError(ctx, req.ChatID, err)
+	}
+
+	digestMode, err := notificationDomain.ParseDigestMode(req.DigestMode)
+	if err != nil {
+		return nil, bs.sendSubscriptionError(ctx, req.ChatID, err)
+	}
+
+	if bs.subscriptionService != nil {
+		if err := bs.subscriptionService.Subscribe(ctx, req); err != nil {
+			return nil, bs.sendSubscriptionError(ctx, req.ChatID, err)
+		}
+	}
+
+	response := fmt.Sprintf("🔔 Successfully subscribed to notifications for project: *%s*", req.ProjectName) +
+		describeSubscriptionFilter(filter) + describeDigestMode(digestMode) + describeTransitionsOnly(req.TransitionsOnly)
 
 	if err := bs.SendFormattedMessage(ctx, req.ChatID, response, "Markdown"); err != nil {
 		return nil, fmt.Errorf("failed to send subscription message: %w", err)
@@ -176,7 +235,12 @@ func (bs *BotServiceImpl) HandleSubscribeCommand(ctx context.Context, req *dto.S
 
 // HandleUnsubscribeCommand handles /unsubscribe command
 func (bs *BotServiceImpl) HandleUnsubscribeCommand(ctx context.Context, req *dto.UnsubscribeCommandRequest) (*dto.UnsubscribeCommandResponse, error) {
-	// For now, return a mock response. This will be connected to real subscription service later
+	if bs.subscriptionService != nil {
+		if err := bs.subscriptionService.Unsubscribe(ctx, req); err != nil {
+			return nil, bs.sendSubscriptionError(ctx, req.ChatID, err)
+		}
+	}
+
 	response := fmt.Sprintf("🔕 Successfully unsubscribed from notifications for project: *%s*", req.ProjectName)
 
 	if err := bs.SendFormattedMessage(ctx, req.ChatID, response, "Markdown"); err != nil {
@@ -190,6 +254,28 @@ func (bs *BotServiceImpl) HandleUnsubscribeCommand(ctx context.Context, req *dto
 	}, nil
 }
 
+// sendSubscriptionError tells the user why a subscription command failed and returns the error.
+// Only errors about the command itself are shown, other errors get a generic message.
+func (bs *BotServiceImpl) sendSubscriptionError(ctx context.Context, chatID int64, err error) error {
+	errorMsg := "❌ Subscription update failed. Please try again."
+
+	var domainErr exception.DomainError
+	switch {
+	case errors.As(err, &domainErr) && (domainErr.Code == notificationDomain.ErrCodeInvalidSubscriptionFilter ||
+		domainErr.Code == notificationDomain.ErrCodeInvalidDigestMode):
+		errorMsg = fmt.Sprintf("❌ Error: %s", domainErr.Message)
+	case errors.Is(err, domain.ErrSubscriptionProjectNotFound),
+		errors.Is(err, domain.ErrNotSubscribed),
+		errors.Is(err, domain.ErrInvalidSubscriptionFilter):
+		errorMsg = fmt.Sprintf("❌ Error: %s", err.Error())
+	}
+
+	if sendErr := bs.SendMessage(ctx, chatID, errorMsg); sendErr != nil {
+		return fmt.Errorf("failed to send subscription error: %w", sendErr)
+	}
+	return err
+}
+
 // SendMessage sends a plain text message
 func (bs *BotServiceImpl) SendMessage(ctx context.Context, chatID int64, message string) error {
 	return bs.telegramAPI.SendMessage(chatID, message)
@@ -268,13 +354,21 @@ func (h *subscribeCommandHandler) Handle(ctx *domain.CommandContext) error {
 		return fmt.Errorf("project name is required")
 	}
 
+	filter, digestMode, transitionsOnly, err := parseSubscriptionFilter(ctx.Args[1:])
+	if err != nil {
+		return h.botService.sendSubscriptionError(context.Background(), ctx.ChatID, err)
+	}
+
 	req := &dto.SubscribeCommandRequest{
-		ProjectName: ctx.Args[0],
-		ChatID:      ctx.ChatID,
-		UserID:      ctx.UserID,
-		Username:    ctx.Username,
+		ProjectName:     ctx.Args[0],
+		ChatID:          ctx.ChatID,
+		UserID:          ctx.UserID,
+		Username:        ctx.Username,
+		Filter:          filter,
+		DigestMode:      digestMode,
+		TransitionsOnly: transitionsOnly,
 	}
-	_, err := h.botService.HandleSubscribeCommand(context.Background(), req)
+	_, err = h.botService.HandleSubscribeCommand(context.Background(), req)
 	return err
 }
 
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/bot/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/bot/port"
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	buildPort "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/port"
+	notificationDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	notificationPort "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+)
+
+// CallbackActionDep holds the dependencies of the callback action service
+type CallbackActionDep struct {
+	BuildService             buildPort.BuildEventService
+	TelegramSubscriptionRepo notificationPort.TelegramSubscriptionRepository
+	NotificationRepo         notificationPort.NotificationLogRepository
+	NotificationSender       notificationPort.NotificationSender
+	// Escalations is optional, acknowledging a build stops escalating its branch when set
+	Escalations notificationPort.EscalationService
+	Logger      *logrus.Logger
+}
+
+// callbackActionService performs the actions of the inline buttons on build notifications
+type callbackActionService struct {
+	CallbackActionDep
+}
+
+// NewCallbackActionService creates a new callback action service
+func NewCallbackActionService(d CallbackActionDep) port.CallbackActionService {
+	return &callbackActionService{
+		CallbackActionDep: d,
+	}
+}
+
+// HandleCallback performs the action of a pressed button and writes its result below the notification
+func (s *callbackActionService) HandleCallback(ctx context.Context, callbackCtx *domain.CallbackContext) (string, error) {
+	action, buildEventID, err := notificationDomain.ParseTelegramCallbackData(callbackCtx.Data)
+	if err != nil {
+		return "", err
+	}
+
+	buildEvent, err := s.BuildService.GetBuildEvent(ctx, buildEventID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get build event: %w", err)
+	}
+
+	// Buttons only act on projects the chat is subscribed to, callback data can be forged.
+	// Escalation chats of the project may only acknowledge, they get no other buttons.
+	subscription, err := s.activeSubscription(ctx, buildEvent.ProjectID(), callbackCtx.ChatID)
+	if err != nil {
+		return "", err
+	}
+	if subscription == nil {
+		isEscalationChat, err := s.isEscalationChat(ctx, buildEvent.ProjectID(), callbackCtx.ChatID)
+		if err != nil {
+			return "", err
+		}
+		if !isEscalationChat || action != notificationDomain.TelegramActionAcknowledge {
+			return "", domain.ErrCallbackNotAuthorized
+		}
+	}
+
+	actor := callbackActor(callbackCtx)
+
+	var notice, result string
+	switch action {
+	case notificationDomain.TelegramActionMute:
+		mutedUntil := time.Now().Add(notificationDomain.TelegramMuteDuration).UTC()
+		subscription.MuteUntil(mutedUntil)
+		if err := s.TelegramSubscriptionRepo.Update(ctx, subscription); err != nil {
+			return "", fmt.Errorf("failed to mute telegram subscription: %w", err)
+		}
+		notice = "🔕 Project muted for 1 hour"
+		result = fmt.Sprintf("🔕 Muted until %s UTC by %s", mutedUntil.Format("15:04"), actor)
+	case notificationDomain.TelegramActionAcknowledge:
+		if s.Escalations != nil {
+			if _, err := s.Escalations.AcknowledgeEscalations(ctx, buildEvent.ProjectID(), buildEvent.Branch(), actor); err != nil {
+				return "", fmt.Errorf("failed to acknowledge escalations: %w", err)
+			}
+		}
+		notice = "👀 Build acknowledged"
+		result = fmt.Sprintf("👀 Acknowledged by %s", actor)
+	case notificationDomain.TelegramActionFailingJobs:
+		jobs, err := s.BuildService.GetBuildJobs(ctx, buildEventID)
+		if err != nil {
+			return "", fmt.Errorf("failed to get build jobs: %w", err)
+		}
+		notice = "📋 Failing jobs"
+		result = formatFailingJobs(jobs)
+	}
+
+	// Escalation chats keep the escalation buttons
+	keyboard := notificationDomain.NewTelegramBuildKeyboard(buildEvent.ID(), buildEvent.BuildURL())
+	if subscription == nil {
+		keyboard = notificationDomain.NewTelegramEscalationKeyboard(buildEvent.ID(), buildEvent.BuildURL())
+	}
+
+	if err := s.updateMessage(ctx, callbackCtx, buildEvent, keyboard, result); err != nil {
+		return "", err
+	}
+
+	s.Logger.WithFields(logrus.Fields{
+		"action":         action,
+		"build_event_id": buildEventID.String(),
+		"chat_id":        callbackCtx.ChatID,
+		"user_id":        callbackCtx.UserID,
+	}).Info("Telegram notification action handled")
+
+	return notice, nil
+}
+
+// updateMessage shows the result of an action below the notification text, replacing an earlier result
+func (s *callbackActionService) updateMessage(
+	ctx context.Context,
+	callbackCtx *domain.CallbackContext,
+	buildEvent *buildDomain.BuildEvent,
+	keyboard *notificationDomain.TelegramInlineKeyboard,
+	result string,
+) error {
+	messageID := strconv.Itoa(callbackCtx.MessageID)
+
+	text := s.notificationText(ctx, buildEvent.ID(), callbackCtx.ChatID, messageID)
+	if text == "" {
+		// Formatting of the original text is lost, but the result is still shown
+		text = html.EscapeString(callbackCtx.MessageText)
+	}
+
+	message := text + "\n\n" + result
+
+	if err := s.NotificationSender.EditTelegramNotification(ctx, callbackCtx.ChatID, messageID, message, keyboard); err != nil {
+		return fmt.Errorf("failed to update telegram message: %w", err)
+	}
+
+	return nil
+}
+
+// activeSubscription returns the active subscription of the chat to a project, nil when it is not subscribed
+func (s *callbackActionService) activeSubscription(
+	ctx context.Context,
+	projectID value_objects.ID,
+	chatID int64,
+) (*notificationDomain.TelegramSubscription, error) {
+	subscription, err := s.TelegramSubscriptionRepo.GetByProjectAndChatID(ctx, projectID, chatID)
+	if err != nil {
+		if errors.Is(err, notificationDomain.ErrTelegramSubscriptionNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get telegram subscription: %w", err)
+	}
+	if !subscription.IsActive() {
+		return nil, nil
+	}
+	return subscription, nil
+}
+
+// isEscalationChat checks if escalations of a project are sent to the chat
+func (s *callbackActionService) isEscalationChat(ctx context.Context, projectID value_objects.ID, chatID int64) (bool, error) {
+	if s.Escalations == nil {
+		return false, nil
+	}
+
+	isEscalationChat, err := s.Escalations.IsEscalationChat(ctx, projectID, chatID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get escalation policies: %w", err)
+	}
+	return isEscalationChat, nil
+}
+
+// notificationText returns the text the notifier last sent as the given message
+func (s *callbackActionService) notificationText(ctx context.Context, buildEventID value_objects.ID, chatID int64, messageID string) string {
+	logs, err := s.NotificationRepo.GetByBuildEventID(ctx, buildEventID)
+	if err != nil {
+		s.Logger.WithError(err).Warn("Failed to look up notification text")
+		return ""
+	}
+
+	recipient := strconv.FormatInt(chatID, 10)
+
+	var latest *notificationDomain.NotificationLog
+	for _, log := range logs {
+		if log.Channel() != notificationDomain.NotificationChannelTelegram ||
+			log.Recipient() != recipient ||
+			log.MessageID() == nil || *log.MessageID() != messageID ||
+			log.SentAt() == nil {
+			continue
+		}
+
+		if latest == nil || log.SentAt().ToTime().After(latest.SentAt().ToTime()) {
+			latest = log
+		}
+	}
+
+	if latest == nil {
+		return ""
+	}
+	return latest.Message()
+}
+
+// callbackActor names the user who pressed a button
+func callbackActor(callbackCtx *domain.CallbackContext) string {
+	if callbackCtx.Username != "" {
+		return "@" + html.EscapeString(callbackCtx.Username)
+	}
+	return fmt.Sprintf("user %d", callbackCtx.UserID)
+}
+
+// formatFailingJobs lists the failed jobs of a build and their failed steps
+func formatFailingJobs(jobs []*buildDomain.BuildJob) string {
+	var b strings.Builder
+	for _, job := range jobs {
+		if !job.IsFailed() {
+			continue
+		}
+		if b.Len() == 0 {
+			b.WriteString("📋 <b>Failing jobs:</b>")
+		}
+		fmt.Fprintf(&b, "\n• %s", html.EscapeString(job.Name()))
+		if steps := job.FailedSteps(); len(steps) > 0 {
+			fmt.Fprintf(&b, " → %s", html.EscapeString(strings.Join(steps, ", ")))
+		}
+	}
+
+	if b.Len() == 0 {
+		return "📋 No failing jobs"
+	}
+	return b.String()
+}
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/bot/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/bot/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/bot/port"
+	notificationDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	notificationPort "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	projectDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/project/domain"
+	projectPort "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/project/port"
+)
+
+// SubscriptionDep holds the dependencies of the bot subscription service
+type SubscriptionDep struct {
+	ProjectRepo              projectPort.ProjectRepository
+	TelegramSubscriptionRepo notificationPort.TelegramSubscriptionRepository
+	Logger                   *logrus.Logger
+}
+
+// subscriptionService manages the telegram subscriptions of chats by project name
+type subscriptionService struct {
+	SubscriptionDep
+}
+
+// NewSubscriptionService creates a new bot subscription service
+func NewSubscriptionService(d SubscriptionDep) port.SubscriptionService {
+	return &subscriptionService{
+		SubscriptionDep: d,
+	}
+}
+
+// Subscribe subscribes a chat to a project. Subscribing again replaces the filter, digest mode and transitions only option
+// and reactivates the subscription.
+func (s *subscriptionService) Subscribe(ctx context.Context, req *dto.SubscribeCommandRequest) error {
+	filter, err := req.Filter.ToDomain()
+	if err != nil {
+		return err
+	}
+
+	digestMode, err := notificationDomain.ParseDigestMode(req.DigestMode)
+	if err != nil {
+		return err
+	}
+
+	project, err := s.getProject(ctx, req.ProjectName)
+	if err != nil {
+		return err
+	}
+
+	subscription, err := s.TelegramSubscriptionRepo.GetByProjectAndChatID(ctx, project.ID(), req.ChatID)
+	if err != nil && !errors.Is(err, notificationDomain.ErrTelegramSubscriptionNotFound) {
+		return fmt.Errorf("failed to get telegram subscription: %w", err)
+	}
+
+	if subscription != nil {
+		subscription.UpdateFilter(filter)
+		if err := subscription.UpdateDigestMode(digestMode); err != nil {
+			return err
+		}
+		subscription.UpdateTransitionsOnly(req.TransitionsOnly)
+		subscription.Activate()
+		if err := s.TelegramSubscriptionRepo.Update(ctx, subscription); err != nil {
+			return fmt.Errorf("failed to update telegram subscription: %w", err)
+		}
+	} else {
+		subscription, err = notificationDomain.NewTelegramSubscription(project.ID(), req.ChatID)
+		if err != nil {
+			return fmt.Errorf("failed to create telegram subscription: %w", err)
+		}
+		subscription.UpdateFilter(filter)
+		if err := subscription.UpdateDigestMode(digestMode); err != nil {
+			return err
+		}
+		subscription.UpdateTransitionsOnly(req.TransitionsOnly)
+		if err := s.TelegramSubscriptionRepo.Create(ctx, subscription); err != nil {
+			return fmt.Errorf("failed to create telegram subscription: %w", err)
+		}
+	}
+
+	s.Logger.WithFields(logrus.Fields{
+		"project_id": project.ID().String(),
+		"chat_id":    req.ChatID,
+		"user_id":    req.UserID,
+	}).Info("Chat subscribed to project")
+
+	return nil
+}
+
+// Unsubscribe removes the subscription of a chat to a project
+func (s *subscriptionService) Unsubscribe(ctx context.Context, req *dto.UnsubscribeCommandRequest) error {
+	project, err := s.getProject(ctx, req.ProjectName)
+	if err != nil {
+		return err
+	}
+
+	subscription, err := s.TelegramSubscriptionRepo.GetByProjectAndChatID(ctx, project.ID(), req.ChatID)
+	if err != nil {
+		if errors.Is(err, notificationDomain.ErrTelegramSubscriptionNotFound) {
+			return domain.ErrNotSubscribed
+		}
+		return fmt.Errorf("failed to get telegram subscription: %w", err)
+	}
+
+	if err := s.TelegramSubscriptionRepo.Delete(ctx, subscription.ID()); err != nil {
+		return fmt.Errorf("failed to delete telegram subscription: %w", err)
+	}
+
+	return nil
+}
+
+// IsSubscribed checks if a chat has an active subscription to a project
+func (s *subscriptionService) IsSubscribed(ctx context.Context, chatID int64, projectName string) (bool, error) {
+	project, err := s.getProject(ctx, projectName)
+	if err != nil {
+		return false, err
+	}
+
+	subscription, err := s.TelegramSubscriptionRepo.GetByProjectAndChatID(ctx, project.ID(), chatID)
+	if err != nil {
+		if errors.Is(err, notificationDomain.ErrTelegramSubscriptionNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get telegram subscription: %w", err)
+	}
+
+	return subscription.IsActive(), nil
+}
+
+// GetSubscriptions lists the active subscriptions of a chat
+func (s *subscriptionService) GetSubscriptions(ctx context.Context, chatID int64) ([]*port.Subscription, error) {
+	subscriptions, err := s.TelegramSubscriptionRepo.GetActiveSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get telegram subscriptions: %w", err)
+	}
+
+	var result []*port.Subscription
+	for _, subscription := range subscriptions {
+		if subscription.ChatID() != chatID {
+			continue
+		}
+
+		project, err := s.ProjectRepo.GetByID(ctx, subscription.ProjectID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project: %w", err)
+		}
+
+		result = append(result, &port.Subscription{
+			ID:              subscription.ID().String(),
+			ProjectName:     project.Name(),
+			ChatID:          subscription.ChatID(),
+			EventTypes:      subscription.EventTypes(),
+			Statuses:        subscription.Statuses(),
+			Branches:        subscription.BranchPatterns(),
+			DigestMode:      string(subscription.DigestMode()),
+			TransitionsOnly: subscription.TransitionsOnly(),
+			IsActive:        subscription.IsActive(),
+		})
+	}
+
+	return result, nil
+}
+
+// getProject looks up a project by the name given in a command
+func (s *subscriptionService) getProject(ctx context.Context, name string) (*projectDomain.Project, error) {
+	project, err := s.ProjectRepo.GetByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, projectDomain.ErrProjectNotFound) {
+			return nil, domain.ErrSubscriptionProjectNotFound
+		}
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	return project, nil
+}
+
+// parseSubscriptionFilter parses the key=value filter arguments of /subscribe, its digest mode and whether only
+// build transitions are notified, e.g. "events=push,release status=failed branches=main,release/* digest=hourly transitions=only"
+func parseSubscriptionFilter(args []string) (dto.TelegramSubscriptionFilter, string, bool, error) {
+	var filter dto.TelegramSubscriptionFilter
+	var digestMode string
+	var transitionsOnly bool
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok || value == "" {
+			return dto.TelegramSubscriptionFilter{}, "", false, domain.ErrInvalidSubscriptionFilter
+		}
+
+		values := strings.Split(value, ",")
+		switch strings.ToLower(key) {
+		case "events":
+			filter.EventTypes = append(filter.EventTypes, values...)
+		case "status":
+			filter.Statuses = append(filter.Statuses, values...)
+		case "branches":
+			filter.Branches = append(filter.Branches, values...)
+		case "digest":
+			digestMode = value
+		case "transitions":
+			switch strings.ToLower(value) {
+			case "only":
+				transitionsOnly = true
+			case "all":
+				transitionsOnly = false
+			default:
+				return dto.TelegramSubscriptionFilter{}, "", false, domain.ErrInvalidSubscriptionFilter
+			}
+		default:
+			return dto.TelegramSubscriptionFilter{}, "", false, domain.ErrInvalidSubscriptionFilter
+		}
+	}
+	return filter, digestMode, transitionsOnly, nil
+}
+
+// describeSubscriptionFilter lists the filters of a subscription in a Markdown reply
+func describeSubscriptionFilter(filter notificationDomain.SubscriptionFilter) string {
+	if filter.IsEmpty() {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("\n\n📋 *Only notifying about:*")
+	for _, line := range []struct {
+		label  string
+		values []string
+	}{
+		{"Events", filter.EventTypes},
+		{"Statuses", filter.Statuses},
+		{"Branches", filter.BranchPatterns},
+	} {
+		if len(line.values) == 0 {
+			continue
+		}
+		// Code spans keep Markdown characters of branch patterns, e.g. "*", literal
+		fmt.Fprintf(&b, "\n• %s: `%s`", line.label, strings.Join(line.values, "`, `"))
+	}
+	return b.String()
+}
+
+// describeDigestMode tells in a Markdown reply when a digest subscription is notified
+func describeDigestMode(mode notificationDomain.DigestMode) string {
+	switch mode {
+	case notificationDomain.DigestModeHourly:
+		return "\n\n📬 Notifications are sent as an hourly digest at the end of each UTC hour."
+	case notificationDomain.DigestModeDaily:
+		return "\n\n📬 Notifications are sent as a daily digest at midnight UTC."
+	default:
+		return ""
+	}
+}
+
+// describeTransitionsOnly tells in a Markdown reply that only builds breaking or fixing a branch are notified
+func describeTransitionsOnly(transitionsOnly bool) string {
+	if !transitionsOnly {
+		return ""
+	}
+	return "\n\n🚦 Only builds breaking or fixing a branch are notified."
+}
@@ -19,9 +19,29 @@ const (
 	BuildStatusSkipped    BuildStatus = "skipped"
 )
 
+// IsTerminal checks if the status ends a build lifecycle
+func (s BuildStatus) IsTerminal() bool {
+	switch s {
+	case BuildStatusSuccess, BuildStatusFailed, BuildStatusCancelled, BuildStatusSkipped:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsValid checks if the status is a known build status
+func (s BuildStatus) IsValid() bool {
+	return isValidBuildStatus(s)
+}
+
 // EventType represents the type of build event
 type EventType value_objects.Status
 
+// IsValid checks if the event type is a known build event type
+func (t EventType) IsValid() bool {
+	return isValidEventType(t)
+}
+
 const (
 	EventTypePush                EventType = "push"
 	EventTypePullRequest         EventType = "pull_request"
@@ -36,6 +56,7 @@ const (
 type BuildEvent struct {
 	id              value_objects.ID
 	projectID       value_objects.ID
+	runID           string
 	eventType       EventType
 	status          BuildStatus
 	branch          string
@@ -47,11 +68,13 @@ type BuildEvent struct {
 	durationSeconds *int
 	webhookPayload  json.RawMessage
 	createdAt       value_objects.Timestamp
+	updatedAt       value_objects.Timestamp
 }
 
 // BuildEventParams contains parameters for creating a build event
 type BuildEventParams struct {
 	ProjectID      value_objects.ID
+	RunID          string
 	EventType      EventType
 	Status         BuildStatus
 	Branch         string
@@ -73,6 +96,7 @@ func NewBuildEvent(params BuildEventParams) (*BuildEvent, error) {
 	buildEvent := &BuildEvent{
 		id:             value_objects.NewID(),
 		projectID:      params.ProjectID,
+		runID:          params.RunID,
 		eventType:      params.EventType,
 		status:         params.Status,
 		branch:         params.Branch,
@@ -83,6 +107,7 @@ func NewBuildEvent(params BuildEventParams) (*BuildEvent, error) {
 		buildURL:       params.BuildURL,
 		webhookPayload: params.WebhookPayload,
 		createdAt:      value_objects.NewTimestamp(),
+		updatedAt:      value_objects.NewTimestamp(),
 	}
 
 	if err := buildEvent.validate(); err != nil {
@@ -96,6 +121,7 @@ func NewBuildEvent(params BuildEventParams) (*BuildEvent, error) {
 type RestoreBuildEventParams struct {
 	ID              value_objects.ID
 	ProjectID       value_objects.ID
+	RunID           string
 	EventType       EventType
 	Status          BuildStatus
 	Branch          string
@@ -107,6 +133,7 @@ type RestoreBuildEventParams struct {
 	DurationSeconds *int
 	WebhookPayload  json.RawMessage
 	CreatedAt       value_objects.Timestamp
+	UpdatedAt       value_objects.Timestamp
 }
 
 // RestoreBuildEvent restores a build event from persistence data
@@ -114,6 +141,7 @@ func RestoreBuildEvent(params RestoreBuildEventParams) *BuildEvent {
 	return &BuildEvent{
 		id:              params.ID,
 		projectID:       params.ProjectID,
+		runID:           params.RunID,
 		eventType:       params.EventType,
 		status:          params.Status,
 		branch:          params.Branch,
@@ -125,6 +153,7 @@ func RestoreBuildEvent(params RestoreBuildEventParams) *BuildEvent {
 		durationSeconds: params.DurationSeconds,
 		webhookPayload:  params.WebhookPayload,
 		createdAt:       params.CreatedAt,
+		updatedAt:       params.UpdatedAt,
 	}
 }
 
@@ -182,6 +211,11 @@ func (be *BuildEvent) ProjectID() value_objects.ID {
 	return be.projectID
 }
 
+// RunID returns the CI provider run identifier, empty for events without a run
+func (be *BuildEvent) RunID() string {
+	return be.runID
+}
+
 // EventType returns the event type
 func (be *BuildEvent) EventType() EventType {
 	return be.eventType
@@ -237,14 +271,32 @@ func (be *BuildEvent) CreatedAt() value_objects.Timestamp {
 	return be.createdAt
 }
 
-// UpdateStatus updates the build status
+// UpdatedAt returns when the build event last changed, for a completed build when it completed
+func (be *BuildEvent) UpdatedAt() value_objects.Timestamp {
+	return be.updatedAt
+}
+
+// UpdateStatus updates the build status.
+// A started build or deployment becomes completed once it reaches a terminal status.
 func (be *BuildEvent) UpdateStatus(status BuildStatus) {
 	be.status = status
+	be.updatedAt = value_objects.NewTimestamp()
+	if !status.IsTerminal() {
+		return
+	}
+
+	switch be.eventType {
+	case EventTypeBuildStarted:
+		be.eventType = EventTypeBuildCompleted
+	case EventTypeDeploymentStarted:
+		be.eventType = EventTypeDeploymentCompleted
+	}
 }
 
 // SetDuration sets the build duration
 func (be *BuildEvent) SetDuration(seconds int) {
 	be.durationSeconds = &seconds
+	be.updatedAt = value_objects.NewTimestamp()
 }
 
 // IsCompleted checks if the build is completed (success, failed, or cancelled)
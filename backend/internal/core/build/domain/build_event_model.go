@@ -13,6 +13,7 @@ import (
 type BuildEventModel struct {
 	ID              uuid.UUID       `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()" json:"id"`
 	ProjectID       uuid.UUID       `gorm:"type:uuid;not null;index:idx_build_events_project_id" json:"project_id"`
+	RunID           string          `gorm:"type:varchar(100);index:idx_build_events_run_id" json:"run_id"`
 	EventType       string          `gorm:"type:varchar(50);not null;index:idx_build_events_event_type" json:"event_type"`
 	Status          string          `gorm:"type:varchar(20);not null;index:idx_build_events_status" json:"status"`
 	Branch          string          `gorm:"type:varchar(255);not null;index:idx_build_events_branch" json:"branch"`
@@ -41,10 +42,12 @@ func (m *BuildEventModel) ToEntity() *BuildEvent {
 	id := value_objects.NewIDFromUUID(m.ID)
 	projectID := value_objects.NewIDFromUUID(m.ProjectID)
 	createdAt := value_objects.NewTimestampFromTime(m.CreatedAt)
+	updatedAt := value_objects.NewTimestampFromTime(m.UpdatedAt)
 
 	return RestoreBuildEvent(RestoreBuildEventParams{
 		ID:              id,
 		ProjectID:       projectID,
+		RunID:           m.RunID,
 		EventType:       EventType(m.EventType),
 		Status:          BuildStatus(m.Status),
 		Branch:          m.Branch,
@@ -56,6 +59,7 @@ func (m *BuildEventModel) ToEntity() *BuildEvent {
 		DurationSeconds: m.DurationSeconds,
 		WebhookPayload:  m.WebhookPayload,
 		CreatedAt:       createdAt,
+		UpdatedAt:       updatedAt,
 	})
 }
 
@@ -63,6 +67,7 @@ func (m *BuildEventModel) ToEntity() *BuildEvent {
 func (m *BuildEventModel) FromEntity(entity *BuildEvent) {
 	m.ID = entity.ID().Value()
 	m.ProjectID = entity.ProjectID().Value()
+	m.RunID = entity.RunID()
 	m.EventType = string(entity.EventType())
 	m.Status = string(entity.Status())
 	m.Branch = entity.Branch()
@@ -74,7 +79,7 @@ func (m *BuildEventModel) FromEntity(entity *BuildEvent) {
 	m.DurationSeconds = entity.DurationSeconds()
 	m.WebhookPayload = entity.WebhookPayload()
 	m.CreatedAt = entity.CreatedAt().ToTime()
-	m.UpdatedAt = time.Now()
+	m.UpdatedAt = entity.UpdatedAt().ToTime()
 }
 
 // ProjectModel represents a simplified project model for relationships
@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
+)
+
+// BuildJob represents a single job of a CI run, stored as a child of the run-level build event
+type BuildJob struct {
+	id              value_objects.ID
+	buildEventID    value_objects.ID
+	jobID           string
+	name            string
+	status          BuildStatus
+	failedSteps     []string
+	url             string
+	durationSeconds *int
+	createdAt       value_objects.Timestamp
+	updatedAt       value_objects.Timestamp
+}
+
+// BuildJobParams contains parameters for creating a new build job
+type BuildJobParams struct {
+	BuildEventID    value_objects.ID
+	JobID           string // CI provider job identifier
+	Name            string
+	Status          BuildStatus
+	FailedSteps     []string
+	URL             string
+	DurationSeconds *int
+}
+
+// NewBuildJob creates a new build job
+func NewBuildJob(params BuildJobParams) (*BuildJob, error) {
+	if params.BuildEventID.IsNil() {
+		return nil, exception.NewDomainError(ErrCodeInvalidBuildEvent, "build event ID cannot be nil")
+	}
+
+	if params.JobID == "" {
+		return nil, exception.NewDomainError(ErrCodeInvalidBuildEvent, "job ID cannot be empty")
+	}
+
+	if !isValidBuildStatus(params.Status) {
+		return nil, ErrInvalidBuildStatus
+	}
+
+	now := value_objects.NewTimestamp()
+	return &BuildJob{
+		id:              value_objects.NewID(),
+		buildEventID:    params.BuildEventID,
+		jobID:           params.JobID,
+		name:            params.Name,
+		status:          params.Status,
+		failedSteps:     params.FailedSteps,
+		url:             params.URL,
+		durationSeconds: params.DurationSeconds,
+		createdAt:       now,
+		updatedAt:       now,
+	}, nil
+}
+
+// RestoreBuildJobParams contains parameters for restoring a build job from persistence
+type RestoreBuildJobParams struct {
+	ID              value_objects.ID
+	BuildEventID    value_objects.ID
+	JobID           string
+	Name            string
+	Status          BuildStatus
+	FailedSteps     []string
+	URL             string
+	DurationSeconds *int
+	CreatedAt       value_objects.Timestamp
+	UpdatedAt       value_objects.Timestamp
+}
+
+// RestoreBuildJob restores a build job from persistence data
+func RestoreBuildJob(params RestoreBuildJobParams) *BuildJob {
+	return &BuildJob{
+		id:              params.ID,
+		buildEventID:    params.BuildEventID,
+		jobID:           params.JobID,
+		name:            params.Name,
+		status:          params.Status,
+		failedSteps:     params.FailedSteps,
+		url:             params.URL,
+		durationSeconds: params.DurationSeconds,
+		createdAt:       params.CreatedAt,
+		updatedAt:       params.UpdatedAt,
+	}
+}
+
+// ID returns the build job ID
+func (j *BuildJob) ID() value_objects.ID {
+	return j.id
+}
+
+// BuildEventID returns the ID of the run-level build event the job belongs to
+func (j *BuildJob) BuildEventID() value_objects.ID {
+	return j.buildEventID
+}
+
+// JobID returns the CI provider job identifier
+func (j *BuildJob) JobID() string {
+	return j.jobID
+}
+
+// Name returns the job name
+func (j *BuildJob) Name() string {
+	return j.name
+}
+
+// Status returns the job status
+func (j *BuildJob) Status() BuildStatus {
+	return j.status
+}
+
+// FailedSteps returns the names of the steps that failed
+func (j *BuildJob) FailedSteps() []string {
+	return j.failedSteps
+}
+
+// URL returns the link to the job logs
+func (j *BuildJob) URL() string {
+	return j.url
+}
+
+// DurationSeconds returns the job duration
+func (j *BuildJob) DurationSeconds() *int {
+	return j.durationSeconds
+}
+
+// CreatedAt returns the creation timestamp
+func (j *BuildJob) CreatedAt() value_objects.Timestamp {
+	return j.createdAt
+}
+
+// UpdatedAt returns the last update timestamp
+func (j *BuildJob) UpdatedAt() value_objects.Timestamp {
+	return j.updatedAt
+}
+
+// Update applies a later delivery of the job
+func (j *BuildJob) Update(status BuildStatus, failedSteps []string, durationSeconds *int) {
+	j.status = status
+	if len(failedSteps) > 0 {
+		j.failedSteps = failedSteps
+	}
+	if durationSeconds != nil {
+		j.durationSeconds = durationSeconds
+	}
+	j.updatedAt = value_objects.NewTimestamp()
+}
+
+// IsFailed checks if the job failed
+func (j *BuildJob) IsFailed() bool {
+	return j.status == BuildStatusFailed
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/google/uuid"
+)
+
+// BuildJobModel represents the GORM model for build jobs
+type BuildJobModel struct {
+	ID              uuid.UUID       `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()" json:"id"`
+	BuildEventID    uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:idx_build_jobs_build_event_job" json:"build_event_id"`
+	JobID           string          `gorm:"type:varchar(100);not null;uniqueIndex:idx_build_jobs_build_event_job" json:"job_id"`
+	Name            string          `gorm:"type:varchar(255)" json:"name"`
+	Status          string          `gorm:"type:varchar(20);not null" json:"status"`
+	FailedSteps     json.RawMessage `gorm:"type:jsonb" json:"failed_steps"`
+	URL             string          `gorm:"type:varchar(500)" json:"url"`
+	DurationSeconds *int            `gorm:"type:integer" json:"duration_seconds"`
+	CreatedAt       time.Time       `gorm:"type:timestamp with time zone;default:now()" json:"created_at"`
+	UpdatedAt       time.Time       `gorm:"type:timestamp with time zone;default:now()" json:"updated_at"`
+
+	// Relationships
+	BuildEvent BuildEventModel `gorm:"foreignKey:BuildEventID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+}
+
+// TableName returns the table name for the BuildJobModel
+func (BuildJobModel) TableName() string {
+	return "build_jobs"
+}
+
+// ToEntity converts the GORM model to domain entity
+func (m *BuildJobModel) ToEntity() *BuildJob {
+	var failedSteps []string
+	if len(m.FailedSteps) > 0 {
+		_ = json.Unmarshal(m.FailedSteps, &failedSteps)
+	}
+
+	return RestoreBuildJob(RestoreBuildJobParams{
+		ID:              value_objects.NewIDFromUUID(m.ID),
+		BuildEventID:    value_objects.NewIDFromUUID(m.BuildEventID),
+		JobID:           m.JobID,
+		Name:            m.Name,
+		Status:          BuildStatus(m.Status),
+		FailedSteps:     failedSteps,
+		URL:             m.URL,
+		DurationSeconds: m.DurationSeconds,
+		CreatedAt:       value_objects.NewTimestampFromTime(m.CreatedAt),
+		UpdatedAt:       value_objects.NewTimestampFromTime(m.UpdatedAt),
+	})
+}
+
+// FromEntity converts domain entity to GORM model
+func (m *BuildJobModel) FromEntity(entity *BuildJob) {
+	m.ID = entity.ID().Value()
+	m.BuildEventID = entity.BuildEventID().Value()
+	m.JobID = entity.JobID()
+	m.Name = entity.Name()
+	m.Status = string(entity.Status())
+	m.FailedSteps = nil
+	if steps := entity.FailedSteps(); len(steps) > 0 {
+		m.FailedSteps, _ = json.Marshal(steps)
+	}
+	m.URL = entity.URL()
+	m.DurationSeconds = entity.DurationSeconds()
+	m.CreatedAt = entity.CreatedAt().ToTime()
+	m.UpdatedAt = entity.UpdatedAt().ToTime()
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
+)
+
+// BuildStatusTransition records a single status change in the lifecycle of a build event
+type BuildStatusTransition struct {
+	id           value_objects.ID
+	buildEventID value_objects.ID
+	fromStatus   BuildStatus
+	toStatus     BuildStatus
+	occurredAt   value_objects.Timestamp
+}
+
+// NewBuildStatusTransition creates a new status transition.
+// An empty fromStatus marks the initial status of the build event.
+func NewBuildStatusTransition(buildEventID value_objects.ID, fromStatus, toStatus BuildStatus) (*BuildStatusTransition, error) {
+	if buildEventID.IsNil() {
+		return nil, exception.NewDomainError(ErrCodeInvalidBuildEvent, "build event ID cannot be nil")
+	}
+
+	if fromStatus != "" && !isValidBuildStatus(fromStatus) {
+		return nil, ErrInvalidBuildStatus
+	}
+
+	if !isValidBuildStatus(toStatus) {
+		return nil, ErrInvalidBuildStatus
+	}
+
+	return &BuildStatusTransition{
+		id:           value_objects.NewID(),
+		buildEventID: buildEventID,
+		fromStatus:   fromStatus,
+		toStatus:     toStatus,
+		occurredAt:   value_objects.NewTimestamp(),
+	}, nil
+}
+
+// RestoreBuildStatusTransitionParams contains parameters for restoring a status transition from persistence
+type RestoreBuildStatusTransitionParams struct {
+	ID           value_objects.ID
+	BuildEventID value_objects.ID
+	FromStatus   BuildStatus
+	ToStatus     BuildStatus
+	OccurredAt   value_objects.Timestamp
+}
+
+// RestoreBuildStatusTransition restores a status transition from persistence data
+func RestoreBuildStatusTransition(params RestoreBuildStatusTransitionParams) *BuildStatusTransition {
+	return &BuildStatusTransition{
+		id:           params.ID,
+		buildEventID: params.BuildEventID,
+		fromStatus:   params.FromStatus,
+		toStatus:     params.ToStatus,
+		occurredAt:   params.OccurredAt,
+	}
+}
+
+// ID returns the transition ID
+func (t *BuildStatusTransition) ID() value_objects.ID {
+	return t.id
+}
+
+// BuildEventID returns the ID of the build event the transition belongs to
+func (t *BuildStatusTransition) BuildEventID() value_objects.ID {
+	return t.buildEventID
+}
+
+// FromStatus returns the previous status, empty for the initial status
+func (t *BuildStatusTransition) FromStatus() BuildStatus {
+	return t.fromStatus
+}
+
+// ToStatus returns the new status
+func (t *BuildStatusTransition) ToStatus() BuildStatus {
+	return t.toStatus
+}
+
+// OccurredAt returns when the transition happened
+func (t *BuildStatusTransition) OccurredAt() value_objects.Timestamp {
+	return t.occurredAt
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/google/uuid"
+)
+
+// BuildStatusTransitionModel represents the GORM model for build status transitions
+type BuildStatusTransitionModel struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()" json:"id"`
+	BuildEventID uuid.UUID `gorm:"type:uuid;not null;index:idx_build_status_transitions_build_event_id" json:"build_event_id"`
+	FromStatus   string    `gorm:"type:varchar(20)" json:"from_status"`
+	ToStatus     string    `gorm:"type:varchar(20);not null" json:"to_status"`
+	OccurredAt   time.Time `gorm:"type:timestamp with time zone;not null;default:now()" json:"occurred_at"`
+
+	// Relationships
+	BuildEvent BuildEventModel `gorm:"foreignKey:BuildEventID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+}
+
+// TableName returns the table name for the BuildStatusTransitionModel
+func (BuildStatusTransitionModel) TableName() string {
+	return "build_status_transitions"
+}
+
+// ToEntity converts the GORM model to domain entity
+func (m *BuildStatusTransitionModel) ToEntity() *BuildStatusTransition {
+	return RestoreBuildStatusTransition(RestoreBuildStatusTransitionParams{
+		ID:           value_objects.NewIDFromUUID(m.ID),
+		BuildEventID: value_objects.NewIDFromUUID(m.BuildEventID),
+		FromStatus:   BuildStatus(m.FromStatus),
+		ToStatus:     BuildStatus(m.ToStatus),
+		OccurredAt:   value_objects.NewTimestampFromTime(m.OccurredAt),
+	})
+}
+
+// FromEntity converts domain entity to GORM model
+func (m *BuildStatusTransitionModel) FromEntity(entity *BuildStatusTransition) {
+	m.ID = entity.ID().Value()
+	m.BuildEventID = entity.BuildEventID().Value()
+	m.FromStatus = string(entity.FromStatus())
+	m.ToStatus = string(entity.ToStatus())
+	m.OccurredAt = entity.OccurredAt().ToTime()
+}
@@ -0,0 +1,75 @@
+package domain
+
+// BuildTransition classifies a completed build against the previous completed build of its branch
+type BuildTransition string
+
+const (
+	// BuildTransitionNewFailure is a failed build breaking a green branch, or the first build of a branch failing
+	BuildTransitionNewFailure BuildTransition = "new_failure"
+	// BuildTransitionStillFailing is a failed build on a branch that was already red
+	BuildTransitionStillFailing BuildTransition = "still_failing"
+	// BuildTransitionFixed is a successful build turning a red branch green
+	BuildTransitionFixed BuildTransition = "fixed"
+	// BuildTransitionSuccess is a successful build on a green branch, or the first build of a branch passing
+	BuildTransitionSuccess BuildTransition = "success"
+)
+
+// IsStatusChange checks if the transition turns a branch red or green
+func (t BuildTransition) IsStatusChange() bool {
+	return t == BuildTransitionNewFailure || t == BuildTransitionFixed
+}
+
+// String returns the string representation of the transition
+func (t BuildTransition) String() string {
+	return string(t)
+}
+
+// ClassifyBuildTransition classifies a completed build against the earlier builds of its branch, newest first.
+// Only successful and failed builds are classified and compared against, cancelled and skipped builds are ignored.
+// It returns false when the build is not a completed build.
+func ClassifyBuildTransition(buildEvent *BuildEvent, history []*BuildEvent) (BuildTransition, bool) {
+	if !buildEvent.HasBuildOutcome() {
+		return "", false
+	}
+
+	var previous *BuildEvent
+	for _, earlier := range history {
+		if earlier.ID() != buildEvent.ID() && earlier.HasBuildOutcome() {
+			previous = earlier
+			break
+		}
+	}
+	wasFailing := previous != nil && previous.IsFailed()
+
+	switch {
+	case buildEvent.IsFailed() && wasFailing:
+		return BuildTransitionStillFailing, true
+	case buildEvent.IsFailed():
+		return BuildTransitionNewFailure, true
+	case wasFailing:
+		return BuildTransitionFixed, true
+	default:
+		return BuildTransitionSuccess, true
+	}
+}
+
+// HasBuildOutcome checks if the build event is a build that completed successfully or failed
+func (be *BuildEvent) HasBuildOutcome() bool {
+	return be.eventType == EventTypeBuildCompleted && (be.status == BuildStatusSuccess || be.status == BuildStatusFailed)
+}
+
+// FailureStreak returns the failed builds since the last successful build of a branch, newest first,
+// given its completed builds newest first. Cancelled and skipped builds neither extend nor end the streak.
+func FailureStreak(history []*BuildEvent) []*BuildEvent {
+	var streak []*BuildEvent
+	for _, buildEvent := range history {
+		if !buildEvent.HasBuildOutcome() {
+			continue
+		}
+		if !buildEvent.IsFailed() {
+			break
+		}
+		streak = append(streak, buildEvent)
+	}
+	return streak
+}
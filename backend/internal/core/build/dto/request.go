@@ -10,6 +10,7 @@ import (
 // CreateBuildEventRequest represents a request to create a build event
 type CreateBuildEventRequest struct {
 	ProjectID       value_objects.ID
+	RunID           string
 	EventType       domain.EventType
 	Status          domain.BuildStatus
 	Branch          string
@@ -22,6 +23,17 @@ type CreateBuildEventRequest struct {
 	WebhookPayload  json.RawMessage
 }
 
+// RecordBuildJobRequest represents a request to record a job of a CI run
+type RecordBuildJobRequest struct {
+	BuildEventID    value_objects.ID
+	JobID           string
+	Name            string
+	Status          domain.BuildStatus
+	FailedSteps     []string
+	URL             string
+	DurationSeconds *int
+}
+
 // ProcessWebhookRequest represents a request to process a webhook
 type ProcessWebhookRequest struct {
 	ProjectID      value_objects.ID
@@ -16,6 +16,9 @@ type BuildEventRepository interface {
 	// GetByID retrieves a build event by its ID
 	GetByID(ctx context.Context, id value_objects.ID) (*domain.BuildEvent, error)
 
+	// GetByRunID retrieves the build event tracking a CI provider run within a project
+	GetByRunID(ctx context.Context, projectID value_objects.ID, runID string) (*domain.BuildEvent, error)
+
 	// GetByProjectID retrieves build events for a specific project
 	GetByProjectID(ctx context.Context, projectID value_objects.ID, filters dto.ListBuildEventFilters) ([]*domain.BuildEvent, error)
 
@@ -37,3 +40,27 @@ type BuildEventRepository interface {
 	// GetBuildMetrics retrieves build metrics for a project
 	GetBuildMetrics(ctx context.Context, projectID value_objects.ID) (*domain.BuildMetrics, error)
 }
+
+// BuildJobRepository defines the contract for build job persistence
+type BuildJobRepository interface {
+	// Create stores a new build job
+	Create(ctx context.Context, job *domain.BuildJob) error
+
+	// Update updates an existing build job
+	Update(ctx context.Context, job *domain.BuildJob) error
+
+	// GetByJobID retrieves a job of a build event by its CI provider job identifier
+	GetByJobID(ctx context.Context, buildEventID value_objects.ID, jobID string) (*domain.BuildJob, error)
+
+	// GetByBuildEventID retrieves the jobs of a build event in creation order
+	GetByBuildEventID(ctx context.Context, buildEventID value_objects.ID) ([]*domain.BuildJob, error)
+}
+
+// BuildStatusTransitionRepository defines the contract for build status history persistence
+type BuildStatusTransitionRepository interface {
+	// Create stores a new status transition
+	Create(ctx context.Context, transition *domain.BuildStatusTransition) error
+
+	// GetByBuildEventID retrieves the transitions of a build event in chronological order
+	GetByBuildEventID(ctx context.Context, buildEventID value_objects.ID) ([]*domain.BuildStatusTransition, error)
+}
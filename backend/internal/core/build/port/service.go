@@ -19,6 +19,18 @@ type BuildEventService interface {
 	// GetBuildEvent retrieves a build event by its ID
 	GetBuildEvent(ctx context.Context, id value_objects.ID) (*domain.BuildEvent, error)
 
+	// GetBuildEventByRunID retrieves the build event tracking a CI provider run
+	GetBuildEventByRunID(ctx context.Context, projectID value_objects.ID, runID string) (*domain.BuildEvent, error)
+
+	// GetStatusTransitions retrieves the status history of a build event
+	GetStatusTransitions(ctx context.Context, buildEventID value_objects.ID) ([]*domain.BuildStatusTransition, error)
+
+	// RecordBuildJob creates or updates a job of a run-level build event
+	RecordBuildJob(ctx context.Context, req dto.RecordBuildJobRequest) (*domain.BuildJob, error)
+
+	// GetBuildJobs retrieves the jobs of a build event
+	GetBuildJobs(ctx context.Context, buildEventID value_objects.ID) ([]*domain.BuildJob, error)
+
 	// GetBuildEventsByProject retrieves build events for a specific project
 	GetBuildEventsByProject(ctx context.Context, projectID value_objects.ID, filters dto.ListBuildEventFilters) ([]*domain.BuildEvent, error)
 
@@ -33,4 +45,13 @@ type BuildEventService interface {
 
 	// ListBuildEvents retrieves build events with filtering and pagination
 	ListBuildEvents(ctx context.Context, filters dto.ListBuildEventFilters) ([]*domain.BuildEvent, error)
+
+	// GetBuildTransition classifies a completed build against the earlier builds of its branch
+	GetBuildTransition(ctx context.Context, buildEventID value_objects.ID) (domain.BuildTransition, bool, error)
+}
+
+// BuildTransitionService classifies completed builds against the earlier builds of their branch
+type BuildTransitionService interface {
+	// GetBuildTransition classifies a completed build, it returns false for other build events
+	GetBuildTransition(ctx context.Context, buildEventID value_objects.ID) (domain.BuildTransition, bool, error)
 }
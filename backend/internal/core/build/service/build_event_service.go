@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/dto"
@@ -10,9 +11,15 @@ import (
 	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
 )
 
+// buildTransitionHistoryLimit is how many earlier builds of a branch are looked at to classify a build,
+// enough to skip the cancelled builds in between
+const buildTransitionHistoryLimit = 20
+
 // Dep for BuildEventService
 type Dep struct {
 	BuildEventRepo port.BuildEventRepository
+	TransitionRepo port.BuildStatusTransitionRepository
+	JobRepo        port.BuildJobRepository
 }
 
 // buildEventService handles build event business logic
@@ -32,6 +39,7 @@ func (s *buildEventService) CreateBuildEvent(ctx context.Context, req dto.Create
 	// Create domain entity from request using the refactored constructor
 	buildEvent, err := domain.NewBuildEvent(domain.BuildEventParams{
 		ProjectID:      req.ProjectID,
+		RunID:          req.RunID,
 		EventType:      req.EventType,
 		Status:         req.Status,
 		Branch:         req.Branch,
@@ -61,6 +69,11 @@ func (s *buildEventService) CreateBuildEvent(ctx context.Context, req dto.Create
 		return nil, err
 	}
 
+	// Record the initial status of the build
+	if err := s.recordTransition(ctx, buildEvent.ID(), "", buildEvent.Status()); err != nil {
+		return nil, err
+	}
+
 	return buildEvent, nil
 }
 
@@ -77,6 +90,22 @@ func (s *buildEventService) GetBuildEvent(ctx context.Context, id value_objects.
 	return s.BuildEventRepo.GetByID(ctx, id)
 }
 
+// GetBuildEventByRunID retrieves the build event tracking a CI provider run
+func (s *buildEventService) GetBuildEventByRunID(ctx context.Context, projectID value_objects.ID, runID string) (*domain.BuildEvent, error) {
+	if runID == "" {
+		return nil, exception.ErrBuildEventNotFound
+	}
+	return s.BuildEventRepo.GetByRunID(ctx, projectID, runID)
+}
+
+// GetStatusTransitions retrieves the status history of a build event
+func (s *buildEventService) GetStatusTransitions(ctx context.Context, buildEventID value_objects.ID) ([]*domain.BuildStatusTransition, error) {
+	if s.TransitionRepo == nil {
+		return []*domain.BuildStatusTransition{}, nil
+	}
+	return s.TransitionRepo.GetByBuildEventID(ctx, buildEventID)
+}
+
 // GetBuildEventsByProject retrieves build events for a specific project
 func (s *buildEventService) GetBuildEventsByProject(ctx context.Context, projectID value_objects.ID, filters dto.ListBuildEventFilters) ([]*domain.BuildEvent, error) {
 	// Set project ID filter
@@ -93,6 +122,7 @@ func (s *buildEventService) UpdateBuildEventStatus(ctx context.Context, id value
 	}
 
 	// Update status
+	previousStatus := buildEvent.Status()
 	buildEvent.UpdateStatus(status)
 
 	// Set duration if provided
@@ -101,7 +131,15 @@ func (s *buildEventService) UpdateBuildEventStatus(ctx context.Context, id value
 	}
 
 	// Save updated build event
-	return s.BuildEventRepo.Update(ctx, buildEvent)
+	if err := s.BuildEventRepo.Update(ctx, buildEvent); err != nil {
+		return err
+	}
+
+	if previousStatus == status {
+		return nil
+	}
+
+	return s.recordTransition(ctx, buildEvent.ID(), previousStatus, status)
 }
 
 // GetLatestBuildEvent gets the latest build event for a project
@@ -119,6 +157,94 @@ func (s *buildEventService) ListBuildEvents(ctx context.Context, filters dto.Lis
 	return s.BuildEventRepo.List(ctx, filters)
 }
 
+// GetBuildTransition classifies a completed build against the earlier completed builds of its project and branch.
+// It returns false for build events that are not completed builds.
+func (s *buildEventService) GetBuildTransition(ctx context.Context, buildEventID value_objects.ID) (domain.BuildTransition, bool, error) {
+	buildEvent, err := s.BuildEventRepo.GetByID(ctx, buildEventID)
+	if err != nil {
+		return "", false, err
+	}
+	if !buildEvent.HasBuildOutcome() {
+		return "", false, nil
+	}
+
+	eventType := domain.EventTypeBuildCompleted
+	branch := buildEvent.Branch()
+	createdAt := buildEvent.CreatedAt().ToTime()
+	history, err := s.BuildEventRepo.GetByProjectID(ctx, buildEvent.ProjectID(), dto.ListBuildEventFilters{
+		EventType: &eventType,
+		Branch:    &branch,
+		DateTo:    &createdAt,
+		Limit:     buildTransitionHistoryLimit,
+	})
+	if err != nil {
+		return "", false, err
+	}
+
+	transition, ok := domain.ClassifyBuildTransition(buildEvent, history)
+	return transition, ok, nil
+}
+
+// RecordBuildJob creates or updates a job of a run-level build event
+func (s *buildEventService) RecordBuildJob(ctx context.Context, req dto.RecordBuildJobRequest) (*domain.BuildJob, error) {
+	if s.JobRepo == nil {
+		return nil, exception.ErrBuildJobNotFound
+	}
+
+	job, err := s.JobRepo.GetByJobID(ctx, req.BuildEventID, req.JobID)
+	if err == nil {
+		job.Update(req.Status, req.FailedSteps, req.DurationSeconds)
+		if err := s.JobRepo.Update(ctx, job); err != nil {
+			return nil, err
+		}
+		return job, nil
+	}
+	if !errors.Is(err, exception.ErrBuildJobNotFound) {
+		return nil, err
+	}
+
+	job, err = domain.NewBuildJob(domain.BuildJobParams{
+		BuildEventID:    req.BuildEventID,
+		JobID:           req.JobID,
+		Name:            req.Name,
+		Status:          req.Status,
+		FailedSteps:     req.FailedSteps,
+		URL:             req.URL,
+		DurationSeconds: req.DurationSeconds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.JobRepo.Create(ctx, job); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// GetBuildJobs retrieves the jobs of a build event
+func (s *buildEventService) GetBuildJobs(ctx context.Context, buildEventID value_objects.ID) ([]*domain.BuildJob, error) {
+	if s.JobRepo == nil {
+		return nil, nil
+	}
+	return s.JobRepo.GetByBuildEventID(ctx, buildEventID)
+}
+
+// recordTransition stores a status change in the build history when a transition repository is configured
+func (s *buildEventService) recordTransition(ctx context.Context, buildEventID value_objects.ID, from, to domain.BuildStatus) error {
+	if s.TransitionRepo == nil {
+		return nil
+	}
+
+	transition, err := domain.NewBuildStatusTransition(buildEventID, from, to)
+	if err != nil {
+		return err
+	}
+
+	return s.TransitionRepo.Create(ctx, transition)
+}
+
 // validateBuildEvent validates business rules for build event
 func (s *buildEventService) validateBuildEvent(buildEvent *domain.BuildEvent) error {
 	// Add custom business validation logic here
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+)
+
+// ChannelMessage is the notification of a build event for channels that lay out their messages.
+// It keeps the build status and template parameters next to the rendered text, so channels pick
+// colors, fields and buttons from the event rather than from the wording of a template.
+// It is stored encoded as the notification message.
+type ChannelMessage struct {
+	Text   string                  `json:"text"`
+	Status buildDomain.BuildStatus `json:"status,omitempty"`
+	Params TemplateParams          `json:"params"`
+}
+
+// messageFact is a labelled template parameter shown as a field of a message
+type messageFact struct {
+	title string
+	value string
+}
+
+// HasChannelMessages checks if the notifications of a channel are stored as channel messages
+func (c NotificationChannel) HasChannelMessages() bool {
+	return c == NotificationChannelSlack || c == NotificationChannelTeams || c == NotificationChannelDiscord
+}
+
+// NewChannelMessage creates the message of a build event rendered through a channel template
+func NewChannelMessage(text string, status buildDomain.BuildStatus, params TemplateParams) *ChannelMessage {
+	return &ChannelMessage{
+		Text:   text,
+		Status: status,
+		Params: params,
+	}
+}
+
+// DecodeChannelMessage decodes a channel message stored as a notification message.
+// Notifications not created for a build event hold plain text, which is kept as a message without a status.
+func DecodeChannelMessage(message string) *ChannelMessage {
+	var decoded ChannelMessage
+	if err := json.Unmarshal([]byte(message), &decoded); err != nil || decoded.Text == "" {
+		return &ChannelMessage{Text: message}
+	}
+	return &decoded
+}
+
+// Encode encodes the message as a notification message
+func (m *ChannelMessage) Encode() (string, error) {
+	message, err := json.Marshal(m)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode channel message: %w", err)
+	}
+	return string(message), nil
+}
+
+// facts returns the template parameters shown as fields, leaving out empty ones
+func (m *ChannelMessage) facts() []messageFact {
+	candidates := []messageFact{
+		{title: "Project", value: m.Params.ProjectName},
+		{title: "Environment", value: m.Params.Environment},
+		{title: "Branch", value: m.Params.BuildBranch},
+		{title: "Commit", value: m.Params.BuildCommit},
+		{title: "Author", value: m.Params.BuildAuthor},
+		{title: "Duration", value: m.Params.BuildDuration},
+		{title: "Error", value: m.Params.ErrorMessage},
+	}
+
+	var facts []messageFact
+	for _, fact := range candidates {
+		if value := strings.TrimSpace(fact.value); value != "" {
+			facts = append(facts, messageFact{title: fact.title, value: value})
+		}
+	}
+	return facts
+}
+
+// buildURL returns the URL of the build when a link button can open it
+func (m *ChannelMessage) buildURL() (string, bool) {
+	return m.Params.BuildURL, isAbsoluteHTTPURL(m.Params.BuildURL)
+}
+
+// isAbsoluteHTTPURL checks if a link can be opened from a card button
+func isAbsoluteHTTPURL(link string) bool {
+	parsedURL, err := url.Parse(link)
+	return err == nil && (parsedURL.Scheme == "https" || parsedURL.Scheme == "http") && parsedURL.Host != ""
+}
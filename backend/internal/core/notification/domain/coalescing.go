@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+)
+
+// coalescedMessageSeparator separates the messages merged into a coalesced notification
+const coalescedMessageSeparator = "\n\n"
+
+// CoalesceNotifications merges the notifications of one recipient about the builds of a commit into the latest one.
+// Only the latest notification of each build event is kept, earlier ones describe superseded states of the same run,
+// e.g. a requested workflow run that completed since. The merged message lists the kept messages oldest first.
+// It returns the latest notification, nil without notifications, the notifications it replaces and the merged message.
+func CoalesceNotifications(logs []*NotificationLog) (*NotificationLog, []*NotificationLog, string) {
+	if len(logs) == 0 {
+		return nil, nil, ""
+	}
+
+	ordered := make([]*NotificationLog, len(logs))
+	copy(ordered, logs)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].CreatedAt().ToTime().Before(ordered[j].CreatedAt().ToTime())
+	})
+
+	latestOfBuild := make(map[string]*NotificationLog, len(ordered))
+	for _, log := range ordered {
+		latestOfBuild[log.BuildEventID().String()] = log
+	}
+
+	var messages []string
+	for _, log := range ordered {
+		if latestOfBuild[log.BuildEventID().String()] == log {
+			messages = append(messages, log.Message())
+		}
+	}
+
+	return ordered[len(ordered)-1], ordered[:len(ordered)-1], strings.Join(messages, coalescedMessageSeparator)
+}
@@ -0,0 +1,228 @@
+package domain
+
+import (
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+)
+
+// DeadLetterReason represents why a notification was moved to the dead-letter queue
+type DeadLetterReason string
+
+const (
+	DeadLetterReasonRetriesExhausted DeadLetterReason = "retries_exhausted"
+	DeadLetterReasonPermanentError   DeadLetterReason = "permanent_error"
+)
+
+// IsValid checks if the dead-letter reason is valid
+func (r DeadLetterReason) IsValid() bool {
+	switch r {
+	case DeadLetterReasonRetriesExhausted, DeadLetterReasonPermanentError:
+		return true
+	default:
+		return false
+	}
+}
+
+// DeadLetterStatus represents the resolution status of a dead-lettered notification
+type DeadLetterStatus string
+
+const (
+	DeadLetterStatusPending   DeadLetterStatus = "pending"
+	DeadLetterStatusRequeued  DeadLetterStatus = "requeued"
+	DeadLetterStatusDiscarded DeadLetterStatus = "discarded"
+)
+
+// IsValid checks if the dead-letter status is valid
+func (s DeadLetterStatus) IsValid() bool {
+	switch s {
+	case DeadLetterStatusPending, DeadLetterStatusRequeued, DeadLetterStatusDiscarded:
+		return true
+	default:
+		return false
+	}
+}
+
+// DeadLetterNotification represents a notification that could not be delivered
+// and is kept for inspection until it is requeued or discarded
+type DeadLetterNotification struct {
+	id                value_objects.ID
+	notificationLogID value_objects.ID
+	buildEventID      value_objects.ID
+	channel           NotificationChannel
+	recipient         string
+	message           string
+	reason            DeadLetterReason
+	lastError         string
+	errorHistory      []DeliveryAttempt
+	attemptCount      int
+	status            DeadLetterStatus
+	resolvedAt        *value_objects.Timestamp
+	createdAt         value_objects.Timestamp
+	updatedAt         value_objects.Timestamp
+}
+
+// NewDeadLetterNotification creates a dead-letter entry from a failed notification log
+func NewDeadLetterNotification(log *NotificationLog, reason DeadLetterReason) (*DeadLetterNotification, error) {
+	if log == nil {
+		return nil, ErrInvalidNotificationLog
+	}
+
+	if !reason.IsValid() {
+		return nil, ErrInvalidDeadLetterReason
+	}
+
+	now := value_objects.NewTimestamp()
+	return &DeadLetterNotification{
+		id:                value_objects.NewID(),
+		notificationLogID: log.ID(),
+		buildEventID:      log.BuildEventID(),
+		channel:           log.Channel(),
+		recipient:         log.Recipient(),
+		message:           log.Message(),
+		reason:            reason,
+		lastError:         log.ErrorMessage(),
+		errorHistory:      log.ErrorHistory(),
+		attemptCount:      log.AttemptCount(),
+		status:            DeadLetterStatusPending,
+		createdAt:         now,
+		updatedAt:         now,
+	}, nil
+}
+
+// RestoreDeadLetterNotification restores a dead-lettered notification from persistence
+func RestoreDeadLetterNotification(params RestoreDeadLetterNotificationParams) *DeadLetterNotification {
+	return &DeadLetterNotification{
+		id:                params.ID,
+		notificationLogID: params.NotificationLogID,
+		buildEventID:      params.BuildEventID,
+		channel:           params.Channel,
+		recipient:         params.Recipient,
+		message:           params.Message,
+		reason:            params.Reason,
+		lastError:         params.LastError,
+		errorHistory:      params.ErrorHistory,
+		attemptCount:      params.AttemptCount,
+		status:            params.Status,
+		resolvedAt:        params.ResolvedAt,
+		createdAt:         params.CreatedAt,
+		updatedAt:         params.UpdatedAt,
+	}
+}
+
+// RestoreDeadLetterNotificationParams holds parameters for restoring a dead-lettered notification
+type RestoreDeadLetterNotificationParams struct {
+	ID                value_objects.ID
+	NotificationLogID value_objects.ID
+	BuildEventID      value_objects.ID
+	Channel           NotificationChannel
+	Recipient         string
+	Message           string
+	Reason            DeadLetterReason
+	LastError         string
+	ErrorHistory      []DeliveryAttempt
+	AttemptCount      int
+	Status            DeadLetterStatus
+	ResolvedAt        *value_objects.Timestamp
+	CreatedAt         value_objects.Timestamp
+	UpdatedAt         value_objects.Timestamp
+}
+
+// ID returns the dead-letter entry ID
+func (d *DeadLetterNotification) ID() value_objects.ID {
+	return d.id
+}
+
+// NotificationLogID returns the ID of the notification log that failed
+func (d *DeadLetterNotification) NotificationLogID() value_objects.ID {
+	return d.notificationLogID
+}
+
+// BuildEventID returns the build event the notification was about
+func (d *DeadLetterNotification) BuildEventID() value_objects.ID {
+	return d.buildEventID
+}
+
+// Channel returns the notification channel
+func (d *DeadLetterNotification) Channel() NotificationChannel {
+	return d.channel
+}
+
+// Recipient returns the recipient that missed the notification
+func (d *DeadLetterNotification) Recipient() string {
+	return d.recipient
+}
+
+// Message returns the notification message
+func (d *DeadLetterNotification) Message() string {
+	return d.message
+}
+
+// Reason returns why the notification was dead-lettered
+func (d *DeadLetterNotification) Reason() DeadLetterReason {
+	return d.reason
+}
+
+// LastError returns the error of the final delivery attempt
+func (d *DeadLetterNotification) LastError() string {
+	return d.lastError
+}
+
+// ErrorHistory returns a copy of the failed delivery attempts, oldest first
+func (d *DeadLetterNotification) ErrorHistory() []DeliveryAttempt {
+	history := make([]DeliveryAttempt, len(d.errorHistory))
+	copy(history, d.errorHistory)
+	return history
+}
+
+// AttemptCount returns the number of delivery attempts made before dead-lettering
+func (d *DeadLetterNotification) AttemptCount() int {
+	return d.attemptCount
+}
+
+// Status returns the resolution status
+func (d *DeadLetterNotification) Status() DeadLetterStatus {
+	return d.status
+}
+
+// ResolvedAt returns when the entry was requeued or discarded
+func (d *DeadLetterNotification) ResolvedAt() *value_objects.Timestamp {
+	return d.resolvedAt
+}
+
+// CreatedAt returns the creation timestamp
+func (d *DeadLetterNotification) CreatedAt() value_objects.Timestamp {
+	return d.createdAt
+}
+
+// UpdatedAt returns the last update timestamp
+func (d *DeadLetterNotification) UpdatedAt() value_objects.Timestamp {
+	return d.updatedAt
+}
+
+// IsResolved checks if the entry has been requeued or discarded
+func (d *DeadLetterNotification) IsResolved() bool {
+	return d.status != DeadLetterStatusPending
+}
+
+// Requeue marks the entry as sent back for delivery
+func (d *DeadLetterNotification) Requeue() error {
+	return d.resolve(DeadLetterStatusRequeued)
+}
+
+// Discard marks the entry as given up on
+func (d *DeadLetterNotification) Discard() error {
+	return d.resolve(DeadLetterStatusDiscarded)
+}
+
+// resolve moves a pending entry to its final status
+func (d *DeadLetterNotification) resolve(status DeadLetterStatus) error {
+	if d.IsResolved() {
+		return ErrDeadLetterAlreadyResolved
+	}
+
+	now := value_objects.NewTimestamp()
+	d.status = status
+	d.resolvedAt = &now
+	d.updatedAt = now
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/google/uuid"
+)
+
+// DeadLetterModel represents the database model for dead-lettered notifications
+type DeadLetterModel struct {
+	ID                uuid.UUID       `gorm:"column:id;primaryKey;type:uuid;default:uuid_generate_v4()"`
+	NotificationLogID uuid.UUID       `gorm:"column:notification_log_id;type:uuid;not null;index:idx_dead_letter_notification_log"`
+	BuildEventID      uuid.UUID       `gorm:"column:build_event_id;type:uuid;not null"`
+	Channel           string          `gorm:"column:channel;type:varchar(20);not null"`
+	Recipient         string          `gorm:"column:recipient;type:text;not null"`
+	Message           string          `gorm:"column:message;type:text;not null"`
+	Reason            string          `gorm:"column:reason;type:varchar(30);not null"`
+	LastError         string          `gorm:"column:last_error;type:text"`
+	ErrorHistory      json.RawMessage `gorm:"column:error_history;type:jsonb"`
+	AttemptCount      int             `gorm:"column:attempt_count;not null;default:0"`
+	Status            string          `gorm:"column:status;type:varchar(20);not null;default:'pending'"`
+	ResolvedAt        *time.Time      `gorm:"column:resolved_at;type:timestamp with time zone"`
+	CreatedAt         time.Time       `gorm:"column:created_at;type:timestamp with time zone;default:current_timestamp"`
+	UpdatedAt         time.Time       `gorm:"column:updated_at;type:timestamp with time zone;default:current_timestamp"`
+}
+
+// TableName returns the table name for dead-lettered notifications
+func (DeadLetterModel) TableName() string {
+	return "dead_letter_notifications"
+}
+
+// ToEntity converts the model to domain entity
+func (m *DeadLetterModel) ToEntity() *DeadLetterNotification {
+	var errorHistory []DeliveryAttempt
+	if len(m.ErrorHistory) > 0 {
+		_ = json.Unmarshal(m.ErrorHistory, &errorHistory)
+	}
+
+	params := RestoreDeadLetterNotificationParams{
+		ID:                value_objects.NewIDFromUUID(m.ID),
+		NotificationLogID: value_objects.NewIDFromUUID(m.NotificationLogID),
+		BuildEventID:      value_objects.NewIDFromUUID(m.BuildEventID),
+		Channel:           NotificationChannel(m.Channel),
+		Recipient:         m.Recipient,
+		Message:           m.Message,
+		Reason:            DeadLetterReason(m.Reason),
+		LastError:         m.LastError,
+		ErrorHistory:      errorHistory,
+		AttemptCount:      m.AttemptCount,
+		Status:            DeadLetterStatus(m.Status),
+		CreatedAt:         value_objects.NewTimestampFromTime(m.CreatedAt),
+		UpdatedAt:         value_objects.NewTimestampFromTime(m.UpdatedAt),
+	}
+
+	if m.ResolvedAt != nil {
+		resolvedAt := value_objects.NewTimestampFromTime(*m.ResolvedAt)
+		params.ResolvedAt = &resolvedAt
+	}
+
+	return RestoreDeadLetterNotification(params)
+}
+
+// FromEntity converts domain entity to model
+func (m *DeadLetterModel) FromEntity(deadLetter *DeadLetterNotification) {
+	m.ID = deadLetter.ID().Value()
+	m.NotificationLogID = deadLetter.NotificationLogID().Value()
+	m.BuildEventID = deadLetter.BuildEventID().Value()
+	m.Channel = string(deadLetter.Channel())
+	m.Recipient = deadLetter.Recipient()
+	m.Message = deadLetter.Message()
+	m.Reason = string(deadLetter.Reason())
+	m.LastError = deadLetter.LastError()
+	m.ErrorHistory = nil
+	if history := deadLetter.ErrorHistory(); len(history) > 0 {
+		m.ErrorHistory, _ = json.Marshal(history)
+	}
+	m.AttemptCount = deadLetter.AttemptCount()
+	m.Status = string(deadLetter.Status())
+	m.ResolvedAt = nil
+	if deadLetter.ResolvedAt() != nil {
+		resolvedAt := deadLetter.ResolvedAt().ToTime()
+		m.ResolvedAt = &resolvedAt
+	}
+	m.CreatedAt = deadLetter.CreatedAt().ToTime()
+	m.UpdatedAt = deadLetter.UpdatedAt().ToTime()
+}
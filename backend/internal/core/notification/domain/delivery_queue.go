@@ -37,10 +37,21 @@ func (s DeliveryStatus) String() string {
 	return string(s)
 }
 
+// QueueKind tells which worker delivers a queued notification
+type QueueKind string
+
+const (
+	// QueueKindDelivery notifications are sent by the delivery service through its delivery channels
+	QueueKindDelivery QueueKind = "delivery"
+	// QueueKindDeferred notifications release a notification log deferred by quiet hours or coalescing
+	QueueKindDeferred QueueKind = "deferred"
+)
+
 // QueuedNotification represents a notification in the delivery queue
 type QueuedNotification struct {
 	ID             value_objects.ID    `json:"id"`
 	NotificationID value_objects.ID    `json:"notification_id"`
+	Kind           QueueKind           `json:"kind"`
 	Channel        NotificationChannel `json:"channel"`
 	Recipient      string              `json:"recipient"`
 	Message        string              `json:"message"`
@@ -51,6 +62,7 @@ type QueuedNotification struct {
 	MaxAttempts    int                 `json:"max_attempts"`
 	Status         DeliveryStatus      `json:"status"`
 	LastError      string              `json:"last_error"`
+	LockedUntil    *time.Time          `json:"locked_until,omitempty"`
 	CreatedAt      time.Time           `json:"created_at"`
 	UpdatedAt      time.Time           `json:"updated_at"`
 }
@@ -67,6 +79,7 @@ func NewQueuedNotification(
 	return &QueuedNotification{
 		ID:             value_objects.NewID(),
 		NotificationID: notificationID,
+		Kind:           QueueKindDelivery,
 		Channel:        channel,
 		Recipient:      recipient,
 		Message:        message,
@@ -92,15 +105,38 @@ func (qn *QueuedNotification) ShouldBeProcessed() bool {
 	return qn.Status == DeliveryStatusPending && !time.Now().Before(qn.ScheduledAt)
 }
 
+// IsClaimable checks if a worker may claim the notification at the given time.
+// Due pending and retrying notifications are claimable, as are processing
+// notifications whose visibility timeout expired or was never set because their worker died.
+func (qn *QueuedNotification) IsClaimable(now time.Time) bool {
+	switch qn.Status {
+	case DeliveryStatusPending, DeliveryStatusRetrying:
+		return !now.Before(qn.ScheduledAt)
+	case DeliveryStatusProcessing:
+		return qn.LockedUntil == nil || qn.LockedUntil.Before(now)
+	default:
+		return false
+	}
+}
+
 // MarkAsProcessing marks the notification as being processed
 func (qn *QueuedNotification) MarkAsProcessing() {
 	qn.Status = DeliveryStatusProcessing
 	qn.UpdatedAt = time.Now()
 }
 
+// Claim marks the notification as processing by a worker until the visibility timeout expires
+func (qn *QueuedNotification) Claim(visibilityTimeout time.Duration) {
+	lockedUntil := time.Now().Add(visibilityTimeout)
+	qn.Status = DeliveryStatusProcessing
+	qn.LockedUntil = &lockedUntil
+	qn.UpdatedAt = time.Now()
+}
+
 // MarkAsDelivered marks the notification as successfully delivered
 func (qn *QueuedNotification) MarkAsDelivered() {
 	qn.Status = DeliveryStatusDelivered
+	qn.LockedUntil = nil
 	qn.UpdatedAt = time.Now()
 }
 
@@ -109,6 +145,7 @@ func (qn *QueuedNotification) MarkAsFailed(errorMessage string) {
 	qn.Status = DeliveryStatusFailed
 	qn.LastError = errorMessage
 	qn.AttemptCount++
+	qn.LockedUntil = nil
 	qn.UpdatedAt = time.Now()
 }
 
@@ -116,6 +153,7 @@ func (qn *QueuedNotification) MarkAsFailed(errorMessage string) {
 func (qn *QueuedNotification) ScheduleRetry(delay time.Duration) {
 	qn.Status = DeliveryStatusRetrying
 	qn.ScheduledAt = time.Now().Add(delay)
+	qn.LockedUntil = nil
 	qn.UpdatedAt = time.Now()
 }
 
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/google/uuid"
+)
+
+// DeliveryQueueModel represents the database model for queued notification deliveries
+type DeliveryQueueModel struct {
+	ID             uuid.UUID  `gorm:"column:id;primaryKey;type:uuid;default:uuid_generate_v4()"`
+	NotificationID uuid.UUID  `gorm:"column:notification_id;type:uuid;not null;index:idx_delivery_queue_notification"`
+	Kind           string     `gorm:"column:kind;type:varchar(20);not null;default:'delivery'"`
+	Channel        string     `gorm:"column:channel;type:varchar(20);not null"`
+	Recipient      string     `gorm:"column:recipient;type:text;not null"`
+	Message        string     `gorm:"column:message;type:text;not null"`
+	Subject        string     `gorm:"column:subject;type:varchar(255)"`
+	Priority       int        `gorm:"column:priority;not null;default:0"`
+	ScheduledAt    time.Time  `gorm:"column:scheduled_at;type:timestamp with time zone;not null;default:current_timestamp"`
+	AttemptCount   int        `gorm:"column:attempt_count;not null;default:0"`
+	MaxAttempts    int        `gorm:"column:max_attempts;not null;default:3"`
+	Status         string     `gorm:"column:status;type:varchar(20);not null;default:'pending'"`
+	LastError      string     `gorm:"column:last_error;type:text"`
+	LockedUntil    *time.Time `gorm:"column:locked_until;type:timestamp with time zone"`
+	CreatedAt      time.Time  `gorm:"column:created_at;type:timestamp with time zone;default:current_timestamp"`
+	UpdatedAt      time.Time  `gorm:"column:updated_at;type:timestamp with time zone;default:current_timestamp"`
+}
+
+// TableName returns the table name for queued notification deliveries
+func (DeliveryQueueModel) TableName() string {
+	return "notification_delivery_queue"
+}
+
+// ToEntity converts the model to domain entity
+func (m *DeliveryQueueModel) ToEntity() *QueuedNotification {
+	return &QueuedNotification{
+		ID:             value_objects.NewIDFromUUID(m.ID),
+		NotificationID: value_objects.NewIDFromUUID(m.NotificationID),
+		Kind:           QueueKind(m.Kind),
+		Channel:        NotificationChannel(m.Channel),
+		Recipient:      m.Recipient,
+		Message:        m.Message,
+		Subject:        m.Subject,
+		Priority:       m.Priority,
+		ScheduledAt:    m.ScheduledAt,
+		AttemptCount:   m.AttemptCount,
+		MaxAttempts:    m.MaxAttempts,
+		Status:         DeliveryStatus(m.Status),
+		LastError:      m.LastError,
+		LockedUntil:    m.LockedUntil,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+// FromEntity converts domain entity to model
+func (m *DeliveryQueueModel) FromEntity(notification *QueuedNotification) {
+	m.ID = notification.ID.Value()
+	m.NotificationID = notification.NotificationID.Value()
+	m.Kind = string(notification.Kind)
+	m.Channel = string(notification.Channel)
+	m.Recipient = notification.Recipient
+	m.Message = notification.Message
+	m.Subject = notification.Subject
+	m.Priority = notification.Priority
+	m.ScheduledAt = notification.ScheduledAt
+	m.AttemptCount = notification.AttemptCount
+	m.MaxAttempts = notification.MaxAttempts
+	m.Status = string(notification.Status)
+	m.LastError = notification.LastError
+	m.LockedUntil = notification.LockedUntil
+	m.CreatedAt = notification.CreatedAt
+	m.UpdatedAt = notification.UpdatedAt
+}
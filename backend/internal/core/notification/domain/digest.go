@@ -0,0 +1,208 @@
+package domain
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"time"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+)
+
+// DigestMode represents how the notifications of a subscription are delivered
+type DigestMode string
+
+const (
+	// DigestModeInstant sends a message per notification
+	DigestModeInstant DigestMode = "instant"
+	// DigestModeHourly sends a single digest at the end of each hour
+	DigestModeHourly DigestMode = "hourly"
+	// DigestModeDaily sends a single digest at midnight UTC
+	DigestModeDaily DigestMode = "daily"
+)
+
+// IsValid checks if the digest mode is valid
+func (m DigestMode) IsValid() bool {
+	switch m {
+	case DigestModeInstant, DigestModeHourly, DigestModeDaily:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsDigest checks if notifications are held back for a digest
+func (m DigestMode) IsDigest() bool {
+	return m == DigestModeHourly || m == DigestModeDaily
+}
+
+// WindowEnd returns when the digest window containing the given time is sent.
+// Windows are aligned to UTC, so every subscription of a mode shares the same windows.
+func (m DigestMode) WindowEnd(t time.Time) time.Time {
+	t = t.UTC()
+	switch m {
+	case DigestModeHourly:
+		return t.Truncate(time.Hour).Add(time.Hour)
+	case DigestModeDaily:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+	default:
+		return t
+	}
+}
+
+// ParseDigestMode parses a digest mode, an empty value is instant delivery
+func ParseDigestMode(value string) (DigestMode, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return DigestModeInstant, nil
+	}
+
+	mode := DigestMode(value)
+	if !mode.IsValid() {
+		return "", NewInvalidDigestModeError(fmt.Sprintf("unknown digest mode: %s", value))
+	}
+	return mode, nil
+}
+
+// DigestEntry is a build event summarized in a digest
+type DigestEntry struct {
+	ProjectName string
+	Branch      string
+	EventType   buildDomain.EventType
+	Status      buildDomain.BuildStatus
+	CommitSHA   string
+	BuildURL    string
+}
+
+// isBuildResult checks if the entry reports the outcome of a build or deployment.
+// Push, pull request and release events are counted separately.
+func (e DigestEntry) isBuildResult() bool {
+	switch e.EventType {
+	case buildDomain.EventTypePush, buildDomain.EventTypePullRequest, buildDomain.EventTypeRelease:
+		return false
+	default:
+		return true
+	}
+}
+
+// DigestFailure links to a failed build of a digest
+type DigestFailure struct {
+	CommitSHA string `json:"commit,omitempty"`
+	BuildURL  string `json:"url,omitempty"`
+}
+
+// DigestBranch summarizes the build events of a branch
+type DigestBranch struct {
+	Branch   string          `json:"branch"`
+	Passed   int             `json:"passed"`
+	Failed   int             `json:"failed"`
+	Other    int             `json:"other"`
+	Failures []DigestFailure `json:"failures,omitempty"`
+}
+
+// DigestProject summarizes the build events of a project, by branch
+type DigestProject struct {
+	Name     string          `json:"name"`
+	Branches []*DigestBranch `json:"branches"`
+}
+
+// Digest summarizes the notifications of a digest window, grouped by project and branch.
+// It is also the digest data of outgoing webhook events.
+type Digest struct {
+	WindowEnd time.Time        `json:"window_end"`
+	Passed    int              `json:"passed"`
+	Failed    int              `json:"failed"`
+	Projects  []*DigestProject `json:"projects"`
+}
+
+// NewDigest groups digest entries by project and branch, both sorted by name
+func NewDigest(windowEnd time.Time, entries []DigestEntry) *Digest {
+	digest := &Digest{WindowEnd: windowEnd.UTC(), Projects: []*DigestProject{}}
+
+	projects := make(map[string]*DigestProject)
+	branches := make(map[string]map[string]*DigestBranch)
+	for _, entry := range entries {
+		project, ok := projects[entry.ProjectName]
+		if !ok {
+			project = &DigestProject{Name: entry.ProjectName}
+			projects[entry.ProjectName] = project
+			branches[entry.ProjectName] = make(map[string]*DigestBranch)
+			digest.Projects = append(digest.Projects, project)
+		}
+
+		branch, ok := branches[entry.ProjectName][entry.Branch]
+		if !ok {
+			branch = &DigestBranch{Branch: entry.Branch}
+			branches[entry.ProjectName][entry.Branch] = branch
+			project.Branches = append(project.Branches, branch)
+		}
+
+		switch {
+		case !entry.isBuildResult():
+			branch.Other++
+		case entry.Status == buildDomain.BuildStatusSuccess:
+			branch.Passed++
+			digest.Passed++
+		case entry.Status == buildDomain.BuildStatusFailed:
+			branch.Failed++
+			digest.Failed++
+			branch.Failures = append(branch.Failures, DigestFailure{CommitSHA: entry.CommitSHA, BuildURL: entry.BuildURL})
+		default:
+			branch.Other++
+		}
+	}
+
+	sort.Slice(digest.Projects, func(i, j int) bool { return digest.Projects[i].Name < digest.Projects[j].Name })
+	for _, project := range digest.Projects {
+		sort.Slice(project.Branches, func(i, j int) bool { return project.Branches[i].Branch < project.Branches[j].Branch })
+	}
+
+	return digest
+}
+
+// IsEmpty checks if the digest summarizes no build events
+func (d *Digest) IsEmpty() bool {
+	return len(d.Projects) == 0
+}
+
+// RenderTelegram renders the digest as a Telegram HTML message, project and branch names are escaped
+func (d *Digest) RenderTelegram() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "📬 <b>Build digest</b> until %s UTC\n", d.WindowEnd.Format("2006-01-02 15:04"))
+	fmt.Fprintf(&b, "✅ %d passed · ❌ %d failed", d.Passed, d.Failed)
+
+	for _, project := range d.Projects {
+		fmt.Fprintf(&b, "\n\n<b>%s</b>", html.EscapeString(project.Name))
+		for _, branch := range project.Branches {
+			fmt.Fprintf(&b, "\n<code>%s</code>: ✅ %d · ❌ %d", html.EscapeString(branch.Branch), branch.Passed, branch.Failed)
+			if branch.Other > 0 {
+				fmt.Fprintf(&b, " · %d other", branch.Other)
+			}
+			for _, failure := range branch.Failures {
+				b.WriteString("\n  • ")
+				b.WriteString(failure.telegramLink())
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// telegramLink renders a failure as an HTML link to the build, or its commit when the build has no URL
+func (f DigestFailure) telegramLink() string {
+	label := "Failed build"
+	if f.CommitSHA != "" {
+		sha := f.CommitSHA
+		if len(sha) > 7 {
+			sha = sha[:7]
+		}
+		label = fmt.Sprintf("Failed build %s", html.EscapeString(sha))
+	}
+
+	if f.BuildURL == "" {
+		return label
+	}
+	return fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(f.BuildURL), label)
+}
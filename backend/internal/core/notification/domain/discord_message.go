@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+)
+
+// Discord embed colors by build status
+const (
+	DiscordColorSuccess    = 0x2eb886
+	DiscordColorFailure    = 0xa30200
+	DiscordColorInProgress = 0xdaa038
+	DiscordColorNeutral    = 0x9ea3a8
+)
+
+// Discord message component types and styles
+const (
+	DiscordComponentActionRow = 1
+	DiscordComponentButton    = 2
+	DiscordButtonStyleLink    = 5
+)
+
+// Discord embed limits
+const (
+	discordMaxTitle       = 256
+	discordMaxDescription = 4096
+	discordMaxFieldName   = 256
+	discordMaxFieldValue  = 1024
+	discordMaxFields      = 25
+	// Field values up to this length are shown side by side
+	discordInlineFieldLength = 40
+)
+
+// discordStatusColors maps build statuses to embed colors
+var discordStatusColors = map[buildDomain.BuildStatus]int{
+	buildDomain.BuildStatusSuccess:    DiscordColorSuccess,
+	buildDomain.BuildStatusFailed:     DiscordColorFailure,
+	buildDomain.BuildStatusInProgress: DiscordColorInProgress,
+	buildDomain.BuildStatusPending:    DiscordColorInProgress,
+}
+
+// DiscordEmbedField is a name and value pair of an embed
+type DiscordEmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline,omitempty"`
+}
+
+// DiscordEmbed is the rich content of a Discord message
+type DiscordEmbed struct {
+	Title       string              `json:"title,omitempty"`
+	Description string              `json:"description,omitempty"`
+	URL         string              `json:"url,omitempty"`
+	Color       int                 `json:"color"`
+	Fields      []DiscordEmbedField `json:"fields,omitempty"`
+}
+
+// DiscordComponent is an action row or a link button of a Discord message
+type DiscordComponent struct {
+	Type       int                `json:"type"`
+	Style      int                `json:"style,omitempty"`
+	Label      string             `json:"label,omitempty"`
+	URL        string             `json:"url,omitempty"`
+	Components []DiscordComponent `json:"components,omitempty"`
+}
+
+// DiscordMessage is the payload posted to Discord webhook URLs
+type DiscordMessage struct {
+	Embeds     []DiscordEmbed     `json:"embeds"`
+	Components []DiscordComponent `json:"components,omitempty"`
+}
+
+// NewDiscordMessage renders a notification as a Discord embed.
+// The subject becomes the embed title and the text its description. The build status
+// picks the color, the template parameters become fields and the build URL a link button.
+func NewDiscordMessage(subject string, message *ChannelMessage) (*DiscordMessage, error) {
+	subject = strings.TrimSpace(subject)
+	text := strings.TrimSpace(message.Text)
+	if subject == "" && text == "" {
+		return nil, ErrInvalidMessage
+	}
+
+	embed := DiscordEmbed{
+		Title:       truncateDiscordText(subject, discordMaxTitle),
+		Description: truncateDiscordText(text, discordMaxDescription),
+		Color:       discordStatusColor(message.Status),
+	}
+
+	for _, fact := range message.facts() {
+		if len(embed.Fields) == discordMaxFields {
+			break
+		}
+		embed.Fields = append(embed.Fields, DiscordEmbedField{
+			Name:   truncateDiscordText(fact.title, discordMaxFieldName),
+			Value:  truncateDiscordText(fact.value, discordMaxFieldValue),
+			Inline: utf8.RuneCountInString(fact.value) <= discordInlineFieldLength,
+		})
+	}
+
+	buildURL, ok := message.buildURL()
+	if !ok {
+		return &DiscordMessage{Embeds: []DiscordEmbed{embed}}, nil
+	}
+
+	// The build link also makes the embed title clickable
+	embed.URL = buildURL
+	return &DiscordMessage{
+		Embeds: []DiscordEmbed{embed},
+		Components: []DiscordComponent{{
+			Type: DiscordComponentActionRow,
+			Components: []DiscordComponent{{
+				Type:  DiscordComponentButton,
+				Style: DiscordButtonStyleLink,
+				Label: "View Build",
+				URL:   buildURL,
+			}},
+		}},
+	}, nil
+}
+
+// discordStatusColor picks the embed color of a build status
+func discordStatusColor(status buildDomain.BuildStatus) int {
+	if color, ok := discordStatusColors[status]; ok {
+		return color
+	}
+	return DiscordColorNeutral
+}
+
+// truncateDiscordText keeps text within a Discord limit, which counts characters
+func truncateDiscordText(text string, limit int) string {
+	if utf8.RuneCountInString(text) <= limit {
+		return text
+	}
+	runes := []rune(text)
+	return string(runes[:limit-1]) + "…"
+}
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"fmt"
+	"html/template"
+	"regexp"
+	"strings"
+)
+
+// DefaultEmailSubject is used for email notifications that were created without a subject
+const DefaultEmailSubject = "CI/CD Notification"
+
+// emailURLPattern finds links in plain-text email bodies
+var emailURLPattern = regexp.MustCompile(`https?://[^\s<>"]+`)
+
+// emailHTMLLayout wraps a plain-text email body rendered from an email template.
+// Blank lines separate paragraphs and links become anchors.
+var emailHTMLLayout = template.Must(template.New("email").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Subject}}</title>
+</head>
+<body style="font-family: Arial, Helvetica, sans-serif; font-size: 14px; color: #24292f;">
+{{- range .Paragraphs}}
+<p>{{range $i, $line := .}}{{if $i}}<br>
+{{end}}{{range $line}}{{if .URL}}<a href="{{.URL}}">{{.Text}}</a>{{else}}{{.Text}}{{end}}{{end}}{{end}}</p>
+{{- end}}
+</body>
+</html>
+`))
+
+// EmailMessage is an email notification with plain-text and HTML alternatives
+type EmailMessage struct {
+	Subject  string
+	TextBody string
+	HTMLBody string
+}
+
+// emailSegment is a piece of a line of an email body, URL is set for links
+type emailSegment struct {
+	Text string
+	URL  string
+}
+
+// NewEmailMessage builds an email from a subject and a plain-text body rendered from an email template.
+// The HTML alternative is rendered from the same text so both parts carry the same content.
+func NewEmailMessage(subject, textBody string) (*EmailMessage, error) {
+	subject = strings.TrimSpace(subject)
+	if subject == "" {
+		subject = DefaultEmailSubject
+	}
+
+	textBody = strings.TrimSpace(strings.ReplaceAll(textBody, "\r\n", "\n"))
+	if textBody == "" {
+		return nil, ErrInvalidMessage
+	}
+
+	var htmlBody strings.Builder
+	data := struct {
+		Subject    string
+		Paragraphs [][][]emailSegment
+	}{
+		Subject:    subject,
+		Paragraphs: emailParagraphs(textBody),
+	}
+	if err := emailHTMLLayout.Execute(&htmlBody, data); err != nil {
+		return nil, NewTemplateRenderError(fmt.Sprintf("failed to render email HTML: %v", err))
+	}
+
+	return &EmailMessage{
+		Subject:  subject,
+		TextBody: textBody,
+		HTMLBody: htmlBody.String(),
+	}, nil
+}
+
+// emailParagraphs splits a plain-text body into paragraphs of lines of segments
+func emailParagraphs(textBody string) [][][]emailSegment {
+	var paragraphs [][][]emailSegment
+	for _, block := range strings.Split(textBody, "\n\n") {
+		if strings.TrimSpace(block) == "" {
+			continue
+		}
+
+		var lines [][]emailSegment
+		for _, line := range strings.Split(strings.Trim(block, "\n"), "\n") {
+			lines = append(lines, emailSegments(line))
+		}
+		paragraphs = append(paragraphs, lines)
+	}
+	return paragraphs
+}
+
+// emailSegments splits a line into text and link segments
+func emailSegments(line string) []emailSegment {
+	var segments []emailSegment
+	last := 0
+	for _, match := range emailURLPattern.FindAllStringIndex(line, -1) {
+		if match[0] > last {
+			segments = append(segments, emailSegment{Text: line[last:match[0]]})
+		}
+		url := line[match[0]:match[1]]
+		segments = append(segments, emailSegment{Text: url, URL: url})
+		last = match[1]
+	}
+	if last < len(line) {
+		segments = append(segments, emailSegment{Text: line[last:]})
+	}
+	return segments
+}
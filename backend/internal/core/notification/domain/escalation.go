@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+)
+
+// Escalation tracks a failure of a branch under an escalation policy, from its first failed build
+// until a successful build resolves it. Acknowledging it stops further escalation steps.
+type Escalation struct {
+	id             value_objects.ID
+	policyID       value_objects.ID
+	projectID      value_objects.ID
+	branch         string
+	buildEventID   value_objects.ID
+	failingSince   time.Time
+	step           int
+	acknowledgedAt *value_objects.Timestamp
+	acknowledgedBy string
+	resolvedAt     *value_objects.Timestamp
+	createdAt      value_objects.Timestamp
+	updatedAt      value_objects.Timestamp
+}
+
+// NewEscalation starts tracking a failure of the policy's branch that began with the given build
+func NewEscalation(policy *EscalationPolicy, buildEventID value_objects.ID, failingSince time.Time) *Escalation {
+	return &Escalation{
+		id:           value_objects.NewID(),
+		policyID:     policy.ID(),
+		projectID:    policy.ProjectID(),
+		branch:       policy.Branch(),
+		buildEventID: buildEventID,
+		failingSince: failingSince,
+		createdAt:    value_objects.NewTimestamp(),
+		updatedAt:    value_objects.NewTimestamp(),
+	}
+}
+
+// RestoreEscalationParams holds parameters for restoring an escalation
+type RestoreEscalationParams struct {
+	ID             value_objects.ID
+	PolicyID       value_objects.ID
+	ProjectID      value_objects.ID
+	Branch         string
+	BuildEventID   value_objects.ID
+	FailingSince   time.Time
+	Step           int
+	AcknowledgedAt *value_objects.Timestamp
+	AcknowledgedBy string
+	ResolvedAt     *value_objects.Timestamp
+	CreatedAt      value_objects.Timestamp
+	UpdatedAt      value_objects.Timestamp
+}
+
+// RestoreEscalation restores an escalation from persistence
+func RestoreEscalation(params RestoreEscalationParams) *Escalation {
+	return &Escalation{
+		id:             params.ID,
+		policyID:       params.PolicyID,
+		projectID:      params.ProjectID,
+		branch:         params.Branch,
+		buildEventID:   params.BuildEventID,
+		failingSince:   params.FailingSince,
+		step:           params.Step,
+		acknowledgedAt: params.AcknowledgedAt,
+		acknowledgedBy: params.AcknowledgedBy,
+		resolvedAt:     params.ResolvedAt,
+		createdAt:      params.CreatedAt,
+		updatedAt:      params.UpdatedAt,
+	}
+}
+
+// ID returns the escalation ID
+func (e *Escalation) ID() value_objects.ID {
+	return e.id
+}
+
+// PolicyID returns the ID of the policy escalating the failure
+func (e *Escalation) PolicyID() value_objects.ID {
+	return e.policyID
+}
+
+// ProjectID returns the project ID
+func (e *Escalation) ProjectID() value_objects.ID {
+	return e.projectID
+}
+
+// Branch returns the failing branch
+func (e *Escalation) Branch() string {
+	return e.branch
+}
+
+// BuildEventID returns the first failed build of the failure
+func (e *Escalation) BuildEventID() value_objects.ID {
+	return e.buildEventID
+}
+
+// FailingSince returns when the first failed build of the failure was recorded
+func (e *Escalation) FailingSince() time.Time {
+	return e.failingSince
+}
+
+// Step returns the number of escalation steps sent
+func (e *Escalation) Step() int {
+	return e.step
+}
+
+// AcknowledgedAt returns when the escalation was acknowledged, nil while it is not
+func (e *Escalation) AcknowledgedAt() *value_objects.Timestamp {
+	return e.acknowledgedAt
+}
+
+// AcknowledgedBy names who acknowledged the escalation
+func (e *Escalation) AcknowledgedBy() string {
+	return e.acknowledgedBy
+}
+
+// ResolvedAt returns when a successful build resolved the failure, nil while the branch is failing
+func (e *Escalation) ResolvedAt() *value_objects.Timestamp {
+	return e.resolvedAt
+}
+
+// CreatedAt returns the creation timestamp
+func (e *Escalation) CreatedAt() value_objects.Timestamp {
+	return e.createdAt
+}
+
+// UpdatedAt returns the last update timestamp
+func (e *Escalation) UpdatedAt() value_objects.Timestamp {
+	return e.updatedAt
+}
+
+// IsAcknowledged checks if someone took over the failure
+func (e *Escalation) IsAcknowledged() bool {
+	return e.acknowledgedAt != nil
+}
+
+// IsResolved checks if the failure was resolved
+func (e *Escalation) IsResolved() bool {
+	return e.resolvedAt != nil
+}
+
+// NextStep returns the escalation step to send given the number of steps that are due,
+// false when it was sent already or the escalation is acknowledged or resolved.
+// Steps missed in between are skipped, only the latest due step is sent.
+func (e *Escalation) NextStep(dueStep int) (int, bool) {
+	if e.IsAcknowledged() || e.IsResolved() || dueStep <= e.step {
+		return 0, false
+	}
+	return dueStep, true
+}
+
+// Escalate records that an escalation step was sent
+func (e *Escalation) Escalate(step int) {
+	e.step = step
+	e.updatedAt = value_objects.NewTimestamp()
+}
+
+// Acknowledge stops further escalation steps, acknowledging again keeps the first acknowledgement
+func (e *Escalation) Acknowledge(acknowledgedBy string) {
+	if e.IsAcknowledged() {
+		return
+	}
+
+	now := value_objects.NewTimestamp()
+	e.acknowledgedAt = &now
+	e.acknowledgedBy = acknowledgedBy
+	e.updatedAt = now
+}
+
+// Resolve ends the escalation once the branch is no longer failing
+func (e *Escalation) Resolve() {
+	if e.IsResolved() {
+		return
+	}
+
+	now := value_objects.NewTimestamp()
+	e.resolvedAt = &now
+	e.updatedAt = now
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/google/uuid"
+)
+
+// EscalationModel represents the database model for escalations
+type EscalationModel struct {
+	ID             uuid.UUID  `gorm:"column:id;primaryKey;type:uuid;default:uuid_generate_v4()"`
+	PolicyID       uuid.UUID  `gorm:"column:policy_id;type:uuid;not null;index:idx_escalations_policy"`
+	ProjectID      uuid.UUID  `gorm:"column:project_id;type:uuid;not null;index:idx_escalations_project"`
+	Branch         string     `gorm:"column:branch;type:varchar(255);not null"`
+	BuildEventID   uuid.UUID  `gorm:"column:build_event_id;type:uuid;not null"`
+	FailingSince   time.Time  `gorm:"column:failing_since;type:timestamp with time zone;not null"`
+	Step           int        `gorm:"column:step;not null;default:0"`
+	AcknowledgedAt *time.Time `gorm:"column:acknowledged_at;type:timestamp with time zone"`
+	AcknowledgedBy string     `gorm:"column:acknowledged_by;type:varchar(255);not null;default:''"`
+	ResolvedAt     *time.Time `gorm:"column:resolved_at;type:timestamp with time zone"`
+	CreatedAt      time.Time  `gorm:"column:created_at;type:timestamp with time zone;default:current_timestamp"`
+	UpdatedAt      time.Time  `gorm:"column:updated_at;type:timestamp with time zone;default:current_timestamp"`
+}
+
+// TableName returns the table name for escalations
+func (EscalationModel) TableName() string {
+	return "escalations"
+}
+
+// ToEntity converts the model to domain entity
+func (m *EscalationModel) ToEntity() *Escalation {
+	params := RestoreEscalationParams{
+		ID:             value_objects.NewIDFromUUID(m.ID),
+		PolicyID:       value_objects.NewIDFromUUID(m.PolicyID),
+		ProjectID:      value_objects.NewIDFromUUID(m.ProjectID),
+		Branch:         m.Branch,
+		BuildEventID:   value_objects.NewIDFromUUID(m.BuildEventID),
+		FailingSince:   m.FailingSince,
+		Step:           m.Step,
+		AcknowledgedBy: m.AcknowledgedBy,
+		CreatedAt:      value_objects.NewTimestampFromTime(m.CreatedAt),
+		UpdatedAt:      value_objects.NewTimestampFromTime(m.UpdatedAt),
+	}
+	if m.AcknowledgedAt != nil {
+		acknowledgedAt := value_objects.NewTimestampFromTime(*m.AcknowledgedAt)
+		params.AcknowledgedAt = &acknowledgedAt
+	}
+	if m.ResolvedAt != nil {
+		resolvedAt := value_objects.NewTimestampFromTime(*m.ResolvedAt)
+		params.ResolvedAt = &resolvedAt
+	}
+
+	return RestoreEscalation(params)
+}
+
+// FromEntity converts domain entity to model
+func (m *EscalationModel) FromEntity(escalation *Escalation) {
+	m.ID = escalation.ID().Value()
+	m.PolicyID = escalation.PolicyID().Value()
+	m.ProjectID = escalation.ProjectID().Value()
+	m.Branch = escalation.Branch()
+	m.BuildEventID = escalation.BuildEventID().Value()
+	m.FailingSince = escalation.FailingSince()
+	m.Step = escalation.Step()
+	m.AcknowledgedAt = nil
+	if escalation.AcknowledgedAt() != nil {
+		acknowledgedAt := escalation.AcknowledgedAt().ToTime()
+		m.AcknowledgedAt = &acknowledgedAt
+	}
+	m.AcknowledgedBy = escalation.AcknowledgedBy()
+	m.ResolvedAt = nil
+	if escalation.ResolvedAt() != nil {
+		resolvedAt := escalation.ResolvedAt().ToTime()
+		m.ResolvedAt = &resolvedAt
+	}
+	m.CreatedAt = escalation.CreatedAt().ToTime()
+	m.UpdatedAt = escalation.UpdatedAt().ToTime()
+}
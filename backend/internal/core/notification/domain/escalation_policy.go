@@ -0,0 +1,254 @@
+package domain
+
+import (
+	"fmt"
+	"net/mail"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+)
+
+const (
+	// DefaultEscalationBranch is the branch escalated when a policy names none
+	DefaultEscalationBranch = "main"
+	// maxEscalationSteps limits how often a single failure is escalated
+	maxEscalationSteps = 10
+	// minEscalationDelay keeps escalations from firing on every evaluation
+	minEscalationDelay = time.Minute
+)
+
+// EscalationPolicy escalates a branch of a project that keeps failing to an escalation chat or email list,
+// once for each step the branch is still failing after
+type EscalationPolicy struct {
+	id              value_objects.ID
+	projectID       value_objects.ID
+	branch          string
+	steps           []time.Duration
+	telegramChatID  *int64
+	emailRecipients []string
+	isActive        bool
+	createdAt       value_objects.Timestamp
+	updatedAt       value_objects.Timestamp
+}
+
+// NewEscalationPolicy creates a new escalation policy entity, escalating the default branch without a branch
+func NewEscalationPolicy(
+	projectID value_objects.ID,
+	branch string,
+	steps []time.Duration,
+	telegramChatID *int64,
+	emailRecipients []string,
+) (*EscalationPolicy, error) {
+	policy := &EscalationPolicy{
+		id:        value_objects.NewID(),
+		projectID: projectID,
+		isActive:  true,
+		createdAt: value_objects.NewTimestamp(),
+		updatedAt: value_objects.NewTimestamp(),
+	}
+
+	if projectID.IsNil() {
+		return nil, ErrInvalidProjectID
+	}
+	if err := policy.UpdateBranch(branch); err != nil {
+		return nil, err
+	}
+	if err := policy.UpdateSteps(steps); err != nil {
+		return nil, err
+	}
+	if err := policy.UpdateTargets(telegramChatID, emailRecipients); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// RestoreEscalationPolicyParams holds parameters for restoring an escalation policy
+type RestoreEscalationPolicyParams struct {
+	ID              value_objects.ID
+	ProjectID       value_objects.ID
+	Branch          string
+	Steps           []time.Duration
+	TelegramChatID  *int64
+	EmailRecipients []string
+	IsActive        bool
+	CreatedAt       value_objects.Timestamp
+	UpdatedAt       value_objects.Timestamp
+}
+
+// RestoreEscalationPolicy restores an escalation policy from persistence
+func RestoreEscalationPolicy(params RestoreEscalationPolicyParams) *EscalationPolicy {
+	return &EscalationPolicy{
+		id:              params.ID,
+		projectID:       params.ProjectID,
+		branch:          params.Branch,
+		steps:           params.Steps,
+		telegramChatID:  params.TelegramChatID,
+		emailRecipients: params.EmailRecipients,
+		isActive:        params.IsActive,
+		createdAt:       params.CreatedAt,
+		updatedAt:       params.UpdatedAt,
+	}
+}
+
+// ID returns the policy ID
+func (ep *EscalationPolicy) ID() value_objects.ID {
+	return ep.id
+}
+
+// ProjectID returns the project ID
+func (ep *EscalationPolicy) ProjectID() value_objects.ID {
+	return ep.projectID
+}
+
+// Branch returns the branch whose failures are escalated
+func (ep *EscalationPolicy) Branch() string {
+	return ep.branch
+}
+
+// Steps returns how long after its first failure a failing branch is escalated, shortest first
+func (ep *EscalationPolicy) Steps() []time.Duration {
+	return ep.steps
+}
+
+// TelegramChatID returns the chat escalations are sent to, nil without one
+func (ep *EscalationPolicy) TelegramChatID() *int64 {
+	return ep.telegramChatID
+}
+
+// EmailRecipients returns the email addresses escalations are sent to
+func (ep *EscalationPolicy) EmailRecipients() []string {
+	return ep.emailRecipients
+}
+
+// IsActive returns whether failures are escalated
+func (ep *EscalationPolicy) IsActive() bool {
+	return ep.isActive
+}
+
+// CreatedAt returns the creation timestamp
+func (ep *EscalationPolicy) CreatedAt() value_objects.Timestamp {
+	return ep.createdAt
+}
+
+// UpdatedAt returns the last update timestamp
+func (ep *EscalationPolicy) UpdatedAt() value_objects.Timestamp {
+	return ep.updatedAt
+}
+
+// IsEscalationChat checks if escalations are sent to the chat
+func (ep *EscalationPolicy) IsEscalationChat(chatID int64) bool {
+	return ep.telegramChatID != nil && *ep.telegramChatID == chatID
+}
+
+// UpdateBranch changes the escalated branch, the default branch when empty
+func (ep *EscalationPolicy) UpdateBranch(branch string) error {
+	branch = strings.TrimSpace(branch)
+	if branch == "" {
+		branch = DefaultEscalationBranch
+	}
+
+	ep.branch = branch
+	ep.updatedAt = value_objects.NewTimestamp()
+	return nil
+}
+
+// UpdateSteps replaces the escalation steps, each a delay after the first failure of at least a minute
+func (ep *EscalationPolicy) UpdateSteps(steps []time.Duration) error {
+	if len(steps) == 0 {
+		return NewInvalidEscalationPolicyError("at least one escalation step is required")
+	}
+	if len(steps) > maxEscalationSteps {
+		return NewInvalidEscalationPolicyError(fmt.Sprintf("at most %d escalation steps are allowed", maxEscalationSteps))
+	}
+
+	sorted := make([]time.Duration, len(steps))
+	copy(sorted, steps)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	for i, step := range sorted {
+		if step < minEscalationDelay {
+			return NewInvalidEscalationPolicyError("escalation steps must be at least a minute")
+		}
+		if i > 0 && step == sorted[i-1] {
+			return NewInvalidEscalationPolicyError(fmt.Sprintf("duplicate escalation step: %s", step))
+		}
+	}
+
+	ep.steps = sorted
+	ep.updatedAt = value_objects.NewTimestamp()
+	return nil
+}
+
+// UpdateTargets replaces the escalation chat and email list, at least one of them is required
+func (ep *EscalationPolicy) UpdateTargets(telegramChatID *int64, emailRecipients []string) error {
+	if telegramChatID != nil && *telegramChatID == 0 {
+		return ErrInvalidTelegramChatID
+	}
+
+	recipients, err := normalizeEscalationRecipients(emailRecipients)
+	if err != nil {
+		return err
+	}
+
+	if telegramChatID == nil && len(recipients) == 0 {
+		return NewInvalidEscalationPolicyError("an escalation chat or email recipient is required")
+	}
+
+	ep.telegramChatID = telegramChatID
+	ep.emailRecipients = recipients
+	ep.updatedAt = value_objects.NewTimestamp()
+	return nil
+}
+
+// Activate activates the policy
+func (ep *EscalationPolicy) Activate() {
+	if !ep.isActive {
+		ep.isActive = true
+		ep.updatedAt = value_objects.NewTimestamp()
+	}
+}
+
+// Deactivate deactivates the policy, failing branches are no longer escalated
+func (ep *EscalationPolicy) Deactivate() {
+	if ep.isActive {
+		ep.isActive = false
+		ep.updatedAt = value_objects.NewTimestamp()
+	}
+}
+
+// DueStep returns the number of escalation steps that are due for a branch failing since the given time
+func (ep *EscalationPolicy) DueStep(failingSince, now time.Time) int {
+	due := 0
+	for _, step := range ep.steps {
+		if now.Before(failingSince.Add(step)) {
+			break
+		}
+		due++
+	}
+	return due
+}
+
+// normalizeEscalationRecipients parses email addresses, keeping the bare address of each unique recipient
+func normalizeEscalationRecipients(recipients []string) ([]string, error) {
+	normalized := make([]string, 0, len(recipients))
+	seen := make(map[string]bool, len(recipients))
+
+	for _, recipient := range recipients {
+		address, err := mail.ParseAddress(strings.TrimSpace(recipient))
+		if err != nil {
+			return nil, NewInvalidEscalationPolicyError(fmt.Sprintf("invalid email recipient: %s", recipient))
+		}
+
+		key := strings.ToLower(address.Address)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		normalized = append(normalized, address.Address)
+	}
+
+	return normalized, nil
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/google/uuid"
+)
+
+// EscalationPolicyModel represents the database model for escalation policies
+type EscalationPolicyModel struct {
+	ID        uuid.UUID `gorm:"column:id;primaryKey;type:uuid;default:uuid_generate_v4()"`
+	ProjectID uuid.UUID `gorm:"column:project_id;type:uuid;not null;index:idx_escalation_policies_project"`
+	Branch    string    `gorm:"column:branch;type:varchar(255);not null;default:'main'"`
+	// Steps are stored as a JSON array of minutes after the first failure
+	StepMinutes     json.RawMessage `gorm:"column:step_minutes;type:jsonb;not null;default:'[]'"`
+	TelegramChatID  *int64          `gorm:"column:telegram_chat_id;type:bigint"`
+	EmailRecipients json.RawMessage `gorm:"column:email_recipients;type:jsonb;not null;default:'[]'"`
+	IsActive        bool            `gorm:"column:is_active;not null;default:true"`
+	CreatedAt       time.Time       `gorm:"column:created_at;type:timestamp with time zone;default:current_timestamp"`
+	UpdatedAt       time.Time       `gorm:"column:updated_at;type:timestamp with time zone;default:current_timestamp"`
+}
+
+// TableName returns the table name for escalation policies
+func (EscalationPolicyModel) TableName() string {
+	return "escalation_policies"
+}
+
+// ToEntity converts the model to domain entity
+func (m *EscalationPolicyModel) ToEntity() *EscalationPolicy {
+	var stepMinutes []int
+	if len(m.StepMinutes) > 0 {
+		_ = json.Unmarshal(m.StepMinutes, &stepMinutes)
+	}
+	steps := make([]time.Duration, len(stepMinutes))
+	for i, minutes := range stepMinutes {
+		steps[i] = time.Duration(minutes) * time.Minute
+	}
+
+	var emailRecipients []string
+	if len(m.EmailRecipients) > 0 {
+		_ = json.Unmarshal(m.EmailRecipients, &emailRecipients)
+	}
+
+	return RestoreEscalationPolicy(RestoreEscalationPolicyParams{
+		ID:              value_objects.NewIDFromUUID(m.ID),
+		ProjectID:       value_objects.NewIDFromUUID(m.ProjectID),
+		Branch:          m.Branch,
+		Steps:           steps,
+		TelegramChatID:  m.TelegramChatID,
+		EmailRecipients: emailRecipients,
+		IsActive:        m.IsActive,
+		CreatedAt:       value_objects.NewTimestampFromTime(m.CreatedAt),
+		UpdatedAt:       value_objects.NewTimestampFromTime(m.UpdatedAt),
+	})
+}
+
+// FromEntity converts domain entity to model
+func (m *EscalationPolicyModel) FromEntity(policy *EscalationPolicy) {
+	stepMinutes := make([]int, len(policy.Steps()))
+	for i, step := range policy.Steps() {
+		stepMinutes[i] = int(step / time.Minute)
+	}
+
+	m.ID = policy.ID().Value()
+	m.ProjectID = policy.ProjectID().Value()
+	m.Branch = policy.Branch()
+	m.StepMinutes, _ = json.Marshal(stepMinutes)
+	m.TelegramChatID = policy.TelegramChatID()
+	m.EmailRecipients = json.RawMessage("[]")
+	if recipients := policy.EmailRecipients(); len(recipients) > 0 {
+		m.EmailRecipients, _ = json.Marshal(recipients)
+	}
+	m.IsActive = policy.IsActive()
+	m.CreatedAt = policy.CreatedAt().ToTime()
+	m.UpdatedAt = policy.UpdatedAt().ToTime()
+}
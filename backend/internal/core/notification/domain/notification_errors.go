@@ -36,12 +36,32 @@ const (
 	ErrCodeRetryConfigurationNotFound        = "RETRY_CONFIGURATION_NOT_FOUND"
 	ErrCodeRetryConfigurationAlreadyActive   = "RETRY_CONFIGURATION_ALREADY_ACTIVE"
 	ErrCodeRetryConfigurationAlreadyInactive = "RETRY_CONFIGURATION_ALREADY_INACTIVE"
+	// Dead-letter queue error codes
+	ErrCodeDeadLetterNotFound        = "DEAD_LETTER_NOT_FOUND"
+	ErrCodeDeadLetterAlreadyResolved = "DEAD_LETTER_ALREADY_RESOLVED"
+	ErrCodeInvalidDeadLetterReason   = "INVALID_DEAD_LETTER_REASON"
+	// Webhook subscription error codes
+	ErrCodeWebhookSubscriptionNotFound = "WEBHOOK_SUBSCRIPTION_NOT_FOUND"
+	ErrCodeInvalidWebhookSubscription  = "INVALID_WEBHOOK_SUBSCRIPTION"
+	ErrCodeWebhookSubscriptionInactive = "WEBHOOK_SUBSCRIPTION_INACTIVE"
+	// Subscription filter error codes
+	ErrCodeInvalidSubscriptionFilter = "INVALID_SUBSCRIPTION_FILTER"
+	// Digest error codes
+	ErrCodeInvalidDigestMode = "INVALID_DIGEST_MODE"
+	// Quiet hours error codes
+	ErrCodeInvalidQuietHours = "INVALID_QUIET_HOURS"
+	// Escalation error codes
+	ErrCodeEscalationPolicyNotFound = "ESCALATION_POLICY_NOT_FOUND"
+	ErrCodeInvalidEscalationPolicy  = "INVALID_ESCALATION_POLICY"
+	ErrCodeEscalationNotFound       = "ESCALATION_NOT_FOUND"
+	ErrCodeInvalidBatchSize         = "INVALID_BATCH_SIZE"
 )
 
 // Repository layer error variables - for repository implementations
 var (
 	ErrNotificationTemplateNotFound = errors.New("notification template not found")
 	ErrRetryConfigurationNotFound   = errors.New("retry configuration not found")
+	ErrQueuedNotificationNotFound   = errors.New("notification not found")
 )
 
 // Generic CRUD error message constants - reusable across all services
@@ -76,6 +96,11 @@ const (
 	ErrMsgMarkNotificationAsSent   = "failed to mark notification as sent: %w"
 	ErrMsgMarkNotificationAsFailed = "failed to mark notification as failed: %w"
 	ErrMsgMarkNotificationAsRetry  = "failed to mark notification as retrying: %w"
+	ErrMsgDeadLetterNotification   = "failed to dead-letter notification: %w"
+	ErrMsgGetDeadLetter            = "failed to get dead-lettered notification: %w"
+	ErrMsgListDeadLetters          = "failed to list dead-lettered notifications: %w"
+	ErrMsgRequeueDeadLetter        = "failed to requeue dead-lettered notification: %w"
+	ErrMsgDiscardDeadLetter        = "failed to discard dead-lettered notification: %w"
 )
 
 // Specialized error message constants for operations with specific parameters
@@ -101,6 +126,32 @@ const (
 	LogMsgMarkNotificationFail       = "Failed to mark notification as failed"
 	LogMsgMarkNotificationSent       = "Failed to mark notification as sent"
 	LogMsgMarkNotificationAsRetrying = "Failed to mark notification as retrying"
+	LogMsgDeadLetterNotification     = "Failed to move notification to the dead-letter queue"
+)
+
+// Dead-letter service log message constants
+const (
+	LogMsgGetDeadLetter     = "Failed to get dead-lettered notification"
+	LogMsgListDeadLetters   = "Failed to list dead-lettered notifications"
+	LogMsgRequeueDeadLetter = "Failed to requeue dead-lettered notification"
+	LogMsgDiscardDeadLetter = "Failed to discard dead-lettered notification"
+)
+
+// Webhook subscription service log message constants
+const (
+	LogMsgCreateWebhookSubscription = "Failed to create webhook subscription"
+	LogMsgGetWebhookSubscription    = "Failed to get webhook subscription"
+	LogMsgUpdateWebhookSubscription = "Failed to update webhook subscription"
+	LogMsgDeleteWebhookSubscription = "Failed to delete webhook subscription"
+)
+
+// Escalation service log message constants
+const (
+	LogMsgCreateEscalationPolicy = "Failed to create escalation policy"
+	LogMsgGetEscalationPolicy    = "Failed to get escalation policy"
+	LogMsgUpdateEscalationPolicy = "Failed to update escalation policy"
+	LogMsgDeleteEscalationPolicy = "Failed to delete escalation policy"
+	LogMsgEvaluateEscalation     = "Failed to evaluate escalation policy"
 )
 
 // Retry service log message constants
@@ -123,6 +174,7 @@ const (
 // Sender service log message constants
 const (
 	LogMsgSendingTelegram = "Sending telegram notification"
+	LogMsgEditingTelegram = "Editing telegram notification"
 	LogMsgSendingEmail    = "Sending email notification"
 	LogMsgSendingSlack    = "Sending slack notification"
 	LogMsgSendingWebhook  = "Sending webhook notification"
@@ -298,6 +350,49 @@ var (
 		ErrCodeRetryConfigurationAlreadyInactive,
 		"retry configuration is already inactive",
 	)
+
+	// Dead-letter queue domain errors
+	ErrDeadLetterNotFound = exception.NewDomainError(
+		ErrCodeDeadLetterNotFound,
+		"dead-lettered notification not found",
+	)
+
+	ErrDeadLetterAlreadyResolved = exception.NewDomainError(
+		ErrCodeDeadLetterAlreadyResolved,
+		"dead-lettered notification has already been requeued or discarded",
+	)
+
+	ErrInvalidDeadLetterReason = exception.NewDomainError(
+		ErrCodeInvalidDeadLetterReason,
+		"dead-letter reason is invalid",
+	)
+
+	// Webhook subscription domain errors
+	ErrWebhookSubscriptionNotFound = exception.NewDomainError(
+		ErrCodeWebhookSubscriptionNotFound,
+		"webhook subscription not found",
+	)
+
+	ErrWebhookSubscriptionInactive = exception.NewDomainError(
+		ErrCodeWebhookSubscriptionInactive,
+		"webhook subscription is inactive",
+	)
+
+	// Escalation domain errors
+	ErrEscalationPolicyNotFound = exception.NewDomainError(
+		ErrCodeEscalationPolicyNotFound,
+		"escalation policy not found",
+	)
+
+	ErrEscalationNotFound = exception.NewDomainError(
+		ErrCodeEscalationNotFound,
+		"escalation not found",
+	)
+
+	ErrInvalidEscalationBatchSize = exception.NewDomainError(
+		ErrCodeInvalidBatchSize,
+		"escalation batch size must be greater than 0",
+	)
 )
 
 // Helper functions to create domain errors with context
@@ -351,6 +446,27 @@ func NewRetryConfigurationNotFoundError(configID string) error {
 	)
 }
 
+func NewInvalidWebhookSubscriptionError(message string) error {
+	return exception.NewDomainError(
+		ErrCodeInvalidWebhookSubscription,
+		message,
+	)
+}
+
+func NewInvalidEscalationPolicyError(message string) error {
+	return exception.NewDomainError(
+		ErrCodeInvalidEscalationPolicy,
+		message,
+	)
+}
+
+func NewInvalidSubscriptionFilterError(message string) error {
+	return exception.NewDomainError(
+		ErrCodeInvalidSubscriptionFilter,
+		message,
+	)
+}
+
 func NewInvalidRecipientError(recipient string) error {
 	return exception.NewDomainError(
 		ErrCodeInvalidRecipient,
@@ -364,3 +480,24 @@ func NewMaxRetriesExceededError(maxRetries int) error {
 		fmt.Sprintf("maximum retry attempts (%d) exceeded", maxRetries),
 	)
 }
+
+func NewInvalidNotificationTransitionError(from, to NotificationStatus) error {
+	return exception.NewDomainError(
+		ErrCodeInvalidNotificationStatus,
+		fmt.Sprintf("cannot change notification status from %s to %s", from, to),
+	)
+}
+
+func NewInvalidDigestModeError(message string) error {
+	return exception.NewDomainError(
+		ErrCodeInvalidDigestMode,
+		message,
+	)
+}
+
+func NewInvalidQuietHoursError(message string) error {
+	return exception.NewDomainError(
+		ErrCodeInvalidQuietHours,
+		message,
+	)
+}
@@ -18,6 +18,12 @@ const (
 	NotificationStatusRetrying  NotificationStatus = "retrying"
 	NotificationStatusCancelled NotificationStatus = "cancelled"
 	NotificationStatusExpired   NotificationStatus = "expired"
+	// NotificationStatusDeadLettered marks a notification moved to the dead-letter queue
+	NotificationStatusDeadLettered NotificationStatus = "dead_lettered"
+	// NotificationStatusBatched marks a notification held back for the digest of its subscription
+	NotificationStatusBatched NotificationStatus = "batched"
+	// NotificationStatusDeferred marks a notification queued until the quiet hours of its recipient end
+	NotificationStatusDeferred NotificationStatus = "deferred"
 )
 
 // IsValid checks if the notification status is valid
@@ -25,7 +31,8 @@ func (s NotificationStatus) IsValid() bool {
 	switch s {
 	case NotificationStatusPending, NotificationStatusSent, NotificationStatusDelivered,
 		NotificationStatusFailed, NotificationStatusRetrying, NotificationStatusCancelled,
-		NotificationStatusExpired:
+		NotificationStatusExpired, NotificationStatusDeadLettered, NotificationStatusBatched,
+		NotificationStatusDeferred:
 		return true
 	default:
 		return false
@@ -40,12 +47,15 @@ const (
 	NotificationChannelEmail    NotificationChannel = "email"
 	NotificationChannelSlack    NotificationChannel = "slack"
 	NotificationChannelWebhook  NotificationChannel = "webhook"
+	NotificationChannelTeams    NotificationChannel = "teams"
+	NotificationChannelDiscord  NotificationChannel = "discord"
 )
 
 // IsValid checks if the notification channel is valid
 func (c NotificationChannel) IsValid() bool {
 	switch c {
-	case NotificationChannelTelegram, NotificationChannelEmail, NotificationChannelSlack, NotificationChannelWebhook:
+	case NotificationChannelTelegram, NotificationChannelEmail, NotificationChannelSlack, NotificationChannelWebhook,
+		NotificationChannelTeams, NotificationChannelDiscord:
 		return true
 	default:
 		return false
@@ -137,6 +147,13 @@ func (nm *NotificationMetrics) RecordFailure() {
 	nm.failedAt = &now
 }
 
+// DeliveryAttempt records a failed delivery attempt of a notification
+type DeliveryAttempt struct {
+	Attempt  int       `json:"attempt"`
+	Error    string    `json:"error"`
+	FailedAt time.Time `json:"failed_at"`
+}
+
 // NotificationLog represents a notification log domain entity
 type NotificationLog struct {
 	id           value_objects.ID
@@ -144,6 +161,7 @@ type NotificationLog struct {
 	projectID    value_objects.ID
 	channel      NotificationChannel
 	recipient    string
+	subject      string // Subject line of email notifications
 	message      string
 	status       NotificationStatus
 	errorMessage string
@@ -153,8 +171,10 @@ type NotificationLog struct {
 	templateID   *value_objects.ID
 	metadata     map[string]interface{}
 	metrics      *NotificationMetrics
+	errorHistory []DeliveryAttempt
 	nextRetryAt  *value_objects.Timestamp
 	expiresAt    *value_objects.Timestamp
+	digestAt     *time.Time // When a batched notification is sent with its digest
 	sentAt       *value_objects.Timestamp
 	createdAt    value_objects.Timestamp
 	updatedAt    value_objects.Timestamp
@@ -207,6 +227,7 @@ func RestoreNotificationLog(params RestoreNotificationLogParams) *NotificationLo
 		projectID:    params.ProjectID,
 		channel:      params.Channel,
 		recipient:    params.Recipient,
+		subject:      params.Subject,
 		message:      params.Message,
 		status:       params.Status,
 		errorMessage: params.ErrorMessage,
@@ -216,8 +237,10 @@ func RestoreNotificationLog(params RestoreNotificationLogParams) *NotificationLo
 		templateID:   params.TemplateID,
 		metadata:     params.Metadata,
 		metrics:      metrics,
+		errorHistory: params.ErrorHistory,
 		nextRetryAt:  params.NextRetryAt,
 		expiresAt:    params.ExpiresAt,
+		digestAt:     params.DigestAt,
 		sentAt:       params.SentAt,
 		createdAt:    params.CreatedAt,
 		updatedAt:    params.UpdatedAt,
@@ -231,6 +254,7 @@ type RestoreNotificationLogParams struct {
 	ProjectID    value_objects.ID
 	Channel      NotificationChannel
 	Recipient    string
+	Subject      string
 	Message      string
 	Status       NotificationStatus
 	ErrorMessage string
@@ -240,8 +264,10 @@ type RestoreNotificationLogParams struct {
 	TemplateID   *value_objects.ID
 	Metadata     map[string]interface{}
 	Metrics      *NotificationMetrics
+	ErrorHistory []DeliveryAttempt
 	NextRetryAt  *value_objects.Timestamp
 	ExpiresAt    *value_objects.Timestamp
+	DigestAt     *time.Time
 	SentAt       *value_objects.Timestamp
 	CreatedAt    value_objects.Timestamp
 	UpdatedAt    value_objects.Timestamp
@@ -272,6 +298,11 @@ func (nl *NotificationLog) Recipient() string {
 	return nl.recipient
 }
 
+// Subject returns the subject line of an email notification
+func (nl *NotificationLog) Subject() string {
+	return nl.subject
+}
+
 // Message returns the notification message
 func (nl *NotificationLog) Message() string {
 	return nl.message
@@ -324,6 +355,18 @@ func (nl *NotificationLog) Metrics() *NotificationMetrics {
 	return nl.metrics
 }
 
+// ErrorHistory returns a copy of the failed delivery attempts, oldest first
+func (nl *NotificationLog) ErrorHistory() []DeliveryAttempt {
+	history := make([]DeliveryAttempt, len(nl.errorHistory))
+	copy(history, nl.errorHistory)
+	return history
+}
+
+// AttemptCount returns the number of delivery attempts made in the current retry cycle
+func (nl *NotificationLog) AttemptCount() int {
+	return nl.retryCount + 1
+}
+
 // NextRetryAt returns the next retry timestamp
 func (nl *NotificationLog) NextRetryAt() *value_objects.Timestamp {
 	return nl.nextRetryAt
@@ -334,6 +377,11 @@ func (nl *NotificationLog) ExpiresAt() *value_objects.Timestamp {
 	return nl.expiresAt
 }
 
+// DigestAt returns when a batched notification is sent with its digest, nil for instant notifications
+func (nl *NotificationLog) DigestAt() *time.Time {
+	return nl.digestAt
+}
+
 // SentAt returns the sent timestamp
 func (nl *NotificationLog) SentAt() *value_objects.Timestamp {
 	return nl.sentAt
@@ -371,6 +419,54 @@ func (nl *NotificationLog) MarkAsSent(messageID *string) error {
 	return nil
 }
 
+// HoldForDigest holds a pending notification back until the digest sent at the given time
+func (nl *NotificationLog) HoldForDigest(digestAt time.Time) error {
+	if nl.status != NotificationStatusPending {
+		return NewInvalidNotificationTransitionError(nl.status, NotificationStatusBatched)
+	}
+
+	digestAt = digestAt.UTC()
+	nl.status = NotificationStatusBatched
+	nl.digestAt = &digestAt
+	nl.updatedAt = value_objects.NewTimestamp()
+
+	return nil
+}
+
+// IsBatched checks if the notification is held back for a digest
+func (nl *NotificationLog) IsBatched() bool {
+	return nl.status == NotificationStatusBatched
+}
+
+// Defer holds a pending notification back until the quiet hours of its recipient end
+func (nl *NotificationLog) Defer() error {
+	if nl.status != NotificationStatusPending {
+		return NewInvalidNotificationTransitionError(nl.status, NotificationStatusDeferred)
+	}
+
+	nl.status = NotificationStatusDeferred
+	nl.updatedAt = value_objects.NewTimestamp()
+
+	return nil
+}
+
+// IsDeferred checks if the notification waits for the quiet hours of its recipient to end
+func (nl *NotificationLog) IsDeferred() bool {
+	return nl.status == NotificationStatusDeferred
+}
+
+// Release puts a deferred notification back to pending once the quiet hours ended
+func (nl *NotificationLog) Release() error {
+	if nl.status != NotificationStatusDeferred {
+		return NewInvalidNotificationTransitionError(nl.status, NotificationStatusPending)
+	}
+
+	nl.status = NotificationStatusPending
+	nl.updatedAt = value_objects.NewTimestamp()
+
+	return nil
+}
+
 // MarkAsDelivered marks the notification as delivered
 func (nl *NotificationLog) MarkAsDelivered() error {
 	nl.status = NotificationStatusDelivered
@@ -395,6 +491,11 @@ func (nl *NotificationLog) MarkAsFailed(errorMessage string) error {
 	nl.status = NotificationStatusFailed
 	nl.errorMessage = strings.TrimSpace(errorMessage)
 	nl.updatedAt = value_objects.NewTimestamp()
+	nl.errorHistory = append(nl.errorHistory, DeliveryAttempt{
+		Attempt:  nl.AttemptCount(),
+		Error:    nl.errorMessage,
+		FailedAt: nl.updatedAt.ToTime(),
+	})
 
 	// Record failure metrics - ensure metrics is not nil
 	if nl.metrics == nil {
@@ -442,6 +543,33 @@ func (nl *NotificationLog) MarkAsCancelled() error {
 	return nil
 }
 
+// MarkAsDeadLettered marks the notification as moved to the dead-letter queue
+func (nl *NotificationLog) MarkAsDeadLettered() error {
+	if nl.status != NotificationStatusFailed {
+		return NewInvalidNotificationTransitionError(nl.status, NotificationStatusDeadLettered)
+	}
+
+	nl.status = NotificationStatusDeadLettered
+	nl.ClearRetrySchedule()
+
+	return nil
+}
+
+// Requeue puts a dead-lettered notification back to pending with a fresh retry budget.
+// The error history is kept so earlier failures stay visible.
+func (nl *NotificationLog) Requeue() error {
+	if nl.status != NotificationStatusDeadLettered {
+		return NewInvalidNotificationTransitionError(nl.status, NotificationStatusPending)
+	}
+
+	nl.status = NotificationStatusPending
+	nl.retryCount = 0
+	nl.errorMessage = ""
+	nl.ClearRetrySchedule()
+
+	return nil
+}
+
 // CanRetry checks if the notification can be retried
 func (nl *NotificationLog) CanRetry() bool {
 	return nl.status == NotificationStatusFailed &&
@@ -488,6 +616,12 @@ func (nl *NotificationLog) SetTemplateID(templateID value_objects.ID) {
 	nl.updatedAt = value_objects.NewTimestamp()
 }
 
+// SetSubject sets the subject line used when the notification is delivered by email
+func (nl *NotificationLog) SetSubject(subject string) {
+	nl.subject = strings.TrimSpace(subject)
+	nl.updatedAt = value_objects.NewTimestamp()
+}
+
 // UpdateMetadata updates specific metadata fields
 func (nl *NotificationLog) UpdateMetadata(key string, value interface{}) {
 	if nl.metadata == nil {
@@ -582,9 +716,20 @@ func (nl *NotificationLog) validateChannelSpecific() error {
 			return NewInvalidRecipientError("slack channel cannot be empty")
 		}
 	case NotificationChannelWebhook:
-		// Webhook URL validation (basic)
+		// Webhook URL validation (basic), subscriptions are addressed by their ID
 		if !strings.HasPrefix(nl.recipient, "http") {
-			return NewInvalidRecipientError("webhook URL must start with http")
+			if _, err := value_objects.NewIDFromString(nl.recipient); err != nil {
+				return NewInvalidRecipientError("webhook recipient must be a URL or a webhook subscription ID")
+			}
+		}
+	case NotificationChannelTeams:
+		// Teams workflows and incoming webhooks are only reachable over https
+		if !strings.HasPrefix(nl.recipient, "https://") {
+			return NewInvalidRecipientError("teams webhook URL must start with https")
+		}
+	case NotificationChannelDiscord:
+		if !strings.HasPrefix(nl.recipient, "https://") {
+			return NewInvalidRecipientError("discord webhook URL must start with https")
 		}
 	}
 
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"encoding/json"
 	"strconv"
 	"time"
 
@@ -15,7 +16,7 @@ type NotificationLogModel struct {
 	BuildEventID uuid.UUID  `gorm:"type:uuid;not null;index:idx_notification_logs_build_event_id"`
 	ChatID       int64      `gorm:"type:bigint;not null;index:idx_notification_logs_chat_id"`
 	Message      string     `gorm:"type:text"`
-	MessageID    *int       `gorm:"type:integer"`
+	MessageID    *string    `gorm:"type:text"`
 	Status       string     `gorm:"type:varchar(20);not null;index:idx_notification_logs_status"`
 	ErrorMessage string     `gorm:"type:text"`
 	SentAt       *time.Time `gorm:"type:timestamp with time zone"`
@@ -25,6 +26,17 @@ type NotificationLogModel struct {
 	RetryCount int        `gorm:"type:integer;not null;default:0;column:retry_count;index:idx_notification_logs_retry_count"`
 	Channel    string     `gorm:"type:varchar(50);column:channel;index:idx_notification_logs_channel"`
 	TemplateID *uuid.UUID `gorm:"type:uuid;column:template_id;index:idx_notification_logs_template"`
+
+	// Additional columns from migration 010
+	MaxRetries   int             `gorm:"type:integer;not null;default:3;column:max_retries"`
+	ErrorHistory json.RawMessage `gorm:"type:jsonb;column:error_history"`
+
+	// Additional columns from migration 011
+	Recipient string `gorm:"type:text;column:recipient"`
+	Subject   string `gorm:"type:varchar(255);column:subject"`
+
+	// Additional columns from migration 018
+	DigestAt *time.Time `gorm:"type:timestamp with time zone;column:digest_at"`
 }
 
 // TableName returns the table name for the NotificationLogModel
@@ -55,17 +67,32 @@ func (nlm *NotificationLogModel) ToEntity() *NotificationLog {
 	// For now, we'll generate a default project ID
 	projectID := value_objects.NewID()
 
+	// Telegram notifications written before migration 011 only have a chat ID
+	recipient := nlm.Recipient
+	if recipient == "" {
+		recipient = strconv.FormatInt(nlm.ChatID, 10)
+	}
+
+	var errorHistory []DeliveryAttempt
+	if len(nlm.ErrorHistory) > 0 {
+		_ = json.Unmarshal(nlm.ErrorHistory, &errorHistory)
+	}
+
 	params := RestoreNotificationLogParams{
 		ID:           id,
 		BuildEventID: buildEventID,
 		ProjectID:    projectID,
 		Channel:      NotificationChannel(nlm.Channel),
-		Recipient:    strconv.FormatInt(nlm.ChatID, 10), // Convert ChatID to string as recipient
-		Message:      nlm.Message,                       // Use actual message from database
+		Recipient:    recipient,
+		Subject:      nlm.Subject,
+		Message:      nlm.Message, // Use actual message from database
 		Status:       NotificationStatus(nlm.Status),
 		ErrorMessage: nlm.ErrorMessage,
 		RetryCount:   nlm.RetryCount,
-		MessageID:    convertIntToStringPointer(nlm.MessageID),
+		MaxRetries:   nlm.MaxRetries,
+		MessageID:    nlm.MessageID,
+		ErrorHistory: errorHistory,
+		DigestAt:     nlm.DigestAt,
 		Metrics:      NewNotificationMetrics(), // Ensure metrics is always initialized
 		CreatedAt:    value_objects.NewTimestampFromTime(nlm.CreatedAt),
 		UpdatedAt:    value_objects.NewTimestampFromTime(nlm.CreatedAt), // Use CreatedAt since no UpdatedAt in DB
@@ -84,15 +111,6 @@ func (nlm *NotificationLogModel) ToEntity() *NotificationLog {
 	return RestoreNotificationLog(params)
 }
 
-// convertIntToStringPointer converts *int to *string
-func convertIntToStringPointer(i *int) *string {
-	if i == nil {
-		return nil
-	}
-	str := strconv.Itoa(*i)
-	return &str
-}
-
 // FromEntity converts domain entity to GORM model
 func (nlm *NotificationLogModel) FromEntity(entity *NotificationLog) {
 	// Parse UUID from string
@@ -103,17 +121,25 @@ func (nlm *NotificationLogModel) FromEntity(entity *NotificationLog) {
 		nlm.BuildEventID = buildEventID
 	}
 
-	// Convert recipient string back to ChatID (assuming it's a number)
+	// Telegram recipients are also kept as ChatID, other channels leave it zero
 	if chatID, err := strconv.ParseInt(entity.Recipient(), 10, 64); err == nil {
 		nlm.ChatID = chatID
 	}
+	nlm.Recipient = entity.Recipient()
+	nlm.Subject = entity.Subject()
 
 	nlm.Channel = string(entity.Channel())
 	nlm.Status = string(entity.Status())
 	nlm.Message = entity.Message()
 	nlm.ErrorMessage = entity.ErrorMessage()
 	nlm.RetryCount = entity.RetryCount()
-	nlm.MessageID = convertStringToIntPointer(entity.MessageID())
+	nlm.MaxRetries = entity.MaxRetries()
+	nlm.ErrorHistory = nil
+	if history := entity.ErrorHistory(); len(history) > 0 {
+		nlm.ErrorHistory, _ = json.Marshal(history)
+	}
+	nlm.MessageID = entity.MessageID()
+	nlm.DigestAt = entity.DigestAt()
 	nlm.CreatedAt = entity.CreatedAt().ToTime()
 
 	if entity.SentAt() != nil {
@@ -126,14 +152,3 @@ func (nlm *NotificationLogModel) FromEntity(entity *NotificationLog) {
 		nlm.TemplateID = &templateID
 	}
 }
-
-// convertStringToIntPointer converts *string to *int
-func convertStringToIntPointer(s *string) *int {
-	if s == nil {
-		return nil
-	}
-	if i, err := strconv.Atoi(*s); err == nil {
-		return &i
-	}
-	return nil
-}
@@ -2,9 +2,11 @@ package domain
 
 import (
 	"fmt"
+	"html"
 	"strings"
 	"text/template"
 
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
 )
 
@@ -16,12 +18,22 @@ const (
 	TemplateTypeBuildFailure NotificationTemplateType = "build_failure"
 	TemplateTypeBuildStarted NotificationTemplateType = "build_started"
 	TemplateTypeDeployment   NotificationTemplateType = "deployment"
+	TemplateTypePush         NotificationTemplateType = "push"
+	TemplateTypePullRequest  NotificationTemplateType = "pull_request"
+	TemplateTypeRelease      NotificationTemplateType = "release"
+
+	// Build transition templates, used for completed builds instead of the build success and failure templates
+	TemplateTypeBuildNewFailure   NotificationTemplateType = "build_new_failure"
+	TemplateTypeBuildStillFailing NotificationTemplateType = "build_still_failing"
+	TemplateTypeBuildFixed        NotificationTemplateType = "build_fixed"
 )
 
 // IsValid checks if the template type is valid
 func (t NotificationTemplateType) IsValid() bool {
 	switch t {
-	case TemplateTypeBuildSuccess, TemplateTypeBuildFailure, TemplateTypeBuildStarted, TemplateTypeDeployment:
+	case TemplateTypeBuildSuccess, TemplateTypeBuildFailure, TemplateTypeBuildStarted, TemplateTypeDeployment,
+		TemplateTypePush, TemplateTypePullRequest, TemplateTypeRelease,
+		TemplateTypeBuildNewFailure, TemplateTypeBuildStillFailing, TemplateTypeBuildFixed:
 		return true
 	default:
 		return false
@@ -33,6 +45,20 @@ func (t NotificationTemplateType) String() string {
 	return string(t)
 }
 
+// buildTransitionTemplateTypes maps build transitions to their notification templates
+var buildTransitionTemplateTypes = map[buildDomain.BuildTransition]NotificationTemplateType{
+	buildDomain.BuildTransitionNewFailure:   TemplateTypeBuildNewFailure,
+	buildDomain.BuildTransitionStillFailing: TemplateTypeBuildStillFailing,
+	buildDomain.BuildTransitionFixed:        TemplateTypeBuildFixed,
+	buildDomain.BuildTransitionSuccess:      TemplateTypeBuildSuccess,
+}
+
+// TemplateTypeForBuildTransition returns the notification template of a build transition
+func TemplateTypeForBuildTransition(transition buildDomain.BuildTransition) (NotificationTemplateType, bool) {
+	templateType, ok := buildTransitionTemplateTypes[transition]
+	return templateType, ok
+}
+
 // NotificationTemplate represents a notification template domain entity
 type NotificationTemplate struct {
 	id               value_objects.ID
@@ -48,15 +74,49 @@ type NotificationTemplate struct {
 
 // TemplateParams holds parameters for template substitution
 type TemplateParams struct {
-	ProjectName   string
-	BuildStatus   string
-	BuildBranch   string
-	BuildCommit   string
-	BuildDuration string
-	BuildURL      string
-	ErrorMessage  string
-	Timestamp     string
-	Environment   string
+	ProjectName   string `json:"project_name,omitempty"`
+	BuildStatus   string `json:"build_status,omitempty"`
+	BuildBranch   string `json:"build_branch,omitempty"`
+	BuildCommit   string `json:"build_commit,omitempty"`
+	BuildAuthor   string `json:"build_author,omitempty"`
+	BuildDuration string `json:"build_duration,omitempty"`
+	BuildURL      string `json:"build_url,omitempty"`
+	ErrorMessage  string `json:"error_message,omitempty"`
+	Timestamp     string `json:"timestamp,omitempty"`
+	Environment   string `json:"environment,omitempty"`
+
+	// EventName is the workflow or pipeline name of builds and the change request noun
+	// (e.g. "Pull Request") of change requests
+	EventName     string `json:"event_name,omitempty"`
+	EventAction   string `json:"event_action,omitempty"`
+	CommitMessage string `json:"commit_message,omitempty"`
+	Title         string `json:"title,omitempty"`
+	TargetBranch  string `json:"target_branch,omitempty"`
+	Tag           string `json:"tag,omitempty"`
+	Changelog     string `json:"changelog,omitempty"` // One line per released commit, ready to be embedded
+}
+
+// htmlEscaped returns the parameters escaped for the HTML messages of Telegram
+func (p TemplateParams) htmlEscaped() TemplateParams {
+	return TemplateParams{
+		ProjectName:   html.EscapeString(p.ProjectName),
+		BuildStatus:   html.EscapeString(p.BuildStatus),
+		BuildBranch:   html.EscapeString(p.BuildBranch),
+		BuildCommit:   html.EscapeString(p.BuildCommit),
+		BuildAuthor:   html.EscapeString(p.BuildAuthor),
+		BuildDuration: html.EscapeString(p.BuildDuration),
+		BuildURL:      html.EscapeString(p.BuildURL),
+		ErrorMessage:  html.EscapeString(p.ErrorMessage),
+		Timestamp:     html.EscapeString(p.Timestamp),
+		Environment:   html.EscapeString(p.Environment),
+		EventName:     html.EscapeString(p.EventName),
+		EventAction:   html.EscapeString(p.EventAction),
+		CommitMessage: html.EscapeString(p.CommitMessage),
+		Title:         html.EscapeString(p.Title),
+		TargetBranch:  html.EscapeString(p.TargetBranch),
+		Tag:           html.EscapeString(p.Tag),
+		Changelog:     html.EscapeString(p.Changelog),
+	}
 }
 
 // NewNotificationTemplate creates a new notification template entity
@@ -232,6 +292,11 @@ func (nt *NotificationTemplate) RenderTemplate(params TemplateParams) (subject,
 		return "", "", ErrTemplateInactive
 	}
 
+	// Telegram messages are sent as HTML, the parameters come from CI providers and commit authors
+	if nt.channel == NotificationChannelTelegram {
+		params = params.htmlEscaped()
+	}
+
 	// Render body template
 	var bodyBuffer strings.Builder
 	if err := nt.compiledTemplate.Execute(&bodyBuffer, params); err != nil {
@@ -274,17 +339,18 @@ func GetDefaultTemplates() map[NotificationTemplateType]map[NotificationChannel]
 		TemplateTypeBuildSuccess: {
 			NotificationChannelTelegram: {
 				Subject: "",
-				Body: `🎉 *Build Success*
+				Body: `🎉 <b>Build Success</b>
 
-*Project:* {{.ProjectName}}
-*Branch:* {{.BuildBranch}}
-*Commit:* {{.BuildCommit}}
-*Duration:* {{.BuildDuration}}
-*Time:* {{.Timestamp}}
+<b>Project:</b> {{.ProjectName}}
+{{if .EventName}}<b>Workflow:</b> {{.EventName}}
+{{end}}<b>Branch:</b> {{.BuildBranch}}
+<b>Commit:</b> {{.BuildCommit}}
+<b>Duration:</b> {{.BuildDuration}}
+<b>Time:</b> {{.Timestamp}}
 
 ✅ Build completed successfully!
 
-[View Build]({{.BuildURL}})`,
+<a href="{{.BuildURL}}">View Build</a>`,
 			},
 			NotificationChannelEmail: {
 				Subject: "[BUILD SUCCESS] {{.ProjectName}} - {{.BuildBranch}}",
@@ -312,9 +378,48 @@ View Build: {{.BuildURL}}`,
 
 <{{.BuildURL}}|View Build>`,
 			},
+			NotificationChannelTeams: {
+				Subject: "🎉 Build Success: {{.ProjectName}}",
+				Body:    `✅ Build completed successfully!`,
+			},
+			NotificationChannelDiscord: {
+				Subject: "🎉 Build Success: {{.ProjectName}}",
+				Body:    `✅ Build completed successfully!`,
+			},
 		},
 		TemplateTypeBuildFailure: {
 			NotificationChannelTelegram: {
+				Subject: "",
+				Body: `🚨 <b>Build Failed</b>
+
+<b>Project:</b> {{.ProjectName}}
+{{if .EventName}}<b>Workflow:</b> {{.EventName}}
+{{end}}<b>Branch:</b> {{.BuildBranch}}
+<b>Commit:</b> {{.BuildCommit}}
+<b>Duration:</b> {{.BuildDuration}}
+<b>Time:</b> {{.Timestamp}}
+
+❌ Build failed!
+{{if .ErrorMessage}}
+<b>Error:</b> {{.ErrorMessage}}
+{{end}}
+<a href="{{.BuildURL}}">View Build</a>`,
+			},
+			NotificationChannelEmail: {
+				Subject: "[BUILD FAILED] {{.ProjectName}} - {{.BuildBranch}}",
+				Body: `Build failed!
+
+Project: {{.ProjectName}}
+Branch: {{.BuildBranch}}
+Commit: {{.BuildCommit}}
+Duration: {{.BuildDuration}}
+Time: {{.Timestamp}}
+
+Error: {{.ErrorMessage}}
+
+View Build: {{.BuildURL}}`,
+			},
+			NotificationChannelSlack: {
 				Subject: "",
 				Body: `🚨 *Build Failed*
 
@@ -328,15 +433,46 @@ View Build: {{.BuildURL}}`,
 
 *Error:* {{.ErrorMessage}}
 
-[View Build]({{.BuildURL}})`,
+<{{.BuildURL}}|View Build>`,
+			},
+			NotificationChannelTeams: {
+				Subject: "🚨 Build Failed: {{.ProjectName}}",
+				Body:    `❌ Build failed!`,
+			},
+			NotificationChannelDiscord: {
+				Subject: "🚨 Build Failed: {{.ProjectName}}",
+				Body:    `❌ Build failed!`,
+			},
+		},
+		// Build transitions compare a completed build with the previous build of its branch.
+		// A successful build on a green branch uses the build success template.
+		TemplateTypeBuildNewFailure: {
+			NotificationChannelTelegram: {
+				Subject: "",
+				Body: `🚨 <b>Build Broken</b>
+
+<b>Project:</b> {{.ProjectName}}
+{{if .EventName}}<b>Workflow:</b> {{.EventName}}
+{{end}}<b>Branch:</b> {{.BuildBranch}}
+<b>Commit:</b> {{.BuildCommit}}
+<b>Author:</b> {{.BuildAuthor}}
+<b>Duration:</b> {{.BuildDuration}}
+<b>Time:</b> {{.Timestamp}}
+
+❌ The branch was green until this build failed!
+{{if .ErrorMessage}}
+<b>Error:</b> {{.ErrorMessage}}
+{{end}}
+<a href="{{.BuildURL}}">View Build</a>`,
 			},
 			NotificationChannelEmail: {
-				Subject: "[BUILD FAILED] {{.ProjectName}} - {{.BuildBranch}}",
-				Body: `Build failed!
+				Subject: "[BUILD BROKEN] {{.ProjectName}} - {{.BuildBranch}}",
+				Body: `The branch was green until this build failed!
 
 Project: {{.ProjectName}}
 Branch: {{.BuildBranch}}
 Commit: {{.BuildCommit}}
+Author: {{.BuildAuthor}}
 Duration: {{.BuildDuration}}
 Time: {{.Timestamp}}
 
@@ -346,34 +482,155 @@ View Build: {{.BuildURL}}`,
 			},
 			NotificationChannelSlack: {
 				Subject: "",
-				Body: `🚨 *Build Failed*
+				Body: `🚨 *Build Broken*
 
 *Project:* {{.ProjectName}}
 *Branch:* {{.BuildBranch}}
 *Commit:* {{.BuildCommit}}
+*Author:* {{.BuildAuthor}}
 *Duration:* {{.BuildDuration}}
 *Time:* {{.Timestamp}}
 
-❌ Build failed!
+❌ The branch was green until this build failed!
 
 *Error:* {{.ErrorMessage}}
 
 <{{.BuildURL}}|View Build>`,
 			},
+			NotificationChannelTeams: {
+				Subject: "🚨 Build Broken: {{.ProjectName}}",
+				Body:    `❌ The branch was green until this build failed!`,
+			},
+			NotificationChannelDiscord: {
+				Subject: "🚨 Build Broken: {{.ProjectName}}",
+				Body:    `❌ The branch was green until this build failed!`,
+			},
 		},
-		TemplateTypeBuildStarted: {
+		TemplateTypeBuildStillFailing: {
 			NotificationChannelTelegram: {
 				Subject: "",
-				Body: `🔄 *Build Started*
+				Body: `🔁 <b>Build Still Failing</b>
+
+<b>Project:</b> {{.ProjectName}}
+{{if .EventName}}<b>Workflow:</b> {{.EventName}}
+{{end}}<b>Branch:</b> {{.BuildBranch}}
+<b>Commit:</b> {{.BuildCommit}}
+<b>Duration:</b> {{.BuildDuration}}
+<b>Time:</b> {{.Timestamp}}
+
+❌ The branch is still red.
+{{if .ErrorMessage}}
+<b>Error:</b> {{.ErrorMessage}}
+{{end}}
+<a href="{{.BuildURL}}">View Build</a>`,
+			},
+			NotificationChannelEmail: {
+				Subject: "[STILL FAILING] {{.ProjectName}} - {{.BuildBranch}}",
+				Body: `The branch is still red.
+
+Project: {{.ProjectName}}
+Branch: {{.BuildBranch}}
+Commit: {{.BuildCommit}}
+Duration: {{.BuildDuration}}
+Time: {{.Timestamp}}
+
+Error: {{.ErrorMessage}}
+
+View Build: {{.BuildURL}}`,
+			},
+			NotificationChannelSlack: {
+				Subject: "",
+				Body: `🔁 *Build Still Failing*
+
+*Project:* {{.ProjectName}}
+*Branch:* {{.BuildBranch}}
+*Commit:* {{.BuildCommit}}
+*Duration:* {{.BuildDuration}}
+*Time:* {{.Timestamp}}
+
+❌ The branch is still red.
+
+*Error:* {{.ErrorMessage}}
+
+<{{.BuildURL}}|View Build>`,
+			},
+			NotificationChannelTeams: {
+				Subject: "🔁 Build Still Failing: {{.ProjectName}}",
+				Body:    `❌ The branch is still red.`,
+			},
+			NotificationChannelDiscord: {
+				Subject: "🔁 Build Still Failing: {{.ProjectName}}",
+				Body:    `❌ The branch is still red.`,
+			},
+		},
+		TemplateTypeBuildFixed: {
+			NotificationChannelTelegram: {
+				Subject: "",
+				Body: `🟢 <b>Build Fixed</b>
+
+<b>Project:</b> {{.ProjectName}}
+{{if .EventName}}<b>Workflow:</b> {{.EventName}}
+{{end}}<b>Branch:</b> {{.BuildBranch}}
+<b>Commit:</b> {{.BuildCommit}}
+<b>Author:</b> {{.BuildAuthor}}
+<b>Duration:</b> {{.BuildDuration}}
+<b>Time:</b> {{.Timestamp}}
+
+✅ The branch is green again!
+
+<a href="{{.BuildURL}}">View Build</a>`,
+			},
+			NotificationChannelEmail: {
+				Subject: "[BUILD FIXED] {{.ProjectName}} - {{.BuildBranch}}",
+				Body: `The branch is green again!
+
+Project: {{.ProjectName}}
+Branch: {{.BuildBranch}}
+Commit: {{.BuildCommit}}
+Author: {{.BuildAuthor}}
+Duration: {{.BuildDuration}}
+Time: {{.Timestamp}}
+
+View Build: {{.BuildURL}}`,
+			},
+			NotificationChannelSlack: {
+				Subject: "",
+				Body: `🟢 *Build Fixed*
 
 *Project:* {{.ProjectName}}
 *Branch:* {{.BuildBranch}}
 *Commit:* {{.BuildCommit}}
+*Author:* {{.BuildAuthor}}
+*Duration:* {{.BuildDuration}}
 *Time:* {{.Timestamp}}
 
+✅ The branch is green again!
+
+<{{.BuildURL}}|View Build>`,
+			},
+			NotificationChannelTeams: {
+				Subject: "🟢 Build Fixed: {{.ProjectName}}",
+				Body:    `✅ The branch is green again!`,
+			},
+			NotificationChannelDiscord: {
+				Subject: "🟢 Build Fixed: {{.ProjectName}}",
+				Body:    `✅ The branch is green again!`,
+			},
+		},
+		TemplateTypeBuildStarted: {
+			NotificationChannelTelegram: {
+				Subject: "",
+				Body: `🔄 <b>Build Started</b>
+
+<b>Project:</b> {{.ProjectName}}
+{{if .EventName}}<b>Workflow:</b> {{.EventName}}
+{{end}}<b>Branch:</b> {{.BuildBranch}}
+<b>Commit:</b> {{.BuildCommit}}
+<b>Time:</b> {{.Timestamp}}
+
 ⏳ Build is now running...
 
-[View Build]({{.BuildURL}})`,
+<a href="{{.BuildURL}}">View Build</a>`,
 			},
 			NotificationChannelSlack: {
 				Subject: "",
@@ -388,25 +645,33 @@ View Build: {{.BuildURL}}`,
 
 <{{.BuildURL}}|View Build>`,
 			},
+			NotificationChannelTeams: {
+				Subject: "🔄 Build Started: {{.ProjectName}}",
+				Body:    `⏳ Build is now running...`,
+			},
+			NotificationChannelDiscord: {
+				Subject: "🔄 Build Started: {{.ProjectName}}",
+				Body:    `⏳ Build is now running...`,
+			},
 		},
 		TemplateTypeDeployment: {
 			NotificationChannelTelegram: {
 				Subject: "",
-				Body: `🚀 *Deployment*
+				Body: `🚀 <b>Deployment</b>
 
-*Project:* {{.ProjectName}}
-*Environment:* {{.Environment}}
-*Branch:* {{.BuildBranch}}
-*Commit:* {{.BuildCommit}}
-*Time:* {{.Timestamp}}
+<b>Project:</b> {{.ProjectName}}
+<b>Environment:</b> {{.Environment}}
+<b>Branch:</b> {{.BuildBranch}}
+<b>Commit:</b> {{.BuildCommit}}
+<b>Time:</b> {{.Timestamp}}
 
-🎯 Successfully deployed to {{.Environment}}!
+{{if eq .BuildStatus "success"}}🎯 Successfully deployed to {{.Environment}}!{{else if eq .BuildStatus "failed"}}❌ Deployment to {{.Environment}} failed!{{else if eq .BuildStatus "cancelled"}}⏹️ Deployment to {{.Environment}} was cancelled{{else}}⏳ Deploying to {{.Environment}}...{{end}}
 
-[View Build]({{.BuildURL}})`,
+<a href="{{.BuildURL}}">View Build</a>`,
 			},
 			NotificationChannelEmail: {
-				Subject: "[DEPLOYMENT] {{.ProjectName}} deployed to {{.Environment}}",
-				Body: `Deployment completed successfully!
+				Subject: "[DEPLOYMENT] {{.ProjectName}} to {{.Environment}}: {{.BuildStatus}}",
+				Body: `Deployment {{.BuildStatus}}!
 
 Project: {{.ProjectName}}
 Environment: {{.Environment}}
@@ -426,10 +691,54 @@ View Build: {{.BuildURL}}`,
 *Commit:* {{.BuildCommit}}
 *Time:* {{.Timestamp}}
 
-🎯 Successfully deployed to {{.Environment}}!
+{{if eq .BuildStatus "success"}}🎯 Successfully deployed to {{.Environment}}!{{else if eq .BuildStatus "failed"}}❌ Deployment to {{.Environment}} failed!{{else if eq .BuildStatus "cancelled"}}⏹️ Deployment to {{.Environment}} was cancelled{{else}}⏳ Deploying to {{.Environment}}...{{end}}
 
 <{{.BuildURL}}|View Build>`,
 			},
+			NotificationChannelTeams: {
+				Subject: "🚀 Deployment: {{.ProjectName}} to {{.Environment}}",
+				Body:    `{{if eq .BuildStatus "success"}}🎯 Successfully deployed to {{.Environment}}!{{else if eq .BuildStatus "failed"}}❌ Deployment to {{.Environment}} failed!{{else if eq .BuildStatus "cancelled"}}⏹️ Deployment to {{.Environment}} was cancelled{{else}}⏳ Deploying to {{.Environment}}...{{end}}`,
+			},
+			NotificationChannelDiscord: {
+				Subject: "🚀 Deployment: {{.ProjectName}} to {{.Environment}}",
+				Body:    `{{if eq .BuildStatus "success"}}🎯 Successfully deployed to {{.Environment}}!{{else if eq .BuildStatus "failed"}}❌ Deployment to {{.Environment}} failed!{{else if eq .BuildStatus "cancelled"}}⏹️ Deployment to {{.Environment}} was cancelled{{else}}⏳ Deploying to {{.Environment}}...{{end}}`,
+			},
+		},
+		// Pushes, change requests and releases only have a default Telegram template.
+		// Other channels are notified about them once a template is stored for the channel.
+		TemplateTypePush: {
+			NotificationChannelTelegram: {
+				Subject: "",
+				Body: `📤 <b>Push Event</b>
+<b>Project:</b> {{.ProjectName}}
+<b>Branch:</b> {{.BuildBranch}}
+<b>Commit:</b> {{if .CommitMessage}}{{.CommitMessage}}{{else}}No message{{end}}
+<b>Author:</b> {{if .BuildAuthor}}{{.BuildAuthor}}{{else}}Unknown Author{{end}}`,
+			},
+		},
+		TemplateTypePullRequest: {
+			NotificationChannelTelegram: {
+				Subject: "",
+				Body: `📋 <b>{{.EventName}} {{.EventAction}}</b>
+<b>Project:</b> {{.ProjectName}}
+<b>Title:</b> {{.Title}}
+<b>Branch:</b> {{.BuildBranch}} → {{.TargetBranch}}
+<b>Author:</b> {{.BuildAuthor}}`,
+			},
+		},
+		TemplateTypeRelease: {
+			NotificationChannelTelegram: {
+				Subject: "",
+				Body: `🏷️ <b>Release {{.Title}}</b>
+<b>Project:</b> {{.ProjectName}}
+<b>Tag:</b> {{.Tag}}
+<b>Author:</b> {{if .BuildAuthor}}{{.BuildAuthor}}{{else}}Unknown Author{{end}}
+
+<b>Changelog:</b>{{if .Changelog}}
+{{.Changelog}}{{else}} no new commits since the previous release{{end}}{{if .BuildURL}}
+
+<b>Release notes:</b> {{.BuildURL}}{{end}}`,
+			},
 		},
 	}
 }
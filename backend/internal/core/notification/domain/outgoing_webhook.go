@@ -0,0 +1,206 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Outgoing webhook schema constants
+const (
+	// WebhookSchemaVersion is the version of the notification schema, bumped on breaking changes
+	WebhookSchemaVersion = "1"
+
+	CloudEventsSpecVersion = "1.0"
+	// CloudEventsTypePrefix namespaces notification event types in CloudEvents
+	CloudEventsTypePrefix = "dev.cicd-notifier."
+
+	WebhookContentTypeJSON        = "application/json"
+	WebhookContentTypeCloudEvents = "application/cloudevents+json"
+)
+
+// Outgoing webhook delivery headers
+const (
+	WebhookHeaderDelivery  = "X-Notifier-Delivery"
+	WebhookHeaderEvent     = "X-Notifier-Event"
+	WebhookHeaderTimestamp = "X-Notifier-Timestamp"
+	WebhookHeaderSignature = "X-Notifier-Signature"
+)
+
+// Outgoing webhook event types
+const (
+	WebhookEventBuildStarted   = "build.started"
+	WebhookEventBuildSucceeded = "build.succeeded"
+	WebhookEventBuildFailed    = "build.failed"
+	WebhookEventDeployment     = "deployment.updated"
+	// WebhookEventDigest summarizes the builds of a digest window for subscriptions with a digest mode
+	WebhookEventDigest = "notification.digest"
+)
+
+// webhookEventTypes maps notification templates to outgoing webhook event types
+var webhookEventTypes = map[NotificationTemplateType]string{
+	TemplateTypeBuildStarted: WebhookEventBuildStarted,
+	TemplateTypeBuildSuccess: WebhookEventBuildSucceeded,
+	TemplateTypeBuildFailure: WebhookEventBuildFailed,
+	TemplateTypeDeployment:   WebhookEventDeployment,
+	// Build transitions keep the event type of the build status, the transition is part of the build data
+	TemplateTypeBuildNewFailure:   WebhookEventBuildFailed,
+	TemplateTypeBuildStillFailing: WebhookEventBuildFailed,
+	TemplateTypeBuildFixed:        WebhookEventBuildSucceeded,
+}
+
+// WebhookEventTypeFor returns the outgoing webhook event type of a notification template
+func WebhookEventTypeFor(templateType NotificationTemplateType) (string, bool) {
+	eventType, ok := webhookEventTypes[templateType]
+	return eventType, ok
+}
+
+// WebhookProject identifies the project of an outgoing webhook event
+type WebhookProject struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// WebhookBuild describes the build or deployment of an outgoing webhook event
+type WebhookBuild struct {
+	ID              string `json:"id"`
+	Status          string `json:"status"`
+	Branch          string `json:"branch,omitempty"`
+	Commit          string `json:"commit,omitempty"`
+	Author          string `json:"author,omitempty"`
+	URL             string `json:"url,omitempty"`
+	Environment     string `json:"environment,omitempty"`
+	DurationSeconds *int   `json:"duration_seconds,omitempty"`
+	Error           string `json:"error,omitempty"`
+	// Transition compares a completed build with the previous build of its branch, e.g. "fixed"
+	Transition string `json:"transition,omitempty"`
+}
+
+// WebhookEventData is the data of an outgoing webhook event.
+// Build and deployment events carry the build, digest events the digest.
+type WebhookEventData struct {
+	Project WebhookProject `json:"project"`
+	Build   *WebhookBuild  `json:"build,omitempty"`
+	Digest  *Digest        `json:"digest,omitempty"`
+}
+
+// OutgoingWebhookEvent is the notification message stored for webhook subscriptions.
+// It is wrapped into the payload format of a subscription when it is delivered.
+type OutgoingWebhookEvent struct {
+	Type       string           `json:"type"`
+	Version    string           `json:"version"`
+	OccurredAt time.Time        `json:"occurred_at"`
+	Data       WebhookEventData `json:"data"`
+}
+
+// NewOutgoingWebhookEvent creates an outgoing webhook event of the current schema version
+func NewOutgoingWebhookEvent(eventType string, occurredAt time.Time, data WebhookEventData) *OutgoingWebhookEvent {
+	return &OutgoingWebhookEvent{
+		Type:       eventType,
+		Version:    WebhookSchemaVersion,
+		OccurredAt: occurredAt.UTC(),
+		Data:       data,
+	}
+}
+
+// DecodeOutgoingWebhookEvent decodes an event stored as a notification message
+func DecodeOutgoingWebhookEvent(message string) (*OutgoingWebhookEvent, error) {
+	var event OutgoingWebhookEvent
+	if err := json.Unmarshal([]byte(message), &event); err != nil {
+		return nil, fmt.Errorf("failed to decode webhook event: %w", err)
+	}
+	if event.Type == "" {
+		return nil, ErrInvalidMessage
+	}
+	return &event, nil
+}
+
+// Encode encodes the event as a notification message
+func (e *OutgoingWebhookEvent) Encode() (string, error) {
+	message, err := json.Marshal(e)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode webhook event: %w", err)
+	}
+	return string(message), nil
+}
+
+// WebhookPayload is the body of deliveries in the json format
+type WebhookPayload struct {
+	ID        string           `json:"id"`
+	Type      string           `json:"type"`
+	Version   string           `json:"version"`
+	Timestamp time.Time        `json:"timestamp"`
+	Data      WebhookEventData `json:"data"`
+}
+
+// CloudEvent is the body of deliveries in the cloudevents format (structured mode)
+type CloudEvent struct {
+	SpecVersion     string           `json:"specversion"`
+	ID              string           `json:"id"`
+	Source          string           `json:"source"`
+	Type            string           `json:"type"`
+	Subject         string           `json:"subject,omitempty"`
+	Time            time.Time        `json:"time"`
+	DataContentType string           `json:"datacontenttype"`
+	SchemaVersion   string           `json:"schemaversion"`
+	Data            WebhookEventData `json:"data"`
+}
+
+// WebhookDelivery is a single delivery of a notification to a webhook endpoint
+type WebhookDelivery struct {
+	ID          string // Stays the same across retries, so receivers can drop duplicates
+	URL         string
+	Secret      string // Optional, deliveries without a secret are sent unsigned
+	EventType   string
+	ContentType string
+	Body        []byte
+}
+
+// NewWebhookDelivery renders an event in the payload format of a subscription
+func NewWebhookDelivery(deliveryID string, subscription *WebhookSubscription, event *OutgoingWebhookEvent) (*WebhookDelivery, error) {
+	delivery := &WebhookDelivery{
+		ID:        deliveryID,
+		URL:       subscription.URL(),
+		Secret:    subscription.Secret(),
+		EventType: event.Type,
+	}
+
+	var payload interface{}
+	switch subscription.Format() {
+	case WebhookPayloadFormatCloudEvents:
+		subject := ""
+		if event.Data.Build != nil {
+			subject = event.Data.Build.ID
+		}
+
+		delivery.ContentType = WebhookContentTypeCloudEvents
+		payload = CloudEvent{
+			SpecVersion:     CloudEventsSpecVersion,
+			ID:              deliveryID,
+			Source:          "/projects/" + event.Data.Project.ID,
+			Type:            CloudEventsTypePrefix + event.Type,
+			Subject:         subject,
+			Time:            event.OccurredAt,
+			DataContentType: WebhookContentTypeJSON,
+			SchemaVersion:   event.Version,
+			Data:            event.Data,
+		}
+	default:
+		delivery.ContentType = WebhookContentTypeJSON
+		payload = WebhookPayload{
+			ID:        deliveryID,
+			Type:      event.Type,
+			Version:   event.Version,
+			Timestamp: event.OccurredAt,
+			Data:      event.Data,
+		}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+	delivery.Body = body
+
+	return delivery, nil
+}
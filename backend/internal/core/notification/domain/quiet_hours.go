@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+)
+
+// quietHoursClockLayout is the layout of the start and end of quiet hours
+const quietHoursClockLayout = "15:04"
+
+// QuietHours is a daily window in a timezone during which notifications are deferred until the window ends.
+// Build events passing the bypass filter are sent right away. The bypass filter only applies when it is
+// not empty, so by default every notification waits.
+type QuietHours struct {
+	Timezone string // IANA timezone name, e.g. "Asia/Jakarta"
+	Start    string // Local clock time the window starts, e.g. "22:00"
+	End      string // Local clock time the window ends, before Start when it spans midnight
+	Bypass   SubscriptionFilter
+}
+
+// NewQuietHours creates validated quiet hours, an empty timezone is UTC
+func NewQuietHours(timezone, start, end string, bypass SubscriptionFilter) (QuietHours, error) {
+	quietHours := QuietHours{
+		Timezone: strings.TrimSpace(timezone),
+		Start:    strings.TrimSpace(start),
+		End:      strings.TrimSpace(end),
+		Bypass:   bypass,
+	}
+	if quietHours.Timezone == "" {
+		quietHours.Timezone = "UTC"
+	}
+
+	if err := quietHours.validate(); err != nil {
+		return QuietHours{}, err
+	}
+
+	return quietHours, nil
+}
+
+// IsEmpty checks if no quiet hours are set
+func (q QuietHours) IsEmpty() bool {
+	return q.Start == "" && q.End == ""
+}
+
+// DeferUntil returns when the quiet hours containing the given time end.
+// It returns false when the time is outside quiet hours or no quiet hours are set.
+func (q QuietHours) DeferUntil(t time.Time) (time.Time, bool) {
+	if q.IsEmpty() {
+		return time.Time{}, false
+	}
+
+	location, err := time.LoadLocation(q.Timezone)
+	if err != nil {
+		return time.Time{}, false
+	}
+	start, errStart := time.Parse(quietHoursClockLayout, q.Start)
+	end, errEnd := time.Parse(quietHoursClockLayout, q.End)
+	if errStart != nil || errEnd != nil {
+		return time.Time{}, false
+	}
+
+	local := t.In(location)
+	minute := local.Hour()*60 + local.Minute()
+	startMinute := start.Hour()*60 + start.Minute()
+	endMinute := end.Hour()*60 + end.Minute()
+
+	var inWindow bool
+	if startMinute < endMinute {
+		inWindow = minute >= startMinute && minute < endMinute
+	} else {
+		// The window spans midnight, e.g. 22:00 to 07:00
+		inWindow = minute >= startMinute || minute < endMinute
+	}
+	if !inWindow {
+		return time.Time{}, false
+	}
+
+	until := time.Date(local.Year(), local.Month(), local.Day(), end.Hour(), end.Minute(), 0, 0, location)
+	if !until.After(local) {
+		until = time.Date(local.Year(), local.Month(), local.Day()+1, end.Hour(), end.Minute(), 0, 0, location)
+	}
+	return until.UTC(), true
+}
+
+// Bypasses checks if a build event is sent during quiet hours
+func (q QuietHours) Bypasses(buildEvent *buildDomain.BuildEvent) bool {
+	return !q.Bypass.IsEmpty() && q.Bypass.Matches(buildEvent)
+}
+
+// validate validates the quiet hours
+func (q QuietHours) validate() error {
+	if q.IsEmpty() {
+		return nil
+	}
+
+	if _, err := time.LoadLocation(q.Timezone); err != nil {
+		return NewInvalidQuietHoursError(fmt.Sprintf("unknown timezone: %s", q.Timezone))
+	}
+
+	for _, clock := range []string{q.Start, q.End} {
+		if _, err := time.Parse(quietHoursClockLayout, clock); err != nil {
+			return NewInvalidQuietHoursError(fmt.Sprintf("quiet hours must be given as HH:MM, got %q", clock))
+		}
+	}
+
+	if q.Start == q.End {
+		return NewInvalidQuietHoursError("quiet hours must start and end at different times")
+	}
+
+	return nil
+}
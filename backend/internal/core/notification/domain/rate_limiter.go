@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"fmt"
 	"time"
 )
 
@@ -45,6 +46,17 @@ func (rle *RateLimitEntry) Reset() {
 	rle.LastRequest = time.Now()
 }
 
+// RecipientRateLimitError reports that a provider asked to wait before sending to a recipient again,
+// e.g. when a Discord webhook bucket is exhausted
+type RecipientRateLimitError struct {
+	Channel    NotificationChannel
+	RetryAfter time.Duration
+}
+
+func (e *RecipientRateLimitError) Error() string {
+	return fmt.Sprintf("%s recipient is rate limited, retry after %s", e.Channel, e.RetryAfter)
+}
+
 // RateLimiter defines the interface for rate limiting operations
 type RateLimiter interface {
 	// Allow checks if a request is allowed for the given key and channel
@@ -99,5 +111,17 @@ func DefaultRateLimitRules() map[NotificationChannel]*RateLimitRule {
 			WindowSize:  time.Minute, // 1 minute window
 			BurstLimit:  20,          // Allow burst of 20 webhooks
 		},
+		NotificationChannelTeams: {
+			Channel:     NotificationChannelTeams,
+			MaxRequests: 30,          // Teams webhooks throttle at a few messages per second
+			WindowSize:  time.Minute, // 1 minute window
+			BurstLimit:  4,           // Allow burst of 4 messages
+		},
+		NotificationChannelDiscord: {
+			Channel:     NotificationChannelDiscord,
+			MaxRequests: 30,          // Discord allows 30 webhook messages per minute per channel
+			WindowSize:  time.Minute, // 1 minute window
+			BurstLimit:  5,           // Webhook buckets allow 5 requests at once
+		},
 	}
 }
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"errors"
 	"time"
 
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
@@ -211,6 +212,16 @@ func (rc *RetryConfiguration) ShouldRetry(attemptCount int, lastError error) boo
 	return rc.isRetryableError(lastError)
 }
 
+// IsPermanentError checks if an error will fail again on every retry
+func (rc *RetryConfiguration) IsPermanentError(err error) bool {
+	return err != nil && !rc.isRetryableError(err)
+}
+
+// IsExhausted checks if a notification has used all of its retry attempts
+func (rc *RetryConfiguration) IsExhausted(attemptCount int) bool {
+	return attemptCount > rc.maxRetryAttempts
+}
+
 // UpdateConfiguration updates the retry configuration
 func (rc *RetryConfiguration) UpdateConfiguration(
 	maxRetryAttempts int,
@@ -282,6 +293,12 @@ func (rc *RetryConfiguration) isRetryableError(err error) bool {
 		return false
 	}
 
+	// Provider errors that know whether they are transient
+	var telegramErr *TelegramAPIError
+	if errors.As(err, &telegramErr) {
+		return !telegramErr.IsPermanent()
+	}
+
 	// Check for specific error types that should not be retried
 	errorString := err.Error()
 
@@ -369,6 +386,20 @@ func GetDefaultRetryPolicies() map[NotificationChannel]RetryPolicy {
 			maxDelay:      2 * time.Minute,
 			backoffFactor: 2.0,
 		},
+		NotificationChannelTeams: {
+			channel:       NotificationChannelTeams,
+			maxAttempts:   3,
+			baseDelay:     30 * time.Second,
+			maxDelay:      5 * time.Minute,
+			backoffFactor: 2.0,
+		},
+		NotificationChannelDiscord: {
+			channel:       NotificationChannelDiscord,
+			maxAttempts:   3,
+			baseDelay:     30 * time.Second,
+			maxDelay:      5 * time.Minute,
+			backoffFactor: 2.0,
+		},
 	}
 }
 
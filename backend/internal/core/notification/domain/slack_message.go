@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+)
+
+// Slack attachment colors by build status
+const (
+	SlackColorSuccess    = "#2eb886"
+	SlackColorFailure    = "#a30200"
+	SlackColorInProgress = "#daa038"
+	SlackColorNeutral    = "#9ea3a8"
+)
+
+// Slack Block Kit limits
+const (
+	slackMaxSectionText = 3000
+	slackMaxBlocks      = 50
+)
+
+// slackStatusColors maps build statuses to attachment colors
+var slackStatusColors = map[buildDomain.BuildStatus]string{
+	buildDomain.BuildStatusSuccess:    SlackColorSuccess,
+	buildDomain.BuildStatusFailed:     SlackColorFailure,
+	buildDomain.BuildStatusInProgress: SlackColorInProgress,
+	buildDomain.BuildStatusPending:    SlackColorInProgress,
+}
+
+// SlackText is a Block Kit text object
+type SlackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// SlackBlock is a Block Kit section block
+type SlackBlock struct {
+	Type string     `json:"type"`
+	Text *SlackText `json:"text,omitempty"`
+}
+
+// SlackAttachment carries the blocks of a message next to a status color bar
+type SlackAttachment struct {
+	Color  string       `json:"color"`
+	Blocks []SlackBlock `json:"blocks"`
+}
+
+// SlackMessage is the payload posted to incoming webhooks and chat.postMessage
+type SlackMessage struct {
+	Channel     string            `json:"channel,omitempty"`
+	Text        string            `json:"text"`
+	Attachments []SlackAttachment `json:"attachments"`
+}
+
+// NewSlackMessage renders a message in Slack mrkdwn as Block Kit sections.
+// Blank lines separate sections, and the build status of the message selects the color.
+func NewSlackMessage(message *ChannelMessage) (*SlackMessage, error) {
+	text := strings.TrimSpace(strings.ReplaceAll(message.Text, "\r\n", "\n"))
+	if text == "" {
+		return nil, ErrInvalidMessage
+	}
+
+	var blocks []SlackBlock
+	for _, paragraph := range strings.Split(text, "\n\n") {
+		paragraph = strings.Trim(paragraph, "\n")
+		if strings.TrimSpace(paragraph) == "" {
+			continue
+		}
+		if len(blocks) == slackMaxBlocks {
+			break
+		}
+		blocks = append(blocks, SlackBlock{
+			Type: "section",
+			Text: &SlackText{Type: "mrkdwn", Text: truncateSlackText(paragraph)},
+		})
+	}
+
+	return &SlackMessage{
+		Text: slackFallbackText(text),
+		Attachments: []SlackAttachment{{
+			Color:  slackStatusColor(message.Status),
+			Blocks: blocks,
+		}},
+	}, nil
+}
+
+// slackStatusColor picks the attachment color of a build status
+func slackStatusColor(status buildDomain.BuildStatus) string {
+	if color, ok := slackStatusColors[status]; ok {
+		return color
+	}
+	return SlackColorNeutral
+}
+
+// slackFallbackText is the first line of a message, shown in push notifications
+func slackFallbackText(text string) string {
+	line := strings.SplitN(text, "\n", 2)[0]
+	return strings.TrimSpace(strings.ReplaceAll(line, "*", ""))
+}
+
+// truncateSlackText keeps section text within the Block Kit limit without splitting a character
+func truncateSlackText(text string) string {
+	if len(text) <= slackMaxSectionText {
+		return text
+	}
+
+	cut := slackMaxSectionText - len("…")
+	for cut > 0 && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+	return text[:cut] + "…"
+}
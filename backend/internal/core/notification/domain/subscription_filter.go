@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+)
+
+// maxSubscriptionFilterValues limits the values of each filter list
+const maxSubscriptionFilterValues = 20
+
+// SubscriptionFilter selects the build events a subscription is notified about.
+// An empty list matches every value, so the zero filter matches all events.
+type SubscriptionFilter struct {
+	EventTypes     []string
+	Statuses       []string
+	BranchPatterns []string // Glob patterns as understood by path.Match, e.g. "release/*"
+}
+
+// NewSubscriptionFilter creates a validated subscription filter.
+// Values are trimmed, event types and statuses are lower-cased and duplicates are dropped.
+func NewSubscriptionFilter(eventTypes, statuses, branchPatterns []string) (SubscriptionFilter, error) {
+	filter := SubscriptionFilter{
+		EventTypes:     normalizeFilterValues(eventTypes, true),
+		Statuses:       normalizeFilterValues(statuses, true),
+		BranchPatterns: normalizeFilterValues(branchPatterns, false),
+	}
+
+	if err := filter.validate(); err != nil {
+		return SubscriptionFilter{}, err
+	}
+
+	return filter, nil
+}
+
+// IsEmpty checks if the filter lets every event through
+func (f SubscriptionFilter) IsEmpty() bool {
+	return len(f.EventTypes) == 0 && len(f.Statuses) == 0 && len(f.BranchPatterns) == 0
+}
+
+// Matches checks if a build event passes the filter
+func (f SubscriptionFilter) Matches(buildEvent *buildDomain.BuildEvent) bool {
+	if len(f.EventTypes) > 0 && !containsFilterValue(f.EventTypes, string(buildEvent.EventType())) {
+		return false
+	}
+
+	if len(f.Statuses) > 0 && !containsFilterValue(f.Statuses, string(buildEvent.Status())) {
+		return false
+	}
+
+	if len(f.BranchPatterns) > 0 && !f.matchesBranch(buildEvent.Branch()) {
+		return false
+	}
+
+	return true
+}
+
+// matchesBranch checks if a branch matches any of the branch patterns
+func (f SubscriptionFilter) matchesBranch(branch string) bool {
+	for _, pattern := range f.BranchPatterns {
+		// Patterns are validated, a match error cannot occur
+		if matched, _ := path.Match(pattern, branch); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// validate validates the subscription filter
+func (f SubscriptionFilter) validate() error {
+	if len(f.EventTypes) > maxSubscriptionFilterValues ||
+		len(f.Statuses) > maxSubscriptionFilterValues ||
+		len(f.BranchPatterns) > maxSubscriptionFilterValues {
+		return NewInvalidSubscriptionFilterError(
+			fmt.Sprintf("a filter accepts at most %d values", maxSubscriptionFilterValues),
+		)
+	}
+
+	for _, eventType := range f.EventTypes {
+		if !buildDomain.EventType(eventType).IsValid() {
+			return NewInvalidSubscriptionFilterError(fmt.Sprintf("unknown event type: %s", eventType))
+		}
+	}
+
+	for _, status := range f.Statuses {
+		if !buildDomain.BuildStatus(status).IsValid() {
+			return NewInvalidSubscriptionFilterError(fmt.Sprintf("unknown build status: %s", status))
+		}
+	}
+
+	for _, pattern := range f.BranchPatterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return NewInvalidSubscriptionFilterError(fmt.Sprintf("invalid branch pattern: %s", pattern))
+		}
+	}
+
+	return nil
+}
+
+// normalizeFilterValues trims the values of a filter list, dropping empty and duplicate values
+func normalizeFilterValues(values []string, lowerCase bool) []string {
+	normalized := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if lowerCase {
+			value = strings.ToLower(value)
+		}
+		if value == "" || containsFilterValue(normalized, value) {
+			continue
+		}
+		normalized = append(normalized, value)
+	}
+	return normalized
+}
+
+// containsFilterValue checks if a filter list contains a value
+func containsFilterValue(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
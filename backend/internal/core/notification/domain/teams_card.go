@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"strings"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+)
+
+// Adaptive Card constants understood by Teams workflows and incoming webhooks
+const (
+	TeamsMessageType        = "message"
+	AdaptiveCardContentType = "application/vnd.microsoft.card.adaptive"
+	AdaptiveCardSchema      = "http://adaptivecards.io/schemas/adaptive-card.json"
+	AdaptiveCardVersion     = "1.4"
+)
+
+// Adaptive Card text colors by build status
+const (
+	AdaptiveColorGood      = "Good"
+	AdaptiveColorAttention = "Attention"
+	AdaptiveColorWarning   = "Warning"
+	AdaptiveColorDefault   = "Default"
+)
+
+// teamsStatusColors maps build statuses to the color of the card title
+var teamsStatusColors = map[buildDomain.BuildStatus]string{
+	buildDomain.BuildStatusSuccess:    AdaptiveColorGood,
+	buildDomain.BuildStatusFailed:     AdaptiveColorAttention,
+	buildDomain.BuildStatusInProgress: AdaptiveColorWarning,
+	buildDomain.BuildStatusPending:    AdaptiveColorWarning,
+}
+
+// AdaptiveFact is a title and value pair of a FactSet
+type AdaptiveFact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+// AdaptiveElement is a TextBlock or FactSet of an Adaptive Card body
+type AdaptiveElement struct {
+	Type   string         `json:"type"`
+	Text   string         `json:"text,omitempty"`
+	Size   string         `json:"size,omitempty"`
+	Weight string         `json:"weight,omitempty"`
+	Color  string         `json:"color,omitempty"`
+	Wrap   bool           `json:"wrap,omitempty"`
+	Facts  []AdaptiveFact `json:"facts,omitempty"`
+}
+
+// AdaptiveAction is an Action.OpenUrl button of an Adaptive Card
+type AdaptiveAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// AdaptiveCardTeams holds the Teams specific card options
+type AdaptiveCardTeams struct {
+	Width string `json:"width"`
+}
+
+// AdaptiveCard is the card posted to Teams
+type AdaptiveCard struct {
+	Schema  string             `json:"$schema"`
+	Type    string             `json:"type"`
+	Version string             `json:"version"`
+	Body    []AdaptiveElement  `json:"body"`
+	Actions []AdaptiveAction   `json:"actions,omitempty"`
+	MSTeams *AdaptiveCardTeams `json:"msteams,omitempty"`
+}
+
+// TeamsAttachment wraps an Adaptive Card in a Teams message
+type TeamsAttachment struct {
+	ContentType string       `json:"contentType"`
+	ContentURL  *string      `json:"contentUrl"`
+	Content     AdaptiveCard `json:"content"`
+}
+
+// TeamsMessage is the payload posted to Teams workflow and incoming webhook URLs
+type TeamsMessage struct {
+	Type        string            `json:"type"`
+	Attachments []TeamsAttachment `json:"attachments"`
+}
+
+// NewTeamsMessage renders a notification as an Adaptive Card.
+// The subject becomes the card title, colored by the build status, and blank lines separate
+// the text into blocks. The template parameters become a fact set and the build URL a button.
+func NewTeamsMessage(subject string, message *ChannelMessage) (*TeamsMessage, error) {
+	subject = strings.TrimSpace(subject)
+	text := strings.TrimSpace(strings.ReplaceAll(message.Text, "\r\n", "\n"))
+	if subject == "" && text == "" {
+		return nil, ErrInvalidMessage
+	}
+
+	card := AdaptiveCard{
+		Schema:  AdaptiveCardSchema,
+		Type:    "AdaptiveCard",
+		Version: AdaptiveCardVersion,
+		MSTeams: &AdaptiveCardTeams{Width: "Full"},
+	}
+
+	if subject != "" {
+		card.Body = append(card.Body, AdaptiveElement{
+			Type:   "TextBlock",
+			Text:   subject,
+			Size:   "Large",
+			Weight: "Bolder",
+			Color:  teamsStatusColor(message.Status),
+			Wrap:   true,
+		})
+	}
+
+	for _, paragraph := range strings.Split(text, "\n\n") {
+		if paragraph = strings.TrimSpace(paragraph); paragraph != "" {
+			card.Body = append(card.Body, AdaptiveElement{Type: "TextBlock", Text: paragraph, Wrap: true})
+		}
+	}
+
+	if messageFacts := message.facts(); len(messageFacts) > 0 {
+		facts := make([]AdaptiveFact, 0, len(messageFacts))
+		for _, fact := range messageFacts {
+			facts = append(facts, AdaptiveFact{Title: fact.title, Value: fact.value})
+		}
+		card.Body = append(card.Body, AdaptiveElement{Type: "FactSet", Facts: facts})
+	}
+
+	if buildURL, ok := message.buildURL(); ok {
+		card.Actions = append(card.Actions, AdaptiveAction{Type: "Action.OpenUrl", Title: "View Build", URL: buildURL})
+	}
+
+	return &TeamsMessage{
+		Type: TeamsMessageType,
+		Attachments: []TeamsAttachment{{
+			ContentType: AdaptiveCardContentType,
+			Content:     card,
+		}},
+	}, nil
+}
+
+// teamsStatusColor picks the title color of a build status
+func teamsStatusColor(status buildDomain.BuildStatus) string {
+	if color, ok := teamsStatusColors[status]; ok {
+		return color
+	}
+	return AdaptiveColorDefault
+}
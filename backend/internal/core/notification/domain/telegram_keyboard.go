@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+)
+
+// Telegram inline keyboard actions of build notifications
+const (
+	TelegramActionMute        = "mute"
+	TelegramActionAcknowledge = "ack"
+	TelegramActionFailingJobs = "jobs"
+)
+
+// Telegram inline keyboard button labels
+const (
+	TelegramButtonViewBuild   = "🔗 View build"
+	TelegramButtonMute        = "🔕 Mute project 1h"
+	TelegramButtonAcknowledge = "👀 Acknowledge"
+	TelegramButtonFailingJobs = "📋 Show failing jobs"
+)
+
+// TelegramMuteDuration is how long the mute button silences a project in a chat
+const TelegramMuteDuration = time.Hour
+
+// telegramCallbackSeparator separates the action from the build event ID in callback data.
+// Telegram limits callback data to 64 bytes, an action and a UUID stay well below it.
+const telegramCallbackSeparator = ":"
+
+// ErrInvalidTelegramCallback is returned for callback data the notifier did not create
+var ErrInvalidTelegramCallback = errors.New("invalid telegram callback data")
+
+// TelegramInlineKeyboardButton is a button of an inline keyboard. Exactly one of URL and
+// CallbackData is set: URL buttons open a link, callback buttons send a callback query to the bot.
+type TelegramInlineKeyboardButton struct {
+	Text         string `json:"text"`
+	URL          string `json:"url,omitempty"`
+	CallbackData string `json:"callback_data,omitempty"`
+}
+
+// TelegramInlineKeyboard is the reply markup of a message with inline buttons
+type TelegramInlineKeyboard struct {
+	InlineKeyboard [][]TelegramInlineKeyboardButton `json:"inline_keyboard"`
+}
+
+// NewTelegramBuildKeyboard creates the action buttons of a build notification.
+// The view button is left out when the build has no URL.
+func NewTelegramBuildKeyboard(buildEventID value_objects.ID, buildURL string) *TelegramInlineKeyboard {
+	var firstRow []TelegramInlineKeyboardButton
+	if buildURL != "" {
+		firstRow = append(firstRow, TelegramInlineKeyboardButton{Text: TelegramButtonViewBuild, URL: buildURL})
+	}
+	firstRow = append(firstRow, TelegramInlineKeyboardButton{
+		Text:         TelegramButtonFailingJobs,
+		CallbackData: NewTelegramCallbackData(TelegramActionFailingJobs, buildEventID),
+	})
+
+	return &TelegramInlineKeyboard{
+		InlineKeyboard: [][]TelegramInlineKeyboardButton{
+			firstRow,
+			{
+				{Text: TelegramButtonAcknowledge, CallbackData: NewTelegramCallbackData(TelegramActionAcknowledge, buildEventID)},
+				{Text: TelegramButtonMute, CallbackData: NewTelegramCallbackData(TelegramActionMute, buildEventID)},
+			},
+		},
+	}
+}
+
+// NewTelegramEscalationKeyboard creates the buttons of an escalation, which can only be acknowledged.
+// The view button is left out when the build has no URL.
+func NewTelegramEscalationKeyboard(buildEventID value_objects.ID, buildURL string) *TelegramInlineKeyboard {
+	var row []TelegramInlineKeyboardButton
+	if buildURL != "" {
+		row = append(row, TelegramInlineKeyboardButton{Text: TelegramButtonViewBuild, URL: buildURL})
+	}
+	row = append(row, TelegramInlineKeyboardButton{
+		Text:         TelegramButtonAcknowledge,
+		CallbackData: NewTelegramCallbackData(TelegramActionAcknowledge, buildEventID),
+	})
+
+	return &TelegramInlineKeyboard{InlineKeyboard: [][]TelegramInlineKeyboardButton{row}}
+}
+
+// NewTelegramCallbackData encodes the callback data of an action on a build event
+func NewTelegramCallbackData(action string, buildEventID value_objects.ID) string {
+	return action + telegramCallbackSeparator + buildEventID.String()
+}
+
+// ParseTelegramCallbackData decodes callback data created by NewTelegramCallbackData
+func ParseTelegramCallbackData(data string) (action string, buildEventID value_objects.ID, err error) {
+	action, rawID, found := strings.Cut(data, telegramCallbackSeparator)
+	if !found {
+		return "", value_objects.ID{}, ErrInvalidTelegramCallback
+	}
+
+	switch action {
+	case TelegramActionMute, TelegramActionAcknowledge, TelegramActionFailingJobs:
+	default:
+		return "", value_objects.ID{}, ErrInvalidTelegramCallback
+	}
+
+	buildEventID, err = value_objects.NewIDFromString(rawID)
+	if err != nil || buildEventID.IsNil() {
+		return "", value_objects.ID{}, ErrInvalidTelegramCallback
+	}
+
+	return action, buildEventID, nil
+}
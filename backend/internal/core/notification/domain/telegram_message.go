@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// TelegramAPIError is an error answered by the Telegram Bot API
+type TelegramAPIError struct {
+	Method      string // Bot API method, e.g. sendMessage
+	ErrorCode   int
+	Description string        // e.g. "Bad Request: chat not found"
+	RetryAfter  time.Duration // Set when the bot is flood limited
+}
+
+func (e *TelegramAPIError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("telegram %s failed (%d): %s, retry after %s", e.Method, e.ErrorCode, e.Description, e.RetryAfter)
+	}
+	return fmt.Sprintf("telegram %s failed (%d): %s", e.Method, e.ErrorCode, e.Description)
+}
+
+// IsPermanent reports whether sending the same request again will fail the same way,
+// e.g. when the chat does not exist or the bot was blocked. Flood limits and server errors are transient.
+func (e *TelegramAPIError) IsPermanent() bool {
+	switch {
+	case e.ErrorCode == http.StatusTooManyRequests, e.ErrorCode >= http.StatusInternalServerError:
+		return false
+	default:
+		return e.ErrorCode >= http.StatusBadRequest
+	}
+}
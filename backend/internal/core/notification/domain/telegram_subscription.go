@@ -3,35 +3,45 @@ package domain
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
 )
 
 // TelegramSubscription represents a Telegram subscription domain entity
 type TelegramSubscription struct {
-	id         value_objects.ID
-	projectID  value_objects.ID
-	chatID     int64
-	userID     *int64
-	username   string
-	eventTypes []string
-	isActive   bool
-	createdAt  value_objects.Timestamp
-	updatedAt  value_objects.Timestamp
+	id              value_objects.ID
+	projectID       value_objects.ID
+	chatID          int64
+	userID          *int64
+	username        string
+	eventTypes      []string
+	statuses        []string
+	branchPatterns  []string
+	isActive        bool
+	mutedUntil      *time.Time
+	digestMode      DigestMode
+	quietHours      QuietHours
+	transitionsOnly bool
+	createdAt       value_objects.Timestamp
+	updatedAt       value_objects.Timestamp
 }
 
 // NewTelegramSubscription creates a new telegram subscription entity
 func NewTelegramSubscription(projectID value_objects.ID, chatID int64) (*TelegramSubscription, error) {
 	subscription := &TelegramSubscription{
-		id:         value_objects.NewID(),
-		projectID:  projectID,
-		chatID:     chatID,
-		userID:     nil,
-		username:   "",
-		eventTypes: []string{},
-		isActive:   true,
-		createdAt:  value_objects.NewTimestamp(),
-		updatedAt:  value_objects.NewTimestamp(),
+		id:             value_objects.NewID(),
+		projectID:      projectID,
+		chatID:         chatID,
+		userID:         nil,
+		username:       "",
+		eventTypes:     []string{},
+		statuses:       []string{},
+		branchPatterns: []string{},
+		isActive:       true,
+		digestMode:     DigestModeInstant,
+		createdAt:      value_objects.NewTimestamp(),
+		updatedAt:      value_objects.NewTimestamp(),
 	}
 
 	if err := subscription.validate(); err != nil {
@@ -44,29 +54,41 @@ func NewTelegramSubscription(projectID value_objects.ID, chatID int64) (*Telegra
 // RestoreTelegramSubscription restores a telegram subscription from persistence
 func RestoreTelegramSubscription(params RestoreTelegramSubscriptionParams) *TelegramSubscription {
 	return &TelegramSubscription{
-		id:         params.ID,
-		projectID:  params.ProjectID,
-		chatID:     params.ChatID,
-		userID:     params.UserID,
-		username:   params.Username,
-		eventTypes: params.EventTypes,
-		isActive:   params.IsActive,
-		createdAt:  params.CreatedAt,
-		updatedAt:  params.UpdatedAt,
+		id:              params.ID,
+		projectID:       params.ProjectID,
+		chatID:          params.ChatID,
+		userID:          params.UserID,
+		username:        params.Username,
+		eventTypes:      params.EventTypes,
+		statuses:        params.Statuses,
+		branchPatterns:  params.BranchPatterns,
+		isActive:        params.IsActive,
+		mutedUntil:      params.MutedUntil,
+		digestMode:      params.DigestMode,
+		quietHours:      params.QuietHours,
+		transitionsOnly: params.TransitionsOnly,
+		createdAt:       params.CreatedAt,
+		updatedAt:       params.UpdatedAt,
 	}
 }
 
 // RestoreTelegramSubscriptionParams holds parameters for restoring a telegram subscription
 type RestoreTelegramSubscriptionParams struct {
-	ID         value_objects.ID
-	ProjectID  value_objects.ID
-	ChatID     int64
-	UserID     *int64
-	Username   string
-	EventTypes []string
-	IsActive   bool
-	CreatedAt  value_objects.Timestamp
-	UpdatedAt  value_objects.Timestamp
+	ID              value_objects.ID
+	ProjectID       value_objects.ID
+	ChatID          int64
+	UserID          *int64
+	Username        string
+	EventTypes      []string
+	Statuses        []string
+	BranchPatterns  []string
+	IsActive        bool
+	MutedUntil      *time.Time
+	DigestMode      DigestMode
+	QuietHours      QuietHours
+	TransitionsOnly bool
+	CreatedAt       value_objects.Timestamp
+	UpdatedAt       value_objects.Timestamp
 }
 
 // ID returns the subscription ID
@@ -99,11 +121,58 @@ func (ts *TelegramSubscription) EventTypes() []string {
 	return ts.eventTypes
 }
 
+// Statuses returns the build statuses the subscription is notified about
+func (ts *TelegramSubscription) Statuses() []string {
+	return ts.statuses
+}
+
+// BranchPatterns returns the branch patterns the subscription is notified about
+func (ts *TelegramSubscription) BranchPatterns() []string {
+	return ts.branchPatterns
+}
+
+// Filter returns the filter selecting the build events the subscription is notified about
+func (ts *TelegramSubscription) Filter() SubscriptionFilter {
+	return SubscriptionFilter{
+		EventTypes:     ts.eventTypes,
+		Statuses:       ts.statuses,
+		BranchPatterns: ts.branchPatterns,
+	}
+}
+
 // IsActive returns whether the subscription is active
 func (ts *TelegramSubscription) IsActive() bool {
 	return ts.isActive
 }
 
+// MutedUntil returns when a mute of the subscription ends, nil if it was never muted
+func (ts *TelegramSubscription) MutedUntil() *time.Time {
+	return ts.mutedUntil
+}
+
+// IsMuted returns whether notifications to the chat are silenced at the given time
+func (ts *TelegramSubscription) IsMuted(now time.Time) bool {
+	return ts.mutedUntil != nil && now.Before(*ts.mutedUntil)
+}
+
+// DigestMode returns whether the chat is notified instantly or by an hourly or daily digest
+func (ts *TelegramSubscription) DigestMode() DigestMode {
+	if ts.digestMode == "" {
+		return DigestModeInstant
+	}
+	return ts.digestMode
+}
+
+// QuietHours returns the daily window during which notifications to the chat are deferred
+func (ts *TelegramSubscription) QuietHours() QuietHours {
+	return ts.quietHours
+}
+
+// TransitionsOnly returns whether the chat is only notified when a build breaks or fixes a branch
+func (ts *TelegramSubscription) TransitionsOnly() bool {
+	return ts.transitionsOnly
+}
+
 // CreatedAt returns the creation timestamp
 func (ts *TelegramSubscription) CreatedAt() value_objects.Timestamp {
 	return ts.createdAt
@@ -130,6 +199,45 @@ func (ts *TelegramSubscription) Deactivate() {
 	}
 }
 
+// MuteUntil silences notifications to the chat until the given time
+func (ts *TelegramSubscription) MuteUntil(until time.Time) {
+	until = until.UTC()
+	ts.mutedUntil = &until
+	ts.updatedAt = value_objects.NewTimestamp()
+}
+
+// UpdateFilter replaces the filter of the subscription, an empty filter notifies about all events
+func (ts *TelegramSubscription) UpdateFilter(filter SubscriptionFilter) {
+	ts.eventTypes = filter.EventTypes
+	ts.statuses = filter.Statuses
+	ts.branchPatterns = filter.BranchPatterns
+	ts.updatedAt = value_objects.NewTimestamp()
+}
+
+// UpdateDigestMode changes how notifications are delivered to the chat
+func (ts *TelegramSubscription) UpdateDigestMode(mode DigestMode) error {
+	if !mode.IsValid() {
+		return NewInvalidDigestModeError(fmt.Sprintf("unknown digest mode: %s", mode))
+	}
+
+	ts.digestMode = mode
+	ts.updatedAt = value_objects.NewTimestamp()
+	return nil
+}
+
+// UpdateQuietHours replaces the quiet hours of the chat, empty quiet hours send every notification right away
+func (ts *TelegramSubscription) UpdateQuietHours(quietHours QuietHours) {
+	ts.quietHours = quietHours
+	ts.updatedAt = value_objects.NewTimestamp()
+}
+
+// UpdateTransitionsOnly switches between notifying the chat about every event
+// and only about builds breaking or fixing a branch
+func (ts *TelegramSubscription) UpdateTransitionsOnly(transitionsOnly bool) {
+	ts.transitionsOnly = transitionsOnly
+	ts.updatedAt = value_objects.NewTimestamp()
+}
+
 // UpdateChatID updates the chat ID (useful for chat migrations)
 func (ts *TelegramSubscription) UpdateChatID(newChatID int64) error {
 	if newChatID == 0 {
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
@@ -10,15 +11,26 @@ import (
 
 // TelegramSubscriptionModel represents the GORM model for telegram subscriptions
 type TelegramSubscriptionModel struct {
-	ID         uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
-	ProjectID  uuid.UUID `gorm:"type:uuid;not null;index:idx_telegram_subscriptions_project_id"`
-	ChatID     int64     `gorm:"type:bigint;not null;index:idx_telegram_subscriptions_chat_id"`
-	UserID     *int64    `gorm:"type:bigint"`
-	Username   string    `gorm:"type:varchar(255)"`
-	EventTypes []string  `gorm:"type:text[];column:event_types"`
-	IsActive   bool      `gorm:"type:boolean;not null;default:true;index:idx_telegram_subscriptions_is_active"`
-	CreatedAt  time.Time `gorm:"type:timestamp with time zone;not null;default:now()"`
-	UpdatedAt  time.Time `gorm:"type:timestamp with time zone;not null;default:now()"`
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	ProjectID uuid.UUID `gorm:"type:uuid;not null;index:idx_telegram_subscriptions_project_id"`
+	ChatID    int64     `gorm:"type:bigint;not null;index:idx_telegram_subscriptions_chat_id"`
+	UserID    *int64    `gorm:"type:bigint"`
+	Username  string    `gorm:"type:varchar(255)"`
+	// Filter lists are stored as JSON arrays, an empty array matches every value
+	EventTypes     json.RawMessage `gorm:"type:jsonb;column:event_types;not null;default:'[]'"`
+	Statuses       json.RawMessage `gorm:"type:jsonb;column:statuses;not null;default:'[]'"`
+	BranchPatterns json.RawMessage `gorm:"type:jsonb;column:branch_patterns;not null;default:'[]'"`
+	IsActive       bool            `gorm:"type:boolean;not null;default:true;index:idx_telegram_subscriptions_is_active"`
+	MutedUntil     *time.Time      `gorm:"type:timestamp with time zone;column:muted_until"`
+	DigestMode     string          `gorm:"type:varchar(10);column:digest_mode;not null;default:'instant'"`
+	// Quiet hours are unset while start and end are empty
+	QuietHoursTimezone string          `gorm:"type:varchar(64);column:quiet_hours_timezone;not null;default:'UTC'"`
+	QuietHoursStart    string          `gorm:"type:varchar(5);column:quiet_hours_start;not null;default:''"`
+	QuietHoursEnd      string          `gorm:"type:varchar(5);column:quiet_hours_end;not null;default:''"`
+	QuietHoursBypass   json.RawMessage `gorm:"type:jsonb;column:quiet_hours_bypass;not null;default:'{}'"`
+	TransitionsOnly    bool            `gorm:"type:boolean;column:transitions_only;not null;default:false"`
+	CreatedAt          time.Time       `gorm:"type:timestamp with time zone;not null;default:now()"`
+	UpdatedAt          time.Time       `gorm:"type:timestamp with time zone;not null;default:now()"`
 }
 
 // TableName returns the table name for the TelegramSubscriptionModel
@@ -50,15 +62,21 @@ func (tsm *TelegramSubscriptionModel) ToEntity() *TelegramSubscription {
 	projectID, _ := value_objects.NewIDFromString(tsm.ProjectID.String())
 
 	params := RestoreTelegramSubscriptionParams{
-		ID:         id,
-		ProjectID:  projectID,
-		ChatID:     tsm.ChatID,
-		UserID:     tsm.UserID,
-		Username:   tsm.Username,
-		EventTypes: tsm.EventTypes,
-		IsActive:   tsm.IsActive,
-		CreatedAt:  value_objects.NewTimestampFromTime(tsm.CreatedAt),
-		UpdatedAt:  value_objects.NewTimestampFromTime(tsm.UpdatedAt),
+		ID:              id,
+		ProjectID:       projectID,
+		ChatID:          tsm.ChatID,
+		UserID:          tsm.UserID,
+		Username:        tsm.Username,
+		EventTypes:      UnmarshalFilterValues(tsm.EventTypes),
+		Statuses:        UnmarshalFilterValues(tsm.Statuses),
+		BranchPatterns:  UnmarshalFilterValues(tsm.BranchPatterns),
+		IsActive:        tsm.IsActive,
+		MutedUntil:      tsm.MutedUntil,
+		DigestMode:      DigestMode(tsm.DigestMode),
+		QuietHours:      RestoreQuietHours(tsm.QuietHoursTimezone, tsm.QuietHoursStart, tsm.QuietHoursEnd, tsm.QuietHoursBypass),
+		TransitionsOnly: tsm.TransitionsOnly,
+		CreatedAt:       value_objects.NewTimestampFromTime(tsm.CreatedAt),
+		UpdatedAt:       value_objects.NewTimestampFromTime(tsm.UpdatedAt),
 	}
 
 	return RestoreTelegramSubscription(params)
@@ -77,8 +95,77 @@ func (tsm *TelegramSubscriptionModel) FromEntity(entity *TelegramSubscription) {
 	tsm.ChatID = entity.ChatID()
 	tsm.UserID = entity.UserID()
 	tsm.Username = entity.Username()
-	tsm.EventTypes = entity.EventTypes()
+	tsm.EventTypes = MarshalFilterValues(entity.EventTypes())
+	tsm.Statuses = MarshalFilterValues(entity.Statuses())
+	tsm.BranchPatterns = MarshalFilterValues(entity.BranchPatterns())
 	tsm.IsActive = entity.IsActive()
+	tsm.MutedUntil = entity.MutedUntil()
+	tsm.DigestMode = string(entity.DigestMode())
+	tsm.QuietHoursTimezone, tsm.QuietHoursStart, tsm.QuietHoursEnd, tsm.QuietHoursBypass = MarshalQuietHours(entity.QuietHours())
+	tsm.TransitionsOnly = entity.TransitionsOnly()
 	tsm.CreatedAt = entity.CreatedAt().ToTime()
 	tsm.UpdatedAt = entity.UpdatedAt().ToTime()
 }
+
+// MarshalFilterValues encodes a filter list as a JSON array for a jsonb column
+func MarshalFilterValues(values []string) json.RawMessage {
+	if len(values) == 0 {
+		return json.RawMessage("[]")
+	}
+	data, _ := json.Marshal(values)
+	return data
+}
+
+// UnmarshalFilterValues decodes a filter list from a jsonb column
+func UnmarshalFilterValues(data json.RawMessage) []string {
+	values := []string{}
+	if len(data) > 0 {
+		_ = json.Unmarshal(data, &values)
+	}
+	return values
+}
+
+// quietHoursBypass is the JSON form of the bypass filter of quiet hours
+type quietHoursBypass struct {
+	EventTypes []string `json:"event_types,omitempty"`
+	Statuses   []string `json:"statuses,omitempty"`
+	Branches   []string `json:"branches,omitempty"`
+}
+
+// MarshalQuietHours encodes quiet hours as their timezone, start, end and jsonb bypass columns
+func MarshalQuietHours(quietHours QuietHours) (string, string, string, json.RawMessage) {
+	timezone := quietHours.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	bypass, _ := json.Marshal(quietHoursBypass{
+		EventTypes: quietHours.Bypass.EventTypes,
+		Statuses:   quietHours.Bypass.Statuses,
+		Branches:   quietHours.Bypass.BranchPatterns,
+	})
+	return timezone, quietHours.Start, quietHours.End, bypass
+}
+
+// RestoreQuietHours decodes quiet hours from their columns
+func RestoreQuietHours(timezone, start, end string, bypass json.RawMessage) QuietHours {
+	if start == "" && end == "" {
+		return QuietHours{}
+	}
+
+	var filter quietHoursBypass
+	if len(bypass) > 0 {
+		_ = json.Unmarshal(bypass, &filter)
+	}
+
+	return QuietHours{
+		Timezone: timezone,
+		Start:    start,
+		End:      end,
+		Bypass: SubscriptionFilter{
+			EventTypes:     filter.EventTypes,
+			Statuses:       filter.Statuses,
+			BranchPatterns: filter.Branches,
+		},
+	}
+}
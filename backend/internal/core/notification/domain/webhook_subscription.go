@@ -0,0 +1,253 @@
+package domain
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+)
+
+// WebhookPayloadFormat represents the body format of outgoing webhook deliveries
+type WebhookPayloadFormat string
+
+const (
+	// WebhookPayloadFormatJSON sends the documented notification schema as plain JSON
+	WebhookPayloadFormatJSON WebhookPayloadFormat = "json"
+	// WebhookPayloadFormatCloudEvents sends a CloudEvents 1.0 event in structured mode
+	WebhookPayloadFormatCloudEvents WebhookPayloadFormat = "cloudevents"
+)
+
+// IsValid checks if the payload format is valid
+func (f WebhookPayloadFormat) IsValid() bool {
+	switch f {
+	case WebhookPayloadFormatJSON, WebhookPayloadFormatCloudEvents:
+		return true
+	default:
+		return false
+	}
+}
+
+// minWebhookSecretLength keeps subscription secrets long enough for HMAC signing
+const minWebhookSecretLength = 16
+
+// WebhookSubscription represents an endpoint receiving signed notifications of a project
+type WebhookSubscription struct {
+	id         value_objects.ID
+	projectID  value_objects.ID
+	url        string
+	secret     string
+	format     WebhookPayloadFormat
+	digestMode DigestMode
+	quietHours QuietHours
+	isActive   bool
+	createdAt  value_objects.Timestamp
+	updatedAt  value_objects.Timestamp
+}
+
+// NewWebhookSubscription creates a new webhook subscription entity
+func NewWebhookSubscription(projectID value_objects.ID, endpointURL, secret string, format WebhookPayloadFormat) (*WebhookSubscription, error) {
+	if format == "" {
+		format = WebhookPayloadFormatJSON
+	}
+
+	subscription := &WebhookSubscription{
+		id:         value_objects.NewID(),
+		projectID:  projectID,
+		url:        strings.TrimSpace(endpointURL),
+		secret:     secret,
+		format:     format,
+		digestMode: DigestModeInstant,
+		isActive:   true,
+		createdAt:  value_objects.NewTimestamp(),
+		updatedAt:  value_objects.NewTimestamp(),
+	}
+
+	if err := subscription.validate(); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+// RestoreWebhookSubscriptionParams holds parameters for restoring a webhook subscription
+type RestoreWebhookSubscriptionParams struct {
+	ID         value_objects.ID
+	ProjectID  value_objects.ID
+	URL        string
+	Secret     string
+	Format     WebhookPayloadFormat
+	DigestMode DigestMode
+	QuietHours QuietHours
+	IsActive   bool
+	CreatedAt  value_objects.Timestamp
+	UpdatedAt  value_objects.Timestamp
+}
+
+// RestoreWebhookSubscription restores a webhook subscription from persistence
+func RestoreWebhookSubscription(params RestoreWebhookSubscriptionParams) *WebhookSubscription {
+	return &WebhookSubscription{
+		id:         params.ID,
+		projectID:  params.ProjectID,
+		url:        params.URL,
+		secret:     params.Secret,
+		format:     params.Format,
+		digestMode: params.DigestMode,
+		quietHours: params.QuietHours,
+		isActive:   params.IsActive,
+		createdAt:  params.CreatedAt,
+		updatedAt:  params.UpdatedAt,
+	}
+}
+
+// ID returns the subscription ID
+func (ws *WebhookSubscription) ID() value_objects.ID {
+	return ws.id
+}
+
+// ProjectID returns the project ID
+func (ws *WebhookSubscription) ProjectID() value_objects.ID {
+	return ws.projectID
+}
+
+// URL returns the endpoint deliveries are posted to
+func (ws *WebhookSubscription) URL() string {
+	return ws.url
+}
+
+// Secret returns the secret deliveries are signed with
+func (ws *WebhookSubscription) Secret() string {
+	return ws.secret
+}
+
+// Format returns the payload format of deliveries
+func (ws *WebhookSubscription) Format() WebhookPayloadFormat {
+	return ws.format
+}
+
+// DigestMode returns whether events are delivered instantly or by an hourly or daily digest
+func (ws *WebhookSubscription) DigestMode() DigestMode {
+	if ws.digestMode == "" {
+		return DigestModeInstant
+	}
+	return ws.digestMode
+}
+
+// QuietHours returns the daily window during which deliveries to the endpoint are deferred
+func (ws *WebhookSubscription) QuietHours() QuietHours {
+	return ws.quietHours
+}
+
+// IsActive returns whether the subscription is active
+func (ws *WebhookSubscription) IsActive() bool {
+	return ws.isActive
+}
+
+// CreatedAt returns the creation timestamp
+func (ws *WebhookSubscription) CreatedAt() value_objects.Timestamp {
+	return ws.createdAt
+}
+
+// UpdatedAt returns the last update timestamp
+func (ws *WebhookSubscription) UpdatedAt() value_objects.Timestamp {
+	return ws.updatedAt
+}
+
+// UpdateURL changes the endpoint deliveries are posted to
+func (ws *WebhookSubscription) UpdateURL(endpointURL string) error {
+	endpointURL = strings.TrimSpace(endpointURL)
+	if err := validateWebhookEndpoint(endpointURL); err != nil {
+		return err
+	}
+
+	ws.url = endpointURL
+	ws.updatedAt = value_objects.NewTimestamp()
+	return nil
+}
+
+// UpdateFormat changes the payload format of deliveries
+func (ws *WebhookSubscription) UpdateFormat(format WebhookPayloadFormat) error {
+	if !format.IsValid() {
+		return NewInvalidWebhookSubscriptionError(fmt.Sprintf("unsupported payload format: %s", format))
+	}
+
+	ws.format = format
+	ws.updatedAt = value_objects.NewTimestamp()
+	return nil
+}
+
+// UpdateDigestMode changes how events are delivered to the endpoint
+func (ws *WebhookSubscription) UpdateDigestMode(mode DigestMode) error {
+	if !mode.IsValid() {
+		return NewInvalidDigestModeError(fmt.Sprintf("unknown digest mode: %s", mode))
+	}
+
+	ws.digestMode = mode
+	ws.updatedAt = value_objects.NewTimestamp()
+	return nil
+}
+
+// UpdateQuietHours replaces the quiet hours of the endpoint, empty quiet hours deliver every event right away
+func (ws *WebhookSubscription) UpdateQuietHours(quietHours QuietHours) {
+	ws.quietHours = quietHours
+	ws.updatedAt = value_objects.NewTimestamp()
+}
+
+// RotateSecret replaces the signing secret, deliveries sent afterwards use the new one
+func (ws *WebhookSubscription) RotateSecret(secret string) error {
+	if len(secret) < minWebhookSecretLength {
+		return NewInvalidWebhookSubscriptionError(
+			fmt.Sprintf("secret must be at least %d characters", minWebhookSecretLength))
+	}
+
+	ws.secret = secret
+	ws.updatedAt = value_objects.NewTimestamp()
+	return nil
+}
+
+// Activate activates the subscription
+func (ws *WebhookSubscription) Activate() {
+	if !ws.isActive {
+		ws.isActive = true
+		ws.updatedAt = value_objects.NewTimestamp()
+	}
+}
+
+// Deactivate deactivates the subscription
+func (ws *WebhookSubscription) Deactivate() {
+	if ws.isActive {
+		ws.isActive = false
+		ws.updatedAt = value_objects.NewTimestamp()
+	}
+}
+
+// validate validates the webhook subscription entity
+func (ws *WebhookSubscription) validate() error {
+	if ws.projectID.IsNil() {
+		return ErrInvalidProjectID
+	}
+
+	if err := validateWebhookEndpoint(ws.url); err != nil {
+		return err
+	}
+
+	if len(ws.secret) < minWebhookSecretLength {
+		return NewInvalidWebhookSubscriptionError(
+			fmt.Sprintf("secret must be at least %d characters", minWebhookSecretLength))
+	}
+
+	if !ws.format.IsValid() {
+		return NewInvalidWebhookSubscriptionError(fmt.Sprintf("unsupported payload format: %s", ws.format))
+	}
+
+	return nil
+}
+
+// validateWebhookEndpoint checks that deliveries can be posted to an endpoint
+func validateWebhookEndpoint(endpointURL string) error {
+	parsedURL, err := url.Parse(endpointURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		return NewInvalidWebhookSubscriptionError("url must be an absolute http or https URL")
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/google/uuid"
+)
+
+// WebhookSubscriptionModel represents the database model for outgoing webhook subscriptions
+type WebhookSubscriptionModel struct {
+	ID                 uuid.UUID       `gorm:"column:id;primaryKey;type:uuid;default:uuid_generate_v4()"`
+	ProjectID          uuid.UUID       `gorm:"column:project_id;type:uuid;not null;index:idx_webhook_subscriptions_project"`
+	URL                string          `gorm:"column:url;type:text;not null"`
+	Secret             string          `gorm:"column:secret;type:varchar(255);not null"`
+	Format             string          `gorm:"column:format;type:varchar(20);not null;default:'json'"`
+	DigestMode         string          `gorm:"column:digest_mode;type:varchar(10);not null;default:'instant'"`
+	QuietHoursTimezone string          `gorm:"column:quiet_hours_timezone;type:varchar(64);not null;default:'UTC'"`
+	QuietHoursStart    string          `gorm:"column:quiet_hours_start;type:varchar(5);not null;default:''"`
+	QuietHoursEnd      string          `gorm:"column:quiet_hours_end;type:varchar(5);not null;default:''"`
+	QuietHoursBypass   json.RawMessage `gorm:"column:quiet_hours_bypass;type:jsonb;not null;default:'{}'"`
+	IsActive           bool            `gorm:"column:is_active;not null;default:true"`
+	CreatedAt          time.Time       `gorm:"column:created_at;type:timestamp with time zone;default:current_timestamp"`
+	UpdatedAt          time.Time       `gorm:"column:updated_at;type:timestamp with time zone;default:current_timestamp"`
+}
+
+// TableName returns the table name for webhook subscriptions
+func (WebhookSubscriptionModel) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// ToEntity converts the model to domain entity
+func (m *WebhookSubscriptionModel) ToEntity() *WebhookSubscription {
+	return RestoreWebhookSubscription(RestoreWebhookSubscriptionParams{
+		ID:         value_objects.NewIDFromUUID(m.ID),
+		ProjectID:  value_objects.NewIDFromUUID(m.ProjectID),
+		URL:        m.URL,
+		Secret:     m.Secret,
+		Format:     WebhookPayloadFormat(m.Format),
+		DigestMode: DigestMode(m.DigestMode),
+		QuietHours: RestoreQuietHours(m.QuietHoursTimezone, m.QuietHoursStart, m.QuietHoursEnd, m.QuietHoursBypass),
+		IsActive:   m.IsActive,
+		CreatedAt:  value_objects.NewTimestampFromTime(m.CreatedAt),
+		UpdatedAt:  value_objects.NewTimestampFromTime(m.UpdatedAt),
+	})
+}
+
+// FromEntity converts domain entity to model
+func (m *WebhookSubscriptionModel) FromEntity(subscription *WebhookSubscription) {
+	m.ID = subscription.ID().Value()
+	m.ProjectID = subscription.ProjectID().Value()
+	m.URL = subscription.URL()
+	m.Secret = subscription.Secret()
+	m.Format = string(subscription.Format())
+	m.DigestMode = string(subscription.DigestMode())
+	m.QuietHoursTimezone, m.QuietHoursStart, m.QuietHoursEnd, m.QuietHoursBypass = MarshalQuietHours(subscription.QuietHours())
+	m.IsActive = subscription.IsActive()
+	m.CreatedAt = subscription.CreatedAt().ToTime()
+	m.UpdatedAt = subscription.UpdatedAt().ToTime()
+}
@@ -206,3 +206,325 @@ type ProcessRetryableNotificationResponse struct {
 	SendToDeadLetter   bool                       `json:"send_to_dead_letter"`
 	RetryConfiguration *domain.RetryConfiguration `json:"retry_configuration"`
 }
+
+// ListDeadLetterFilters represents filters for listing dead-lettered notifications
+type ListDeadLetterFilters struct {
+	Status  *domain.DeadLetterStatus    `json:"status,omitempty" validate:"omitempty,oneof=pending requeued discarded"`
+	Channel *domain.NotificationChannel `json:"channel,omitempty" validate:"omitempty,oneof=telegram email slack webhook"`
+	Limit   *int                        `json:"limit,omitempty" validate:"omitempty,min=1,max=100"`
+	Offset  *int                        `json:"offset,omitempty" validate:"omitempty,min=0"`
+}
+
+// DeliveryAttemptResponse represents a failed delivery attempt
+type DeliveryAttemptResponse struct {
+	Attempt  int       `json:"attempt"`
+	Error    string    `json:"error"`
+	FailedAt time.Time `json:"failed_at"`
+}
+
+// DeadLetterResponse represents a dead-lettered notification response
+type DeadLetterResponse struct {
+	ID                string                     `json:"id"`
+	NotificationLogID string                     `json:"notification_log_id"`
+	BuildEventID      string                     `json:"build_event_id"`
+	Channel           domain.NotificationChannel `json:"channel"`
+	Recipient         string                     `json:"recipient"`
+	Message           string                     `json:"message"`
+	Reason            domain.DeadLetterReason    `json:"reason"`
+	LastError         string                     `json:"last_error"`
+	AttemptCount      int                        `json:"attempt_count"`
+	ErrorHistory      []DeliveryAttemptResponse  `json:"error_history"`
+	Status            domain.DeadLetterStatus    `json:"status"`
+	ResolvedAt        *time.Time                 `json:"resolved_at,omitempty"`
+	CreatedAt         time.Time                  `json:"created_at"`
+	UpdatedAt         time.Time                  `json:"updated_at"`
+}
+
+// DeadLetterListResponse represents a paginated list of dead-lettered notifications
+type DeadLetterListResponse struct {
+	DeadLetters []DeadLetterResponse `json:"dead_letters"`
+	Total       int64                `json:"total"`
+	Limit       int                  `json:"limit"`
+	Offset      int                  `json:"offset"`
+}
+
+// ToDeadLetterResponse converts domain entity to response DTO
+func ToDeadLetterResponse(entity *domain.DeadLetterNotification) DeadLetterResponse {
+	history := entity.ErrorHistory()
+	attempts := make([]DeliveryAttemptResponse, len(history))
+	for i, attempt := range history {
+		attempts[i] = DeliveryAttemptResponse{
+			Attempt:  attempt.Attempt,
+			Error:    attempt.Error,
+			FailedAt: attempt.FailedAt,
+		}
+	}
+
+	response := DeadLetterResponse{
+		ID:                entity.ID().String(),
+		NotificationLogID: entity.NotificationLogID().String(),
+		BuildEventID:      entity.BuildEventID().String(),
+		Channel:           entity.Channel(),
+		Recipient:         entity.Recipient(),
+		Message:           entity.Message(),
+		Reason:            entity.Reason(),
+		LastError:         entity.LastError(),
+		AttemptCount:      entity.AttemptCount(),
+		ErrorHistory:      attempts,
+		Status:            entity.Status(),
+		CreatedAt:         entity.CreatedAt().ToTime(),
+		UpdatedAt:         entity.UpdatedAt().ToTime(),
+	}
+
+	if entity.ResolvedAt() != nil {
+		resolvedAt := entity.ResolvedAt().ToTime()
+		response.ResolvedAt = &resolvedAt
+	}
+
+	return response
+}
+
+// ToDeadLetterResponseList converts domain entities to response DTOs
+func ToDeadLetterResponseList(entities []*domain.DeadLetterNotification) []DeadLetterResponse {
+	responses := make([]DeadLetterResponse, len(entities))
+	for i, entity := range entities {
+		responses[i] = ToDeadLetterResponse(entity)
+	}
+	return responses
+}
+
+// WebhookEventFilter selects build events by type, status and branch. Empty lists match every value.
+type WebhookEventFilter struct {
+	EventTypes []string `json:"event_types"`
+	Statuses   []string `json:"statuses"`
+	Branches   []string `json:"branches"` // Glob patterns, e.g. "release/*"
+}
+
+// WebhookQuietHours is a daily window in a timezone during which deliveries are deferred until it ends.
+// Build events passing the bypass filter are still delivered right away, an empty bypass filter lets none through.
+type WebhookQuietHours struct {
+	Timezone string             `json:"timezone"` // IANA timezone, UTC when empty
+	Start    string             `json:"start"`    // HH:MM
+	End      string             `json:"end"`      // HH:MM, before start when the window spans midnight
+	Bypass   WebhookEventFilter `json:"bypass"`
+}
+
+// ToDomain converts the quiet hours to validated domain quiet hours
+func (q WebhookQuietHours) ToDomain() (domain.QuietHours, error) {
+	bypass, err := domain.NewSubscriptionFilter(q.Bypass.EventTypes, q.Bypass.Statuses, q.Bypass.Branches)
+	if err != nil {
+		return domain.QuietHours{}, err
+	}
+	return domain.NewQuietHours(q.Timezone, q.Start, q.End, bypass)
+}
+
+// CreateWebhookSubscriptionRequest represents the request to subscribe an endpoint to a project
+type CreateWebhookSubscriptionRequest struct {
+	URL    string                      `json:"url" validate:"required,url"`
+	Secret string                      `json:"secret,omitempty" validate:"omitempty,min=16,max=255"`
+	Format domain.WebhookPayloadFormat `json:"format,omitempty" validate:"omitempty,oneof=json cloudevents"`
+	// DigestMode delivers events instantly (default) or as an hourly or daily digest
+	DigestMode domain.DigestMode  `json:"digest_mode,omitempty" validate:"omitempty,oneof=instant hourly daily"`
+	QuietHours *WebhookQuietHours `json:"quiet_hours,omitempty"`
+}
+
+// UpdateWebhookSubscriptionRequest represents the request to update a webhook subscription.
+// Given quiet hours replace the current ones, quiet hours without start and end remove them.
+type UpdateWebhookSubscriptionRequest struct {
+	URL        *string                      `json:"url,omitempty" validate:"omitempty,url"`
+	Format     *domain.WebhookPayloadFormat `json:"format,omitempty" validate:"omitempty,oneof=json cloudevents"`
+	DigestMode *domain.DigestMode           `json:"digest_mode,omitempty" validate:"omitempty,oneof=instant hourly daily"`
+	QuietHours *WebhookQuietHours           `json:"quiet_hours,omitempty"`
+	IsActive   *bool                        `json:"is_active,omitempty"`
+}
+
+// WebhookSubscriptionResponse represents a webhook subscription response.
+// The secret is only included when it was just created or rotated.
+type WebhookSubscriptionResponse struct {
+	ID         string                      `json:"id"`
+	ProjectID  string                      `json:"project_id"`
+	URL        string                      `json:"url"`
+	Format     domain.WebhookPayloadFormat `json:"format"`
+	DigestMode domain.DigestMode           `json:"digest_mode"`
+	QuietHours *WebhookQuietHours          `json:"quiet_hours"`
+	Secret     string                      `json:"secret,omitempty"`
+	IsActive   bool                        `json:"is_active"`
+	CreatedAt  time.Time                   `json:"created_at"`
+	UpdatedAt  time.Time                   `json:"updated_at"`
+}
+
+// ToWebhookSubscriptionResponse converts domain entity to response DTO without its secret
+func ToWebhookSubscriptionResponse(entity *domain.WebhookSubscription) WebhookSubscriptionResponse {
+	return WebhookSubscriptionResponse{
+		ID:         entity.ID().String(),
+		ProjectID:  entity.ProjectID().String(),
+		URL:        entity.URL(),
+		Format:     entity.Format(),
+		DigestMode: entity.DigestMode(),
+		QuietHours: toWebhookQuietHours(entity.QuietHours()),
+		IsActive:   entity.IsActive(),
+		CreatedAt:  entity.CreatedAt().ToTime(),
+		UpdatedAt:  entity.UpdatedAt().ToTime(),
+	}
+}
+
+// toWebhookQuietHours converts domain quiet hours to a response DTO, nil without quiet hours
+func toWebhookQuietHours(quietHours domain.QuietHours) *WebhookQuietHours {
+	if quietHours.IsEmpty() {
+		return nil
+	}
+
+	return &WebhookQuietHours{
+		Timezone: quietHours.Timezone,
+		Start:    quietHours.Start,
+		End:      quietHours.End,
+		Bypass: WebhookEventFilter{
+			EventTypes: nonNilFilterValues(quietHours.Bypass.EventTypes),
+			Statuses:   nonNilFilterValues(quietHours.Bypass.Statuses),
+			Branches:   nonNilFilterValues(quietHours.Bypass.BranchPatterns),
+		},
+	}
+}
+
+// nonNilFilterValues keeps empty filter lists as empty JSON arrays
+func nonNilFilterValues(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// ToWebhookSubscriptionResponseWithSecret converts domain entity to response DTO including its secret
+func ToWebhookSubscriptionResponseWithSecret(entity *domain.WebhookSubscription) WebhookSubscriptionResponse {
+	response := ToWebhookSubscriptionResponse(entity)
+	response.Secret = entity.Secret()
+	return response
+}
+
+// ToWebhookSubscriptionResponseList converts domain entities to response DTOs without their secrets
+func ToWebhookSubscriptionResponseList(entities []*domain.WebhookSubscription) []WebhookSubscriptionResponse {
+	responses := make([]WebhookSubscriptionResponse, len(entities))
+	for i, entity := range entities {
+		responses[i] = ToWebhookSubscriptionResponse(entity)
+	}
+	return responses
+}
+
+// CreateEscalationPolicyRequest represents the request to escalate a failing branch of a project
+type CreateEscalationPolicyRequest struct {
+	// Branch defaults to the default branch, main
+	Branch string `json:"branch,omitempty" validate:"omitempty,max=255"`
+	// EscalateAfterMinutes lists how long after its first failure a branch that is still failing is escalated
+	EscalateAfterMinutes []int    `json:"escalate_after_minutes" validate:"required,min=1,max=10,dive,min=1"`
+	TelegramChatID       *int64   `json:"telegram_chat_id,omitempty"`
+	EmailRecipients      []string `json:"email_recipients,omitempty"`
+}
+
+// UpdateEscalationPolicyRequest represents the request to update an escalation policy.
+// Fields left out are not changed, a telegram chat ID of 0 removes the escalation chat.
+type UpdateEscalationPolicyRequest struct {
+	Branch               *string   `json:"branch,omitempty" validate:"omitempty,max=255"`
+	EscalateAfterMinutes []int     `json:"escalate_after_minutes,omitempty" validate:"omitempty,max=10,dive,min=1"`
+	TelegramChatID       *int64    `json:"telegram_chat_id,omitempty"`
+	EmailRecipients      *[]string `json:"email_recipients,omitempty"`
+	IsActive             *bool     `json:"is_active,omitempty"`
+}
+
+// EscalationPolicyResponse represents an escalation policy response
+type EscalationPolicyResponse struct {
+	ID                   string    `json:"id"`
+	ProjectID            string    `json:"project_id"`
+	Branch               string    `json:"branch"`
+	EscalateAfterMinutes []int     `json:"escalate_after_minutes"`
+	TelegramChatID       *int64    `json:"telegram_chat_id"`
+	EmailRecipients      []string  `json:"email_recipients"`
+	IsActive             bool      `json:"is_active"`
+	CreatedAt            time.Time `json:"created_at"`
+	UpdatedAt            time.Time `json:"updated_at"`
+}
+
+// ToEscalationPolicyResponse converts domain entity to response DTO
+func ToEscalationPolicyResponse(entity *domain.EscalationPolicy) EscalationPolicyResponse {
+	minutes := make([]int, len(entity.Steps()))
+	for i, step := range entity.Steps() {
+		minutes[i] = int(step / time.Minute)
+	}
+
+	recipients := entity.EmailRecipients()
+	if recipients == nil {
+		recipients = []string{}
+	}
+
+	return EscalationPolicyResponse{
+		ID:                   entity.ID().String(),
+		ProjectID:            entity.ProjectID().String(),
+		Branch:               entity.Branch(),
+		EscalateAfterMinutes: minutes,
+		TelegramChatID:       entity.TelegramChatID(),
+		EmailRecipients:      recipients,
+		IsActive:             entity.IsActive(),
+		CreatedAt:            entity.CreatedAt().ToTime(),
+		UpdatedAt:            entity.UpdatedAt().ToTime(),
+	}
+}
+
+// ToEscalationPolicyResponseList converts domain entities to response DTOs
+func ToEscalationPolicyResponseList(entities []*domain.EscalationPolicy) []EscalationPolicyResponse {
+	responses := make([]EscalationPolicyResponse, len(entities))
+	for i, entity := range entities {
+		responses[i] = ToEscalationPolicyResponse(entity)
+	}
+	return responses
+}
+
+// AcknowledgeEscalationsRequest represents the request to stop escalating the failing branches of a project
+type AcknowledgeEscalationsRequest struct {
+	// Branch limits the acknowledgement to one branch, all failing branches are acknowledged without it
+	Branch         string `json:"branch,omitempty" validate:"omitempty,max=255"`
+	AcknowledgedBy string `json:"acknowledged_by" validate:"required,max=255"`
+}
+
+// EscalationResponse represents an escalation response
+type EscalationResponse struct {
+	ID             string     `json:"id"`
+	PolicyID       string     `json:"policy_id"`
+	ProjectID      string     `json:"project_id"`
+	Branch         string     `json:"branch"`
+	BuildEventID   string     `json:"build_event_id"`
+	FailingSince   time.Time  `json:"failing_since"`
+	Step           int        `json:"step"`
+	AcknowledgedAt *time.Time `json:"acknowledged_at"`
+	AcknowledgedBy string     `json:"acknowledged_by,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// ToEscalationResponse converts domain entity to response DTO
+func ToEscalationResponse(entity *domain.Escalation) EscalationResponse {
+	response := EscalationResponse{
+		ID:             entity.ID().String(),
+		PolicyID:       entity.PolicyID().String(),
+		ProjectID:      entity.ProjectID().String(),
+		Branch:         entity.Branch(),
+		BuildEventID:   entity.BuildEventID().String(),
+		FailingSince:   entity.FailingSince(),
+		Step:           entity.Step(),
+		AcknowledgedBy: entity.AcknowledgedBy(),
+		CreatedAt:      entity.CreatedAt().ToTime(),
+		UpdatedAt:      entity.UpdatedAt().ToTime(),
+	}
+	if entity.AcknowledgedAt() != nil {
+		acknowledgedAt := entity.AcknowledgedAt().ToTime()
+		response.AcknowledgedAt = &acknowledgedAt
+	}
+	return response
+}
+
+// ToEscalationResponseList converts domain entities to response DTOs
+func ToEscalationResponseList(entities []*domain.Escalation) []EscalationResponse {
+	responses := make([]EscalationResponse, len(entities))
+	for i, entity := range entities {
+		responses[i] = ToEscalationResponse(entity)
+	}
+	return responses
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/dto"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
 )
 
@@ -67,6 +68,18 @@ type NotificationLogRepository interface {
 	// GetPendingNotifications retrieves pending notifications
 	GetPendingNotifications(ctx context.Context, limit int) ([]*domain.NotificationLog, error)
 
+	// GetDueDigestNotifications retrieves batched notifications whose digest is due at the given time
+	GetDueDigestNotifications(ctx context.Context, before time.Time, limit int) ([]*domain.NotificationLog, error)
+
+	// GetDeferredByCommit retrieves the deferred notifications of a channel recipient about builds of a commit, oldest first
+	GetDeferredByCommit(
+		ctx context.Context,
+		projectID value_objects.ID,
+		commitSHA string,
+		channel domain.NotificationChannel,
+		recipient string,
+	) ([]*domain.NotificationLog, error)
+
 	// Count returns the total number of notification logs matching the criteria
 	Count(ctx context.Context, projectID *value_objects.ID, status *domain.NotificationStatus) (int64, error)
 
@@ -148,8 +161,13 @@ type DeliveryQueueRepository interface {
 	// GetPendingByPriority retrieves pending notifications ordered by priority
 	GetPendingByPriority(ctx context.Context, limit int) ([]*domain.QueuedNotification, error)
 
-	// GetFailedNotifications retrieves failed notifications that can be retried
-	GetFailedNotifications(ctx context.Context, limit int) ([]*domain.QueuedNotification, error)
+	// ClaimPending atomically marks up to limit due notifications of the kind as processing,
+	// highest priority first, and returns them. A claimed notification that is not delivered
+	// or failed within visibilityTimeout becomes claimable again.
+	ClaimPending(ctx context.Context, kind domain.QueueKind, limit int, visibilityTimeout time.Duration) ([]*domain.QueuedNotification, error)
+
+	// GetFailedNotifications retrieves failed notifications of the kind that can be retried
+	GetFailedNotifications(ctx context.Context, kind domain.QueueKind, limit int) ([]*domain.QueuedNotification, error)
 
 	// Update saves changes to an existing queued notification
 	Update(ctx context.Context, notification *domain.QueuedNotification) error
@@ -170,6 +188,81 @@ type DeliveryQueueRepository interface {
 	GetQueueStats(ctx context.Context) (map[string]int64, error)
 }
 
+// DeadLetterRepository defines the interface for dead-lettered notification persistence
+type DeadLetterRepository interface {
+	// Create stores a notification moved to the dead-letter queue
+	Create(ctx context.Context, deadLetter *domain.DeadLetterNotification) error
+
+	// GetByID retrieves a dead-lettered notification by ID
+	GetByID(ctx context.Context, id value_objects.ID) (*domain.DeadLetterNotification, error)
+
+	// List retrieves dead-lettered notifications, newest first
+	List(ctx context.Context, filters dto.ListDeadLetterFilters) ([]*domain.DeadLetterNotification, error)
+
+	// Count returns the number of dead-lettered notifications matching the filters
+	Count(ctx context.Context, filters dto.ListDeadLetterFilters) (int64, error)
+
+	// Update saves changes to a dead-lettered notification
+	Update(ctx context.Context, deadLetter *domain.DeadLetterNotification) error
+}
+
+// WebhookSubscriptionRepository defines the interface for webhook subscription persistence
+type WebhookSubscriptionRepository interface {
+	// Create creates a new webhook subscription
+	Create(ctx context.Context, subscription *domain.WebhookSubscription) error
+
+	// GetByID retrieves a webhook subscription by its ID
+	GetByID(ctx context.Context, id value_objects.ID) (*domain.WebhookSubscription, error)
+
+	// GetByProjectID retrieves the webhook subscriptions of a project, oldest first
+	GetByProjectID(ctx context.Context, projectID value_objects.ID) ([]*domain.WebhookSubscription, error)
+
+	// GetActiveByProjectID retrieves the active webhook subscriptions of a project
+	GetActiveByProjectID(ctx context.Context, projectID value_objects.ID) ([]*domain.WebhookSubscription, error)
+
+	// Update updates an existing webhook subscription
+	Update(ctx context.Context, subscription *domain.WebhookSubscription) error
+
+	// Delete deletes a webhook subscription by its ID
+	Delete(ctx context.Context, id value_objects.ID) error
+}
+
+// EscalationPolicyRepository defines the interface for escalation policy persistence
+type EscalationPolicyRepository interface {
+	// Create creates a new escalation policy
+	Create(ctx context.Context, policy *domain.EscalationPolicy) error
+
+	// GetByID retrieves an escalation policy by its ID
+	GetByID(ctx context.Context, id value_objects.ID) (*domain.EscalationPolicy, error)
+
+	// GetByProjectID retrieves the escalation policies of a project, oldest first
+	GetByProjectID(ctx context.Context, projectID value_objects.ID) ([]*domain.EscalationPolicy, error)
+
+	// GetActive retrieves a page of the active escalation policies of all projects, oldest first
+	GetActive(ctx context.Context, limit, offset int) ([]*domain.EscalationPolicy, error)
+
+	// Update updates an existing escalation policy
+	Update(ctx context.Context, policy *domain.EscalationPolicy) error
+
+	// Delete deletes an escalation policy and its escalations by its ID
+	Delete(ctx context.Context, id value_objects.ID) error
+}
+
+// EscalationRepository defines the interface for escalation persistence
+type EscalationRepository interface {
+	// Create creates a new escalation
+	Create(ctx context.Context, escalation *domain.Escalation) error
+
+	// GetOpenByPolicyID retrieves the unresolved escalation of a policy, ErrEscalationNotFound without one
+	GetOpenByPolicyID(ctx context.Context, policyID value_objects.ID) (*domain.Escalation, error)
+
+	// GetOpenByProjectID retrieves the unresolved escalations of a project, oldest first
+	GetOpenByProjectID(ctx context.Context, projectID value_objects.ID) ([]*domain.Escalation, error)
+
+	// Update updates an existing escalation
+	Update(ctx context.Context, escalation *domain.Escalation) error
+}
+
 // RateLimiterRepository defines the interface for rate limiter persistence
 type RateLimiterRepository interface {
 	// GetEntry retrieves a rate limit entry
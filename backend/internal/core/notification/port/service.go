@@ -124,6 +124,12 @@ type NotificationLogService interface {
 	// ProcessFailedNotifications processes failed notifications for retry
 	ProcessFailedNotifications(ctx context.Context, limit int) error
 
+	// SendDueDigests sends the digests of batched notifications whose digest window has ended
+	SendDueDigests(ctx context.Context, limit int) error
+
+	// SendDeferredNotifications sends the notifications deferred by quiet hours that ended
+	SendDeferredNotifications(ctx context.Context, limit int) error
+
 	// GetNotificationStats retrieves notification statistics for a project
 	GetNotificationStats(ctx context.Context, projectID value_objects.ID) (map[domain.NotificationStatus]int64, error)
 
@@ -137,11 +143,16 @@ type NotificationLogService interface {
 
 // TelegramSubscriptionService defines the contract for telegram subscription business logic
 type TelegramSubscriptionService interface {
-	// CreateTelegramSubscription creates a new telegram subscription
+	// CreateTelegramSubscription creates a new telegram subscription notified about the events passing the filter,
+	// instantly or by digest, and outside its quiet hours
 	CreateTelegramSubscription(
 		ctx context.Context,
 		projectID value_objects.ID,
 		chatID int64,
+		filter domain.SubscriptionFilter,
+		digestMode domain.DigestMode,
+		quietHours domain.QuietHours,
+		transitionsOnly bool,
 	) (*domain.TelegramSubscription, error)
 
 	// GetTelegramSubscription retrieves a telegram subscription by its ID
@@ -153,12 +164,16 @@ type TelegramSubscriptionService interface {
 	// GetTelegramSubscriptionByChatID retrieves a telegram subscription by chat ID
 	GetTelegramSubscriptionByChatID(ctx context.Context, chatID int64) (*domain.TelegramSubscription, error)
 
-	// UpdateTelegramSubscription updates a telegram subscription
+	// UpdateTelegramSubscription updates the given fields of a telegram subscription
 	UpdateTelegramSubscription(
 		ctx context.Context,
 		id value_objects.ID,
 		chatID *int64,
 		isActive *bool,
+		filter *domain.SubscriptionFilter,
+		digestMode *domain.DigestMode,
+		quietHours *domain.QuietHours,
+		transitionsOnly *bool,
 	) (*domain.TelegramSubscription, error)
 
 	// DeleteTelegramSubscription deletes a telegram subscription
@@ -190,10 +205,36 @@ type TelegramSubscriptionService interface {
 	ValidateSubscriptionParameters(ctx context.Context, projectID value_objects.ID, chatID int64, userID *int64) error
 }
 
+// ChannelNotificationService defines the contract for notifying the recipients a project configures
+// for a channel, such as email addresses or Slack channels
+type ChannelNotificationService interface {
+	// CreateChannelNotificationsForBuildEvent creates a notification of the channel for each recipient of a build event
+	CreateChannelNotificationsForBuildEvent(
+		ctx context.Context,
+		buildEventID, projectID value_objects.ID,
+		channel domain.NotificationChannel,
+		recipients []string,
+		subject, body string,
+	) ([]*domain.NotificationLog, error)
+}
+
 // NotificationSender defines the contract for sending notifications through different channels
 type NotificationSender interface {
-	// SendTelegramNotification sends a notification through Telegram
-	SendTelegramNotification(ctx context.Context, chatID int64, message string) (messageID string, err error)
+	// SendTelegramNotification sends a notification through Telegram, keyboard is optional
+	SendTelegramNotification(
+		ctx context.Context,
+		chatID int64,
+		message string,
+		keyboard *domain.TelegramInlineKeyboard,
+	) (messageID string, err error)
+
+	// EditTelegramNotification replaces the text and inline buttons of a message sent earlier through Telegram
+	EditTelegramNotification(
+		ctx context.Context,
+		chatID int64,
+		messageID, message string,
+		keyboard *domain.TelegramInlineKeyboard,
+	) error
 
 	// SendEmailNotification sends a notification through email
 	SendEmailNotification(ctx context.Context, email, subject, message string) error
@@ -201,8 +242,8 @@ type NotificationSender interface {
 	// SendSlackNotification sends a notification through Slack
 	SendSlackNotification(ctx context.Context, channel, message string) (messageID string, err error)
 
-	// SendWebhookNotification sends a notification through webhook
-	SendWebhookNotification(ctx context.Context, webhookURL, message string) error
+	// SendWebhookNotification posts a webhook delivery, signing it when the delivery has a secret
+	SendWebhookNotification(ctx context.Context, delivery domain.WebhookDelivery) error
 }
 
 // RetryService defines the interface for retry logic operations
@@ -244,6 +285,49 @@ type RetryService interface {
 	ProcessRetryableNotification(ctx context.Context, req dto.ProcessRetryableNotificationRequest) (*dto.ProcessRetryableNotificationResponse, error)
 }
 
+// DeadLetterService defines the interface for managing dead-lettered notifications
+type DeadLetterService interface {
+	// ListDeadLetters lists dead-lettered notifications matching the filters
+	ListDeadLetters(ctx context.Context, filters dto.ListDeadLetterFilters) ([]*domain.DeadLetterNotification, error)
+
+	// CountDeadLetters counts dead-lettered notifications matching the filters
+	CountDeadLetters(ctx context.Context, filters dto.ListDeadLetterFilters) (int64, error)
+
+	// GetDeadLetter retrieves a dead-lettered notification with its error history
+	GetDeadLetter(ctx context.Context, id value_objects.ID) (*domain.DeadLetterNotification, error)
+
+	// RequeueDeadLetter sends a dead-lettered notification back for delivery
+	RequeueDeadLetter(ctx context.Context, id value_objects.ID) (*domain.DeadLetterNotification, error)
+
+	// DiscardDeadLetter gives up on a dead-lettered notification
+	DiscardDeadLetter(ctx context.Context, id value_objects.ID) (*domain.DeadLetterNotification, error)
+}
+
+// WebhookSubscriptionService defines the interface for managing outgoing webhook subscriptions
+type WebhookSubscriptionService interface {
+	// CreateWebhookSubscription subscribes an endpoint to the notifications of a project.
+	// A signing secret is generated when the request has none.
+	CreateWebhookSubscription(ctx context.Context, projectID value_objects.ID, req dto.CreateWebhookSubscriptionRequest) (*domain.WebhookSubscription, error)
+
+	// GetWebhookSubscription retrieves a webhook subscription by its ID
+	GetWebhookSubscription(ctx context.Context, id value_objects.ID) (*domain.WebhookSubscription, error)
+
+	// GetWebhookSubscriptionsByProject retrieves the webhook subscriptions of a project
+	GetWebhookSubscriptionsByProject(ctx context.Context, projectID value_objects.ID) ([]*domain.WebhookSubscription, error)
+
+	// GetActiveWebhookSubscriptions retrieves the webhook subscriptions notified about a project's events
+	GetActiveWebhookSubscriptions(ctx context.Context, projectID value_objects.ID) ([]*domain.WebhookSubscription, error)
+
+	// UpdateWebhookSubscription updates the endpoint, format or state of a webhook subscription
+	UpdateWebhookSubscription(ctx context.Context, id value_objects.ID, req dto.UpdateWebhookSubscriptionRequest) (*domain.WebhookSubscription, error)
+
+	// RotateWebhookSubscriptionSecret replaces the signing secret of a webhook subscription
+	RotateWebhookSubscriptionSecret(ctx context.Context, id value_objects.ID) (*domain.WebhookSubscription, error)
+
+	// DeleteWebhookSubscription deletes a webhook subscription
+	DeleteWebhookSubscription(ctx context.Context, id value_objects.ID) error
+}
+
 // DeliveryChannel defines the interface for notification delivery channels (abstraction for Dewi's work)
 type DeliveryChannel interface {
 	// Send sends a notification through the specific channel
@@ -262,6 +346,13 @@ type DeliveryChannel interface {
 	GetRateLimitInfo() (maxRequests int, windowSize time.Duration)
 }
 
+// RecipientRateLimiter is implemented by delivery channels that follow rate limits
+// the provider reports per recipient, such as Discord's per-webhook buckets
+type RecipientRateLimiter interface {
+	// RetryAfter returns how long to wait before sending to the recipient again, zero when it can be sent now
+	RetryAfter(recipient string) time.Duration
+}
+
 // NotificationDeliveryService defines the interface for notification delivery operations
 type NotificationDeliveryService interface {
 	// QueueNotification adds a notification to the delivery queue
@@ -288,3 +379,35 @@ type NotificationDeliveryService interface {
 	// CheckRateLimit checks if a notification can be sent based on rate limiting
 	CheckRateLimit(ctx context.Context, channel domain.NotificationChannel, recipient string) (bool, error)
 }
+
+// EscalationService defines the interface for escalating branches that keep failing
+type EscalationService interface {
+	// CreateEscalationPolicy creates an escalation policy for a branch of a project
+	CreateEscalationPolicy(ctx context.Context, projectID value_objects.ID, req dto.CreateEscalationPolicyRequest) (*domain.EscalationPolicy, error)
+
+	// GetEscalationPolicy retrieves an escalation policy by its ID
+	GetEscalationPolicy(ctx context.Context, id value_objects.ID) (*domain.EscalationPolicy, error)
+
+	// GetEscalationPoliciesByProject retrieves the escalation policies of a project
+	GetEscalationPoliciesByProject(ctx context.Context, projectID value_objects.ID) ([]*domain.EscalationPolicy, error)
+
+	// UpdateEscalationPolicy updates the branch, steps, targets or state of an escalation policy
+	UpdateEscalationPolicy(ctx context.Context, id value_objects.ID, req dto.UpdateEscalationPolicyRequest) (*domain.EscalationPolicy, error)
+
+	// DeleteEscalationPolicy deletes an escalation policy
+	DeleteEscalationPolicy(ctx context.Context, id value_objects.ID) error
+
+	// GetOpenEscalations retrieves the escalations of a project whose branch is still failing
+	GetOpenEscalations(ctx context.Context, projectID value_objects.ID) ([]*domain.Escalation, error)
+
+	// AcknowledgeEscalations stops escalating the failing branches of a project, all of them when branch is empty.
+	// It returns the acknowledged escalations.
+	AcknowledgeEscalations(ctx context.Context, projectID value_objects.ID, branch, acknowledgedBy string) ([]*domain.Escalation, error)
+
+	// IsEscalationChat checks if escalations of a project are sent to the chat
+	IsEscalationChat(ctx context.Context, projectID value_objects.ID, chatID int64) (bool, error)
+
+	// EvaluateEscalations escalates the failing branches of all active policies whose next step is due,
+	// evaluating the policies in pages of batchSize
+	EvaluateEscalations(ctx context.Context, batchSize int) error
+}
@@ -0,0 +1,124 @@
+package deadletter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/sirupsen/logrus"
+)
+
+type Dep struct {
+	DeadLetterRepo   port.DeadLetterRepository
+	NotificationRepo port.NotificationLogRepository
+	Logger           *logrus.Logger
+}
+
+// deadLetterService implements dead-letter queue business logic
+type deadLetterService struct {
+	Dep
+}
+
+// NewDeadLetterService creates a new dead-letter service
+func NewDeadLetterService(d Dep) port.DeadLetterService {
+	return &deadLetterService{
+		Dep: d,
+	}
+}
+
+// ListDeadLetters lists dead-lettered notifications matching the filters
+func (s *deadLetterService) ListDeadLetters(ctx context.Context, filters dto.ListDeadLetterFilters) ([]*domain.DeadLetterNotification, error) {
+	deadLetters, err := s.DeadLetterRepo.List(ctx, filters)
+	if err != nil {
+		s.Logger.WithError(err).Error(domain.LogMsgListDeadLetters)
+		return nil, fmt.Errorf(domain.ErrMsgListDeadLetters, err)
+	}
+
+	return deadLetters, nil
+}
+
+// CountDeadLetters counts dead-lettered notifications matching the filters
+func (s *deadLetterService) CountDeadLetters(ctx context.Context, filters dto.ListDeadLetterFilters) (int64, error) {
+	count, err := s.DeadLetterRepo.Count(ctx, filters)
+	if err != nil {
+		s.Logger.WithError(err).Error(domain.LogMsgListDeadLetters)
+		return 0, fmt.Errorf(domain.ErrMsgListDeadLetters, err)
+	}
+
+	return count, nil
+}
+
+// GetDeadLetter retrieves a dead-lettered notification with its error history
+func (s *deadLetterService) GetDeadLetter(ctx context.Context, id value_objects.ID) (*domain.DeadLetterNotification, error) {
+	deadLetter, err := s.DeadLetterRepo.GetByID(ctx, id)
+	if err != nil {
+		s.Logger.WithError(err).WithField("dead_letter_id", id.String()).Error(domain.LogMsgGetDeadLetter)
+		return nil, fmt.Errorf(domain.ErrMsgGetDeadLetter, err)
+	}
+
+	return deadLetter, nil
+}
+
+// RequeueDeadLetter puts the notification back to pending, so the pending
+// notifications job delivers it again with a fresh retry budget
+func (s *deadLetterService) RequeueDeadLetter(ctx context.Context, id value_objects.ID) (*domain.DeadLetterNotification, error) {
+	deadLetter, err := s.GetDeadLetter(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := deadLetter.Requeue(); err != nil {
+		return nil, err
+	}
+
+	log, err := s.NotificationRepo.GetByID(ctx, deadLetter.NotificationLogID())
+	if err != nil {
+		s.Logger.WithError(err).Error(domain.LogMsgGetNotificationLog)
+		return nil, fmt.Errorf(domain.ErrMsgRequeueDeadLetter, err)
+	}
+
+	if err := log.Requeue(); err != nil {
+		return nil, err
+	}
+
+	if err := s.NotificationRepo.Update(ctx, log); err != nil {
+		s.Logger.WithError(err).Error(domain.LogMsgRequeueDeadLetter)
+		return nil, fmt.Errorf(domain.ErrMsgRequeueDeadLetter, err)
+	}
+
+	if err := s.DeadLetterRepo.Update(ctx, deadLetter); err != nil {
+		s.Logger.WithError(err).Error(domain.LogMsgRequeueDeadLetter)
+		return nil, fmt.Errorf(domain.ErrMsgRequeueDeadLetter, err)
+	}
+
+	s.Logger.WithFields(logrus.Fields{
+		"dead_letter_id": id.String(),
+		"log_id":         log.ID().String(),
+	}).Info("Dead-lettered notification requeued")
+
+	return deadLetter, nil
+}
+
+// DiscardDeadLetter gives up on a dead-lettered notification
+func (s *deadLetterService) DiscardDeadLetter(ctx context.Context, id value_objects.ID) (*domain.DeadLetterNotification, error) {
+	deadLetter, err := s.GetDeadLetter(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := deadLetter.Discard(); err != nil {
+		return nil, err
+	}
+
+	if err := s.DeadLetterRepo.Update(ctx, deadLetter); err != nil {
+		s.Logger.WithError(err).Error(domain.LogMsgDiscardDeadLetter)
+		return nil, fmt.Errorf(domain.ErrMsgDiscardDeadLetter, err)
+	}
+
+	s.Logger.WithField("dead_letter_id", id.String()).Info("Dead-lettered notification discarded")
+
+	return deadLetter, nil
+}
@@ -2,13 +2,19 @@ package delivery
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
 )
 
+// DefaultVisibilityTimeout is how long a claimed notification stays hidden from
+// other workers before it is considered stuck and claimed again
+const DefaultVisibilityTimeout = 5 * time.Minute
+
 // notificationDeliveryService implements the NotificationDeliveryService interface
 type notificationDeliveryService struct {
 	queueRepo     port.DeliveryQueueRepository
@@ -57,10 +63,10 @@ func (s *notificationDeliveryService) QueueNotification(ctx context.Context, not
 
 // ProcessQueue processes pending notifications in the queue
 func (s *notificationDeliveryService) ProcessQueue(ctx context.Context, batchSize int) error {
-	// Get pending notifications by priority
-	notifications, err := s.queueRepo.GetPendingByPriority(ctx, batchSize)
+	// Claim due notifications by priority so concurrent workers never send the same one
+	notifications, err := s.queueRepo.ClaimPending(ctx, domain.QueueKindDelivery, batchSize, DefaultVisibilityTimeout)
 	if err != nil {
-		return fmt.Errorf("failed to get pending notifications: %w", err)
+		return fmt.Errorf("failed to claim pending notifications: %w", err)
 	}
 
 	if len(notifications) == 0 {
@@ -81,7 +87,7 @@ func (s *notificationDeliveryService) ProcessQueue(ctx context.Context, batchSiz
 // ProcessRetryQueue processes failed notifications for retry
 func (s *notificationDeliveryService) ProcessRetryQueue(ctx context.Context, batchSize int) error {
 	// Get failed notifications that can be retried
-	failedNotifications, err := s.queueRepo.GetFailedNotifications(ctx, batchSize)
+	failedNotifications, err := s.queueRepo.GetFailedNotifications(ctx, domain.QueueKindDelivery, batchSize)
 	if err != nil {
 		return fmt.Errorf("failed to get failed notifications: %w", err)
 	}
@@ -196,6 +202,13 @@ func (s *notificationDeliveryService) SendNotification(ctx context.Context, chan
 		return "", fmt.Errorf("delivery channel %s is not available", channel)
 	}
 
+	// Hold back recipients the provider asked to wait for
+	if limiter, ok := deliveryChannel.(port.RecipientRateLimiter); ok {
+		if retryAfter := limiter.RetryAfter(recipient); retryAfter > 0 {
+			return "", &domain.RecipientRateLimitError{Channel: channel, RetryAfter: retryAfter}
+		}
+	}
+
 	// Send notification
 	messageID, err := deliveryChannel.Send(ctx, recipient, subject, message)
 	if err != nil {
@@ -210,13 +223,8 @@ func (s *notificationDeliveryService) CheckRateLimit(ctx context.Context, channe
 	return s.rateLimiter.Allow(ctx, recipient, channel)
 }
 
-// processNotification processes a single notification
+// processNotification processes a single notification claimed by this worker
 func (s *notificationDeliveryService) processNotification(ctx context.Context, notification *domain.QueuedNotification) error {
-	// Mark as processing
-	if err := s.queueRepo.UpdateStatus(ctx, notification.ID, domain.DeliveryStatusProcessing, ""); err != nil {
-		return fmt.Errorf("failed to mark notification as processing: %w", err)
-	}
-
 	// Check rate limit
 	allowed, err := s.CheckRateLimit(ctx, notification.Channel, notification.Recipient)
 	if err != nil {
@@ -234,6 +242,13 @@ func (s *notificationDeliveryService) processNotification(ctx context.Context, n
 
 	// Send notification
 	messageID, err := s.SendNotification(ctx, notification.Channel, notification.Recipient, notification.Subject, notification.Message)
+	var rateLimitErr *domain.RecipientRateLimitError
+	if errors.As(err, &rateLimitErr) {
+		// The provider throttled the recipient, send again once it allows more requests
+		notification.ScheduleRetry(rateLimitErr.RetryAfter)
+		s.queueRepo.Update(ctx, notification)
+		return err
+	}
 	if err != nil {
 		// Mark as failed
 		s.queueRepo.UpdateStatus(ctx, notification.ID, domain.DeliveryStatusFailed, err.Error())
@@ -0,0 +1,296 @@
+package escalation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	buildDto "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/sirupsen/logrus"
+)
+
+// escalationHistoryLimit is how many completed builds of a branch are loaded to find the start of its failure
+const escalationHistoryLimit = 50
+
+// branchFailure is the open escalation of a failing branch and its failed builds, newest first
+type branchFailure struct {
+	escalation *domain.Escalation
+	streak     []*buildDomain.BuildEvent
+}
+
+// EvaluateEscalations escalates the failing branches of all active policies whose next step is due.
+// A policy failing to evaluate does not keep the others from being escalated.
+func (s *escalationService) EvaluateEscalations(ctx context.Context, batchSize int) error {
+	if batchSize <= 0 {
+		return domain.ErrInvalidEscalationBatchSize
+	}
+
+	var errs []error
+	for offset := 0; ; offset += batchSize {
+		policies, err := s.EscalationPolicyRepo.GetActive(ctx, batchSize, offset)
+		if err != nil {
+			return fmt.Errorf(domain.ErrMsgGet, resourceEscalationPolicy, err)
+		}
+
+		for _, policy := range policies {
+			if err := s.evaluatePolicy(ctx, policy); err != nil {
+				s.Logger.WithError(err).WithField("policy_id", policy.ID().String()).Error(domain.LogMsgEvaluateEscalation)
+				errs = append(errs, err)
+			}
+		}
+
+		if len(policies) < batchSize {
+			return errors.Join(errs...)
+		}
+	}
+}
+
+// evaluatePolicy sends the escalation step of a policy that is due, if any
+func (s *escalationService) evaluatePolicy(ctx context.Context, policy *domain.EscalationPolicy) error {
+	failure, err := s.syncEscalation(ctx, policy)
+	if err != nil || failure == nil {
+		return err
+	}
+
+	escalation := failure.escalation
+	step, due := escalation.NextStep(policy.DueStep(escalation.FailingSince(), time.Now()))
+	if !due {
+		return nil
+	}
+
+	if err := s.sendEscalation(ctx, policy, failure, step); err != nil {
+		return err
+	}
+
+	escalation.Escalate(step)
+	if err := s.EscalationRepo.Update(ctx, escalation); err != nil {
+		return fmt.Errorf(domain.ErrMsgUpdate, resourceEscalation, err)
+	}
+
+	s.Logger.WithFields(logrus.Fields{
+		"escalation_id": escalation.ID().String(),
+		"policy_id":     policy.ID().String(),
+		"branch":        escalation.Branch(),
+		"step":          step,
+	}).Info("Failing branch escalated")
+
+	return nil
+}
+
+// syncEscalation matches the open escalation of a policy with the builds of its branch. A successful build resolves it,
+// a new failure replaces it. It returns nil when the branch is not failing.
+func (s *escalationService) syncEscalation(ctx context.Context, policy *domain.EscalationPolicy) (*branchFailure, error) {
+	eventType := buildDomain.EventTypeBuildCompleted
+	branch := policy.Branch()
+	history, err := s.BuildEventRepo.GetByProjectID(ctx, policy.ProjectID(), buildDto.ListBuildEventFilters{
+		EventType: &eventType,
+		Branch:    &branch,
+		Limit:     escalationHistoryLimit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get build history: %w", err)
+	}
+	streak := buildDomain.FailureStreak(history)
+
+	escalation, err := s.EscalationRepo.GetOpenByPolicyID(ctx, policy.ID())
+	if err != nil && !errors.Is(err, domain.ErrEscalationNotFound) {
+		return nil, fmt.Errorf(domain.ErrMsgGet, resourceEscalation, err)
+	}
+
+	if escalation != nil && !isSameFailure(escalation, streak, history) {
+		escalation.Resolve()
+		if err := s.EscalationRepo.Update(ctx, escalation); err != nil {
+			return nil, fmt.Errorf(domain.ErrMsgUpdate, resourceEscalation, err)
+		}
+		escalation = nil
+	}
+
+	if len(streak) == 0 {
+		return nil, nil
+	}
+
+	if escalation == nil {
+		first := streak[len(streak)-1]
+		escalation = domain.NewEscalation(policy, first.ID(), first.UpdatedAt().ToTime())
+		if err := s.EscalationRepo.Create(ctx, escalation); err != nil {
+			return nil, fmt.Errorf(domain.ErrMsgPersist, resourceEscalation, err)
+		}
+	}
+
+	return &branchFailure{escalation: escalation, streak: streak}, nil
+}
+
+// isSameFailure checks if the failed builds of a branch still belong to the failure an escalation tracks.
+// A failure longer than the loaded history has its first failed build cut off, it is still the same failure.
+func isSameFailure(escalation *domain.Escalation, streak, history []*buildDomain.BuildEvent) bool {
+	if len(streak) == 0 {
+		return false
+	}
+
+	for _, buildEvent := range streak {
+		if buildEvent.ID() == escalation.BuildEventID() {
+			return true
+		}
+	}
+
+	return len(history) >= escalationHistoryLimit && streak[len(streak)-1] == lastBuildWithOutcome(history)
+}
+
+// lastBuildWithOutcome returns the oldest build of the history that succeeded or failed
+func lastBuildWithOutcome(history []*buildDomain.BuildEvent) *buildDomain.BuildEvent {
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].HasBuildOutcome() {
+			return history[i]
+		}
+	}
+	return nil
+}
+
+// sendEscalation sends an escalation step to the chat and email recipients of a policy.
+// It fails only when no target was reached, so the step is retried on the next evaluation.
+func (s *escalationService) sendEscalation(ctx context.Context, policy *domain.EscalationPolicy, failure *branchFailure, step int) error {
+	latest := failure.streak[0]
+	projectName := s.projectName(ctx, policy)
+	failingFor := time.Since(failure.escalation.FailingSince())
+
+	var errs []error
+	sent := 0
+
+	if chatID := policy.TelegramChatID(); chatID != nil {
+		message := formatTelegramEscalation(projectName, policy, failure, step, failingFor)
+		keyboard := domain.NewTelegramEscalationKeyboard(latest.ID(), latest.BuildURL())
+		if _, err := s.NotificationSender.SendTelegramNotification(ctx, *chatID, message, keyboard); err != nil {
+			errs = append(errs, fmt.Errorf(domain.ErrMsgSendTelegramNotification, err))
+		} else {
+			sent++
+		}
+	}
+
+	if recipients := policy.EmailRecipients(); len(recipients) > 0 {
+		subject := fmt.Sprintf("[ESCALATION] %s - %s failing for %s", projectName, policy.Branch(), formatFailingFor(failingFor))
+		body := formatEmailEscalation(projectName, policy, failure, step, failingFor)
+		for _, recipient := range recipients {
+			if err := s.NotificationSender.SendEmailNotification(ctx, recipient, subject, body); err != nil {
+				errs = append(errs, fmt.Errorf(domain.ErrMsgSendEmailNotification, err))
+				continue
+			}
+			sent++
+		}
+	}
+
+	if sent == 0 {
+		return errors.Join(errs...)
+	}
+	if len(errs) > 0 {
+		s.Logger.WithError(errors.Join(errs...)).WithField("policy_id", policy.ID().String()).
+			Warn("Escalation not sent to every target")
+	}
+
+	return nil
+}
+
+// projectName returns the name of the project of a policy, its ID when the project cannot be looked up
+func (s *escalationService) projectName(ctx context.Context, policy *domain.EscalationPolicy) string {
+	if s.ProjectRepo == nil {
+		return policy.ProjectID().String()
+	}
+
+	project, err := s.ProjectRepo.GetByID(ctx, policy.ProjectID())
+	if err != nil {
+		s.Logger.WithError(err).WithField("project_id", policy.ProjectID().String()).Warn("Failed to get project name")
+		return policy.ProjectID().String()
+	}
+
+	return project.Name()
+}
+
+// formatTelegramEscalation renders an escalation step as an HTML Telegram message
+func formatTelegramEscalation(
+	projectName string,
+	policy *domain.EscalationPolicy,
+	failure *branchFailure,
+	step int,
+	failingFor time.Duration,
+) string {
+	latest := failure.streak[0]
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "🚨 <b>Escalation %d/%d: %s</b>\n\n", step, len(policy.Steps()), html.EscapeString(projectName))
+	fmt.Fprintf(&b, "Branch <code>%s</code> has been failing for %s (%s).\n",
+		html.EscapeString(policy.Branch()), formatFailingFor(failingFor), formatFailedBuilds(len(failure.streak)))
+	if latest.CommitSHA() != "" {
+		fmt.Fprintf(&b, "Latest failure: <code>%s</code>", html.EscapeString(shortSHA(latest.CommitSHA())))
+		if latest.AuthorName() != "" {
+			fmt.Fprintf(&b, " by %s", html.EscapeString(latest.AuthorName()))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\nAcknowledge to stop further escalations.")
+
+	return b.String()
+}
+
+// formatEmailEscalation renders an escalation step as a plain text email
+func formatEmailEscalation(
+	projectName string,
+	policy *domain.EscalationPolicy,
+	failure *branchFailure,
+	step int,
+	failingFor time.Duration,
+) string {
+	latest := failure.streak[0]
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Escalation %d/%d: %s\n\n", step, len(policy.Steps()), projectName)
+	fmt.Fprintf(&b, "Branch %s has been failing for %s (%s).\n",
+		policy.Branch(), formatFailingFor(failingFor), formatFailedBuilds(len(failure.streak)))
+	if latest.CommitSHA() != "" {
+		fmt.Fprintf(&b, "Latest failure: %s", shortSHA(latest.CommitSHA()))
+		if latest.AuthorName() != "" {
+			fmt.Fprintf(&b, " by %s", latest.AuthorName())
+		}
+		b.WriteString("\n")
+	}
+	if latest.BuildURL() != "" {
+		fmt.Fprintf(&b, "Build: %s\n", latest.BuildURL())
+	}
+	b.WriteString("\nAcknowledge the escalation to stop further escalations.")
+
+	return b.String()
+}
+
+// formatFailingFor renders how long a branch has been failing in hours and minutes
+func formatFailingFor(d time.Duration) string {
+	d = d.Truncate(time.Minute)
+	hours, minutes := int(d/time.Hour), int(d%time.Hour/time.Minute)
+
+	switch {
+	case hours == 0:
+		return fmt.Sprintf("%dm", minutes)
+	case minutes == 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	}
+}
+
+// formatFailedBuilds renders the number of failed builds of a failure
+func formatFailedBuilds(count int) string {
+	if count == 1 {
+		return "1 failed build"
+	}
+	return fmt.Sprintf("%d failed builds", count)
+}
+
+// shortSHA shortens a commit SHA to the length Git shows by default
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
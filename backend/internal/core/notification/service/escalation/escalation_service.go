@@ -0,0 +1,264 @@
+package escalation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	buildPort "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	projectPort "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/project/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/sirupsen/logrus"
+)
+
+// Resource type constants
+const (
+	resourceEscalationPolicy = "escalation policy"
+	resourceEscalation       = "escalation"
+)
+
+type Dep struct {
+	EscalationPolicyRepo port.EscalationPolicyRepository
+	EscalationRepo       port.EscalationRepository
+	BuildEventRepo       buildPort.BuildEventRepository
+	NotificationSender   port.NotificationSender
+	// ProjectRepo is optional, escalations name the project ID without it
+	ProjectRepo projectPort.ProjectRepository
+	Logger      *logrus.Logger
+}
+
+// escalationService escalates branches that keep failing under the escalation policies of their project
+type escalationService struct {
+	Dep
+}
+
+// NewEscalationService creates a new escalation service
+func NewEscalationService(d Dep) port.EscalationService {
+	return &escalationService{
+		Dep: d,
+	}
+}
+
+// CreateEscalationPolicy creates an escalation policy for a branch of a project
+func (s *escalationService) CreateEscalationPolicy(
+	ctx context.Context,
+	projectID value_objects.ID,
+	req dto.CreateEscalationPolicyRequest,
+) (*domain.EscalationPolicy, error) {
+	policy, err := domain.NewEscalationPolicy(projectID, req.Branch, minutesToSteps(req.EscalateAfterMinutes), req.TelegramChatID, req.EmailRecipients)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.EscalationPolicyRepo.Create(ctx, policy); err != nil {
+		s.Logger.WithError(err).Error(domain.LogMsgCreateEscalationPolicy)
+		return nil, fmt.Errorf(domain.ErrMsgPersist, resourceEscalationPolicy, err)
+	}
+
+	s.Logger.WithFields(logrus.Fields{
+		"policy_id":  policy.ID().String(),
+		"project_id": projectID.String(),
+		"branch":     policy.Branch(),
+	}).Info("Escalation policy created successfully")
+
+	return policy, nil
+}
+
+// GetEscalationPolicy retrieves an escalation policy by its ID
+func (s *escalationService) GetEscalationPolicy(ctx context.Context, id value_objects.ID) (*domain.EscalationPolicy, error) {
+	policy, err := s.EscalationPolicyRepo.GetByID(ctx, id)
+	if err != nil {
+		s.Logger.WithError(err).WithField("policy_id", id.String()).Error(domain.LogMsgGetEscalationPolicy)
+		return nil, fmt.Errorf(domain.ErrMsgGet, resourceEscalationPolicy, err)
+	}
+
+	return policy, nil
+}
+
+// GetEscalationPoliciesByProject retrieves the escalation policies of a project
+func (s *escalationService) GetEscalationPoliciesByProject(ctx context.Context, projectID value_objects.ID) ([]*domain.EscalationPolicy, error) {
+	policies, err := s.EscalationPolicyRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		s.Logger.WithError(err).WithField("project_id", projectID.String()).Error(domain.LogMsgGetEscalationPolicy)
+		return nil, fmt.Errorf(domain.ErrMsgGet, resourceEscalationPolicy, err)
+	}
+
+	return policies, nil
+}
+
+// UpdateEscalationPolicy updates the branch, steps, targets or state of an escalation policy.
+// Moving the policy to another branch or deactivating it resolves its open escalation.
+func (s *escalationService) UpdateEscalationPolicy(
+	ctx context.Context,
+	id value_objects.ID,
+	req dto.UpdateEscalationPolicyRequest,
+) (*domain.EscalationPolicy, error) {
+	policy, err := s.GetEscalationPolicy(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	branch, wasActive := policy.Branch(), policy.IsActive()
+
+	if req.Branch != nil {
+		if err := policy.UpdateBranch(*req.Branch); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.EscalateAfterMinutes != nil {
+		if err := policy.UpdateSteps(minutesToSteps(req.EscalateAfterMinutes)); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.TelegramChatID != nil || req.EmailRecipients != nil {
+		chatID := policy.TelegramChatID()
+		if req.TelegramChatID != nil {
+			chatID = req.TelegramChatID
+			if *req.TelegramChatID == 0 {
+				chatID = nil
+			}
+		}
+		recipients := policy.EmailRecipients()
+		if req.EmailRecipients != nil {
+			recipients = *req.EmailRecipients
+		}
+		if err := policy.UpdateTargets(chatID, recipients); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.IsActive != nil {
+		if *req.IsActive {
+			policy.Activate()
+		} else {
+			policy.Deactivate()
+		}
+	}
+
+	if err := s.EscalationPolicyRepo.Update(ctx, policy); err != nil {
+		s.Logger.WithError(err).WithField("policy_id", id.String()).Error(domain.LogMsgUpdateEscalationPolicy)
+		return nil, fmt.Errorf(domain.ErrMsgUpdate, resourceEscalationPolicy, err)
+	}
+
+	if (wasActive && !policy.IsActive()) || branch != policy.Branch() {
+		if err := s.resolveOpenEscalation(ctx, policy); err != nil {
+			return nil, err
+		}
+	}
+
+	return policy, nil
+}
+
+// DeleteEscalationPolicy deletes an escalation policy
+func (s *escalationService) DeleteEscalationPolicy(ctx context.Context, id value_objects.ID) error {
+	if err := s.EscalationPolicyRepo.Delete(ctx, id); err != nil {
+		s.Logger.WithError(err).WithField("policy_id", id.String()).Error(domain.LogMsgDeleteEscalationPolicy)
+		return fmt.Errorf(domain.ErrMsgDelete, resourceEscalationPolicy, err)
+	}
+
+	return nil
+}
+
+// GetOpenEscalations retrieves the escalations of a project whose branch is still failing
+func (s *escalationService) GetOpenEscalations(ctx context.Context, projectID value_objects.ID) ([]*domain.Escalation, error) {
+	escalations, err := s.EscalationRepo.GetOpenByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf(domain.ErrMsgGet, resourceEscalation, err)
+	}
+
+	return escalations, nil
+}
+
+// AcknowledgeEscalations stops escalating the failing branches of a project, all of them when branch is empty.
+// A failure is acknowledged even when it was not escalated yet, so its escalation steps are never sent.
+func (s *escalationService) AcknowledgeEscalations(
+	ctx context.Context,
+	projectID value_objects.ID,
+	branch, acknowledgedBy string,
+) ([]*domain.Escalation, error) {
+	policies, err := s.GetEscalationPoliciesByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var acknowledged []*domain.Escalation
+	for _, policy := range policies {
+		if !policy.IsActive() || (branch != "" && policy.Branch() != branch) {
+			continue
+		}
+
+		failure, err := s.syncEscalation(ctx, policy)
+		if err != nil {
+			return nil, err
+		}
+		if failure == nil {
+			continue
+		}
+
+		escalation := failure.escalation
+		if !escalation.IsAcknowledged() {
+			escalation.Acknowledge(acknowledgedBy)
+			if err := s.EscalationRepo.Update(ctx, escalation); err != nil {
+				return nil, fmt.Errorf(domain.ErrMsgUpdate, resourceEscalation, err)
+			}
+
+			s.Logger.WithFields(logrus.Fields{
+				"escalation_id":   escalation.ID().String(),
+				"project_id":      projectID.String(),
+				"branch":          escalation.Branch(),
+				"acknowledged_by": acknowledgedBy,
+			}).Info("Escalation acknowledged")
+		}
+		acknowledged = append(acknowledged, escalation)
+	}
+
+	return acknowledged, nil
+}
+
+// IsEscalationChat checks if escalations of a project are sent to the chat
+func (s *escalationService) IsEscalationChat(ctx context.Context, projectID value_objects.ID, chatID int64) (bool, error) {
+	policies, err := s.GetEscalationPoliciesByProject(ctx, projectID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, policy := range policies {
+		if policy.IsActive() && policy.IsEscalationChat(chatID) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// resolveOpenEscalation resolves the open escalation of a policy, if there is one
+func (s *escalationService) resolveOpenEscalation(ctx context.Context, policy *domain.EscalationPolicy) error {
+	escalation, err := s.EscalationRepo.GetOpenByPolicyID(ctx, policy.ID())
+	if err != nil {
+		if errors.Is(err, domain.ErrEscalationNotFound) {
+			return nil
+		}
+		return fmt.Errorf(domain.ErrMsgGet, resourceEscalation, err)
+	}
+
+	escalation.Resolve()
+	if err := s.EscalationRepo.Update(ctx, escalation); err != nil {
+		return fmt.Errorf(domain.ErrMsgUpdate, resourceEscalation, err)
+	}
+
+	return nil
+}
+
+// minutesToSteps converts escalation steps given in minutes
+func minutesToSteps(minutes []int) []time.Duration {
+	steps := make([]time.Duration, len(minutes))
+	for i, m := range minutes {
+		steps[i] = time.Duration(m) * time.Minute
+	}
+	return steps
+}
@@ -142,21 +142,25 @@ func (s *notificationFormatterService) GetAvailableTemplateVariables(templateTyp
 	}
 
 	switch templateType {
-	case domain.TemplateTypeBuildSuccess:
+	case domain.TemplateTypeBuildSuccess, domain.TemplateTypeBuildFixed:
 		return append(baseVars, []string{
 			"BuildStatus",
 			"BuildBranch",
 			"BuildCommit",
+			"BuildAuthor",
 			"BuildDuration",
+			"EventName",
 		}...)
 
-	case domain.TemplateTypeBuildFailure:
+	case domain.TemplateTypeBuildFailure, domain.TemplateTypeBuildNewFailure, domain.TemplateTypeBuildStillFailing:
 		return append(baseVars, []string{
 			"BuildStatus",
 			"BuildBranch",
 			"BuildCommit",
+			"BuildAuthor",
 			"BuildDuration",
 			"ErrorMessage",
+			"EventName",
 		}...)
 
 	case domain.TemplateTypeDeployment:
@@ -164,6 +168,7 @@ func (s *notificationFormatterService) GetAvailableTemplateVariables(templateTyp
 			"BuildStatus",
 			"BuildBranch",
 			"BuildCommit",
+			"BuildAuthor",
 			"BuildDuration",
 			"Environment",
 		}...)
@@ -173,6 +178,36 @@ func (s *notificationFormatterService) GetAvailableTemplateVariables(templateTyp
 			"BuildStatus",
 			"BuildBranch",
 			"BuildCommit",
+			"BuildAuthor",
+			"EventName",
+		}...)
+
+	case domain.TemplateTypePush:
+		return append(baseVars, []string{
+			"BuildBranch",
+			"BuildCommit",
+			"BuildAuthor",
+			"CommitMessage",
+		}...)
+
+	case domain.TemplateTypePullRequest:
+		return append(baseVars, []string{
+			"BuildStatus",
+			"BuildBranch",
+			"BuildCommit",
+			"BuildAuthor",
+			"EventName",
+			"EventAction",
+			"Title",
+			"TargetBranch",
+		}...)
+
+	case domain.TemplateTypeRelease:
+		return append(baseVars, []string{
+			"BuildAuthor",
+			"Title",
+			"Tag",
+			"Changelog",
 		}...)
 
 	default:
@@ -0,0 +1,84 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/sirupsen/logrus"
+)
+
+// coalesceUntil returns when the coalescing window of a notification about the build event closes,
+// the zero time when it is not coalesced. Failures are never held back by the window; only the
+// notifications about the other builds of a commit are coalesced.
+func (s *notificationLogService) coalesceUntil(ctx context.Context, events *filteredBuildEvent, now time.Time) time.Time {
+	if s.CoalesceWindow <= 0 || s.DeliveryQueueRepo == nil {
+		return time.Time{}
+	}
+
+	// Only builds of a known commit are coalesced
+	buildEvent := s.loadFilteredBuildEvent(ctx, events)
+	if buildEvent == nil || buildEvent.CommitSHA() == "" || buildEvent.IsFailed() {
+		return time.Time{}
+	}
+
+	return now.Add(s.CoalesceWindow)
+}
+
+// coalesceDeferredNotification merges the deferred notifications of the same recipient about the commit of a
+// deferred notification into the latest one and cancels the others. It returns false when a later notification
+// is still deferred, which then sends the merged message once it is due.
+// Notifications are sent on their own when the commit cannot be resolved. Only Telegram messages are merged,
+// notifications of other channels, e.g. webhook payloads deferred by quiet hours, are each sent as they are.
+func (s *notificationLogService) coalesceDeferredNotification(ctx context.Context, log *domain.NotificationLog) (bool, error) {
+	if s.CoalesceWindow <= 0 || s.BuildEventRepo == nil || log.Channel() != domain.NotificationChannelTelegram {
+		return true, nil
+	}
+
+	buildEvent, err := s.BuildEventRepo.GetByID(ctx, log.BuildEventID())
+	if err != nil {
+		s.Logger.WithError(err).WithField("build_event_id", log.BuildEventID().String()).
+			Warn("Failed to get build event, notification is not coalesced")
+		return true, nil
+	}
+	if buildEvent.CommitSHA() == "" {
+		return true, nil
+	}
+
+	logs, err := s.NotificationRepo.GetDeferredByCommit(ctx, buildEvent.ProjectID(), buildEvent.CommitSHA(), log.Channel(), log.Recipient())
+	if err != nil {
+		s.Logger.WithError(err).WithField("log_id", log.ID().String()).
+			Warn("Failed to get deferred notifications of the commit, notification is not coalesced")
+		return true, nil
+	}
+	if len(logs) <= 1 {
+		return true, nil
+	}
+
+	latest, superseded, message := domain.CoalesceNotifications(logs)
+	if latest.ID() != log.ID() {
+		return false, nil
+	}
+
+	for _, previous := range superseded {
+		if err := previous.MarkAsCancelled(); err != nil {
+			return false, err
+		}
+		if err := s.NotificationRepo.Update(ctx, previous); err != nil {
+			return false, fmt.Errorf(domain.ErrMsgUpdateNotificationLog, err)
+		}
+	}
+	if err := log.UpdateMessage(message); err != nil {
+		return false, err
+	}
+
+	s.Logger.WithFields(logrus.Fields{
+		"log_id":          log.ID().String(),
+		"commit_sha":      buildEvent.CommitSHA(),
+		"recipient":       log.Recipient(),
+		"coalesced_count": len(superseded),
+	}).Info("Coalesced notifications of a commit")
+
+	return true, nil
+}
@@ -0,0 +1,235 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/sirupsen/logrus"
+)
+
+// digestBatch holds the batched notifications sent as one digest to a recipient
+type digestBatch struct {
+	channel   domain.NotificationChannel
+	recipient string
+	windowEnd time.Time
+	logs      []*domain.NotificationLog
+}
+
+// SendDueDigests sends a digest to each recipient with batched notifications whose digest window has ended.
+// Digests that fail to send keep their notifications batched, so the next run sends them again.
+func (s *notificationLogService) SendDueDigests(ctx context.Context, limit int) error {
+	s.Logger.WithField("limit", limit).Info("Sending due notification digests")
+
+	logs, err := s.NotificationRepo.GetDueDigestNotifications(ctx, time.Now(), limit)
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to get due digest notifications")
+		return fmt.Errorf("failed to get due digest notifications: %w", err)
+	}
+
+	batches := groupDigestBatches(logs)
+	for _, batch := range batches {
+		if err := s.sendDigest(ctx, batch); err != nil {
+			s.Logger.WithError(err).WithFields(logrus.Fields{
+				"channel":   batch.channel,
+				"recipient": batch.recipient,
+			}).Error("Failed to send notification digest")
+			// Continue with other digests even if one fails
+		}
+	}
+
+	s.Logger.WithFields(logrus.Fields{
+		"notifications_count": len(logs),
+		"digests_count":       len(batches),
+	}).Info("Completed sending notification digests")
+
+	return nil
+}
+
+// groupDigestBatches groups batched notifications by channel, recipient and digest window, keeping their order
+func groupDigestBatches(logs []*domain.NotificationLog) []*digestBatch {
+	var batches []*digestBatch
+	index := make(map[string]*digestBatch)
+	for _, log := range logs {
+		if log.DigestAt() == nil {
+			continue
+		}
+
+		key := fmt.Sprintf("%s|%s|%d", log.Channel(), log.Recipient(), log.DigestAt().Unix())
+		batch, ok := index[key]
+		if !ok {
+			batch = &digestBatch{
+				channel:   log.Channel(),
+				recipient: log.Recipient(),
+				windowEnd: *log.DigestAt(),
+			}
+			index[key] = batch
+			batches = append(batches, batch)
+		}
+		batch.logs = append(batch.logs, log)
+	}
+	return batches
+}
+
+// sendDigest renders the digest of a batch, sends it and marks its notifications as sent
+func (s *notificationLogService) sendDigest(ctx context.Context, batch *digestBatch) error {
+	digest, err := s.buildDigest(ctx, batch)
+	if err != nil {
+		return err
+	}
+
+	// Nothing left to summarize, e.g. the build events were deleted
+	if digest.IsEmpty() {
+		for _, log := range batch.logs {
+			_ = log.MarkAsCancelled()
+			if err := s.NotificationRepo.Update(ctx, log); err != nil {
+				return fmt.Errorf(domain.ErrMsgUpdateNotificationLog, err)
+			}
+		}
+		return nil
+	}
+
+	var messageID string
+	switch batch.channel {
+	case domain.NotificationChannelTelegram:
+		messageID, err = s.sendTelegramDigest(ctx, batch, digest)
+	case domain.NotificationChannelWebhook:
+		messageID, err = s.sendWebhookDigest(ctx, batch, digest)
+	default:
+		err = fmt.Errorf("digests are not supported for channel %s", batch.channel)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, log := range batch.logs {
+		if err := s.markNotificationAsSent(ctx, log, messageID); err != nil {
+			return err
+		}
+	}
+
+	s.Logger.WithFields(logrus.Fields{
+		"channel":             batch.channel,
+		"recipient":           batch.recipient,
+		"notifications_count": len(batch.logs),
+		"message_id":          messageID,
+	}).Info("Notification digest sent successfully")
+
+	return nil
+}
+
+// buildDigest summarizes the build events of a batch, each build event once with its current status
+func (s *notificationLogService) buildDigest(ctx context.Context, batch *digestBatch) (*domain.Digest, error) {
+	if s.BuildEventRepo == nil {
+		return nil, fmt.Errorf("digests need a build event repository")
+	}
+
+	var entries []domain.DigestEntry
+	seen := make(map[value_objects.ID]bool)
+	projectNames := make(map[value_objects.ID]string)
+	for _, log := range batch.logs {
+		if seen[log.BuildEventID()] {
+			continue
+		}
+		seen[log.BuildEventID()] = true
+
+		buildEvent, err := s.BuildEventRepo.GetByID(ctx, log.BuildEventID())
+		if err != nil {
+			s.Logger.WithError(err).WithField("build_event_id", log.BuildEventID().String()).
+				Warn("Failed to get build event, leaving it out of the digest")
+			continue
+		}
+
+		projectID := buildEvent.ProjectID()
+		name, ok := projectNames[projectID]
+		if !ok {
+			name = s.projectName(ctx, projectID)
+			projectNames[projectID] = name
+		}
+
+		entries = append(entries, domain.DigestEntry{
+			ProjectName: name,
+			Branch:      buildEvent.Branch(),
+			EventType:   buildEvent.EventType(),
+			Status:      buildEvent.Status(),
+			CommitSHA:   buildEvent.CommitSHA(),
+			BuildURL:    buildEvent.BuildURL(),
+		})
+	}
+
+	return domain.NewDigest(batch.windowEnd, entries), nil
+}
+
+// projectName returns the name of a project, falling back to its ID
+func (s *notificationLogService) projectName(ctx context.Context, projectID value_objects.ID) string {
+	if s.ProjectRepo == nil {
+		return projectID.String()
+	}
+
+	project, err := s.ProjectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		s.Logger.WithError(err).WithField("project_id", projectID.String()).Warn("Failed to get project name for digest")
+		return projectID.String()
+	}
+	return project.Name()
+}
+
+// sendTelegramDigest sends a digest to a Telegram chat
+func (s *notificationLogService) sendTelegramDigest(ctx context.Context, batch *digestBatch, digest *domain.Digest) (string, error) {
+	chatID, err := s.parseTelegramChatID(batch.recipient)
+	if err != nil {
+		return "", err
+	}
+
+	messageID, err := s.NotificationSender.SendTelegramNotification(ctx, chatID, digest.RenderTelegram(), nil)
+	if err != nil {
+		return "", fmt.Errorf(domain.ErrMsgSendTelegramNotification, err)
+	}
+	return messageID, nil
+}
+
+// sendWebhookDigest sends a digest event to a webhook subscription, which belongs to a single project.
+// The ID of the first notification is the delivery ID, so a resent digest keeps its ID.
+func (s *notificationLogService) sendWebhookDigest(
+	ctx context.Context,
+	batch *digestBatch,
+	digest *domain.Digest,
+) (string, error) {
+	if s.WebhookSubscriptionRepo == nil {
+		return "", fmt.Errorf("webhook subscriptions are not configured")
+	}
+
+	subscriptionID, err := value_objects.NewIDFromString(batch.recipient)
+	if err != nil {
+		return "", domain.NewInvalidRecipientError(batch.recipient)
+	}
+
+	subscription, err := s.WebhookSubscriptionRepo.GetByID(ctx, subscriptionID)
+	if err != nil {
+		return "", err
+	}
+	if !subscription.IsActive() {
+		return "", domain.ErrWebhookSubscriptionInactive
+	}
+
+	event := domain.NewOutgoingWebhookEvent(domain.WebhookEventDigest, batch.windowEnd, domain.WebhookEventData{
+		Project: domain.WebhookProject{
+			ID:   subscription.ProjectID().String(),
+			Name: s.projectName(ctx, subscription.ProjectID()),
+		},
+		Digest: digest,
+	})
+
+	deliveryID := batch.logs[0].ID().String()
+	delivery, err := domain.NewWebhookDelivery(deliveryID, subscription, event)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.NotificationSender.SendWebhookNotification(ctx, *delivery); err != nil {
+		return "", fmt.Errorf(domain.ErrMsgSendWebhookNotification, err)
+	}
+	return deliveryID, nil
+}
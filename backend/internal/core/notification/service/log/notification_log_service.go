@@ -2,10 +2,15 @@ package log
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	buildPort "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/port"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	projectPort "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/project/port"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
 	"github.com/sirupsen/logrus"
 )
@@ -14,7 +19,33 @@ type Dep struct {
 	NotificationRepo         port.NotificationLogRepository
 	TelegramSubscriptionRepo port.TelegramSubscriptionRepository
 	NotificationSender       port.NotificationSender
-	Logger                   *logrus.Logger
+	// RetryConfigRepo provides per-channel retry limits; the default configuration is used without it
+	RetryConfigRepo port.RetryConfigurationRepository
+	// DeadLetterRepo stores notifications that will not be retried; dead-lettering is off without it
+	DeadLetterRepo port.DeadLetterRepository
+	// DeliveryChannels deliver channels the notification sender does not handle, e.g. Teams
+	DeliveryChannels []port.DeliveryChannel
+	// WebhookSubscriptionRepo resolves webhook notifications addressed to a subscription ID
+	WebhookSubscriptionRepo port.WebhookSubscriptionRepository
+	// EditTelegramMessages edits the message sent for a build event when its status changes,
+	// so each run keeps a single message per chat
+	EditTelegramMessages bool
+	// TelegramActions adds inline buttons to Telegram notifications, e.g. to acknowledge a build or mute a project
+	TelegramActions bool
+	// BuildEventRepo links the view button of Telegram notifications to the build; the button is left out without it.
+	// It also resolves the build event matched against subscription filters; filters are not applied without it.
+	BuildEventRepo buildPort.BuildEventRepository
+	// ProjectRepo names the projects of digests; digests show project IDs without it
+	ProjectRepo projectPort.ProjectRepository
+	// DeliveryQueueRepo defers notifications during the quiet hours of a chat; quiet hours are ignored without it
+	DeliveryQueueRepo port.DeliveryQueueRepository
+	// BuildTransitions classifies builds for subscriptions that only want builds breaking or fixing a branch;
+	// those subscriptions get every notification without it
+	BuildTransitions buildPort.BuildTransitionService
+	// CoalesceWindow defers Telegram notifications so the builds of one commit are merged into a single message per chat.
+	// Coalescing needs the delivery queue and build event repository and is off when the window is 0.
+	CoalesceWindow time.Duration
+	Logger         *logrus.Logger
 }
 
 // notificationLogService implements notification log business logic
@@ -29,12 +60,32 @@ func NewNotificationLogService(d Dep) port.NotificationLogService {
 	}
 }
 
+// NewChannelNotificationService creates the service that fans build events out to project channel recipients
+func NewChannelNotificationService(d Dep) port.ChannelNotificationService {
+	return &notificationLogService{
+		Dep: d,
+	}
+}
+
 // CreateNotificationLog creates a new notification log
 func (s *notificationLogService) CreateNotificationLog(
 	ctx context.Context,
 	buildEventID, projectID value_objects.ID,
 	channel domain.NotificationChannel,
 	recipient, message string,
+) (*domain.NotificationLog, error) {
+	return s.createNotificationLog(ctx, buildEventID, projectID, channel, recipient, message, domain.DigestModeInstant, time.Time{})
+}
+
+// createNotificationLog creates a notification log, held back for the next digest when the recipient has a digest mode
+// or deferred until deferUntil when it is set
+func (s *notificationLogService) createNotificationLog(
+	ctx context.Context,
+	buildEventID, projectID value_objects.ID,
+	channel domain.NotificationChannel,
+	recipient, message string,
+	digestMode domain.DigestMode,
+	deferUntil time.Time,
 ) (*domain.NotificationLog, error) {
 	s.Logger.WithFields(logrus.Fields{
 		"build_event_id": buildEventID.String(),
@@ -50,6 +101,10 @@ func (s *notificationLogService) CreateNotificationLog(
 		return nil, fmt.Errorf(domain.ErrMsgCreateNotificationLog, err)
 	}
 
+	if err := s.holdNotificationLog(ctx, log, digestMode, deferUntil); err != nil {
+		return nil, err
+	}
+
 	// Persist the notification log
 	if err := s.NotificationRepo.Create(ctx, log); err != nil {
 		s.Logger.WithError(err).Error("Failed to persist notification log")
@@ -60,6 +115,27 @@ func (s *notificationLogService) CreateNotificationLog(
 	return log, nil
 }
 
+// holdNotificationLog holds a new notification back for the next digest when the recipient has a digest mode
+// or defers it until deferUntil when it is set
+func (s *notificationLogService) holdNotificationLog(
+	ctx context.Context,
+	log *domain.NotificationLog,
+	digestMode domain.DigestMode,
+	deferUntil time.Time,
+) error {
+	if digestMode.IsDigest() {
+		if err := log.HoldForDigest(digestMode.WindowEnd(time.Now())); err != nil {
+			return fmt.Errorf(domain.ErrMsgCreateNotificationLog, err)
+		}
+		return nil
+	}
+
+	if !deferUntil.IsZero() {
+		return s.deferNotification(ctx, log, deferUntil)
+	}
+	return nil
+}
+
 // CreateNotificationForBuildEvent creates notifications for all subscribed channels for a build event
 func (s *notificationLogService) CreateNotificationForBuildEvent(
 	ctx context.Context,
@@ -79,6 +155,8 @@ func (s *notificationLogService) CreateNotificationForBuildEvent(
 	}
 
 	var notifications []*domain.NotificationLog
+	now := time.Now()
+	events := &filteredBuildEvent{id: buildEventID}
 
 	// Create notification for each active subscription
 	for _, subscription := range subscriptions {
@@ -87,14 +165,37 @@ func (s *notificationLogService) CreateNotificationForBuildEvent(
 			continue
 		}
 
-		// Create notification log for telegram
-		log, err := s.CreateNotificationLog(
+		// Muted from the notification buttons
+		if subscription.IsMuted(now) {
+			s.Logger.WithField("chat_id", subscription.ChatID()).Debug("Skipping notification to muted chat")
+			continue
+		}
+
+		if !s.matchesSubscriptionFilter(ctx, events, subscription) {
+			s.Logger.WithField("chat_id", subscription.ChatID()).Debug("Skipping notification filtered out by subscription")
+			continue
+		}
+
+		if !s.matchesTransitionsOnly(ctx, events, subscription) {
+			s.Logger.WithField("chat_id", subscription.ChatID()).Debug("Skipping notification about a build not changing the branch status")
+			continue
+		}
+
+		// Create notification log for telegram, chats with a digest mode get it with their next digest,
+		// chats in their quiet hours once the quiet hours end and others once the coalescing window closed
+		deferUntil := s.quietHoursEnd(ctx, events, subscription.QuietHours(), subscription.GetChatIDString(), now)
+		if coalesceUntil := s.coalesceUntil(ctx, events, now); coalesceUntil.After(deferUntil) {
+			deferUntil = coalesceUntil
+		}
+		log, err := s.createNotificationLog(
 			ctx,
 			buildEventID,
 			projectID,
 			domain.NotificationChannelTelegram,
 			subscription.GetChatIDString(),
 			message,
+			subscription.DigestMode(),
+			deferUntil,
 		)
 		if err != nil {
 			s.Logger.WithError(err).WithField("chat_id", subscription.ChatID()).Error("Failed to create notification log")
@@ -103,6 +204,7 @@ func (s *notificationLogService) CreateNotificationForBuildEvent(
 
 		notifications = append(notifications, log)
 	}
+	s.cancelSupersededDeferred(ctx, buildEventID, notifications)
 
 	s.Logger.WithFields(logrus.Fields{
 		"build_event_id":      buildEventID.String(),
@@ -112,6 +214,131 @@ func (s *notificationLogService) CreateNotificationForBuildEvent(
 	return notifications, nil
 }
 
+// filteredBuildEvent holds the build event of a fan-out, loaded once for the first filtered subscription,
+// and its build transition, classified once for the first transitions only subscription
+type filteredBuildEvent struct {
+	id     value_objects.ID
+	event  *buildDomain.BuildEvent
+	loaded bool
+
+	transition        buildDomain.BuildTransition
+	transitionLoaded  bool
+	transitionUnknown bool
+}
+
+// matchesSubscriptionFilter checks if a subscription is notified about the build event.
+// Subscriptions are notified when the build event cannot be resolved, rather than silently missing builds.
+func (s *notificationLogService) matchesSubscriptionFilter(
+	ctx context.Context,
+	events *filteredBuildEvent,
+	subscription *domain.TelegramSubscription,
+) bool {
+	filter := subscription.Filter()
+	if filter.IsEmpty() {
+		return true
+	}
+
+	buildEvent := s.loadFilteredBuildEvent(ctx, events)
+	if buildEvent == nil {
+		return true
+	}
+	return filter.Matches(buildEvent)
+}
+
+// loadFilteredBuildEvent returns the build event of a fan-out, nil when it cannot be resolved
+func (s *notificationLogService) loadFilteredBuildEvent(ctx context.Context, events *filteredBuildEvent) *buildDomain.BuildEvent {
+	if !events.loaded {
+		events.loaded = true
+		if s.BuildEventRepo == nil {
+			s.Logger.Warn("Subscription filters are not applied without a build event repository")
+		} else if event, err := s.BuildEventRepo.GetByID(ctx, events.id); err != nil {
+			s.Logger.WithError(err).WithField("build_event_id", events.id.String()).
+				Warn("Failed to get build event, subscription filters are not applied")
+		} else {
+			events.event = event
+		}
+	}
+	return events.event
+}
+
+// CreateChannelNotificationsForBuildEvent creates a notification of the channel for each recipient of a build event
+func (s *notificationLogService) CreateChannelNotificationsForBuildEvent(
+	ctx context.Context,
+	buildEventID, projectID value_objects.ID,
+	channel domain.NotificationChannel,
+	recipients []string,
+	subject, body string,
+) ([]*domain.NotificationLog, error) {
+	s.Logger.WithFields(logrus.Fields{
+		"build_event_id":   buildEventID.String(),
+		"project_id":       projectID.String(),
+		"channel":          channel,
+		"recipients_count": len(recipients),
+	}).Info("Creating channel notifications for build event")
+
+	notifications := make([]*domain.NotificationLog, 0, len(recipients))
+	now := time.Now()
+	events := &filteredBuildEvent{id: buildEventID}
+	for _, recipient := range recipients {
+		log, err := domain.NewNotificationLog(buildEventID, projectID, channel, recipient, body, 3) // Default maxRetries = 3
+		if err != nil {
+			s.Logger.WithError(err).WithField("recipient", recipient).Error("Failed to create notification log entity")
+			return nil, fmt.Errorf(domain.ErrMsgCreateNotificationLog, err)
+		}
+		if subject != "" {
+			log.SetSubject(subject)
+		}
+
+		// Subscriptions with a digest mode get it with their next digest and subscriptions
+		// in their quiet hours once the quiet hours end
+		digestMode, deferUntil := domain.DigestModeInstant, time.Time{}
+		if subscription := s.recipientSubscription(ctx, channel, recipient); subscription != nil {
+			digestMode = subscription.DigestMode()
+			deferUntil = s.quietHoursEnd(ctx, events, subscription.QuietHours(), recipient, now)
+		}
+		if err := s.holdNotificationLog(ctx, log, digestMode, deferUntil); err != nil {
+			return nil, err
+		}
+
+		if err := s.NotificationRepo.Create(ctx, log); err != nil {
+			s.Logger.WithError(err).WithField("recipient", recipient).Error("Failed to persist notification log")
+			return nil, fmt.Errorf(domain.ErrMsgPersistNotificationLog, err)
+		}
+
+		notifications = append(notifications, log)
+	}
+
+	s.cancelSupersededDeferred(ctx, buildEventID, notifications)
+
+	return notifications, nil
+}
+
+// recipientSubscription returns the webhook subscription a channel recipient is addressed by.
+// Only webhook subscriptions have a digest mode and quiet hours, other recipients are notified right away.
+func (s *notificationLogService) recipientSubscription(
+	ctx context.Context,
+	channel domain.NotificationChannel,
+	recipient string,
+) *domain.WebhookSubscription {
+	if channel != domain.NotificationChannelWebhook || s.WebhookSubscriptionRepo == nil {
+		return nil
+	}
+
+	subscriptionID, err := value_objects.NewIDFromString(recipient)
+	if err != nil {
+		return nil
+	}
+
+	subscription, err := s.WebhookSubscriptionRepo.GetByID(ctx, subscriptionID)
+	if err != nil {
+		// Sending right away is better than holding back a notification for an unknown subscription
+		s.Logger.WithError(err).WithField("recipient", recipient).Warn("Failed to get webhook subscription")
+		return nil
+	}
+
+	return subscription
+}
+
 // SendNotification sends a notification and updates the log
 func (s *notificationLogService) SendNotification(ctx context.Context, notificationLogID value_objects.ID) error {
 	s.Logger.WithField("log_id", notificationLogID.String()).Info("Sending notification")
@@ -122,6 +349,18 @@ func (s *notificationLogService) SendNotification(ctx context.Context, notificat
 		return err
 	}
 
+	// Batched notifications are sent with the digest of their recipient
+	if log.IsBatched() {
+		s.Logger.WithField("log_id", notificationLogID.String()).Debug("Notification held back for digest")
+		return nil
+	}
+
+	// Deferred notifications are sent once the quiet hours of their recipient end
+	if log.IsDeferred() {
+		s.Logger.WithField("log_id", notificationLogID.String()).Debug("Notification deferred until quiet hours end")
+		return nil
+	}
+
 	// Send notification through appropriate channel
 	messageID, err := s.sendNotificationByChannel(ctx, log)
 	if err != nil {
@@ -154,10 +393,40 @@ func (s *notificationLogService) sendNotificationByChannel(ctx context.Context,
 	case domain.NotificationChannelWebhook:
 		return s.sendWebhookNotification(ctx, log)
 	default:
-		return "", fmt.Errorf("unsupported notification channel: %s", log.Channel())
+		return s.sendDeliveryChannelNotification(ctx, log)
 	}
 }
 
+// sendDeliveryChannelNotification sends a notification through the delivery channel registered for its channel
+func (s *notificationLogService) sendDeliveryChannelNotification(ctx context.Context, log *domain.NotificationLog) (string, error) {
+	for _, channel := range s.DeliveryChannels {
+		if channel.GetChannelType() != log.Channel() {
+			continue
+		}
+
+		if !channel.IsAvailable(ctx) {
+			return "", fmt.Errorf("delivery channel %s is not available", log.Channel())
+		}
+
+		// Failing fast leaves the notification to the retry job instead of hitting the provider limit
+		if limiter, ok := channel.(port.RecipientRateLimiter); ok {
+			if retryAfter := limiter.RetryAfter(log.Recipient()); retryAfter > 0 {
+				return "", &domain.RecipientRateLimitError{Channel: log.Channel(), RetryAfter: retryAfter}
+			}
+		}
+
+		messageID, err := channel.Send(ctx, log.Recipient(), log.Subject(), log.Message())
+		if err != nil {
+			s.Logger.WithError(err).WithField("channel", log.Channel()).Error("Failed to send notification")
+			return "", fmt.Errorf("failed to send %s notification: %w", log.Channel(), err)
+		}
+
+		return messageID, nil
+	}
+
+	return "", fmt.Errorf("unsupported notification channel: %s", log.Channel())
+}
+
 // sendTelegramNotification handles Telegram-specific notification sending
 func (s *notificationLogService) sendTelegramNotification(ctx context.Context, log *domain.NotificationLog) (string, error) {
 	chatID, err := s.parseTelegramChatID(log.Recipient())
@@ -165,7 +434,27 @@ func (s *notificationLogService) sendTelegramNotification(ctx context.Context, l
 		return "", err
 	}
 
-	messageID, err := s.NotificationSender.SendTelegramNotification(ctx, chatID, log.Message())
+	keyboard := s.telegramKeyboard(ctx, log)
+
+	if s.EditTelegramMessages {
+		if messageID := s.sentTelegramMessageID(ctx, log); messageID != "" {
+			err := s.NotificationSender.EditTelegramNotification(ctx, chatID, messageID, log.Message(), keyboard)
+			if err == nil {
+				return messageID, nil
+			}
+
+			var apiErr *domain.TelegramAPIError
+			if !errors.As(err, &apiErr) || !apiErr.IsPermanent() {
+				s.Logger.WithError(err).Error("Failed to edit telegram notification")
+				return "", fmt.Errorf(domain.ErrMsgSendTelegramNotification, err)
+			}
+
+			// The message cannot be edited anymore, e.g. it was deleted from the chat
+			s.Logger.WithError(err).WithField("message_id", messageID).Warn("Telegram message not editable, sending a new one")
+		}
+	}
+
+	messageID, err := s.NotificationSender.SendTelegramNotification(ctx, chatID, log.Message(), keyboard)
 	if err != nil {
 		s.Logger.WithError(err).Error("Failed to send telegram notification")
 		return "", fmt.Errorf(domain.ErrMsgSendTelegramNotification, err)
@@ -174,9 +463,59 @@ func (s *notificationLogService) sendTelegramNotification(ctx context.Context, l
 	return messageID, nil
 }
 
+// telegramKeyboard returns the action buttons of a Telegram notification, nil when actions are off
+func (s *notificationLogService) telegramKeyboard(ctx context.Context, log *domain.NotificationLog) *domain.TelegramInlineKeyboard {
+	if !s.TelegramActions {
+		return nil
+	}
+
+	buildURL := ""
+	if s.BuildEventRepo != nil {
+		buildEvent, err := s.BuildEventRepo.GetByID(ctx, log.BuildEventID())
+		if err != nil {
+			// The other buttons still work without the link
+			s.Logger.WithError(err).WithField("build_event_id", log.BuildEventID().String()).Warn("Failed to look up build URL")
+		} else {
+			buildURL = buildEvent.BuildURL()
+		}
+	}
+
+	return domain.NewTelegramBuildKeyboard(log.BuildEventID(), buildURL)
+}
+
+// sentTelegramMessageID returns the message last sent to the chat of a notification for the same build event
+func (s *notificationLogService) sentTelegramMessageID(ctx context.Context, log *domain.NotificationLog) string {
+	logs, err := s.NotificationRepo.GetByBuildEventID(ctx, log.BuildEventID())
+	if err != nil {
+		// Sending a new message is better than not notifying at all
+		s.Logger.WithError(err).Warn("Failed to look up sent telegram messages")
+		return ""
+	}
+
+	var latest *domain.NotificationLog
+	for _, previous := range logs {
+		if previous.ID() == log.ID() ||
+			previous.Channel() != domain.NotificationChannelTelegram ||
+			previous.Recipient() != log.Recipient() ||
+			previous.MessageID() == nil || *previous.MessageID() == "" ||
+			previous.SentAt() == nil {
+			continue
+		}
+
+		if latest == nil || previous.SentAt().ToTime().After(latest.SentAt().ToTime()) {
+			latest = previous
+		}
+	}
+
+	if latest == nil {
+		return ""
+	}
+	return *latest.MessageID()
+}
+
 // sendEmailNotification handles Email-specific notification sending
 func (s *notificationLogService) sendEmailNotification(ctx context.Context, log *domain.NotificationLog) (string, error) {
-	err := s.NotificationSender.SendEmailNotification(ctx, log.Recipient(), log.Message(), log.Message())
+	err := s.NotificationSender.SendEmailNotification(ctx, log.Recipient(), log.Subject(), log.Message())
 	if err != nil {
 		s.Logger.WithError(err).Error("Failed to send email notification")
 		return "", fmt.Errorf(domain.ErrMsgSendEmailNotification, err)
@@ -196,15 +535,57 @@ func (s *notificationLogService) sendSlackNotification(ctx context.Context, log
 	return messageID, nil
 }
 
-// sendWebhookNotification handles Webhook-specific notification sending
+// sendWebhookNotification handles Webhook-specific notification sending.
+// The notification ID is the delivery ID, so receivers see the same ID on every retry.
 func (s *notificationLogService) sendWebhookNotification(ctx context.Context, log *domain.NotificationLog) (string, error) {
-	err := s.NotificationSender.SendWebhookNotification(ctx, log.Recipient(), log.Message())
+	delivery, err := s.webhookDelivery(ctx, log)
 	if err != nil {
+		s.Logger.WithError(err).Error("Failed to prepare webhook delivery")
+		return "", fmt.Errorf(domain.ErrMsgSendWebhookNotification, err)
+	}
+
+	if err := s.NotificationSender.SendWebhookNotification(ctx, *delivery); err != nil {
 		s.Logger.WithError(err).Error("Failed to send webhook notification")
 		return "", fmt.Errorf(domain.ErrMsgSendWebhookNotification, err)
 	}
 
-	return "webhook-sent", nil // Webhook doesn't return messageID
+	return delivery.ID, nil
+}
+
+// webhookDelivery prepares the delivery of a webhook notification.
+// Subscriptions are loaded on every attempt, so retries use their current URL, secret and format.
+func (s *notificationLogService) webhookDelivery(ctx context.Context, log *domain.NotificationLog) (*domain.WebhookDelivery, error) {
+	deliveryID := log.ID().String()
+
+	subscriptionID, err := value_objects.NewIDFromString(log.Recipient())
+	if err != nil {
+		// Plain URL recipients receive the message as it is, unsigned
+		return &domain.WebhookDelivery{
+			ID:          deliveryID,
+			URL:         log.Recipient(),
+			ContentType: domain.WebhookContentTypeJSON,
+			Body:        []byte(log.Message()),
+		}, nil
+	}
+
+	if s.WebhookSubscriptionRepo == nil {
+		return nil, fmt.Errorf("webhook subscriptions are not configured")
+	}
+
+	subscription, err := s.WebhookSubscriptionRepo.GetByID(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if !subscription.IsActive() {
+		return nil, domain.ErrWebhookSubscriptionInactive
+	}
+
+	event, err := domain.DecodeOutgoingWebhookEvent(log.Message())
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewWebhookDelivery(deliveryID, subscription, event)
 }
 
 // parseTelegramChatID parses and validates Telegram chat ID from recipient string
@@ -217,9 +598,18 @@ func (s *notificationLogService) parseTelegramChatID(recipient string) (int64, e
 	return chatID, nil
 }
 
-// handleSendFailure handles notification send failure by marking as failed
+// handleSendFailure handles notification send failure by marking as failed,
+// or moving it to the dead-letter queue when it will not be retried
 func (s *notificationLogService) handleSendFailure(ctx context.Context, log *domain.NotificationLog, sendErr error) error {
 	log.MarkAsFailed(sendErr.Error())
+
+	if reason, ok := s.deadLetterReason(ctx, log, sendErr); ok {
+		if err := s.moveToDeadLetter(ctx, log, reason); err == nil {
+			return sendErr
+		}
+		// Keep the notification as failed so it is not lost
+	}
+
 	if updateErr := s.NotificationRepo.Update(ctx, log); updateErr != nil {
 		s.Logger.WithError(updateErr).Error(domain.LogMsgMarkNotificationFail)
 		// Return the original send error, but log the update error
@@ -227,6 +617,86 @@ func (s *notificationLogService) handleSendFailure(ctx context.Context, log *dom
 	return sendErr
 }
 
+// retryConfiguration returns the retry configuration of a channel, falling back to the default
+func (s *notificationLogService) retryConfiguration(ctx context.Context, channel domain.NotificationChannel) *domain.RetryConfiguration {
+	if s.RetryConfigRepo == nil {
+		return domain.GetDefaultRetryConfiguration()
+	}
+
+	config, err := s.RetryConfigRepo.GetByChannel(ctx, channel)
+	if err != nil || config == nil {
+		if err != nil && !errors.Is(err, domain.ErrRetryConfigurationNotFound) {
+			s.Logger.WithError(err).WithField("channel", channel).Warn(domain.LogMsgGetRetryConfig)
+		}
+		return domain.GetDefaultRetryConfiguration()
+	}
+
+	return config
+}
+
+// deadLetterEnabled checks if notifications of a channel may be dead-lettered
+func (s *notificationLogService) deadLetterEnabled(ctx context.Context, channel domain.NotificationChannel) (*domain.RetryConfiguration, bool) {
+	if s.DeadLetterRepo == nil {
+		return nil, false
+	}
+
+	config := s.retryConfiguration(ctx, channel)
+	return config, config.EnableDeadLetterQueue()
+}
+
+// deadLetterReason decides whether a failed notification goes to the dead-letter queue
+func (s *notificationLogService) deadLetterReason(ctx context.Context, log *domain.NotificationLog, sendErr error) (domain.DeadLetterReason, bool) {
+	config, enabled := s.deadLetterEnabled(ctx, log.Channel())
+	if !enabled {
+		return "", false
+	}
+
+	if config.IsPermanentError(sendErr) {
+		return domain.DeadLetterReasonPermanentError, true
+	}
+
+	if config.IsExhausted(log.AttemptCount()) {
+		return domain.DeadLetterReasonRetriesExhausted, true
+	}
+
+	return "", false
+}
+
+// moveToDeadLetter stores a failed notification in the dead-letter queue and marks its log
+func (s *notificationLogService) moveToDeadLetter(ctx context.Context, log *domain.NotificationLog, reason domain.DeadLetterReason) error {
+	deadLetter, err := domain.NewDeadLetterNotification(log, reason)
+	if err != nil {
+		s.Logger.WithError(err).Error(domain.LogMsgDeadLetterNotification)
+		return fmt.Errorf(domain.ErrMsgDeadLetterNotification, err)
+	}
+
+	if err := s.DeadLetterRepo.Create(ctx, deadLetter); err != nil {
+		s.Logger.WithError(err).WithField("log_id", log.ID().String()).Error(domain.LogMsgDeadLetterNotification)
+		return fmt.Errorf(domain.ErrMsgDeadLetterNotification, err)
+	}
+
+	if err := log.MarkAsDeadLettered(); err != nil {
+		s.Logger.WithError(err).WithField("log_id", log.ID().String()).Error(domain.LogMsgDeadLetterNotification)
+		return fmt.Errorf(domain.ErrMsgDeadLetterNotification, err)
+	}
+
+	if err := s.NotificationRepo.Update(ctx, log); err != nil {
+		s.Logger.WithError(err).WithField("log_id", log.ID().String()).Error(domain.LogMsgDeadLetterNotification)
+		return fmt.Errorf(domain.ErrMsgDeadLetterNotification, err)
+	}
+
+	s.Logger.WithFields(logrus.Fields{
+		"log_id":         log.ID().String(),
+		"dead_letter_id": deadLetter.ID().String(),
+		"channel":        log.Channel(),
+		"recipient":      log.Recipient(),
+		"reason":         reason,
+		"attempts":       deadLetter.AttemptCount(),
+	}).Warn("Notification moved to the dead-letter queue")
+
+	return nil
+}
+
 // markNotificationAsSent marks notification as sent and updates the repository
 func (s *notificationLogService) markNotificationAsSent(ctx context.Context, log *domain.NotificationLog, messageID string) error {
 	if err := log.MarkAsSent(&messageID); err != nil {
@@ -377,13 +847,9 @@ func (s *notificationLogService) ProcessFailedNotifications(ctx context.Context,
 
 	// Process each failed notification
 	for _, log := range failedLogs {
-		// Try to send the notification again
-		if err := s.SendNotification(ctx, log.ID()); err != nil {
+		if err := s.RetryFailedNotification(ctx, log.ID()); err != nil {
 			s.Logger.WithError(err).WithField("log_id", log.ID().String()).Error("Failed to retry notification")
-			// Mark as retrying if retry count hasn't exceeded
-			if retryErr := s.MarkNotificationAsRetrying(ctx, log.ID(), log.RetryCount(), err.Error()); retryErr != nil {
-				s.Logger.WithError(retryErr).WithField("log_id", log.ID().String()).Error(domain.LogMsgMarkNotificationAsRetrying)
-			}
+			// Continue with other notifications even if one fails
 		}
 	}
 
@@ -412,13 +878,25 @@ func (s *notificationLogService) RetryFailedNotification(ctx context.Context, no
 		return err
 	}
 
+	// Count the retry before sending so a failure is recorded against this attempt
+	if err := log.MarkAsRetrying(); err != nil {
+		s.Logger.WithError(err).Error(domain.LogMsgMarkNotificationAsRetrying)
+		if _, enabled := s.deadLetterEnabled(ctx, log.Channel()); enabled {
+			if dlqErr := s.moveToDeadLetter(ctx, log, domain.DeadLetterReasonRetriesExhausted); dlqErr != nil {
+				return dlqErr
+			}
+		}
+		return fmt.Errorf(domain.ErrMsgMarkNotificationAsRetry, err)
+	}
+
+	if err := s.NotificationRepo.Update(ctx, log); err != nil {
+		s.Logger.WithError(err).Error(domain.LogMsgMarkNotificationAsRetrying)
+		return fmt.Errorf(domain.ErrMsgMarkNotificationAsRetry, err)
+	}
+
 	// Try to send the notification again
 	if err := s.SendNotification(ctx, notificationLogID); err != nil {
 		s.Logger.WithError(err).Error("Failed to retry notification")
-		// Mark as retrying if retry count hasn't exceeded
-		if retryErr := s.MarkNotificationAsRetrying(ctx, notificationLogID, log.RetryCount(), err.Error()); retryErr != nil {
-			s.Logger.WithError(retryErr).Error(domain.LogMsgMarkNotificationAsRetrying)
-		}
 		return err
 	}
 
@@ -0,0 +1,211 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/sirupsen/logrus"
+)
+
+// deferredVisibilityTimeout is how long a claimed deferred notification stays hidden from other instances
+const deferredVisibilityTimeout = 5 * time.Minute
+
+// quietHoursEnd returns when the quiet hours of a recipient end if a notification about the build event is deferred,
+// the zero time when it is sent right away
+func (s *notificationLogService) quietHoursEnd(
+	ctx context.Context,
+	events *filteredBuildEvent,
+	quietHours domain.QuietHours,
+	recipient string,
+	now time.Time,
+) time.Time {
+	until, ok := quietHours.DeferUntil(now)
+	if !ok {
+		return time.Time{}
+	}
+
+	if s.DeliveryQueueRepo == nil {
+		s.Logger.Warn("Quiet hours are not applied without a delivery queue")
+		return time.Time{}
+	}
+
+	// Events that cannot be resolved cannot pass the bypass filter and wait like any other
+	if buildEvent := s.loadFilteredBuildEvent(ctx, events); buildEvent != nil && quietHours.Bypasses(buildEvent) {
+		s.Logger.WithField("recipient", recipient).Debug("Notification bypasses quiet hours")
+		return time.Time{}
+	}
+
+	return until
+}
+
+// deferNotification defers a new notification and queues its delivery for the end of the quiet hours
+// or coalescing window
+func (s *notificationLogService) deferNotification(ctx context.Context, log *domain.NotificationLog, until time.Time) error {
+	if err := log.Defer(); err != nil {
+		return fmt.Errorf(domain.ErrMsgCreateNotificationLog, err)
+	}
+
+	queued := domain.NewQueuedNotification(log.ID(), log.Channel(), log.Recipient(), log.Message(), log.Subject(), 0, log.MaxRetries())
+	queued.Kind = domain.QueueKindDeferred
+	queued.ScheduledAt = until
+	if err := s.DeliveryQueueRepo.Create(ctx, queued); err != nil {
+		s.Logger.WithError(err).Error("Failed to queue deferred notification")
+		return fmt.Errorf("failed to queue deferred notification: %w", err)
+	}
+
+	s.Logger.WithFields(logrus.Fields{
+		"log_id":    log.ID().String(),
+		"recipient": log.Recipient(),
+		"until":     until,
+	}).Info("Notification deferred")
+
+	return nil
+}
+
+// SendDeferredNotifications sends the notifications whose quiet hours or coalescing window ended.
+// The delivery queue entry of a notification only defers it; once due, the notification is sent,
+// retried and dead-lettered like any other.
+func (s *notificationLogService) SendDeferredNotifications(ctx context.Context, limit int) error {
+	if s.DeliveryQueueRepo == nil {
+		return nil
+	}
+
+	s.Logger.WithField("limit", limit).Info("Sending deferred notifications")
+
+	queued, err := s.DeliveryQueueRepo.ClaimPending(ctx, domain.QueueKindDeferred, limit, deferredVisibilityTimeout)
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to claim deferred notifications")
+		return fmt.Errorf("failed to claim deferred notifications: %w", err)
+	}
+
+	for _, notification := range queued {
+		status, errorMessage := domain.DeliveryStatusDelivered, ""
+		if err := s.releaseDeferredNotification(ctx, notification); err != nil {
+			s.Logger.WithError(err).WithField("log_id", notification.NotificationID.String()).
+				Error("Failed to send deferred notification")
+			// Continue with other notifications even if one fails
+			status, errorMessage = domain.DeliveryStatusFailed, err.Error()
+		}
+
+		if err := s.DeliveryQueueRepo.UpdateStatus(ctx, notification.ID, status, errorMessage); err != nil {
+			s.Logger.WithError(err).WithField("queue_id", notification.ID.String()).
+				Error("Failed to update deferred notification status")
+		}
+	}
+
+	s.Logger.WithField("notifications_count", len(queued)).Info("Completed sending deferred notifications")
+
+	return nil
+}
+
+// releaseDeferredNotification puts a deferred notification back to pending and sends it,
+// merged with the other deferred notifications about its commit
+func (s *notificationLogService) releaseDeferredNotification(ctx context.Context, queued *domain.QueuedNotification) error {
+	log, err := s.getNotificationLog(ctx, queued.NotificationID)
+	if err != nil {
+		return err
+	}
+
+	// Already sent or cancelled, e.g. through the API
+	if !log.IsDeferred() {
+		return nil
+	}
+
+	superseded, err := s.supersededByLaterNotification(ctx, log)
+	if err != nil || superseded {
+		return err
+	}
+
+	latest, err := s.coalesceDeferredNotification(ctx, log)
+	if err != nil || !latest {
+		return err
+	}
+
+	if err := log.Release(); err != nil {
+		return err
+	}
+	if err := s.NotificationRepo.Update(ctx, log); err != nil {
+		return fmt.Errorf(domain.ErrMsgUpdateNotificationLog, err)
+	}
+
+	return s.SendNotification(ctx, log.ID())
+}
+
+// supersededByLaterNotification cancels a deferred notification when its recipient has since been notified about
+// the same build event. The later notification describes a newer state of the build, e.g. a failure sent right away
+// while the notification about the started run waited, which the deferred one must not be sent or edited over.
+func (s *notificationLogService) supersededByLaterNotification(ctx context.Context, log *domain.NotificationLog) (bool, error) {
+	logs, err := s.NotificationRepo.GetByBuildEventID(ctx, log.BuildEventID())
+	if err != nil {
+		return false, fmt.Errorf(domain.ErrMsgGetNotificationLog, err)
+	}
+
+	for _, later := range logs {
+		if later.ID() == log.ID() ||
+			later.Channel() != log.Channel() ||
+			later.Recipient() != log.Recipient() ||
+			later.Status() == domain.NotificationStatusCancelled ||
+			!later.CreatedAt().ToTime().After(log.CreatedAt().ToTime()) {
+			continue
+		}
+
+		if err := log.MarkAsCancelled(); err != nil {
+			return false, err
+		}
+		if err := s.NotificationRepo.Update(ctx, log); err != nil {
+			return false, fmt.Errorf(domain.ErrMsgUpdateNotificationLog, err)
+		}
+
+		s.Logger.WithFields(logrus.Fields{
+			"log_id":       log.ID().String(),
+			"later_log_id": later.ID().String(),
+		}).Info("Deferred notification superseded by a later notification of the build event")
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// cancelSupersededDeferred cancels the deferred notifications about a build event whose recipients were just notified
+// about it again. It runs once per fan-out; failing to look them up leaves them to the check when they are released.
+func (s *notificationLogService) cancelSupersededDeferred(
+	ctx context.Context,
+	buildEventID value_objects.ID,
+	notifications []*domain.NotificationLog,
+) {
+	if s.DeliveryQueueRepo == nil || len(notifications) == 0 {
+		return
+	}
+
+	created := make(map[value_objects.ID]bool, len(notifications))
+	recipients := make(map[string]bool, len(notifications))
+	for _, notification := range notifications {
+		created[notification.ID()] = true
+		recipients[string(notification.Channel())+":"+notification.Recipient()] = true
+	}
+
+	logs, err := s.NotificationRepo.GetByBuildEventID(ctx, buildEventID)
+	if err != nil {
+		s.Logger.WithError(err).WithField("build_event_id", buildEventID.String()).
+			Warn("Failed to get notifications of the build event, superseded deferred notifications are not cancelled")
+		return
+	}
+
+	for _, previous := range logs {
+		if created[previous.ID()] || !previous.IsDeferred() ||
+			!recipients[string(previous.Channel())+":"+previous.Recipient()] {
+			continue
+		}
+
+		if err := previous.MarkAsCancelled(); err != nil {
+			continue
+		}
+		if err := s.NotificationRepo.Update(ctx, previous); err != nil {
+			s.Logger.WithError(err).WithField("log_id", previous.ID().String()).
+				Warn("Failed to cancel superseded deferred notification")
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package log
+
+import (
+	"context"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+)
+
+// matchesTransitionsOnly checks if a subscription is notified about the build event when it only wants builds
+// that break or fix a branch. Subscriptions are notified when the transition cannot be resolved,
+// rather than silently missing builds.
+func (s *notificationLogService) matchesTransitionsOnly(
+	ctx context.Context,
+	events *filteredBuildEvent,
+	subscription *domain.TelegramSubscription,
+) bool {
+	if !subscription.TransitionsOnly() {
+		return true
+	}
+
+	if !events.transitionLoaded {
+		events.transitionLoaded = true
+		if s.BuildTransitions == nil {
+			s.Logger.Warn("Transitions only subscriptions get every notification without a build transition service")
+			events.transitionUnknown = true
+		} else if transition, ok, err := s.BuildTransitions.GetBuildTransition(ctx, events.id); err != nil {
+			s.Logger.WithError(err).WithField("build_event_id", events.id.String()).
+				Warn("Failed to classify build transition, transitions only subscriptions are notified")
+			events.transitionUnknown = true
+		} else if ok {
+			events.transition = transition
+		}
+	}
+
+	return events.transitionUnknown || events.transition.IsStatusChange()
+}
@@ -0,0 +1,233 @@
+package sender
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultDiscordTimeout    = 30 * time.Second
+	defaultDiscordMaxRetries = 3
+	// discordDefaultRetryAfter is used when a 429 response does not say how long to wait
+	discordDefaultRetryAfter = time.Second
+)
+
+// Discord rate limit response headers
+const (
+	discordHeaderRemaining  = "X-RateLimit-Remaining"
+	discordHeaderResetAfter = "X-RateLimit-Reset-After"
+)
+
+// DiscordConfig holds Discord configuration.
+// Discord needs no credentials, each project brings its own webhook URLs.
+type DiscordConfig struct {
+	Timeout    time.Duration // Defaults to 30 seconds
+	MaxRetries int           // Defaults to 3
+}
+
+// discordMessageResponse is the message Discord returns for webhook executions with wait=true
+type discordMessageResponse struct {
+	ID string `json:"id"`
+}
+
+// discordRateLimitResponse is the body of a 429 response
+type discordRateLimitResponse struct {
+	Message    string  `json:"message"`
+	RetryAfter float64 `json:"retry_after"`
+	Global     bool    `json:"global"`
+}
+
+// discordChannel delivers notifications to Discord webhooks as embeds.
+// It follows the rate limit bucket Discord reports for each webhook.
+type discordChannel struct {
+	config DiscordConfig
+	client *http.Client
+	logger *logrus.Logger
+
+	mu           sync.Mutex
+	blockedUntil map[string]time.Time
+}
+
+// NewDiscordChannel creates a delivery channel posting embeds to Discord webhook URLs
+func NewDiscordChannel(config DiscordConfig, logger *logrus.Logger) port.DeliveryChannel {
+	if config.Timeout <= 0 {
+		config.Timeout = defaultDiscordTimeout
+	}
+	if config.MaxRetries <= 0 {
+		config.MaxRetries = defaultDiscordMaxRetries
+	}
+
+	return &discordChannel{
+		config:       config,
+		client:       &http.Client{Timeout: config.Timeout},
+		logger:       logger,
+		blockedUntil: make(map[string]time.Time),
+	}
+}
+
+// Send executes a Discord webhook and returns the ID of the created message
+func (c *discordChannel) Send(ctx context.Context, recipient, subject, message string) (string, error) {
+	c.logger.WithField("subject", subject).Info("Sending Discord notification")
+
+	executeURL, err := discordExecuteURL(recipient)
+	if err != nil {
+		return "", err
+	}
+
+	discordMessage, err := domain.NewDiscordMessage(subject, domain.DecodeChannelMessage(message))
+	if err != nil {
+		return "", fmt.Errorf("failed to build discord embed: %w", err)
+	}
+
+	body, err := json.Marshal(discordMessage)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode discord message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, executeURL, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create discord request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to send discord request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		retryAfter := discordRetryAfter(resp)
+		c.block(recipient, retryAfter)
+		return "", &domain.RecipientRateLimitError{Channel: domain.NotificationChannelDiscord, RetryAfter: retryAfter}
+	}
+	c.recordRateLimit(recipient, resp.Header)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("discord returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(detail)))
+	}
+
+	var result discordMessageResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode discord response: %w", err)
+	}
+
+	c.logger.WithField("message_id", result.ID).Info("Discord notification sent successfully")
+	return result.ID, nil
+}
+
+// RetryAfter returns how long the webhook bucket of a recipient is exhausted
+func (c *discordChannel) RetryAfter(recipient string) time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	until, ok := c.blockedUntil[recipient]
+	if !ok {
+		return 0
+	}
+	if wait := time.Until(until); wait > 0 {
+		return wait
+	}
+	delete(c.blockedUntil, recipient)
+	return 0
+}
+
+// GetChannelType returns the Discord channel type
+func (c *discordChannel) GetChannelType() domain.NotificationChannel {
+	return domain.NotificationChannelDiscord
+}
+
+// IsAvailable reports Discord as available, as it needs no global configuration
+func (c *discordChannel) IsAvailable(ctx context.Context) bool {
+	return true
+}
+
+// GetMaxRetries returns the maximum number of retries for Discord
+func (c *discordChannel) GetMaxRetries() int {
+	return c.config.MaxRetries
+}
+
+// GetRateLimitInfo returns the default Discord rate limit
+func (c *discordChannel) GetRateLimitInfo() (int, time.Duration) {
+	rule := domain.DefaultRateLimitRules()[domain.NotificationChannelDiscord]
+	return rule.MaxRequests, rule.WindowSize
+}
+
+// recordRateLimit blocks a webhook until its bucket resets once no requests remain
+func (c *discordChannel) recordRateLimit(recipient string, header http.Header) {
+	if header.Get(discordHeaderRemaining) != "0" {
+		return
+	}
+	if resetAfter, ok := parseDiscordSeconds(header.Get(discordHeaderResetAfter)); ok {
+		c.block(recipient, resetAfter)
+	}
+}
+
+// block holds back a webhook for the given duration
+func (c *discordChannel) block(recipient string, wait time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	until := time.Now().Add(wait)
+	if until.After(c.blockedUntil[recipient]) {
+		c.blockedUntil[recipient] = until
+	}
+}
+
+// discordRetryAfter reads how long to wait from a 429 response
+func discordRetryAfter(resp *http.Response) time.Duration {
+	var body discordRateLimitResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1024)).Decode(&body); err == nil && body.RetryAfter > 0 {
+		return secondsToDuration(body.RetryAfter)
+	}
+	if wait, ok := parseDiscordSeconds(resp.Header.Get(discordHeaderResetAfter)); ok {
+		return wait
+	}
+	if wait, ok := parseDiscordSeconds(resp.Header.Get("Retry-After")); ok {
+		return wait
+	}
+	return discordDefaultRetryAfter
+}
+
+// parseDiscordSeconds parses the fractional seconds used by Discord rate limit headers
+func parseDiscordSeconds(value string) (time.Duration, bool) {
+	seconds, err := strconv.ParseFloat(value, 64)
+	if err != nil || seconds <= 0 {
+		return 0, false
+	}
+	return secondsToDuration(seconds), true
+}
+
+// secondsToDuration rounds fractional seconds up to whole milliseconds
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(math.Ceil(seconds*1000)) * time.Millisecond
+}
+
+// discordExecuteURL asks Discord to return the created message and to accept link buttons
+func discordExecuteURL(webhook string) (string, error) {
+	webhookURL, err := url.Parse(webhook)
+	if err != nil || webhookURL.Scheme != "https" || webhookURL.Host == "" {
+		return "", fmt.Errorf("invalid discord webhook URL")
+	}
+
+	query := webhookURL.Query()
+	query.Set("wait", "true")
+	query.Set("with_components", "true")
+	webhookURL.RawQuery = query.Encode()
+	return webhookURL.String(), nil
+}
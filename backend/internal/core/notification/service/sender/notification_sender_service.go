@@ -1,13 +1,18 @@
 package sender
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"net/http"
-	"strings"
+	"strconv"
+	"time"
 
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/crypto"
 	"github.com/sirupsen/logrus"
 )
 
@@ -19,6 +24,12 @@ const (
 	resourceWebhookMsg  = "webhook message"
 )
 
+// Outgoing webhook request settings
+const (
+	defaultWebhookTimeout = 30 * time.Second
+	webhookUserAgent      = "CICD-Status-Notifier-Webhook/" + domain.WebhookSchemaVersion
+)
+
 // EmailConfig holds email configuration
 type EmailConfig struct {
 	SMTPHost     string
@@ -26,19 +37,25 @@ type EmailConfig struct {
 	SMTPUsername string
 	SMTPPassword string
 	FromEmail    string
+	FromName     string
+	Security     EmailSecurity // Defaults to STARTTLS
+	Timeout      time.Duration // Defaults to 30 seconds
+	TLSConfig    *tls.Config   // Optional, e.g. to trust a private CA
 }
 
 // SlackConfig holds Slack configuration
 type SlackConfig struct {
-	WebhookURL string
-	BotToken   string
+	WebhookURL string // Default incoming webhook, used for channels without a webhook of their own
+	BotToken   string // Posts to channel names and IDs through chat.postMessage
+	APIBaseURL string // Optional, defaults to https://slack.com/api
 }
 
 type Dep struct {
-	TelegramBotToken string
-	EmailConfig      EmailConfig
-	SlackConfig      SlackConfig
-	Logger           *logrus.Logger
+	TelegramBotToken   string
+	TelegramAPIBaseURL string // Optional, defaults to https://api.telegram.org
+	EmailConfig        EmailConfig
+	SlackConfig        SlackConfig
+	Logger             *logrus.Logger
 }
 
 type notificationSenderService struct {
@@ -52,8 +69,13 @@ func NewNotificationSenderService(d Dep) port.NotificationSender {
 	}
 }
 
-// SendTelegramNotification sends a notification through Telegram
-func (s *notificationSenderService) SendTelegramNotification(ctx context.Context, chatID int64, message string) (messageID string, err error) {
+// SendTelegramNotification sends a notification through Telegram, with inline buttons when a keyboard is given
+func (s *notificationSenderService) SendTelegramNotification(
+	ctx context.Context,
+	chatID int64,
+	message string,
+	keyboard *domain.TelegramInlineKeyboard,
+) (messageID string, err error) {
 	s.Logger.WithFields(logrus.Fields{
 		"chat_id": chatID,
 		"message": message,
@@ -65,43 +87,27 @@ func (s *notificationSenderService) SendTelegramNotification(ctx context.Context
 		return "", fmt.Errorf(domain.ErrMsgSend, resourceTelegramMsg, err)
 	}
 
-	// Prepare the request URL
-	url := fmt.Sprintf("https://api.telegram.org/bot%s/sendMessage", s.TelegramBotToken)
-
-	// Prepare the request body
-	body := fmt.Sprintf(`{
-		"chat_id": %d,
-		"text": "%s",
-		"parse_mode": "HTML"
-	}`, chatID, escapeJSON(message))
-
-	// Create HTTP request
-	req, err := http.NewRequestWithContext(ctx, "POST", url, strings.NewReader(body))
-	if err != nil {
-		s.Logger.WithError(err).Error("Failed to create telegram request")
-		return "", fmt.Errorf(domain.ErrMsgSend, resourceTelegramMsg, err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-
-	// Send the request
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	var sent telegramMessage
+	err = s.callTelegram(ctx, "sendMessage", telegramSendMessageRequest{
+		ChatID:      chatID,
+		Text:        message,
+		ParseMode:   telegramParseModeHTML,
+		ReplyMarkup: keyboard,
+	}, &sent)
 	if err != nil {
-		s.Logger.WithError(err).Error("Failed to send telegram request")
-		return "", fmt.Errorf(domain.ErrMsgSend, resourceTelegramMsg, err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		err = fmt.Errorf("telegram API returned status: %d", resp.StatusCode)
-		s.Logger.WithError(err).Error("Telegram API error")
+		entry := s.Logger.WithError(err).WithField("chat_id", chatID)
+		var apiErr *domain.TelegramAPIError
+		if errors.As(err, &apiErr) {
+			entry = entry.WithFields(logrus.Fields{
+				"error_code":  apiErr.ErrorCode,
+				"description": apiErr.Description,
+			})
+		}
+		entry.Error("Telegram API error")
 		return "", fmt.Errorf(domain.ErrMsgSend, resourceTelegramMsg, err)
 	}
 
-	// For simplicity, return a placeholder message ID
-	// In a real implementation, you would parse the response to get the actual message ID
-	messageID = fmt.Sprintf("tg_%d_%s", chatID, resp.Header.Get("Date"))
+	messageID = strconv.FormatInt(sent.MessageID, 10)
 
 	s.Logger.WithFields(logrus.Fields{
 		"chat_id":    chatID,
@@ -111,6 +117,44 @@ func (s *notificationSenderService) SendTelegramNotification(ctx context.Context
 	return messageID, nil
 }
 
+// EditTelegramNotification replaces the text and inline buttons of a message sent earlier through Telegram
+func (s *notificationSenderService) EditTelegramNotification(
+	ctx context.Context,
+	chatID int64,
+	messageID, message string,
+	keyboard *domain.TelegramInlineKeyboard,
+) error {
+	s.Logger.WithFields(logrus.Fields{
+		"chat_id":    chatID,
+		"message_id": messageID,
+	}).Info(domain.LogMsgEditingTelegram)
+
+	if s.TelegramBotToken == "" {
+		err := fmt.Errorf("telegram bot token is not configured")
+		s.Logger.WithError(err).Error("Telegram bot token missing")
+		return fmt.Errorf(domain.ErrMsgSend, resourceTelegramMsg, err)
+	}
+
+	id, err := strconv.ParseInt(messageID, 10, 64)
+	if err != nil {
+		return fmt.Errorf(domain.ErrMsgSend, resourceTelegramMsg, fmt.Errorf("invalid message ID %q", messageID))
+	}
+
+	err = s.callTelegram(ctx, "editMessageText", telegramEditMessageTextRequest{
+		ChatID:      chatID,
+		MessageID:   id,
+		Text:        message,
+		ParseMode:   telegramParseModeHTML,
+		ReplyMarkup: keyboard,
+	}, nil)
+	if err != nil && !isTelegramMessageNotModified(err) {
+		s.Logger.WithError(err).WithField("chat_id", chatID).Error("Telegram API error")
+		return fmt.Errorf(domain.ErrMsgSend, resourceTelegramMsg, err)
+	}
+
+	return nil
+}
+
 // SendEmailNotification sends a notification through Email
 func (s *notificationSenderService) SendEmailNotification(ctx context.Context, to, subject, body string) error {
 	s.Logger.WithFields(logrus.Fields{
@@ -124,9 +168,17 @@ func (s *notificationSenderService) SendEmailNotification(ctx context.Context, t
 		return fmt.Errorf(domain.ErrMsgSend, resourceEmailMsg, err)
 	}
 
-	// TODO: Implement actual SMTP email sending
-	// For now, just simulate success
-	messageID := fmt.Sprintf("email_%s_%d", to, len(body))
+	email, err := domain.NewEmailMessage(subject, body)
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to build email message")
+		return fmt.Errorf(domain.ErrMsgSend, resourceEmailMsg, err)
+	}
+
+	messageID, err := s.sendMail(ctx, to, email)
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to send email over SMTP")
+		return fmt.Errorf(domain.ErrMsgSend, resourceEmailMsg, err)
+	}
 
 	s.Logger.WithFields(logrus.Fields{
 		"to":         to,
@@ -136,22 +188,37 @@ func (s *notificationSenderService) SendEmailNotification(ctx context.Context, t
 	return nil
 }
 
-// SendSlackNotification sends a notification through Slack
+// SendSlackNotification sends a notification through Slack.
+// The channel is either an incoming webhook URL or a channel name or ID posted to with the bot token.
+// Incoming webhooks do not return a message timestamp, so their message ID is empty.
 func (s *notificationSenderService) SendSlackNotification(ctx context.Context, channel, message string) (messageID string, err error) {
 	s.Logger.WithFields(logrus.Fields{
 		"channel": channel,
 		"message": message,
 	}).Info(domain.LogMsgSendingSlack)
 
-	if s.SlackConfig.WebhookURL == "" && s.SlackConfig.BotToken == "" {
+	slackMessage, err := domain.NewSlackMessage(domain.DecodeChannelMessage(message))
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to build slack message")
+		return "", fmt.Errorf(domain.ErrMsgSend, resourceSlackMsg, err)
+	}
+
+	switch {
+	case isSlackWebhookURL(channel):
+		err = s.postSlackWebhook(ctx, channel, slackMessage)
+	case channel != "" && s.SlackConfig.BotToken != "":
+		messageID, err = s.postSlackMessage(ctx, channel, slackMessage)
+	case s.SlackConfig.WebhookURL != "":
+		err = s.postSlackWebhook(ctx, s.SlackConfig.WebhookURL, slackMessage)
+	default:
 		err = fmt.Errorf("slack configuration is not set")
 		s.Logger.WithError(err).Error("Slack configuration missing")
 		return "", fmt.Errorf(domain.ErrMsgSend, resourceSlackMsg, err)
 	}
-
-	// TODO: Implement actual Slack API call
-	// For now, just simulate success
-	messageID = fmt.Sprintf("slack_%s_%d", channel, len(message))
+	if err != nil {
+		s.Logger.WithError(err).WithField("channel", channel).Error("Slack API error")
+		return "", fmt.Errorf(domain.ErrMsgSend, resourceSlackMsg, err)
+	}
 
 	s.Logger.WithFields(logrus.Fields{
 		"channel":    channel,
@@ -161,30 +228,49 @@ func (s *notificationSenderService) SendSlackNotification(ctx context.Context, c
 	return messageID, nil
 }
 
-// SendWebhookNotification sends a notification through Webhook
-func (s *notificationSenderService) SendWebhookNotification(ctx context.Context, webhookURL, payload string) error {
+// SendWebhookNotification posts a webhook delivery.
+// Every delivery carries its ID, event type and send time in headers. With a secret it is also
+// signed over "<timestamp>.<body>", so receivers can verify it and reject replays.
+func (s *notificationSenderService) SendWebhookNotification(ctx context.Context, delivery domain.WebhookDelivery) error {
 	s.Logger.WithFields(logrus.Fields{
-		"webhook_url": webhookURL,
-		"payload":     payload,
+		"delivery_id": delivery.ID,
+		"event":       delivery.EventType,
 	}).Info(domain.LogMsgSendingWebhook)
 
-	if webhookURL == "" {
+	if delivery.URL == "" {
 		err := fmt.Errorf("webhook URL is empty")
 		s.Logger.WithError(err).Error("Webhook URL missing")
 		return fmt.Errorf(domain.ErrMsgSend, resourceWebhookMsg, err)
 	}
 
 	// Create HTTP request
-	req, err := http.NewRequestWithContext(ctx, "POST", webhookURL, strings.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Body))
 	if err != nil {
 		s.Logger.WithError(err).Error("Failed to create webhook request")
 		return fmt.Errorf(domain.ErrMsgSend, resourceWebhookMsg, err)
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	contentType := delivery.ContentType
+	if contentType == "" {
+		contentType = domain.WebhookContentTypeJSON
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("User-Agent", webhookUserAgent)
+	if delivery.ID != "" {
+		req.Header.Set(domain.WebhookHeaderDelivery, delivery.ID)
+	}
+	if delivery.EventType != "" {
+		req.Header.Set(domain.WebhookHeaderEvent, delivery.EventType)
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set(domain.WebhookHeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	if delivery.Secret != "" {
+		req.Header.Set(domain.WebhookHeaderSignature, crypto.SignOutgoingWebhook(delivery.Secret, timestamp, delivery.Body))
+	}
 
 	// Send the request
-	client := &http.Client{}
+	client := &http.Client{Timeout: defaultWebhookTimeout}
 	resp, err := client.Do(req)
 	if err != nil {
 		s.Logger.WithError(err).Error("Failed to send webhook request")
@@ -198,24 +284,10 @@ func (s *notificationSenderService) SendWebhookNotification(ctx context.Context,
 		return fmt.Errorf(domain.ErrMsgSend, resourceWebhookMsg, err)
 	}
 
-	// Generate a message ID based on response
-	messageID := fmt.Sprintf("webhook_%s_%d", webhookURL, resp.StatusCode)
-
 	s.Logger.WithFields(logrus.Fields{
-		"webhook_url": webhookURL,
-		"message_id":  messageID,
+		"delivery_id": delivery.ID,
 		"status_code": resp.StatusCode,
 	}).Info(domain.WebhookNotificationSent)
 
 	return nil
 }
-
-// escapeJSON escapes special characters in JSON strings
-func escapeJSON(s string) string {
-	s = strings.ReplaceAll(s, "\\", "\\\\")
-	s = strings.ReplaceAll(s, "\"", "\\\"")
-	s = strings.ReplaceAll(s, "\n", "\\n")
-	s = strings.ReplaceAll(s, "\r", "\\r")
-	s = strings.ReplaceAll(s, "\t", "\\t")
-	return s
-}
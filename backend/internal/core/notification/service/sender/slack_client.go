@@ -0,0 +1,115 @@
+package sender
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+)
+
+const (
+	defaultSlackAPIBaseURL = "https://slack.com/api"
+	slackRequestTimeout    = 30 * time.Second
+)
+
+// slackPostMessageResponse is the response of chat.postMessage
+type slackPostMessageResponse struct {
+	OK      bool   `json:"ok"`
+	Error   string `json:"error,omitempty"`
+	Channel string `json:"channel,omitempty"`
+	TS      string `json:"ts,omitempty"`
+}
+
+// isSlackWebhookURL checks if a Slack channel is an incoming webhook URL
+func isSlackWebhookURL(channel string) bool {
+	return strings.HasPrefix(channel, "https://")
+}
+
+// postSlackWebhook posts a message to an incoming webhook, which answers with a plain "ok"
+func (s *notificationSenderService) postSlackWebhook(ctx context.Context, webhookURL string, message *domain.SlackMessage) error {
+	resp, err := s.postSlackJSON(ctx, webhookURL, "", message)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return slackStatusError(resp, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
+
+// postSlackMessage posts a message with chat.postMessage and returns its timestamp
+func (s *notificationSenderService) postSlackMessage(ctx context.Context, channel string, message *domain.SlackMessage) (string, error) {
+	baseURL := s.SlackConfig.APIBaseURL
+	if baseURL == "" {
+		baseURL = defaultSlackAPIBaseURL
+	}
+
+	payload := *message
+	payload.Channel = channel
+
+	resp, err := s.postSlackJSON(ctx, strings.TrimRight(baseURL, "/")+"/chat.postMessage", s.SlackConfig.BotToken, &payload)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", slackStatusError(resp, "")
+	}
+
+	var result slackPostMessageResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode slack response: %w", err)
+	}
+	if !result.OK {
+		return "", fmt.Errorf("slack API error: %s", result.Error)
+	}
+
+	return result.TS, nil
+}
+
+// postSlackJSON posts a Slack payload, authenticating with the bot token when one is given
+func (s *notificationSenderService) postSlackJSON(ctx context.Context, url, token string, payload interface{}) (*http.Response, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode slack message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create slack request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	client := &http.Client{Timeout: slackRequestTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send slack request: %w", err)
+	}
+
+	return resp, nil
+}
+
+// slackStatusError describes a failed Slack HTTP response, including the rate limit delay
+func slackStatusError(resp *http.Response, detail string) error {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return fmt.Errorf("slack rate limited, retry after %ss", resp.Header.Get("Retry-After"))
+	}
+	if detail != "" {
+		return fmt.Errorf("slack returned status %d: %s", resp.StatusCode, detail)
+	}
+	return fmt.Errorf("slack returned status %d", resp.StatusCode)
+}
@@ -0,0 +1,252 @@
+package sender
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+)
+
+// EmailSecurity selects how the SMTP connection is secured
+type EmailSecurity string
+
+const (
+	// EmailSecurityStartTLS upgrades a plain connection with STARTTLS, usually on port 587
+	EmailSecurityStartTLS EmailSecurity = "starttls"
+	// EmailSecurityTLS connects with implicit TLS, usually on port 465
+	EmailSecurityTLS EmailSecurity = "tls"
+	// EmailSecurityNone sends without encryption, only meant for local relays
+	EmailSecurityNone EmailSecurity = "none"
+)
+
+// IsValid checks if the email security mode is supported
+func (e EmailSecurity) IsValid() bool {
+	switch e {
+	case EmailSecurityStartTLS, EmailSecurityTLS, EmailSecurityNone:
+		return true
+	default:
+		return false
+	}
+}
+
+const (
+	defaultSMTPTimeout = 30 * time.Second
+	defaultSMTPPort    = 587
+)
+
+// sendMail delivers an email to a single recipient over SMTP and returns its Message-ID
+func (s *notificationSenderService) sendMail(ctx context.Context, to string, email *domain.EmailMessage) (string, error) {
+	cfg := s.EmailConfig
+
+	recipient, err := mail.ParseAddress(to)
+	if err != nil {
+		return "", fmt.Errorf("invalid recipient %q: %w", to, err)
+	}
+	from, err := mail.ParseAddress(cfg.FromEmail)
+	if err != nil {
+		return "", fmt.Errorf("invalid sender %q: %w", cfg.FromEmail, err)
+	}
+	if cfg.FromName != "" {
+		from.Name = cfg.FromName
+	}
+
+	messageID := newEmailMessageID(from.Address)
+	message, err := buildEmailMessage(from, recipient, messageID, email)
+	if err != nil {
+		return "", err
+	}
+
+	client, err := s.dialSMTP(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer client.Close()
+
+	if cfg.SMTPUsername != "" {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return "", fmt.Errorf("smtp server %s does not support authentication", cfg.SMTPHost)
+		}
+		auth := smtp.PlainAuth("", cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPHost)
+		if err := client.Auth(auth); err != nil {
+			return "", fmt.Errorf("smtp authentication failed: %w", err)
+		}
+	}
+
+	if err := client.Mail(from.Address); err != nil {
+		return "", fmt.Errorf("smtp MAIL FROM failed: %w", err)
+	}
+	if err := client.Rcpt(recipient.Address); err != nil {
+		return "", fmt.Errorf("smtp RCPT TO failed: %w", err)
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return "", fmt.Errorf("smtp DATA failed: %w", err)
+	}
+	if _, err := writer.Write(message); err != nil {
+		_ = writer.Close()
+		return "", fmt.Errorf("failed to write email: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return "", fmt.Errorf("smtp server rejected email: %w", err)
+	}
+
+	// The message is accepted at this point, a failing QUIT does not undo that
+	_ = client.Quit()
+
+	return messageID, nil
+}
+
+// dialSMTP connects to the SMTP server, securing the connection as configured
+func (s *notificationSenderService) dialSMTP(ctx context.Context) (*smtp.Client, error) {
+	cfg := s.EmailConfig
+
+	port := cfg.SMTPPort
+	if port == 0 {
+		port = defaultSMTPPort
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultSMTPTimeout
+	}
+	security := cfg.Security
+	if security == "" {
+		security = EmailSecurityStartTLS
+	}
+	if !security.IsValid() {
+		return nil, fmt.Errorf("unsupported email security mode: %s", security)
+	}
+
+	tlsConfig := &tls.Config{ServerName: cfg.SMTPHost, MinVersion: tls.VersionTLS12}
+	if cfg.TLSConfig != nil {
+		tlsConfig = cfg.TLSConfig.Clone()
+		if tlsConfig.ServerName == "" {
+			tlsConfig.ServerName = cfg.SMTPHost
+		}
+	}
+
+	address := net.JoinHostPort(cfg.SMTPHost, strconv.Itoa(port))
+	dialer := &net.Dialer{Timeout: timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to smtp server %s: %w", address, err)
+	}
+
+	// Bound the whole SMTP conversation by the timeout and the context deadline
+	deadline := time.Now().Add(timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	_ = conn.SetDeadline(deadline)
+
+	if security == EmailSecurityTLS {
+		tlsConn := tls.Client(conn, tlsConfig)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("tls handshake with smtp server failed: %w", err)
+		}
+		conn = tlsConn
+	}
+
+	client, err := smtp.NewClient(conn, cfg.SMTPHost)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to start smtp session: %w", err)
+	}
+
+	if security == EmailSecurityStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			client.Close()
+			return nil, fmt.Errorf("smtp server %s does not support STARTTLS", cfg.SMTPHost)
+		}
+		if err := client.StartTLS(tlsConfig); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("smtp STARTTLS failed: %w", err)
+		}
+	}
+
+	return client, nil
+}
+
+// buildEmailMessage renders a multipart/alternative MIME message with plain-text and HTML parts
+func buildEmailMessage(from, to *mail.Address, messageID string, email *domain.EmailMessage) ([]byte, error) {
+	var body bytes.Buffer
+	parts := multipart.NewWriter(&body)
+
+	alternatives := []struct {
+		contentType string
+		content     string
+	}{
+		{contentType: "text/plain; charset=UTF-8", content: email.TextBody},
+		{contentType: "text/html; charset=UTF-8", content: email.HTMLBody},
+	}
+	for _, alternative := range alternatives {
+		part, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {alternative.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create email part: %w", err)
+		}
+
+		encoder := quotedprintable.NewWriter(part)
+		if _, err := encoder.Write([]byte(toCRLF(alternative.content))); err != nil {
+			return nil, fmt.Errorf("failed to encode email part: %w", err)
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, fmt.Errorf("failed to encode email part: %w", err)
+		}
+	}
+	if err := parts.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finish email body: %w", err)
+	}
+
+	var message bytes.Buffer
+	headers := []struct{ key, value string }{
+		{"From", from.String()},
+		{"To", to.String()},
+		{"Subject", mime.QEncoding.Encode("utf-8", email.Subject)},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"Message-ID", messageID},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", mime.FormatMediaType("multipart/alternative", map[string]string{"boundary": parts.Boundary()})},
+	}
+	for _, header := range headers {
+		fmt.Fprintf(&message, "%s: %s\r\n", header.key, header.value)
+	}
+	message.WriteString("\r\n")
+	message.Write(body.Bytes())
+
+	return message.Bytes(), nil
+}
+
+// newEmailMessageID creates a unique Message-ID in the domain of the sender
+func newEmailMessageID(fromAddress string) string {
+	host := "localhost"
+	if at := strings.LastIndex(fromAddress, "@"); at >= 0 && at < len(fromAddress)-1 {
+		host = fromAddress[at+1:]
+	}
+
+	random := make([]byte, 12)
+	_, _ = rand.Read(random)
+	return fmt.Sprintf("<%d.%s@%s>", time.Now().UnixNano(), hex.EncodeToString(random), host)
+}
+
+// toCRLF normalizes line endings to CRLF as required by SMTP
+func toCRLF(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "\r\n", "\n"), "\n", "\r\n")
+}
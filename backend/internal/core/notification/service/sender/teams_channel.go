@@ -0,0 +1,123 @@
+package sender
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultTeamsTimeout    = 30 * time.Second
+	defaultTeamsMaxRetries = 3
+)
+
+// TeamsConfig holds Microsoft Teams configuration.
+// Teams needs no credentials, each project brings its own workflow or incoming webhook URLs.
+type TeamsConfig struct {
+	Timeout    time.Duration // Defaults to 30 seconds
+	MaxRetries int           // Defaults to 3
+}
+
+// teamsChannel delivers notifications to Teams as Adaptive Cards
+type teamsChannel struct {
+	config TeamsConfig
+	client *http.Client
+	logger *logrus.Logger
+}
+
+// NewTeamsChannel creates a delivery channel posting Adaptive Cards to Teams webhook URLs
+func NewTeamsChannel(config TeamsConfig, logger *logrus.Logger) port.DeliveryChannel {
+	if config.Timeout <= 0 {
+		config.Timeout = defaultTeamsTimeout
+	}
+	if config.MaxRetries <= 0 {
+		config.MaxRetries = defaultTeamsMaxRetries
+	}
+
+	return &teamsChannel{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+		logger: logger,
+	}
+}
+
+// Send posts a notification card to a Teams webhook URL.
+// Teams does not return an ID for webhook messages, so the message ID is empty.
+func (c *teamsChannel) Send(ctx context.Context, recipient, subject, message string) (string, error) {
+	c.logger.WithField("subject", subject).Info("Sending Teams notification")
+
+	webhookURL, err := url.Parse(recipient)
+	if err != nil || webhookURL.Scheme != "https" || webhookURL.Host == "" {
+		return "", fmt.Errorf("invalid teams webhook URL")
+	}
+
+	teamsMessage, err := domain.NewTeamsMessage(subject, domain.DecodeChannelMessage(message))
+	if err != nil {
+		return "", fmt.Errorf("failed to build teams card: %w", err)
+	}
+
+	body, err := json.Marshal(teamsMessage)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode teams card: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, recipient, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create teams request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to send teams request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	responseBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	detail := strings.TrimSpace(string(responseBody))
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return "", fmt.Errorf("teams rate limited, retry after %ss", resp.Header.Get("Retry-After"))
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("teams returned status %d: %s", resp.StatusCode, detail)
+	}
+	// Legacy incoming webhooks report delivery failures in a 200 response body
+	if strings.Contains(strings.ToLower(detail), "failed") {
+		return "", fmt.Errorf("teams rejected message: %s", detail)
+	}
+
+	c.logger.Info("Teams notification sent successfully")
+	return "", nil
+}
+
+// GetChannelType returns the Teams channel type
+func (c *teamsChannel) GetChannelType() domain.NotificationChannel {
+	return domain.NotificationChannelTeams
+}
+
+// IsAvailable reports Teams as available, as it needs no global configuration
+func (c *teamsChannel) IsAvailable(ctx context.Context) bool {
+	return true
+}
+
+// GetMaxRetries returns the maximum number of retries for Teams
+func (c *teamsChannel) GetMaxRetries() int {
+	return c.config.MaxRetries
+}
+
+// GetRateLimitInfo returns the default Teams rate limit
+func (c *teamsChannel) GetRateLimitInfo() (int, time.Duration) {
+	rule := domain.DefaultRateLimitRules()[domain.NotificationChannelTeams]
+	return rule.MaxRequests, rule.WindowSize
+}
@@ -0,0 +1,133 @@
+package sender
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+)
+
+const (
+	defaultTelegramAPIBaseURL = "https://api.telegram.org"
+	telegramRequestTimeout    = 30 * time.Second
+	telegramParseModeHTML     = "HTML"
+)
+
+// telegramResponse is the envelope of every Bot API response
+type telegramResponse struct {
+	OK          bool                        `json:"ok"`
+	Result      json.RawMessage             `json:"result,omitempty"`
+	ErrorCode   int                         `json:"error_code,omitempty"`
+	Description string                      `json:"description,omitempty"`
+	Parameters  *telegramResponseParameters `json:"parameters,omitempty"`
+}
+
+// telegramResponseParameters explains how a failed request can be retried
+type telegramResponseParameters struct {
+	RetryAfter int `json:"retry_after,omitempty"`
+}
+
+// telegramSendMessageRequest is the request of sendMessage
+type telegramSendMessageRequest struct {
+	ChatID      int64                          `json:"chat_id"`
+	Text        string                         `json:"text"`
+	ParseMode   string                         `json:"parse_mode,omitempty"`
+	ReplyMarkup *domain.TelegramInlineKeyboard `json:"reply_markup,omitempty"`
+}
+
+// telegramEditMessageTextRequest is the request of editMessageText
+type telegramEditMessageTextRequest struct {
+	ChatID      int64                          `json:"chat_id"`
+	MessageID   int64                          `json:"message_id"`
+	Text        string                         `json:"text"`
+	ParseMode   string                         `json:"parse_mode,omitempty"`
+	ReplyMarkup *domain.TelegramInlineKeyboard `json:"reply_markup,omitempty"`
+}
+
+// telegramMessage is the part of a sent message the notifier keeps
+type telegramMessage struct {
+	MessageID int64 `json:"message_id"`
+}
+
+// callTelegram calls a Bot API method and decodes its result.
+// Failures answered by the API are returned as *domain.TelegramAPIError.
+func (s *notificationSenderService) callTelegram(ctx context.Context, method string, payload, result interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode telegram request: %w", err)
+	}
+
+	baseURL := s.TelegramAPIBaseURL
+	if baseURL == "" {
+		baseURL = defaultTelegramAPIBaseURL
+	}
+	endpoint := fmt.Sprintf("%s/bot%s/%s", strings.TrimRight(baseURL, "/"), s.TelegramBotToken, method)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create telegram request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Timeout: telegramRequestTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		// The URL contains the bot token, keep it out of the error
+		return fmt.Errorf("failed to send telegram request: %w", unwrapURLError(err))
+	}
+	defer resp.Body.Close()
+
+	var response telegramResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&response); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return &domain.TelegramAPIError{Method: method, ErrorCode: resp.StatusCode, Description: http.StatusText(resp.StatusCode)}
+		}
+		return fmt.Errorf("failed to decode telegram response: %w", err)
+	}
+
+	if !response.OK {
+		apiErr := &domain.TelegramAPIError{
+			Method:      method,
+			ErrorCode:   response.ErrorCode,
+			Description: response.Description,
+		}
+		if apiErr.ErrorCode == 0 {
+			apiErr.ErrorCode = resp.StatusCode
+		}
+		if response.Parameters != nil && response.Parameters.RetryAfter > 0 {
+			apiErr.RetryAfter = time.Duration(response.Parameters.RetryAfter) * time.Second
+		}
+		return apiErr
+	}
+
+	if result != nil {
+		if err := json.Unmarshal(response.Result, result); err != nil {
+			return fmt.Errorf("failed to decode telegram result: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// isTelegramMessageNotModified checks if an edit failed only because the text did not change
+func isTelegramMessageNotModified(err error) bool {
+	var apiErr *domain.TelegramAPIError
+	return errors.As(err, &apiErr) && strings.Contains(apiErr.Description, "message is not modified")
+}
+
+// unwrapURLError drops the request URL from transport errors
+func unwrapURLError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err
+	}
+	return err
+}
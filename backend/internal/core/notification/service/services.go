@@ -3,12 +3,16 @@ package service
 // Package level constructors for easy import and backward compatibility
 
 import (
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/deadletter"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/delivery"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/escalation"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/formatter"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/log"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/retry"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/sender"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/subscription"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/template"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/webhooksubscription"
 )
 
 // Type aliases for backward compatibility
@@ -30,14 +34,30 @@ type (
 
 	// Telegram Subscription Service
 	TelegramSubscriptionDep = subscription.Dep
+
+	// Dead-Letter Service
+	DeadLetterDep = deadletter.Dep
+
+	// Outgoing Webhook Subscription Service
+	WebhookSubscriptionDep = webhooksubscription.Dep
+
+	// Escalation Service
+	EscalationDep = escalation.Dep
 )
 
 // Constructor aliases for backward compatibility
 var (
 	NewNotificationLogService       = log.NewNotificationLogService
+	NewChannelNotificationService   = log.NewChannelNotificationService
 	NewNotificationSenderService    = sender.NewNotificationSenderService
+	NewTeamsChannel                 = sender.NewTeamsChannel
+	NewDiscordChannel               = sender.NewDiscordChannel
 	NewNotificationTemplateService  = template.NewNotificationTemplateService
 	NewNotificationFormatterService = formatter.NewNotificationFormatterService
 	NewRetryService                 = retry.NewRetryService
 	NewTelegramSubscriptionService  = subscription.NewTelegramSubscriptionService
+	NewDeadLetterService            = deadletter.NewDeadLetterService
+	NewWebhookSubscriptionService   = webhooksubscription.NewWebhookSubscriptionService
+	NewEscalationService            = escalation.NewEscalationService
+	NewNotificationDeliveryService  = delivery.NewNotificationDeliveryService
 )
@@ -38,6 +38,10 @@ func (s *telegramSubscriptionService) CreateTelegramSubscription(
 	ctx context.Context,
 	projectID value_objects.ID,
 	chatID int64,
+	filter domain.SubscriptionFilter,
+	digestMode domain.DigestMode,
+	quietHours domain.QuietHours,
+	transitionsOnly bool,
 ) (*domain.TelegramSubscription, error) {
 	s.Logger.WithFields(logrus.Fields{
 		"project_id": projectID.String(),
@@ -56,6 +60,24 @@ func (s *telegramSubscriptionService) CreateTelegramSubscription(
 		return nil, fmt.Errorf(domain.ErrMsgCreate, resourceSubscription, err)
 	}
 
+	if !filter.IsEmpty() {
+		subscription.UpdateFilter(filter)
+	}
+
+	if digestMode != "" {
+		if err := subscription.UpdateDigestMode(digestMode); err != nil {
+			return nil, err
+		}
+	}
+
+	if !quietHours.IsEmpty() {
+		subscription.UpdateQuietHours(quietHours)
+	}
+
+	if transitionsOnly {
+		subscription.UpdateTransitionsOnly(true)
+	}
+
 	// Persist the subscription
 	if err := s.TelegramRepo.Create(ctx, subscription); err != nil {
 		s.Logger.WithError(err).Error("Failed to persist telegram subscription")
@@ -111,6 +133,10 @@ func (s *telegramSubscriptionService) UpdateTelegramSubscription(
 	id value_objects.ID,
 	chatID *int64,
 	isActive *bool,
+	filter *domain.SubscriptionFilter,
+	digestMode *domain.DigestMode,
+	quietHours *domain.QuietHours,
+	transitionsOnly *bool,
 ) (*domain.TelegramSubscription, error) {
 	s.Logger.WithField("id", id.String()).Info("Updating telegram subscription")
 
@@ -138,6 +164,28 @@ func (s *telegramSubscriptionService) UpdateTelegramSubscription(
 		}
 	}
 
+	// Replace the event filter if provided
+	if filter != nil {
+		subscription.UpdateFilter(*filter)
+	}
+
+	// Switch between instant and digest delivery if provided
+	if digestMode != nil {
+		if err := subscription.UpdateDigestMode(*digestMode); err != nil {
+			return nil, err
+		}
+	}
+
+	// Replace the quiet hours if provided, empty quiet hours remove them
+	if quietHours != nil {
+		subscription.UpdateQuietHours(*quietHours)
+	}
+
+	// Switch between all build notifications and transitions only if provided
+	if transitionsOnly != nil {
+		subscription.UpdateTransitionsOnly(*transitionsOnly)
+	}
+
 	// Update the subscription in repository
 	if err := s.TelegramRepo.Update(ctx, subscription); err != nil {
 		s.Logger.WithError(err).Error(domain.LogMsgUpdateSubscription)
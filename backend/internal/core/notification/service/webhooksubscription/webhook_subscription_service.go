@@ -0,0 +1,202 @@
+package webhooksubscription
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/crypto"
+	"github.com/sirupsen/logrus"
+)
+
+// Resource type constants
+const (
+	resourceWebhookSubscription = "webhook subscription"
+)
+
+type Dep struct {
+	WebhookSubscriptionRepo port.WebhookSubscriptionRepository
+	Logger                  *logrus.Logger
+}
+
+// webhookSubscriptionService implements outgoing webhook subscription business logic
+type webhookSubscriptionService struct {
+	Dep
+}
+
+// NewWebhookSubscriptionService creates a new webhook subscription service
+func NewWebhookSubscriptionService(d Dep) port.WebhookSubscriptionService {
+	return &webhookSubscriptionService{
+		Dep: d,
+	}
+}
+
+// CreateWebhookSubscription subscribes an endpoint to the notifications of a project
+func (s *webhookSubscriptionService) CreateWebhookSubscription(
+	ctx context.Context,
+	projectID value_objects.ID,
+	req dto.CreateWebhookSubscriptionRequest,
+) (*domain.WebhookSubscription, error) {
+	secret := req.Secret
+	if secret == "" {
+		generated, err := crypto.GenerateWebhookSecret()
+		if err != nil {
+			return nil, fmt.Errorf(domain.ErrMsgCreate, resourceWebhookSubscription, err)
+		}
+		secret = generated
+	}
+
+	subscription, err := domain.NewWebhookSubscription(projectID, req.URL, secret, req.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.DigestMode != "" {
+		if err := subscription.UpdateDigestMode(req.DigestMode); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.QuietHours != nil {
+		quietHours, err := req.QuietHours.ToDomain()
+		if err != nil {
+			return nil, err
+		}
+		subscription.UpdateQuietHours(quietHours)
+	}
+
+	if err := s.WebhookSubscriptionRepo.Create(ctx, subscription); err != nil {
+		s.Logger.WithError(err).Error(domain.LogMsgCreateWebhookSubscription)
+		return nil, fmt.Errorf(domain.ErrMsgPersist, resourceWebhookSubscription, err)
+	}
+
+	s.Logger.WithFields(logrus.Fields{
+		"subscription_id": subscription.ID().String(),
+		"project_id":      projectID.String(),
+	}).Info("Webhook subscription created successfully")
+
+	return subscription, nil
+}
+
+// GetWebhookSubscription retrieves a webhook subscription by its ID
+func (s *webhookSubscriptionService) GetWebhookSubscription(ctx context.Context, id value_objects.ID) (*domain.WebhookSubscription, error) {
+	subscription, err := s.WebhookSubscriptionRepo.GetByID(ctx, id)
+	if err != nil {
+		s.Logger.WithError(err).WithField("subscription_id", id.String()).Error(domain.LogMsgGetWebhookSubscription)
+		return nil, fmt.Errorf(domain.ErrMsgGet, resourceWebhookSubscription, err)
+	}
+
+	return subscription, nil
+}
+
+// GetWebhookSubscriptionsByProject retrieves the webhook subscriptions of a project
+func (s *webhookSubscriptionService) GetWebhookSubscriptionsByProject(ctx context.Context, projectID value_objects.ID) ([]*domain.WebhookSubscription, error) {
+	subscriptions, err := s.WebhookSubscriptionRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		s.Logger.WithError(err).WithField("project_id", projectID.String()).Error(domain.LogMsgGetWebhookSubscription)
+		return nil, fmt.Errorf(domain.ErrMsgGet, resourceWebhookSubscription, err)
+	}
+
+	return subscriptions, nil
+}
+
+// GetActiveWebhookSubscriptions retrieves the webhook subscriptions notified about a project's events
+func (s *webhookSubscriptionService) GetActiveWebhookSubscriptions(ctx context.Context, projectID value_objects.ID) ([]*domain.WebhookSubscription, error) {
+	subscriptions, err := s.WebhookSubscriptionRepo.GetActiveByProjectID(ctx, projectID)
+	if err != nil {
+		s.Logger.WithError(err).WithField("project_id", projectID.String()).Error(domain.LogMsgGetWebhookSubscription)
+		return nil, fmt.Errorf(domain.ErrMsgGet, resourceWebhookSubscription, err)
+	}
+
+	return subscriptions, nil
+}
+
+// UpdateWebhookSubscription updates the endpoint, format, digest mode, quiet hours or state of a webhook subscription
+func (s *webhookSubscriptionService) UpdateWebhookSubscription(
+	ctx context.Context,
+	id value_objects.ID,
+	req dto.UpdateWebhookSubscriptionRequest,
+) (*domain.WebhookSubscription, error) {
+	subscription, err := s.GetWebhookSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != nil {
+		if err := subscription.UpdateURL(*req.URL); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Format != nil {
+		if err := subscription.UpdateFormat(*req.Format); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.DigestMode != nil {
+		if err := subscription.UpdateDigestMode(*req.DigestMode); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.QuietHours != nil {
+		quietHours, err := req.QuietHours.ToDomain()
+		if err != nil {
+			return nil, err
+		}
+		subscription.UpdateQuietHours(quietHours)
+	}
+
+	if req.IsActive != nil {
+		if *req.IsActive {
+			subscription.Activate()
+		} else {
+			subscription.Deactivate()
+		}
+	}
+
+	if err := s.WebhookSubscriptionRepo.Update(ctx, subscription); err != nil {
+		s.Logger.WithError(err).WithField("subscription_id", id.String()).Error(domain.LogMsgUpdateWebhookSubscription)
+		return nil, fmt.Errorf(domain.ErrMsgUpdate, resourceWebhookSubscription, err)
+	}
+
+	return subscription, nil
+}
+
+// RotateWebhookSubscriptionSecret replaces the signing secret of a webhook subscription
+func (s *webhookSubscriptionService) RotateWebhookSubscriptionSecret(ctx context.Context, id value_objects.ID) (*domain.WebhookSubscription, error) {
+	subscription, err := s.GetWebhookSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := crypto.GenerateWebhookSecret()
+	if err != nil {
+		return nil, fmt.Errorf(domain.ErrMsgUpdate, resourceWebhookSubscription, err)
+	}
+	if err := subscription.RotateSecret(secret); err != nil {
+		return nil, err
+	}
+
+	if err := s.WebhookSubscriptionRepo.Update(ctx, subscription); err != nil {
+		s.Logger.WithError(err).WithField("subscription_id", id.String()).Error(domain.LogMsgUpdateWebhookSubscription)
+		return nil, fmt.Errorf(domain.ErrMsgUpdate, resourceWebhookSubscription, err)
+	}
+
+	s.Logger.WithField("subscription_id", id.String()).Info("Webhook subscription secret rotated")
+	return subscription, nil
+}
+
+// DeleteWebhookSubscription deletes a webhook subscription
+func (s *webhookSubscriptionService) DeleteWebhookSubscription(ctx context.Context, id value_objects.ID) error {
+	if err := s.WebhookSubscriptionRepo.Delete(ctx, id); err != nil {
+		s.Logger.WithError(err).WithField("subscription_id", id.String()).Error(domain.LogMsgDeleteWebhookSubscription)
+		return fmt.Errorf(domain.ErrMsgDelete, resourceWebhookSubscription, err)
+	}
+
+	return nil
+}
@@ -1,10 +1,12 @@
 package domain
 
 import (
+	"net/mail"
 	"net/url"
 	"strings"
 
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
 )
 
 // ProjectStatus represents the status of a project
@@ -18,14 +20,18 @@ const (
 
 // Project represents a CI/CD project domain entity
 type Project struct {
-	id             value_objects.ID
-	name           string
-	repositoryURL  string
-	webhookSecret  string
-	telegramChatID *int64
-	status         ProjectStatus
-	createdAt      value_objects.Timestamp
-	updatedAt      value_objects.Timestamp
+	id              value_objects.ID
+	name            string
+	repositoryURL   string
+	webhookSecret   string
+	telegramChatID  *int64
+	emailRecipients []string
+	slackChannels   []string
+	teamsWebhooks   []string
+	discordWebhooks []string
+	status          ProjectStatus
+	createdAt       value_objects.Timestamp
+	updatedAt       value_objects.Timestamp
 }
 
 // NewProject creates a new project entity
@@ -97,6 +103,22 @@ func (p *Project) TelegramChatID() *int64 {
 	return p.telegramChatID
 }
 
+func (p *Project) EmailRecipients() []string {
+	return append([]string{}, p.emailRecipients...)
+}
+
+func (p *Project) SlackChannels() []string {
+	return append([]string{}, p.slackChannels...)
+}
+
+func (p *Project) TeamsWebhooks() []string {
+	return append([]string{}, p.teamsWebhooks...)
+}
+
+func (p *Project) DiscordWebhooks() []string {
+	return append([]string{}, p.discordWebhooks...)
+}
+
 func (p *Project) Status() ProjectStatus {
 	return p.status
 }
@@ -162,6 +184,58 @@ func (p *Project) UpdateTelegramChatID(chatID *int64) error {
 	return nil
 }
 
+// UpdateEmailRecipients replaces the email recipients of the project.
+// Addresses are normalized to their bare form and duplicates are dropped.
+func (p *Project) UpdateEmailRecipients(recipients []string) error {
+	normalized, err := normalizeEmailRecipients(recipients)
+	if err != nil {
+		return err
+	}
+
+	p.emailRecipients = normalized
+	p.updatedAt = value_objects.NewTimestamp()
+	return nil
+}
+
+// UpdateSlackChannels replaces the Slack channels of the project.
+// A channel is a channel name or ID posted to with the bot token, or an incoming webhook URL.
+func (p *Project) UpdateSlackChannels(channels []string) error {
+	normalized, err := normalizeSlackChannels(channels)
+	if err != nil {
+		return err
+	}
+
+	p.slackChannels = normalized
+	p.updatedAt = value_objects.NewTimestamp()
+	return nil
+}
+
+// UpdateTeamsWebhooks replaces the Microsoft Teams webhooks of the project.
+// A webhook is the https URL of a Teams workflow or incoming webhook.
+func (p *Project) UpdateTeamsWebhooks(webhooks []string) error {
+	normalized, err := normalizeWebhookURLs(webhooks, NewInvalidTeamsWebhookError)
+	if err != nil {
+		return err
+	}
+
+	p.teamsWebhooks = normalized
+	p.updatedAt = value_objects.NewTimestamp()
+	return nil
+}
+
+// UpdateDiscordWebhooks replaces the Discord webhooks of the project.
+// A webhook is the https URL of a Discord channel webhook.
+func (p *Project) UpdateDiscordWebhooks(webhooks []string) error {
+	normalized, err := normalizeWebhookURLs(webhooks, NewInvalidDiscordWebhookError)
+	if err != nil {
+		return err
+	}
+
+	p.discordWebhooks = normalized
+	p.updatedAt = value_objects.NewTimestamp()
+	return nil
+}
+
 // SetStatus sets the project status
 func (p *Project) SetStatus(status ProjectStatus) {
 	p.status = status
@@ -203,6 +277,26 @@ func (p *Project) CanReceiveNotifications() bool {
 	return p.IsActive() && p.telegramChatID != nil
 }
 
+// HasEmailRecipients checks if the project notifies anyone by email
+func (p *Project) HasEmailRecipients() bool {
+	return len(p.emailRecipients) > 0
+}
+
+// HasSlackChannels checks if the project notifies any Slack channel
+func (p *Project) HasSlackChannels() bool {
+	return len(p.slackChannels) > 0
+}
+
+// HasTeamsWebhooks checks if the project notifies any Microsoft Teams channel
+func (p *Project) HasTeamsWebhooks() bool {
+	return len(p.teamsWebhooks) > 0
+}
+
+// HasDiscordWebhooks checks if the project notifies any Discord channel
+func (p *Project) HasDiscordWebhooks() bool {
+	return len(p.discordWebhooks) > 0
+}
+
 // ValidateWebhookSecret validates if the provided secret matches the project's webhook secret
 func (p *Project) ValidateWebhookSecret(secret string) bool {
 	return p.webhookSecret == secret
@@ -210,14 +304,18 @@ func (p *Project) ValidateWebhookSecret(secret string) bool {
 
 // ProjectDBData holds the data needed to reconstruct a Project entity from database
 type ProjectDBData struct {
-	ID             value_objects.ID
-	Name           string
-	RepositoryURL  string
-	WebhookSecret  string
-	TelegramChatID *int64
-	Status         ProjectStatus
-	CreatedAt      value_objects.Timestamp
-	UpdatedAt      value_objects.Timestamp
+	ID              value_objects.ID
+	Name            string
+	RepositoryURL   string
+	WebhookSecret   string
+	TelegramChatID  *int64
+	EmailRecipients []string
+	SlackChannels   []string
+	TeamsWebhooks   []string
+	DiscordWebhooks []string
+	Status          ProjectStatus
+	CreatedAt       value_objects.Timestamp
+	UpdatedAt       value_objects.Timestamp
 }
 
 // NewProjectFromDB creates a project entity from database data for repository reconstruction.
@@ -230,13 +328,96 @@ type ProjectDBData struct {
 // Returns a pointer to the reconstructed Project entity.
 func NewProjectFromDB(data ProjectDBData) *Project {
 	return &Project{
-		id:             data.ID,
-		name:           data.Name,
-		repositoryURL:  data.RepositoryURL,
-		webhookSecret:  data.WebhookSecret,
-		telegramChatID: data.TelegramChatID,
-		status:         data.Status,
-		createdAt:      data.CreatedAt,
-		updatedAt:      data.UpdatedAt,
+		id:              data.ID,
+		name:            data.Name,
+		repositoryURL:   data.RepositoryURL,
+		webhookSecret:   data.WebhookSecret,
+		telegramChatID:  data.TelegramChatID,
+		emailRecipients: data.EmailRecipients,
+		slackChannels:   data.SlackChannels,
+		teamsWebhooks:   data.TeamsWebhooks,
+		discordWebhooks: data.DiscordWebhooks,
+		status:          data.Status,
+		createdAt:       data.CreatedAt,
+		updatedAt:       data.UpdatedAt,
+	}
+}
+
+// normalizeEmailRecipients parses email addresses, keeping the bare address of each unique recipient
+func normalizeEmailRecipients(recipients []string) ([]string, error) {
+	normalized := make([]string, 0, len(recipients))
+	seen := make(map[string]bool, len(recipients))
+
+	for _, recipient := range recipients {
+		address, err := mail.ParseAddress(strings.TrimSpace(recipient))
+		if err != nil {
+			return nil, NewInvalidEmailRecipientError(recipient)
+		}
+
+		key := strings.ToLower(address.Address)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		normalized = append(normalized, address.Address)
 	}
+
+	return normalized, nil
+}
+
+// normalizeSlackChannels validates Slack channels and incoming webhook URLs, dropping duplicates
+func normalizeSlackChannels(channels []string) ([]string, error) {
+	normalized := make([]string, 0, len(channels))
+	seen := make(map[string]bool, len(channels))
+
+	for _, channel := range channels {
+		channel = strings.TrimSpace(channel)
+		if !isValidSlackChannel(channel) {
+			return nil, NewInvalidSlackChannelError(channel)
+		}
+
+		if seen[channel] {
+			continue
+		}
+		seen[channel] = true
+		normalized = append(normalized, channel)
+	}
+
+	return normalized, nil
+}
+
+// isValidSlackChannel checks for a channel name (#builds), a channel ID (C0123ABC) or an https webhook URL
+func isValidSlackChannel(channel string) bool {
+	if strings.HasPrefix(channel, "https://") {
+		parsedURL, err := url.Parse(channel)
+		return err == nil && parsedURL.Host != ""
+	}
+
+	name := strings.TrimPrefix(channel, "#")
+	if name == "" || len(name) > 80 {
+		return false
+	}
+	return !strings.ContainsAny(name, " \t\n,#@")
+}
+
+// normalizeWebhookURLs validates https webhook URLs, dropping duplicates
+func normalizeWebhookURLs(webhooks []string, invalidError func(string) exception.DomainError) ([]string, error) {
+	normalized := make([]string, 0, len(webhooks))
+	seen := make(map[string]bool, len(webhooks))
+
+	for _, webhook := range webhooks {
+		webhook = strings.TrimSpace(webhook)
+		parsedURL, err := url.Parse(webhook)
+		if err != nil || parsedURL.Scheme != "https" || parsedURL.Host == "" {
+			return nil, invalidError(webhook)
+		}
+
+		if seen[webhook] {
+			continue
+		}
+		seen[webhook] = true
+		normalized = append(normalized, webhook)
+	}
+
+	return normalized, nil
 }
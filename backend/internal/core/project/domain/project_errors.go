@@ -6,13 +6,17 @@ import (
 
 // Project-specific error codes
 const (
-	ErrCodeProjectNotFound      = "PROJECT_NOT_FOUND"
-	ErrCodeProjectAlreadyExists = "PROJECT_ALREADY_EXISTS"
-	ErrCodeInvalidProjectName   = "INVALID_PROJECT_NAME"
-	ErrCodeInvalidRepositoryURL = "INVALID_REPOSITORY_URL"
-	ErrCodeProjectNotActive     = "PROJECT_NOT_ACTIVE"
-	ErrCodeInvalidWebhookSecret = "INVALID_WEBHOOK_SECRET"
-	ErrCodeInvalidTelegramChat  = "INVALID_TELEGRAM_CHAT"
+	ErrCodeProjectNotFound       = "PROJECT_NOT_FOUND"
+	ErrCodeProjectAlreadyExists  = "PROJECT_ALREADY_EXISTS"
+	ErrCodeInvalidProjectName    = "INVALID_PROJECT_NAME"
+	ErrCodeInvalidRepositoryURL  = "INVALID_REPOSITORY_URL"
+	ErrCodeProjectNotActive      = "PROJECT_NOT_ACTIVE"
+	ErrCodeInvalidWebhookSecret  = "INVALID_WEBHOOK_SECRET"
+	ErrCodeInvalidTelegramChat   = "INVALID_TELEGRAM_CHAT"
+	ErrCodeInvalidEmailRecipient = "INVALID_EMAIL_RECIPIENT"
+	ErrCodeInvalidSlackChannel   = "INVALID_SLACK_CHANNEL"
+	ErrCodeInvalidTeamsWebhook   = "INVALID_TEAMS_WEBHOOK"
+	ErrCodeInvalidDiscordWebhook = "INVALID_DISCORD_WEBHOOK"
 )
 
 // Project-specific domain errors
@@ -51,6 +55,26 @@ var (
 		ErrCodeInvalidTelegramChat,
 		"telegram chat ID is invalid",
 	)
+
+	ErrInvalidEmailRecipient = exception.NewDomainError(
+		ErrCodeInvalidEmailRecipient,
+		"email recipient is invalid",
+	)
+
+	ErrInvalidSlackChannel = exception.NewDomainError(
+		ErrCodeInvalidSlackChannel,
+		"slack channel is invalid",
+	)
+
+	ErrInvalidTeamsWebhook = exception.NewDomainError(
+		ErrCodeInvalidTeamsWebhook,
+		"teams webhook is invalid",
+	)
+
+	ErrInvalidDiscordWebhook = exception.NewDomainError(
+		ErrCodeInvalidDiscordWebhook,
+		"discord webhook is invalid",
+	)
 )
 
 // NewProjectNotFoundError creates a project not found error with context
@@ -68,3 +92,35 @@ func NewProjectAlreadyExistsError(name string) exception.DomainError {
 		"project already exists: "+name,
 	)
 }
+
+// NewInvalidEmailRecipientError creates an invalid email recipient error with context
+func NewInvalidEmailRecipientError(recipient string) exception.DomainError {
+	return exception.NewDomainError(
+		ErrCodeInvalidEmailRecipient,
+		"email recipient is invalid: "+recipient,
+	)
+}
+
+// NewInvalidSlackChannelError creates an invalid slack channel error with context
+func NewInvalidSlackChannelError(channel string) exception.DomainError {
+	return exception.NewDomainError(
+		ErrCodeInvalidSlackChannel,
+		"slack channel is invalid: "+channel,
+	)
+}
+
+// NewInvalidTeamsWebhookError creates an invalid teams webhook error with context
+func NewInvalidTeamsWebhookError(webhook string) exception.DomainError {
+	return exception.NewDomainError(
+		ErrCodeInvalidTeamsWebhook,
+		"teams webhook is invalid: "+webhook,
+	)
+}
+
+// NewInvalidDiscordWebhookError creates an invalid discord webhook error with context
+func NewInvalidDiscordWebhookError(webhook string) exception.DomainError {
+	return exception.NewDomainError(
+		ErrCodeInvalidDiscordWebhook,
+		"discord webhook is invalid: "+webhook,
+	)
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
@@ -17,6 +18,18 @@ type ProjectModel struct {
 	IsActive       bool      `gorm:"column:is_active;not null;default:true"`
 	CreatedAt      time.Time `gorm:"type:timestamp with time zone;default:now()"`
 	UpdatedAt      time.Time `gorm:"type:timestamp with time zone;default:now()"`
+
+	// Additional columns from migration 011
+	EmailRecipients json.RawMessage `gorm:"type:jsonb;column:email_recipients;not null;default:'[]'"`
+
+	// Additional columns from migration 012
+	SlackChannels json.RawMessage `gorm:"type:jsonb;column:slack_channels;not null;default:'[]'"`
+
+	// Additional columns from migration 013
+	TeamsWebhooks json.RawMessage `gorm:"type:jsonb;column:teams_webhooks;not null;default:'[]'"`
+
+	// Additional columns from migration 014
+	DiscordWebhooks json.RawMessage `gorm:"type:jsonb;column:discord_webhooks;not null;default:'[]'"`
 }
 
 // TableName specifies the table name for GORM
@@ -34,16 +47,40 @@ func (m *ProjectModel) ToEntity() (*Project, error) {
 	createdAt := value_objects.NewTimestampFromTime(m.CreatedAt)
 	updatedAt := value_objects.NewTimestampFromTime(m.UpdatedAt)
 
+	var emailRecipients []string
+	if len(m.EmailRecipients) > 0 {
+		_ = json.Unmarshal(m.EmailRecipients, &emailRecipients)
+	}
+
+	var slackChannels []string
+	if len(m.SlackChannels) > 0 {
+		_ = json.Unmarshal(m.SlackChannels, &slackChannels)
+	}
+
+	var teamsWebhooks []string
+	if len(m.TeamsWebhooks) > 0 {
+		_ = json.Unmarshal(m.TeamsWebhooks, &teamsWebhooks)
+	}
+
+	var discordWebhooks []string
+	if len(m.DiscordWebhooks) > 0 {
+		_ = json.Unmarshal(m.DiscordWebhooks, &discordWebhooks)
+	}
+
 	// Create entity from database data
 	dbData := ProjectDBData{
-		ID:             id,
-		Name:           m.Name,
-		RepositoryURL:  m.RepositoryURL,
-		WebhookSecret:  m.WebhookSecret,
-		TelegramChatID: m.TelegramChatID,
-		Status:         mapIsActiveToStatus(m.IsActive),
-		CreatedAt:      createdAt,
-		UpdatedAt:      updatedAt,
+		ID:              id,
+		Name:            m.Name,
+		RepositoryURL:   m.RepositoryURL,
+		WebhookSecret:   m.WebhookSecret,
+		TelegramChatID:  m.TelegramChatID,
+		EmailRecipients: emailRecipients,
+		SlackChannels:   slackChannels,
+		TeamsWebhooks:   teamsWebhooks,
+		DiscordWebhooks: discordWebhooks,
+		Status:          mapIsActiveToStatus(m.IsActive),
+		CreatedAt:       createdAt,
+		UpdatedAt:       updatedAt,
 	}
 
 	return NewProjectFromDB(dbData), nil
@@ -68,6 +105,22 @@ func (m *ProjectModel) FromEntity(project *Project) {
 	m.RepositoryURL = project.RepositoryURL()
 	m.WebhookSecret = project.WebhookSecret()
 	m.TelegramChatID = project.TelegramChatID()
+	m.EmailRecipients = json.RawMessage("[]")
+	if recipients := project.EmailRecipients(); len(recipients) > 0 {
+		m.EmailRecipients, _ = json.Marshal(recipients)
+	}
+	m.SlackChannels = json.RawMessage("[]")
+	if channels := project.SlackChannels(); len(channels) > 0 {
+		m.SlackChannels, _ = json.Marshal(channels)
+	}
+	m.TeamsWebhooks = json.RawMessage("[]")
+	if webhooks := project.TeamsWebhooks(); len(webhooks) > 0 {
+		m.TeamsWebhooks, _ = json.Marshal(webhooks)
+	}
+	m.DiscordWebhooks = json.RawMessage("[]")
+	if webhooks := project.DiscordWebhooks(); len(webhooks) > 0 {
+		m.DiscordWebhooks, _ = json.Marshal(webhooks)
+	}
 	// Map Status to IsActive boolean
 	m.IsActive = project.Status() == ProjectStatus("active")
 	m.CreatedAt = project.CreatedAt().ToTime()
@@ -7,29 +7,41 @@ import (
 
 // CreateProjectRequest represents the request to create a new project
 type CreateProjectRequest struct {
-	Name           string `json:"name" validate:"required,min=1,max=100"`
-	RepositoryURL  string `json:"repository_url" validate:"required,url"`
-	WebhookSecret  string `json:"webhook_secret" validate:"required,min=10"`
-	TelegramChatID *int64 `json:"telegram_chat_id,omitempty"`
+	Name            string   `json:"name" validate:"required,min=1,max=100"`
+	RepositoryURL   string   `json:"repository_url" validate:"required,url"`
+	WebhookSecret   string   `json:"webhook_secret" validate:"required,min=10"`
+	TelegramChatID  *int64   `json:"telegram_chat_id,omitempty"`
+	EmailRecipients []string `json:"email_recipients,omitempty" validate:"omitempty,max=50,dive,email"`
+	SlackChannels   []string `json:"slack_channels,omitempty" validate:"omitempty,max=20,dive,min=1"`
+	TeamsWebhooks   []string `json:"teams_webhooks,omitempty" validate:"omitempty,max=20,dive,url"`
+	DiscordWebhooks []string `json:"discord_webhooks,omitempty" validate:"omitempty,max=20,dive,url"`
 }
 
 // UpdateProjectRequest represents the request to update a project
 type UpdateProjectRequest struct {
-	Name           *string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
-	RepositoryURL  *string `json:"repository_url,omitempty" validate:"omitempty,url"`
-	WebhookSecret  *string `json:"webhook_secret,omitempty" validate:"omitempty,min=10"`
-	TelegramChatID *int64  `json:"telegram_chat_id,omitempty"`
+	Name            *string   `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	RepositoryURL   *string   `json:"repository_url,omitempty" validate:"omitempty,url"`
+	WebhookSecret   *string   `json:"webhook_secret,omitempty" validate:"omitempty,min=10"`
+	TelegramChatID  *int64    `json:"telegram_chat_id,omitempty"`
+	EmailRecipients *[]string `json:"email_recipients,omitempty" validate:"omitempty,max=50,dive,email"`
+	SlackChannels   *[]string `json:"slack_channels,omitempty" validate:"omitempty,max=20,dive,min=1"`
+	TeamsWebhooks   *[]string `json:"teams_webhooks,omitempty" validate:"omitempty,max=20,dive,url"`
+	DiscordWebhooks *[]string `json:"discord_webhooks,omitempty" validate:"omitempty,max=20,dive,url"`
 }
 
 // ProjectResponse represents the response containing project information
 type ProjectResponse struct {
-	ID             string                  `json:"id"`
-	Name           string                  `json:"name"`
-	RepositoryURL  string                  `json:"repository_url"`
-	Status         string                  `json:"status"`
-	TelegramChatID *int64                  `json:"telegram_chat_id,omitempty"`
-	CreatedAt      value_objects.Timestamp `json:"created_at"`
-	UpdatedAt      value_objects.Timestamp `json:"updated_at"`
+	ID              string                  `json:"id"`
+	Name            string                  `json:"name"`
+	RepositoryURL   string                  `json:"repository_url"`
+	Status          string                  `json:"status"`
+	TelegramChatID  *int64                  `json:"telegram_chat_id,omitempty"`
+	EmailRecipients []string                `json:"email_recipients"`
+	SlackChannels   []string                `json:"slack_channels"`
+	TeamsWebhooks   []string                `json:"teams_webhooks"`
+	DiscordWebhooks []string                `json:"discord_webhooks"`
+	CreatedAt       value_objects.Timestamp `json:"created_at"`
+	UpdatedAt       value_objects.Timestamp `json:"updated_at"`
 }
 
 // ListProjectFilters represents filters for listing projects
@@ -60,13 +72,17 @@ type ProjectStatusUpdateRequest struct {
 // ToProjectResponse converts a domain project to a response DTO
 func ToProjectResponse(project *domain.Project) *ProjectResponse {
 	return &ProjectResponse{
-		ID:             project.ID().String(),
-		Name:           project.Name(),
-		RepositoryURL:  project.RepositoryURL(),
-		Status:         string(project.Status()),
-		TelegramChatID: project.TelegramChatID(),
-		CreatedAt:      project.CreatedAt(),
-		UpdatedAt:      project.UpdatedAt(),
+		ID:              project.ID().String(),
+		Name:            project.Name(),
+		RepositoryURL:   project.RepositoryURL(),
+		Status:          string(project.Status()),
+		TelegramChatID:  project.TelegramChatID(),
+		EmailRecipients: project.EmailRecipients(),
+		SlackChannels:   project.SlackChannels(),
+		TeamsWebhooks:   project.TeamsWebhooks(),
+		DiscordWebhooks: project.DiscordWebhooks(),
+		CreatedAt:       project.CreatedAt(),
+		UpdatedAt:       project.UpdatedAt(),
 	}
 }
 
@@ -47,6 +47,30 @@ func (s *projectService) CreateProject(ctx context.Context, req dto.CreateProjec
 		return nil, err
 	}
 
+	if len(req.EmailRecipients) > 0 {
+		if err := project.UpdateEmailRecipients(req.EmailRecipients); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(req.SlackChannels) > 0 {
+		if err := project.UpdateSlackChannels(req.SlackChannels); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(req.TeamsWebhooks) > 0 {
+		if err := project.UpdateTeamsWebhooks(req.TeamsWebhooks); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(req.DiscordWebhooks) > 0 {
+		if err := project.UpdateDiscordWebhooks(req.DiscordWebhooks); err != nil {
+			return nil, err
+		}
+	}
+
 	// Persist to repository
 	if err := s.ProjectRepo.Create(ctx, project); err != nil {
 		return nil, err
@@ -123,6 +147,22 @@ func (s *projectService) UpdateProject(ctx context.Context, id value_objects.ID,
 		return nil, err
 	}
 
+	if err := s.updateProjectEmailRecipients(project, req.EmailRecipients); err != nil {
+		return nil, err
+	}
+
+	if err := s.updateProjectSlackChannels(project, req.SlackChannels); err != nil {
+		return nil, err
+	}
+
+	if err := s.updateProjectTeamsWebhooks(project, req.TeamsWebhooks); err != nil {
+		return nil, err
+	}
+
+	if err := s.updateProjectDiscordWebhooks(project, req.DiscordWebhooks); err != nil {
+		return nil, err
+	}
+
 	// Persist all changes to the repository
 	if err := s.ProjectRepo.Update(ctx, project); err != nil {
 		return nil, err
@@ -177,6 +217,42 @@ func (s *projectService) updateProjectTelegramChatID(project *domain.Project, ne
 	return project.UpdateTelegramChatID(newChatID)
 }
 
+// updateProjectEmailRecipients replaces the email recipients if provided
+func (s *projectService) updateProjectEmailRecipients(project *domain.Project, newRecipients *[]string) error {
+	if newRecipients == nil {
+		return nil
+	}
+
+	return project.UpdateEmailRecipients(*newRecipients)
+}
+
+// updateProjectSlackChannels replaces the Slack channels if provided
+func (s *projectService) updateProjectSlackChannels(project *domain.Project, newChannels *[]string) error {
+	if newChannels == nil {
+		return nil
+	}
+
+	return project.UpdateSlackChannels(*newChannels)
+}
+
+// updateProjectTeamsWebhooks replaces the Teams webhooks if provided
+func (s *projectService) updateProjectTeamsWebhooks(project *domain.Project, newWebhooks *[]string) error {
+	if newWebhooks == nil {
+		return nil
+	}
+
+	return project.UpdateTeamsWebhooks(*newWebhooks)
+}
+
+// updateProjectDiscordWebhooks replaces the Discord webhooks if provided
+func (s *projectService) updateProjectDiscordWebhooks(project *domain.Project, newWebhooks *[]string) error {
+	if newWebhooks == nil {
+		return nil
+	}
+
+	return project.UpdateDiscordWebhooks(*newWebhooks)
+}
+
 // validateUniqueProjectName ensures the project name is unique across all projects except the current one
 func (s *projectService) validateUniqueProjectName(ctx context.Context, name string, excludeID value_objects.ID) error {
 	existingProject, err := s.ProjectRepo.GetByName(ctx, name)
@@ -0,0 +1,18 @@
+package domain
+
+// Provider identifies the CI system a webhook originates from
+type Provider string
+
+const (
+	// Supported CI providers
+	ProviderGitHub Provider = "github"
+	ProviderGitLab Provider = "gitlab"
+)
+
+// Provider returns the CI provider that emits the event type
+func (t WebhookEventType) Provider() Provider {
+	if t.IsGitLab() {
+		return ProviderGitLab
+	}
+	return ProviderGitHub
+}
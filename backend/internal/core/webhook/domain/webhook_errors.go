@@ -9,6 +9,10 @@ const (
 	WebhookErrInvalidEvent     = "WEBHOOK_INVALID_EVENT"
 	WebhookErrProjectNotFound  = "WEBHOOK_PROJECT_NOT_FOUND"
 	WebhookErrProcessingFailed = "WEBHOOK_PROCESSING_FAILED"
+	WebhookErrMissingSignature = "WEBHOOK_MISSING_SIGNATURE"
+	WebhookErrMissingEvent     = "WEBHOOK_MISSING_EVENT"
+	WebhookErrUnknownProvider  = "WEBHOOK_UNKNOWN_PROVIDER"
+	WebhookErrQueueFull        = "WEBHOOK_QUEUE_FULL"
 )
 
 // Webhook-specific domain errors
@@ -17,6 +21,10 @@ var (
 		WebhookErrInvalidSignature,
 		"webhook signature verification failed",
 	)
+	ErrWebhookQueueFull = exception.NewDomainError(
+		WebhookErrQueueFull,
+		"webhook processing queue is full",
+	)
 )
 
 // NewWebhookInvalidPayloadError creates an error for invalid webhook payload
@@ -50,3 +58,27 @@ func NewWebhookProcessingFailedError(message string) exception.DomainError {
 		"webhook processing failed: "+message,
 	)
 }
+
+// NewWebhookMissingSignatureError creates an error when the signature header is absent
+func NewWebhookMissingSignatureError(header string) exception.DomainError {
+	return exception.NewDomainError(
+		WebhookErrMissingSignature,
+		"missing "+header+" header",
+	)
+}
+
+// NewWebhookMissingEventError creates an error when the event type header is absent
+func NewWebhookMissingEventError(header string) exception.DomainError {
+	return exception.NewDomainError(
+		WebhookErrMissingEvent,
+		"missing "+header+" header",
+	)
+}
+
+// NewWebhookUnknownProviderError creates an error for CI providers without a registered adapter
+func NewWebhookUnknownProviderError(provider string) exception.DomainError {
+	return exception.NewDomainError(
+		WebhookErrUnknownProvider,
+		"unsupported CI provider: "+provider,
+	)
+}
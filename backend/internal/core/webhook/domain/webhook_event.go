@@ -11,19 +11,40 @@ type WebhookEventType string
 
 const (
 	// Supported webhook event types
-	WorkflowRunEvent WebhookEventType = "workflow_run"
-	PushEvent        WebhookEventType = "push"
-	PullRequestEvent WebhookEventType = "pull_request"
+	WorkflowRunEvent      WebhookEventType = "workflow_run"
+	WorkflowJobEvent      WebhookEventType = "workflow_job"
+	CheckRunEvent         WebhookEventType = "check_run"
+	PushEvent             WebhookEventType = "push"
+	PullRequestEvent      WebhookEventType = "pull_request"
+	DeploymentEvent       WebhookEventType = "deployment"
+	DeploymentStatusEvent WebhookEventType = "deployment_status"
+	ReleaseEvent          WebhookEventType = "release"
+
+	// GitLab webhook event types
+	GitLabPipelineEvent     WebhookEventType = "gitlab_pipeline"
+	GitLabJobEvent          WebhookEventType = "gitlab_job"
+	GitLabPushEvent         WebhookEventType = "gitlab_push"
+	GitLabMergeRequestEvent WebhookEventType = "gitlab_merge_request"
 )
 
-// WebhookEvent represents a webhook event received from GitHub
+// IsGitLab returns true if the event type originates from GitLab
+func (t WebhookEventType) IsGitLab() bool {
+	switch t {
+	case GitLabPipelineEvent, GitLabJobEvent, GitLabPushEvent, GitLabMergeRequestEvent:
+		return true
+	default:
+		return false
+	}
+}
+
+// WebhookEvent represents a webhook event received from a CI provider
 type WebhookEvent struct {
 	id          value_objects.ID
 	projectID   value_objects.ID
 	eventType   WebhookEventType
 	payload     string // JSON payload as string
 	signature   string
-	deliveryID  string // GitHub delivery ID
+	deliveryID  string // Provider delivery ID
 	processedAt *time.Time
 	createdAt   value_objects.Timestamp
 }
@@ -108,9 +129,10 @@ func (w *WebhookEvent) CreatedAt() value_objects.Timestamp { return w.createdAt
 // isValidEventType checks if the event type is supported
 func isValidEventType(eventType WebhookEventType) bool {
 	switch eventType {
-	case WorkflowRunEvent, PushEvent, PullRequestEvent:
+	case WorkflowRunEvent, WorkflowJobEvent, CheckRunEvent, PushEvent, PullRequestEvent,
+		DeploymentEvent, DeploymentStatusEvent, ReleaseEvent:
 		return true
 	default:
-		return false
+		return eventType.IsGitLab()
 	}
 }
@@ -0,0 +1,82 @@
+package dto
+
+import (
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
+)
+
+// CIEventKind classifies a provider-neutral build event
+type CIEventKind string
+
+const (
+	// CIEventBuild covers workflow runs and pipelines
+	CIEventBuild CIEventKind = "build"
+	// CIEventJob covers single jobs of a build, recorded under the run's build event
+	CIEventJob CIEventKind = "job"
+	// CIEventPush covers branch pushes
+	CIEventPush CIEventKind = "push"
+	// CIEventChangeRequest covers pull requests and merge requests
+	CIEventChangeRequest CIEventKind = "change_request"
+	// CIEventDeployment covers deployments and their status updates
+	CIEventDeployment CIEventKind = "deployment"
+	// CIEventRelease covers published releases
+	CIEventRelease CIEventKind = "release"
+)
+
+// CIBuildEvent is the provider-neutral representation of a CI webhook.
+// CI provider adapters convert their payloads into this structure so the
+// webhook service can create build events and notifications uniformly.
+type CIBuildEvent struct {
+	Kind CIEventKind
+	// RunID identifies the CI run across deliveries so every delivery of the
+	// same run updates a single build event. Empty for events without a run.
+	RunID           string
+	EventType       buildDomain.EventType
+	Status          buildDomain.BuildStatus
+	Branch          string
+	CommitSHA       string
+	CommitMessage   string
+	AuthorName      string
+	AuthorEmail     string
+	BuildURL        string
+	DurationSeconds *int
+
+	// Repository is the display name of the repository or project
+	Repository string
+	// Name is the workflow, pipeline or job name for builds and jobs and the
+	// change request noun (e.g. "Pull Request") for change requests
+	Name string
+
+	// Change request specific fields
+	Title        string
+	TargetBranch string
+	Action       string
+
+	// Deployment specific fields
+	Environment string
+
+	// Job specific fields. RunID identifies the parent run.
+	JobID       string
+	FailedSteps []string
+
+	// Release specific fields. The changelog is assembled by the webhook
+	// service from the pushes recorded since the previous release.
+	Tag       string
+	Changelog []CICommit
+}
+
+// CICommit is the provider-neutral representation of a pushed commit
+type CICommit struct {
+	SHA        string
+	Message    string
+	AuthorName string
+	URL        string
+}
+
+// WebhookHeaders holds the provider specific values extracted from webhook request headers
+type WebhookHeaders struct {
+	EventName  string // Raw event name as sent by the provider
+	EventType  domain.WebhookEventType
+	Signature  string
+	DeliveryID string
+}
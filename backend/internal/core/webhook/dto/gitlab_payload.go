@@ -0,0 +1,124 @@
+package dto
+
+// GitLabPayload represents GitLab webhook payload structure.
+// It covers the Pipeline, Job, Push and Merge Request hooks.
+type GitLabPayload struct {
+	ObjectKind string        `json:"object_kind"`
+	EventName  string        `json:"event_name,omitempty"`
+	User       *GitLabUser   `json:"user,omitempty"`
+	Project    GitLabProject `json:"project"`
+
+	// Pipeline and merge request hook specific fields
+	ObjectAttributes *GitLabObjectAttributes `json:"object_attributes,omitempty"`
+	Commit           *GitLabHookCommit       `json:"commit,omitempty"`
+	Builds           []GitLabBuild           `json:"builds,omitempty"`
+
+	// Push hook specific fields
+	Ref         string         `json:"ref,omitempty"`
+	Before      string         `json:"before,omitempty"`
+	After       string         `json:"after,omitempty"`
+	CheckoutSHA string         `json:"checkout_sha,omitempty"`
+	UserName    string         `json:"user_name,omitempty"`
+	UserEmail   string         `json:"user_email,omitempty"`
+	Commits     []GitLabCommit `json:"commits,omitempty"`
+
+	// Job hook specific fields
+	SHA                string           `json:"sha,omitempty"`
+	BuildID            int64            `json:"build_id,omitempty"`
+	BuildName          string           `json:"build_name,omitempty"`
+	BuildStage         string           `json:"build_stage,omitempty"`
+	BuildStatus        string           `json:"build_status,omitempty"`
+	BuildDuration      *float64         `json:"build_duration,omitempty"`
+	BuildFailureReason string           `json:"build_failure_reason,omitempty"`
+	PipelineID         int64            `json:"pipeline_id,omitempty"`
+	Repository         GitLabRepository `json:"repository"`
+}
+
+// GitLabUser represents a GitLab user
+type GitLabUser struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	Username string `json:"username"`
+	Email    string `json:"email,omitempty"`
+}
+
+// GitLabProject represents a GitLab project
+type GitLabProject struct {
+	ID                int64  `json:"id"`
+	Name              string `json:"name"`
+	PathWithNamespace string `json:"path_with_namespace"`
+	WebURL            string `json:"web_url"`
+	DefaultBranch     string `json:"default_branch"`
+}
+
+// GitLabRepository represents the repository block sent with job hooks
+type GitLabRepository struct {
+	Name     string `json:"name"`
+	URL      string `json:"url"`
+	Homepage string `json:"homepage"`
+}
+
+// GitLabObjectAttributes holds the attributes of a pipeline or merge request
+type GitLabObjectAttributes struct {
+	ID     int64  `json:"id"`
+	IID    int64  `json:"iid"`
+	Name   string `json:"name,omitempty"`
+	URL    string `json:"url,omitempty"`
+	Status string `json:"status,omitempty"`
+
+	// Pipeline attributes
+	Ref        string `json:"ref,omitempty"`
+	Tag        bool   `json:"tag,omitempty"`
+	SHA        string `json:"sha,omitempty"`
+	BeforeSHA  string `json:"before_sha,omitempty"`
+	Source     string `json:"source,omitempty"`
+	Duration   *int   `json:"duration,omitempty"`
+	CreatedAt  string `json:"created_at,omitempty"`
+	FinishedAt string `json:"finished_at,omitempty"`
+
+	// Merge request attributes
+	Title        string        `json:"title,omitempty"`
+	State        string        `json:"state,omitempty"`
+	Action       string        `json:"action,omitempty"`
+	SourceBranch string        `json:"source_branch,omitempty"`
+	TargetBranch string        `json:"target_branch,omitempty"`
+	LastCommit   *GitLabCommit `json:"last_commit,omitempty"`
+}
+
+// GitLabCommit represents a commit in push and merge request hooks
+type GitLabCommit struct {
+	ID        string       `json:"id"`
+	Message   string       `json:"message"`
+	Title     string       `json:"title,omitempty"`
+	Timestamp string       `json:"timestamp"`
+	URL       string       `json:"url"`
+	Author    GitLabAuthor `json:"author"`
+}
+
+// GitLabHookCommit represents the commit block sent with pipeline and job hooks.
+// Pipeline hooks carry an author object while job hooks flatten the author fields.
+type GitLabHookCommit struct {
+	Message     string        `json:"message"`
+	Title       string        `json:"title,omitempty"`
+	URL         string        `json:"url,omitempty"`
+	Author      *GitLabAuthor `json:"author,omitempty"`
+	AuthorName  string        `json:"author_name,omitempty"`
+	AuthorEmail string        `json:"author_email,omitempty"`
+}
+
+// GitLabAuthor represents a commit author
+type GitLabAuthor struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// GitLabBuild represents a job summary within a pipeline hook
+type GitLabBuild struct {
+	ID            int64    `json:"id"`
+	Stage         string   `json:"stage"`
+	Name          string   `json:"name"`
+	Status        string   `json:"status"`
+	Duration      *float64 `json:"duration,omitempty"`
+	FailureReason string   `json:"failure_reason,omitempty"`
+	AllowFailure  bool     `json:"allow_failure"`
+}
@@ -22,6 +22,10 @@ type GitHubActionsPayload struct {
 	} `json:"sender"`
 	WorkflowRun *WorkflowRun `json:"workflow_run,omitempty"`
 
+	// Workflow job and check run event specific fields
+	WorkflowJob *WorkflowJob `json:"workflow_job,omitempty"`
+	CheckRun    *CheckRun    `json:"check_run,omitempty"`
+
 	// Push event specific fields
 	Ref        string   `json:"ref,omitempty"`
 	Before     string   `json:"before,omitempty"`
@@ -37,6 +41,13 @@ type GitHubActionsPayload struct {
 	// Pull request event specific fields
 	Number      int          `json:"number,omitempty"`
 	PullRequest *PullRequest `json:"pull_request,omitempty"`
+
+	// Deployment and deployment_status event specific fields
+	Deployment       *Deployment       `json:"deployment,omitempty"`
+	DeploymentStatus *DeploymentStatus `json:"deployment_status,omitempty"`
+
+	// Release event specific fields
+	Release *Release `json:"release,omitempty"`
 }
 
 // WorkflowRun represents GitHub workflow run information
@@ -49,11 +60,54 @@ type WorkflowRun struct {
 	CreatedAt  time.Time `json:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at"`
 	RunNumber  int       `json:"run_number"`
+	RunAttempt int       `json:"run_attempt,omitempty"`
 	Event      string    `json:"event"`
 	HeadBranch string    `json:"head_branch"`
 	HeadSha    string    `json:"head_sha"`
 }
 
+// WorkflowJob represents a job of a GitHub workflow run
+type WorkflowJob struct {
+	ID           int64          `json:"id"`
+	RunID        int64          `json:"run_id"`
+	RunAttempt   int            `json:"run_attempt,omitempty"`
+	Name         string         `json:"name"`
+	WorkflowName string         `json:"workflow_name,omitempty"`
+	Status       string         `json:"status"`
+	Conclusion   string         `json:"conclusion"`
+	HTMLURL      string         `json:"html_url"`
+	HeadBranch   string         `json:"head_branch"`
+	HeadSha      string         `json:"head_sha"`
+	StartedAt    *time.Time     `json:"started_at,omitempty"`
+	CompletedAt  *time.Time     `json:"completed_at,omitempty"`
+	Steps        []WorkflowStep `json:"steps,omitempty"`
+}
+
+// WorkflowStep represents a step of a workflow job
+type WorkflowStep struct {
+	Number     int    `json:"number"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion"`
+}
+
+// CheckRun represents a GitHub check run
+type CheckRun struct {
+	ID          int64      `json:"id"`
+	Name        string     `json:"name"`
+	HeadSha     string     `json:"head_sha"`
+	Status      string     `json:"status"`
+	Conclusion  string     `json:"conclusion"`
+	HTMLURL     string     `json:"html_url"`
+	DetailsURL  string     `json:"details_url"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	CheckSuite  *struct {
+		ID         int64  `json:"id"`
+		HeadBranch string `json:"head_branch"`
+	} `json:"check_suite,omitempty"`
+}
+
 // User represents a GitHub user
 type User struct {
 	Login string `json:"login"`
@@ -103,6 +157,48 @@ type PullRequest struct {
 	Base             Branch     `json:"base"`
 }
 
+// Deployment represents a GitHub deployment
+type Deployment struct {
+	ID          int64     `json:"id"`
+	SHA         string    `json:"sha"`
+	Ref         string    `json:"ref"`
+	Task        string    `json:"task"`
+	Environment string    `json:"environment"`
+	Description string    `json:"description"`
+	Creator     *User     `json:"creator,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// DeploymentStatus represents a status update of a GitHub deployment
+type DeploymentStatus struct {
+	ID             int64     `json:"id"`
+	State          string    `json:"state"`
+	Description    string    `json:"description"`
+	Environment    string    `json:"environment"`
+	TargetURL      string    `json:"target_url"`
+	LogURL         string    `json:"log_url"`
+	EnvironmentURL string    `json:"environment_url"`
+	Creator        *User     `json:"creator,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// Release represents a GitHub release
+type Release struct {
+	ID              int64      `json:"id"`
+	TagName         string     `json:"tag_name"`
+	Name            string     `json:"name"`
+	Body            string     `json:"body"`
+	HTMLURL         string     `json:"html_url"`
+	TargetCommitish string     `json:"target_commitish"`
+	Draft           bool       `json:"draft"`
+	Prerelease      bool       `json:"prerelease"`
+	Author          *User      `json:"author,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	PublishedAt     *time.Time `json:"published_at,omitempty"`
+}
+
 // Branch represents a git branch
 type Branch struct {
 	Label string     `json:"label"`
@@ -127,11 +223,12 @@ type Repository struct {
 // ProcessWebhookRequest represents a request to process a webhook
 type ProcessWebhookRequest struct {
 	ProjectID  value_objects.ID        `json:"project_id" validate:"required"`
+	Provider   domain.Provider         `json:"provider"` // Defaults to the event type's provider
 	EventType  domain.WebhookEventType `json:"event_type" validate:"required"`
 	Signature  string                  `json:"signature" validate:"required"`
 	DeliveryID string                  `json:"delivery_id"`
 	Body       []byte                  `json:"body" validate:"required"`
-	Payload    GitHubActionsPayload    `json:"payload"`
+	Payload    interface{}             `json:"payload"` // Payload decoded by the provider adapter
 }
 
 // WebhookEventResponse represents webhook event response
@@ -0,0 +1,42 @@
+package port
+
+import (
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/dto"
+)
+
+// CIProvider defines the contract for a CI system webhook adapter.
+// Each adapter owns signature verification, event type detection and the
+// conversion of its payloads into provider-neutral build events.
+type CIProvider interface {
+	// Name returns the provider identifier used in webhook routes
+	Name() domain.Provider
+
+	// ParseHeaders extracts the event type, signature and delivery ID from request headers
+	ParseHeaders(header func(key string) string) (dto.WebhookHeaders, error)
+
+	// VerifySignature verifies the webhook signature against the project secret
+	VerifySignature(secret, signature string, body []byte) bool
+
+	// RedactSignature returns the value that may safely be stored alongside the event
+	RedactSignature(signature string) string
+
+	// DecodePayload decodes a raw request body into the provider payload
+	DecodePayload(eventType domain.WebhookEventType, body []byte) (interface{}, error)
+
+	// ToBuildEvent converts a decoded payload into a provider-neutral build event.
+	// A nil event without error means the delivery carries nothing to record.
+	ToBuildEvent(eventType domain.WebhookEventType, payload interface{}) (*dto.CIBuildEvent, error)
+
+	// PushCommits returns the commits of a stored push payload, oldest first
+	PushCommits(body []byte) ([]dto.CICommit, error)
+}
+
+// ProviderRegistry defines the contract for looking up CI provider adapters
+type ProviderRegistry interface {
+	// Register adds or replaces the adapter for its provider
+	Register(provider CIProvider)
+
+	// Get retrieves the adapter for a provider
+	Get(name domain.Provider) (CIProvider, error)
+}
@@ -2,6 +2,7 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
@@ -30,6 +31,6 @@ type WebhookEventRepository interface {
 	// ExistsByDeliveryID checks if a webhook event with the given delivery ID exists
 	ExistsByDeliveryID(ctx context.Context, deliveryID string) (bool, error)
 
-	// GetUnprocessedEvents retrieves unprocessed webhook events
-	GetUnprocessedEvents(ctx context.Context, limit int) ([]*domain.WebhookEvent, error)
+	// GetUnprocessedEvents retrieves unprocessed webhook events received before the given time, oldest first
+	GetUnprocessedEvents(ctx context.Context, receivedBefore time.Time, limit int) ([]*domain.WebhookEvent, error)
 }
@@ -13,6 +13,13 @@ type WebhookService interface {
 	// ProcessWebhook processes an incoming webhook request
 	ProcessWebhook(ctx context.Context, req dto.ProcessWebhookRequest) (*domain.WebhookEvent, error)
 
+	// AcceptWebhook verifies and stores an incoming webhook request, leaving it to be processed later.
+	// A delivery that was already received returns the stored event.
+	AcceptWebhook(ctx context.Context, req dto.ProcessWebhookRequest) (*domain.WebhookEvent, error)
+
+	// ProcessWebhookEvent processes a stored webhook event and marks it as processed
+	ProcessWebhookEvent(ctx context.Context, event *domain.WebhookEvent) error
+
 	// VerifyWebhookSignature verifies the webhook signature
 	VerifyWebhookSignature(secret, signature string, body []byte) bool
 
@@ -22,6 +29,21 @@ type WebhookService interface {
 	// GetWebhookEventsByProject retrieves webhook events for a specific project
 	GetWebhookEventsByProject(ctx context.Context, projectID value_objects.ID, limit, offset int) ([]*domain.WebhookEvent, error)
 
+	// GetUnprocessedWebhookEvents retrieves stored webhook events that are still unprocessed, oldest first.
+	// Recently received events are left out, the request or worker that received them may still process them.
+	GetUnprocessedWebhookEvents(ctx context.Context, limit int) ([]*domain.WebhookEvent, error)
+
 	// ReprocessFailedWebhooks reprocesses failed webhook events
 	ReprocessFailedWebhooks(ctx context.Context, limit int) error
 }
+
+// WebhookDispatcher hands stored webhook events over for background processing
+type WebhookDispatcher interface {
+	// Dispatch queues a webhook event for processing. It returns a queue-full
+	// domain error when no more events can be accepted.
+	Dispatch(event *domain.WebhookEvent) error
+
+	// DispatchUnprocessed queues the stored webhook events left unprocessed, skipping events that are
+	// already queued or running. It stops early when the queue is full.
+	DispatchUnprocessed(ctx context.Context, limit int) error
+}
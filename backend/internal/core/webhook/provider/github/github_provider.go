@@ -0,0 +1,670 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/crypto"
+)
+
+// GitHub webhook headers
+const (
+	HeaderSignature = "X-Hub-Signature-256"
+	HeaderEvent     = "X-GitHub-Event"
+	HeaderDelivery  = "X-GitHub-Delivery"
+)
+
+// Constants for URL patterns and ref parsing
+const (
+	commitURLPath       = "/commit/"
+	deploymentsPath     = "/deployments"
+	branchRefPath       = "refs/heads/"
+	defaultBranch       = "main"
+	deploymentRunPrefix = "deployment-"
+	releaseRunPrefix    = "release-"
+)
+
+// actionsJobURLPattern matches the details URL of check runs created by GitHub Actions,
+// capturing the run ID and the attempt number when the URL carries one
+var actionsJobURLPattern = regexp.MustCompile(`/actions/runs/(\d+)(?:/attempts/(\d+))?/job/\d+`)
+
+// provider is the GitHub CI provider adapter
+type provider struct {
+	verifier crypto.SignatureVerifier
+}
+
+// NewProvider creates a GitHub CI provider adapter
+func NewProvider(verifier crypto.SignatureVerifier) port.CIProvider {
+	return &provider{
+		verifier: verifier,
+	}
+}
+
+// Name returns the provider identifier
+func (p *provider) Name() domain.Provider {
+	return domain.ProviderGitHub
+}
+
+// ParseHeaders extracts the event type, signature and delivery ID from request headers
+func (p *provider) ParseHeaders(header func(key string) string) (dto.WebhookHeaders, error) {
+	headers := dto.WebhookHeaders{
+		EventName:  header(HeaderEvent),
+		Signature:  header(HeaderSignature),
+		DeliveryID: header(HeaderDelivery),
+	}
+
+	if headers.Signature == "" {
+		return headers, domain.NewWebhookMissingSignatureError(HeaderSignature)
+	}
+	if headers.EventName == "" {
+		return headers, domain.NewWebhookMissingEventError(HeaderEvent)
+	}
+
+	headers.EventType = domain.WebhookEventType(headers.EventName)
+	switch headers.EventType {
+	case domain.WorkflowRunEvent, domain.WorkflowJobEvent, domain.CheckRunEvent,
+		domain.PushEvent, domain.PullRequestEvent, domain.DeploymentEvent, domain.DeploymentStatusEvent, domain.ReleaseEvent:
+		return headers, nil
+	default:
+		return headers, domain.NewWebhookInvalidEventError(headers.EventName)
+	}
+}
+
+// VerifySignature verifies the X-Hub-Signature-256 HMAC
+func (p *provider) VerifySignature(secret, signature string, body []byte) bool {
+	return p.verifier.VerifySignature(secret, signature, body)
+}
+
+// RedactSignature returns the signature unchanged, HMAC digests do not reveal the secret
+func (p *provider) RedactSignature(signature string) string {
+	return signature
+}
+
+// DecodePayload decodes a raw request body into a GitHub payload
+func (p *provider) DecodePayload(_ domain.WebhookEventType, body []byte) (interface{}, error) {
+	var payload dto.GitHubActionsPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, domain.NewWebhookInvalidPayloadError("invalid GitHub payload")
+	}
+	return payload, nil
+}
+
+// ToBuildEvent converts a GitHub payload into a provider-neutral build event
+func (p *provider) ToBuildEvent(eventType domain.WebhookEventType, raw interface{}) (*dto.CIBuildEvent, error) {
+	var payload dto.GitHubActionsPayload
+	switch v := raw.(type) {
+	case dto.GitHubActionsPayload:
+		payload = v
+	case *dto.GitHubActionsPayload:
+		payload = *v
+	default:
+		return nil, domain.NewWebhookInvalidPayloadError("unexpected GitHub payload type")
+	}
+
+	switch eventType {
+	case domain.WorkflowRunEvent:
+		return p.workflowRunEvent(payload)
+	case domain.WorkflowJobEvent:
+		return p.workflowJobEvent(payload)
+	case domain.CheckRunEvent:
+		return p.checkRunEvent(payload)
+	case domain.PushEvent:
+		return p.pushEvent(payload), nil
+	case domain.PullRequestEvent:
+		return p.pullRequestEvent(payload)
+	case domain.DeploymentEvent, domain.DeploymentStatusEvent:
+		return p.deploymentEvent(payload)
+	case domain.ReleaseEvent:
+		return p.releaseEvent(payload)
+	default:
+		return nil, domain.NewWebhookInvalidEventError(string(eventType))
+	}
+}
+
+// PushCommits returns the commits of a stored push payload
+func (p *provider) PushCommits(body []byte) ([]dto.CICommit, error) {
+	var payload dto.GitHubActionsPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, domain.NewWebhookInvalidPayloadError("invalid GitHub payload")
+	}
+
+	commits := payload.Commits
+	if len(commits) == 0 && payload.HeadCommit != nil {
+		commits = []dto.Commit{*payload.HeadCommit}
+	}
+
+	result := make([]dto.CICommit, 0, len(commits))
+	for _, commit := range commits {
+		result = append(result, dto.CICommit{
+			SHA:        commit.ID,
+			Message:    commit.Message,
+			AuthorName: p.userDisplayName(commit.Author),
+			URL:        commit.URL,
+		})
+	}
+	return result, nil
+}
+
+// workflowRunEvent converts workflow_run events
+func (p *provider) workflowRunEvent(payload dto.GitHubActionsPayload) (*dto.CIBuildEvent, error) {
+	// Validate payload
+	if payload.WorkflowRun == nil {
+		return nil, fmt.Errorf("invalid workflow run payload: workflow_run is nil")
+	}
+
+	// Extract branch with fallback
+	branch := defaultBranch
+	if payload.WorkflowRun.HeadBranch != "" {
+		branch = payload.WorkflowRun.HeadBranch
+	}
+
+	// Extract commit SHA and build URL
+	commitSHA := payload.WorkflowRun.HeadSha
+	buildURL := payload.WorkflowRun.HTMLURL
+
+	// Use repository commit URL if available
+	repoURL := p.safeRepositoryURL(payload)
+	if repoURL != "" && commitSHA != "" {
+		buildURL = repoURL + commitURLPath + commitSHA
+	}
+
+	return &dto.CIBuildEvent{
+		Kind:            dto.CIEventBuild,
+		RunID:           p.workflowRunID(payload.WorkflowRun),
+		EventType:       p.determineEventType(payload.Action),
+		Status:          p.determineBuildStatus(payload.WorkflowRun.Conclusion),
+		Branch:          branch,
+		CommitSHA:       commitSHA,
+		BuildURL:        buildURL,
+		DurationSeconds: p.workflowRunDuration(payload.Action, payload.WorkflowRun),
+		Repository:      p.safeRepositoryName(payload),
+		Name:            payload.WorkflowRun.Name,
+	}, nil
+}
+
+// workflowRunID returns the run identifier, falling back to the run number for payloads without an ID.
+// Re-runs reuse the run ID, so later attempts are tracked as separate builds.
+func (p *provider) workflowRunID(run *dto.WorkflowRun) string {
+	switch {
+	case run.ID != 0:
+		return p.attemptRunID(strconv.FormatInt(run.ID, 10), run.RunAttempt)
+	case run.RunNumber != 0:
+		return p.attemptRunID(strconv.Itoa(run.RunNumber), run.RunAttempt)
+	default:
+		return ""
+	}
+}
+
+// attemptRunID suffixes the run identifier with the attempt number of re-runs
+func (p *provider) attemptRunID(runID string, attempt int) string {
+	if attempt > 1 {
+		return runID + "#" + strconv.Itoa(attempt)
+	}
+	return runID
+}
+
+// workflowRunDuration computes the run duration from its timestamps once the run has completed
+func (p *provider) workflowRunDuration(action string, run *dto.WorkflowRun) *int {
+	if action != "completed" || run.CreatedAt.IsZero() || run.UpdatedAt.Before(run.CreatedAt) {
+		return nil
+	}
+
+	seconds := int(run.UpdatedAt.Sub(run.CreatedAt).Seconds())
+	return &seconds
+}
+
+// workflowJobEvent converts workflow_job events into a job of the parent workflow run
+func (p *provider) workflowJobEvent(payload dto.GitHubActionsPayload) (*dto.CIBuildEvent, error) {
+	job := payload.WorkflowJob
+	if job == nil {
+		return nil, fmt.Errorf("invalid workflow job payload: workflow_job is nil")
+	}
+
+	var failedSteps []string
+	for _, step := range job.Steps {
+		if step.Conclusion == "failure" || step.Conclusion == "timed_out" {
+			failedSteps = append(failedSteps, step.Name)
+		}
+	}
+
+	event := p.jobEvent(payload, job.Name, job.Status, job.Conclusion, job.StartedAt, job.CompletedAt)
+	event.RunID = p.attemptRunID(strconv.FormatInt(job.RunID, 10), job.RunAttempt)
+	event.JobID = strconv.FormatInt(job.ID, 10)
+	event.CommitSHA = job.HeadSha
+	event.BuildURL = job.HTMLURL
+	event.FailedSteps = failedSteps
+	if job.HeadBranch != "" {
+		event.Branch = job.HeadBranch
+	}
+	return event, nil
+}
+
+// checkRunEvent converts check_run events created by GitHub Actions into a job of the parent workflow run.
+// Check runs reported by other apps belong to no workflow run and are not recorded.
+func (p *provider) checkRunEvent(payload dto.GitHubActionsPayload) (*dto.CIBuildEvent, error) {
+	checkRun := payload.CheckRun
+	if checkRun == nil {
+		return nil, fmt.Errorf("invalid check run payload: check_run is nil")
+	}
+
+	match := actionsJobURLPattern.FindStringSubmatch(checkRun.DetailsURL)
+	if match == nil {
+		match = actionsJobURLPattern.FindStringSubmatch(checkRun.HTMLURL)
+	}
+	if match == nil {
+		return nil, nil
+	}
+
+	// Check runs carry no attempt number outside the URL, jobs without one belong to the first attempt
+	attempt := 1
+	if match[2] != "" {
+		attempt, _ = strconv.Atoi(match[2])
+	}
+
+	event := p.jobEvent(payload, checkRun.Name, checkRun.Status, checkRun.Conclusion, checkRun.StartedAt, checkRun.CompletedAt)
+	event.RunID = p.attemptRunID(match[1], attempt)
+	event.JobID = strconv.FormatInt(checkRun.ID, 10)
+	event.CommitSHA = checkRun.HeadSha
+	event.BuildURL = checkRun.HTMLURL
+	if checkRun.CheckSuite != nil && checkRun.CheckSuite.HeadBranch != "" {
+		event.Branch = checkRun.CheckSuite.HeadBranch
+	}
+	return event, nil
+}
+
+// jobEvent builds the fields shared by workflow jobs and check runs
+func (p *provider) jobEvent(payload dto.GitHubActionsPayload, name, status, conclusion string, startedAt, completedAt *time.Time) *dto.CIBuildEvent {
+	event := &dto.CIBuildEvent{
+		Kind:       dto.CIEventJob,
+		EventType:  buildDomain.EventTypeBuildStarted,
+		Status:     p.determineJobStatus(status, conclusion),
+		Branch:     defaultBranch,
+		Repository: p.safeRepositoryName(payload),
+		Name:       name,
+	}
+
+	if status == "completed" {
+		event.EventType = buildDomain.EventTypeBuildCompleted
+		if startedAt != nil && completedAt != nil && !completedAt.Before(*startedAt) {
+			seconds := int(completedAt.Sub(*startedAt).Seconds())
+			event.DurationSeconds = &seconds
+		}
+	}
+
+	return event
+}
+
+// determineJobStatus determines the status of a workflow job or check run
+func (p *provider) determineJobStatus(status, conclusion string) buildDomain.BuildStatus {
+	if status == "in_progress" {
+		return buildDomain.BuildStatusInProgress
+	}
+	if status != "completed" {
+		return buildDomain.BuildStatusPending
+	}
+
+	switch conclusion {
+	case "success", "neutral":
+		return buildDomain.BuildStatusSuccess
+	case "failure", "timed_out", "startup_failure", "action_required":
+		return buildDomain.BuildStatusFailed
+	case "cancelled", "stale":
+		return buildDomain.BuildStatusCancelled
+	case "skipped":
+		return buildDomain.BuildStatusSkipped
+	default:
+		return buildDomain.BuildStatusFailed
+	}
+}
+
+// determineBuildStatus determines build status from workflow conclusion
+func (p *provider) determineBuildStatus(conclusion string) buildDomain.BuildStatus {
+	switch conclusion {
+	case "success":
+		return buildDomain.BuildStatusSuccess
+	case "failure":
+		return buildDomain.BuildStatusFailed
+	case "cancelled":
+		return buildDomain.BuildStatusCancelled
+	default:
+		return buildDomain.BuildStatusInProgress
+	}
+}
+
+// determineEventType determines event type from workflow action
+func (p *provider) determineEventType(action string) buildDomain.EventType {
+	switch action {
+	case "completed":
+		return buildDomain.EventTypeBuildCompleted
+	case "requested":
+		return buildDomain.EventTypeBuildStarted
+	default:
+		return buildDomain.EventTypeBuildCompleted
+	}
+}
+
+// pushEvent converts push events
+func (p *provider) pushEvent(payload dto.GitHubActionsPayload) *dto.CIBuildEvent {
+	commit := p.extractCommitInfo(payload)
+
+	return &dto.CIBuildEvent{
+		Kind:          dto.CIEventPush,
+		EventType:     buildDomain.EventTypePush,
+		Status:        buildDomain.BuildStatusSuccess,
+		Branch:        p.extractBranchFromRef(payload.Ref),
+		CommitSHA:     commit.SHA,
+		CommitMessage: commit.Message,
+		AuthorName:    commit.AuthorName,
+		AuthorEmail:   commit.AuthorEmail,
+		BuildURL:      commit.BuildURL,
+		Repository:    p.safeRepositoryName(payload),
+	}
+}
+
+// extractBranchFromRef extracts branch name from git ref
+func (p *provider) extractBranchFromRef(ref string) string {
+	if ref == "" {
+		return defaultBranch
+	}
+	if len(ref) > len(branchRefPath) && ref[:len(branchRefPath)] == branchRefPath {
+		return ref[len(branchRefPath):]
+	}
+	return defaultBranch
+}
+
+// commitInfo holds commit information
+type commitInfo struct {
+	SHA         string
+	Message     string
+	AuthorName  string
+	AuthorEmail string
+	BuildURL    string
+}
+
+// extractCommitInfo extracts commit information from payload with enhanced nil safety
+func (p *provider) extractCommitInfo(payload dto.GitHubActionsPayload) (result commitInfo) {
+	// Use named return and defer to ensure we always return something valid
+	defer func() {
+		if r := recover(); r != nil {
+			// If panic occurs, return the fallback commit info
+			result = p.createFallbackCommitInfo(payload)
+		}
+	}()
+
+	// Try HeadCommit first
+	if payload.HeadCommit != nil {
+		return commitInfo{
+			SHA:         payload.HeadCommit.ID,
+			Message:     payload.HeadCommit.Message,
+			AuthorName:  payload.HeadCommit.Author.Name,
+			AuthorEmail: payload.HeadCommit.Author.Email,
+			BuildURL:    payload.HeadCommit.URL,
+		}
+	}
+
+	// Try Commits array as fallback
+	if len(payload.Commits) > 0 {
+		lastCommit := payload.Commits[len(payload.Commits)-1]
+		buildURL := ""
+		repoURL := p.safeRepositoryURL(payload)
+		if repoURL != "" && lastCommit.ID != "" {
+			buildURL = repoURL + commitURLPath + lastCommit.ID
+		}
+
+		return commitInfo{
+			SHA:         lastCommit.ID,
+			Message:     lastCommit.Message,
+			AuthorName:  lastCommit.Author.Name,
+			AuthorEmail: lastCommit.Author.Email,
+			BuildURL:    buildURL,
+		}
+	}
+
+	// Final fallback
+	return p.createFallbackCommitInfo(payload)
+}
+
+// createFallbackCommitInfo creates fallback commit info when HeadCommit and Commits are not available
+func (p *provider) createFallbackCommitInfo(payload dto.GitHubActionsPayload) commitInfo {
+	sha := payload.After
+	if sha == "" {
+		sha = "unknown"
+	}
+
+	var authorName, authorEmail string
+	if payload.Pusher != nil {
+		authorName = payload.Pusher.Name
+		authorEmail = payload.Pusher.Email
+	}
+
+	buildURL := ""
+	repoURL := p.safeRepositoryURL(payload)
+	if repoURL != "" && sha != "unknown" {
+		buildURL = repoURL + commitURLPath + sha
+	}
+
+	return commitInfo{
+		SHA:         sha,
+		Message:     "Push to " + p.extractBranchFromRef(payload.Ref),
+		AuthorName:  authorName,
+		AuthorEmail: authorEmail,
+		BuildURL:    buildURL,
+	}
+}
+
+// pullRequestEvent converts pull_request events
+func (p *provider) pullRequestEvent(payload dto.GitHubActionsPayload) (*dto.CIBuildEvent, error) {
+	if payload.PullRequest == nil {
+		return nil, fmt.Errorf("pull request data is missing")
+	}
+
+	pr := payload.PullRequest
+
+	return &dto.CIBuildEvent{
+		Kind:          dto.CIEventChangeRequest,
+		EventType:     buildDomain.EventTypePullRequest,
+		Status:        p.determinePRStatus(payload.Action, pr.State),
+		Branch:        pr.Head.Ref,
+		CommitSHA:     pr.Head.SHA,
+		CommitMessage: fmt.Sprintf("Pull Request: %s", pr.Title),
+		AuthorName:    pr.User.Name,
+		AuthorEmail:   pr.User.Email,
+		BuildURL:      pr.HTMLURL,
+		Repository:    p.safeRepositoryName(payload),
+		Name:          "Pull Request",
+		Title:         pr.Title,
+		TargetBranch:  pr.Base.Ref,
+		Action:        p.getPRActionText(payload.Action, pr.State),
+	}, nil
+}
+
+// determinePRStatus determines the build status based on PR action and state
+func (p *provider) determinePRStatus(action, state string) buildDomain.BuildStatus {
+	status := buildDomain.BuildStatusPending
+	if action == "closed" {
+		if state == "merged" {
+			status = buildDomain.BuildStatusSuccess
+		} else {
+			status = buildDomain.BuildStatusCancelled
+		}
+	}
+	return status
+}
+
+// getPRActionText returns the action text for pull request notifications
+func (p *provider) getPRActionText(action, state string) string {
+	switch action {
+	case "closed":
+		if state == "merged" {
+			return "merged"
+		}
+		return "closed"
+	case "reopened":
+		return "reopened"
+	case "synchronize":
+		return "updated"
+	default:
+		return "opened"
+	}
+}
+
+// deploymentEvent converts deployment and deployment_status events.
+// Both events share the deployment ID as run ID so status updates land on the same build event.
+func (p *provider) deploymentEvent(payload dto.GitHubActionsPayload) (*dto.CIBuildEvent, error) {
+	deployment := payload.Deployment
+	if deployment == nil {
+		return nil, fmt.Errorf("invalid deployment payload: deployment is nil")
+	}
+
+	event := &dto.CIBuildEvent{
+		Kind:          dto.CIEventDeployment,
+		RunID:         deploymentRunPrefix + strconv.FormatInt(deployment.ID, 10),
+		EventType:     buildDomain.EventTypeDeploymentStarted,
+		Status:        buildDomain.BuildStatusPending,
+		Branch:        p.extractTargetRef(deployment.Ref),
+		CommitSHA:     deployment.SHA,
+		CommitMessage: deployment.Description,
+		Repository:    p.safeRepositoryName(payload),
+		Name:          "Deployment",
+		Environment:   deployment.Environment,
+	}
+	if repoURL := p.safeRepositoryURL(payload); repoURL != "" {
+		event.BuildURL = repoURL + deploymentsPath
+	}
+	if deployment.Creator != nil {
+		event.AuthorName = p.userDisplayName(*deployment.Creator)
+		event.AuthorEmail = deployment.Creator.Email
+	}
+
+	if status := payload.DeploymentStatus; status != nil {
+		event.Status = p.determineDeploymentStatus(status.State)
+		if event.Status.IsTerminal() {
+			event.EventType = buildDomain.EventTypeDeploymentCompleted
+		}
+		if status.Environment != "" {
+			event.Environment = status.Environment
+		}
+		if targetURL := p.deploymentTargetURL(*status); targetURL != "" {
+			event.BuildURL = targetURL
+		}
+		if status.Description != "" {
+			event.CommitMessage = status.Description
+		}
+		if status.Creator != nil {
+			event.AuthorName = p.userDisplayName(*status.Creator)
+			event.AuthorEmail = status.Creator.Email
+		}
+		if event.EventType == buildDomain.EventTypeDeploymentCompleted &&
+			!deployment.CreatedAt.IsZero() && !status.CreatedAt.Before(deployment.CreatedAt) {
+			seconds := int(status.CreatedAt.Sub(deployment.CreatedAt).Seconds())
+			event.DurationSeconds = &seconds
+		}
+	}
+
+	return event, nil
+}
+
+// releaseEvent converts release events. Only publishing a release is recorded,
+// drafts and edits of an existing release carry nothing to notify about.
+func (p *provider) releaseEvent(payload dto.GitHubActionsPayload) (*dto.CIBuildEvent, error) {
+	release := payload.Release
+	if release == nil {
+		return nil, fmt.Errorf("invalid release payload: release is nil")
+	}
+	if payload.Action != "published" || release.Draft {
+		return nil, nil
+	}
+
+	title := release.Name
+	if title == "" {
+		title = release.TagName
+	}
+
+	event := &dto.CIBuildEvent{
+		Kind:          dto.CIEventRelease,
+		RunID:         releaseRunPrefix + strconv.FormatInt(release.ID, 10),
+		EventType:     buildDomain.EventTypeRelease,
+		Status:        buildDomain.BuildStatusSuccess,
+		Branch:        p.extractTargetRef(release.TargetCommitish),
+		CommitMessage: title,
+		BuildURL:      release.HTMLURL,
+		Repository:    p.safeRepositoryName(payload),
+		Name:          "Release",
+		Title:         title,
+		Tag:           release.TagName,
+	}
+	if release.Author != nil {
+		event.AuthorName = p.userDisplayName(*release.Author)
+		event.AuthorEmail = release.Author.Email
+	}
+
+	return event, nil
+}
+
+// determineDeploymentStatus maps a deployment status state to a build status
+func (p *provider) determineDeploymentStatus(state string) buildDomain.BuildStatus {
+	switch state {
+	case "success":
+		return buildDomain.BuildStatusSuccess
+	case "failure", "error":
+		return buildDomain.BuildStatusFailed
+	case "inactive":
+		return buildDomain.BuildStatusCancelled
+	case "in_progress":
+		return buildDomain.BuildStatusInProgress
+	default:
+		return buildDomain.BuildStatusPending
+	}
+}
+
+// deploymentTargetURL returns the most useful link of a deployment status
+func (p *provider) deploymentTargetURL(status dto.DeploymentStatus) string {
+	switch {
+	case status.TargetURL != "":
+		return status.TargetURL
+	case status.LogURL != "":
+		return status.LogURL
+	default:
+		return status.EnvironmentURL
+	}
+}
+
+// extractTargetRef returns the branch, tag or SHA targeted by a deployment or release
+func (p *provider) extractTargetRef(ref string) string {
+	if ref == "" {
+		return defaultBranch
+	}
+	return strings.TrimPrefix(ref, branchRefPath)
+}
+
+// userDisplayName returns the name of a user, falling back to the login
+func (p *provider) userDisplayName(user dto.User) string {
+	if user.Name != "" {
+		return user.Name
+	}
+	return user.Login
+}
+
+// safeRepositoryName safely extracts repository name from payload
+func (p *provider) safeRepositoryName(payload dto.GitHubActionsPayload) string {
+	if payload.Repository.FullName != "" {
+		return payload.Repository.FullName
+	}
+	if payload.Repository.Name != "" {
+		return payload.Repository.Name
+	}
+	return "Unknown Repository"
+}
+
+// safeRepositoryURL safely extracts repository HTML URL from payload
+func (p *provider) safeRepositoryURL(payload dto.GitHubActionsPayload) string {
+	return payload.Repository.HTMLURL
+}
@@ -0,0 +1,436 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/crypto"
+)
+
+// GitLab webhook headers
+const (
+	HeaderToken     = "X-Gitlab-Token"
+	HeaderEvent     = "X-Gitlab-Event"
+	HeaderEventUUID = "X-Gitlab-Event-UUID"
+)
+
+// Constants for URL patterns and ref parsing
+const (
+	// redactedToken is stored in place of a signature, GitLab sends the
+	// shared secret itself which must never be persisted
+	redactedToken = "x-gitlab-token"
+	pipelinePath  = "/-/pipelines/"
+	jobPath       = "/-/jobs/"
+	commitPath    = "/-/commit/"
+	branchRefPath = "refs/heads/"
+	defaultBranch = "main"
+)
+
+// eventTypes maps X-Gitlab-Event header values to webhook event types
+var eventTypes = map[string]domain.WebhookEventType{
+	"Pipeline Hook":      domain.GitLabPipelineEvent,
+	"Job Hook":           domain.GitLabJobEvent,
+	"Push Hook":          domain.GitLabPushEvent,
+	"Merge Request Hook": domain.GitLabMergeRequestEvent,
+}
+
+// provider is the GitLab CI provider adapter
+type provider struct {
+	verifier       crypto.SignatureVerifier
+	instanceSecret string
+}
+
+// NewProvider creates a GitLab CI provider adapter. instanceSecret is an
+// optional instance-wide secret token accepted in addition to project secrets.
+func NewProvider(verifier crypto.SignatureVerifier, instanceSecret string) port.CIProvider {
+	return &provider{
+		verifier:       verifier,
+		instanceSecret: instanceSecret,
+	}
+}
+
+// Name returns the provider identifier
+func (p *provider) Name() domain.Provider {
+	return domain.ProviderGitLab
+}
+
+// ParseHeaders extracts the event type, secret token and delivery ID from request headers
+func (p *provider) ParseHeaders(header func(key string) string) (dto.WebhookHeaders, error) {
+	headers := dto.WebhookHeaders{
+		EventName:  header(HeaderEvent),
+		Signature:  header(HeaderToken),
+		DeliveryID: header(HeaderEventUUID),
+	}
+
+	if headers.Signature == "" {
+		return headers, domain.NewWebhookMissingSignatureError(HeaderToken)
+	}
+	if headers.EventName == "" {
+		return headers, domain.NewWebhookMissingEventError(HeaderEvent)
+	}
+
+	eventType, ok := eventTypes[headers.EventName]
+	if !ok {
+		return headers, domain.NewWebhookInvalidEventError(headers.EventName)
+	}
+	headers.EventType = eventType
+
+	return headers, nil
+}
+
+// VerifySignature verifies the secret token against the project secret, then the instance-wide secret
+func (p *provider) VerifySignature(secret, token string, body []byte) bool {
+	return p.verifier.VerifySignature(secret, token, body) ||
+		p.verifier.VerifySignature(p.instanceSecret, token, body)
+}
+
+// RedactSignature replaces the secret token with a fixed marker
+func (p *provider) RedactSignature(_ string) string {
+	return redactedToken
+}
+
+// DecodePayload decodes a raw request body into a GitLab payload
+func (p *provider) DecodePayload(_ domain.WebhookEventType, body []byte) (interface{}, error) {
+	var payload dto.GitLabPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, domain.NewWebhookInvalidPayloadError("invalid GitLab payload")
+	}
+	return payload, nil
+}
+
+// PushCommits returns the commits of a stored push hook payload
+func (p *provider) PushCommits(body []byte) ([]dto.CICommit, error) {
+	var payload dto.GitLabPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, domain.NewWebhookInvalidPayloadError("invalid GitLab payload")
+	}
+
+	result := make([]dto.CICommit, 0, len(payload.Commits))
+	for _, commit := range payload.Commits {
+		result = append(result, dto.CICommit{
+			SHA:        commit.ID,
+			Message:    commit.Message,
+			AuthorName: commit.Author.Name,
+			URL:        commit.URL,
+		})
+	}
+	return result, nil
+}
+
+// ToBuildEvent converts a GitLab payload into a provider-neutral build event
+func (p *provider) ToBuildEvent(eventType domain.WebhookEventType, raw interface{}) (*dto.CIBuildEvent, error) {
+	var payload dto.GitLabPayload
+	switch v := raw.(type) {
+	case dto.GitLabPayload:
+		payload = v
+	case *dto.GitLabPayload:
+		payload = *v
+	default:
+		return nil, domain.NewWebhookInvalidPayloadError("unexpected GitLab payload type")
+	}
+
+	switch eventType {
+	case domain.GitLabPipelineEvent:
+		return p.pipelineEvent(payload)
+	case domain.GitLabJobEvent:
+		return p.jobEvent(payload)
+	case domain.GitLabPushEvent:
+		return p.pushEvent(payload), nil
+	case domain.GitLabMergeRequestEvent:
+		return p.mergeRequestEvent(payload)
+	default:
+		return nil, domain.NewWebhookInvalidEventError(string(eventType))
+	}
+}
+
+// pipelineEvent converts Pipeline Hook events
+func (p *provider) pipelineEvent(payload dto.GitLabPayload) (*dto.CIBuildEvent, error) {
+	attrs := payload.ObjectAttributes
+	if attrs == nil {
+		return nil, fmt.Errorf("invalid pipeline payload: object_attributes is nil")
+	}
+
+	status := p.determineBuildStatus(attrs.Status)
+	commitMessage, authorName, authorEmail := p.extractHookCommit(payload)
+
+	buildURL := attrs.URL
+	if buildURL == "" && payload.Project.WebURL != "" {
+		buildURL = fmt.Sprintf("%s%s%d", payload.Project.WebURL, pipelinePath, attrs.ID)
+	}
+
+	name := fmt.Sprintf("Pipeline #%d", attrs.ID)
+	if attrs.Name != "" {
+		name = attrs.Name
+	}
+
+	return &dto.CIBuildEvent{
+		Kind:            dto.CIEventBuild,
+		RunID:           strconv.FormatInt(attrs.ID, 10),
+		EventType:       p.determineEventType(status),
+		Status:          status,
+		Branch:          p.branchOrDefault(attrs.Ref),
+		CommitSHA:       attrs.SHA,
+		CommitMessage:   commitMessage,
+		AuthorName:      authorName,
+		AuthorEmail:     authorEmail,
+		BuildURL:        buildURL,
+		DurationSeconds: attrs.Duration,
+		Repository:      p.projectName(payload),
+		Name:            name,
+	}, nil
+}
+
+// jobEvent converts Job Hook events into a job of the parent pipeline
+func (p *provider) jobEvent(payload dto.GitLabPayload) (*dto.CIBuildEvent, error) {
+	if payload.BuildID == 0 {
+		return nil, fmt.Errorf("invalid job payload: build_id is missing")
+	}
+	if payload.PipelineID == 0 {
+		return nil, fmt.Errorf("invalid job payload: pipeline_id is missing")
+	}
+
+	status := p.determineBuildStatus(payload.BuildStatus)
+	commitMessage, authorName, authorEmail := p.extractHookCommit(payload)
+
+	buildURL := ""
+	if webURL := p.projectURL(payload); webURL != "" {
+		buildURL = fmt.Sprintf("%s%s%d", webURL, jobPath, payload.BuildID)
+	}
+
+	var duration *int
+	if payload.BuildDuration != nil {
+		seconds := int(*payload.BuildDuration)
+		duration = &seconds
+	}
+
+	name := payload.BuildName
+	if payload.BuildStage != "" {
+		name = fmt.Sprintf("%s (%s)", payload.BuildName, payload.BuildStage)
+	}
+
+	return &dto.CIBuildEvent{
+		Kind:            dto.CIEventJob,
+		RunID:           strconv.FormatInt(payload.PipelineID, 10),
+		JobID:           strconv.FormatInt(payload.BuildID, 10),
+		EventType:       p.determineEventType(status),
+		Status:          status,
+		Branch:          p.branchOrDefault(payload.Ref),
+		CommitSHA:       payload.SHA,
+		CommitMessage:   commitMessage,
+		AuthorName:      authorName,
+		AuthorEmail:     authorEmail,
+		BuildURL:        buildURL,
+		DurationSeconds: duration,
+		Repository:      p.projectName(payload),
+		Name:            name,
+	}, nil
+}
+
+// pushEvent converts Push Hook events
+func (p *provider) pushEvent(payload dto.GitLabPayload) *dto.CIBuildEvent {
+	event := p.extractPushCommit(payload)
+	event.Kind = dto.CIEventPush
+	event.EventType = buildDomain.EventTypePush
+	event.Status = buildDomain.BuildStatusSuccess
+	event.Branch = p.branchOrDefault(payload.Ref)
+	event.Repository = p.projectName(payload)
+	return event
+}
+
+// mergeRequestEvent converts Merge Request Hook events
+func (p *provider) mergeRequestEvent(payload dto.GitLabPayload) (*dto.CIBuildEvent, error) {
+	mr := payload.ObjectAttributes
+	if mr == nil {
+		return nil, fmt.Errorf("merge request data is missing")
+	}
+
+	var commitSHA string
+	if mr.LastCommit != nil {
+		commitSHA = mr.LastCommit.ID
+	}
+
+	var authorName, authorEmail string
+	if payload.User != nil {
+		authorName = payload.User.Name
+		authorEmail = payload.User.Email
+	}
+
+	return &dto.CIBuildEvent{
+		Kind:          dto.CIEventChangeRequest,
+		EventType:     buildDomain.EventTypePullRequest,
+		Status:        p.determineMergeRequestStatus(mr.Action, mr.State),
+		Branch:        mr.SourceBranch,
+		CommitSHA:     commitSHA,
+		CommitMessage: fmt.Sprintf("Merge Request: %s", mr.Title),
+		AuthorName:    authorName,
+		AuthorEmail:   authorEmail,
+		BuildURL:      mr.URL,
+		Repository:    p.projectName(payload),
+		Name:          "Merge Request",
+		Title:         mr.Title,
+		TargetBranch:  mr.TargetBranch,
+		Action:        p.getMergeRequestActionText(mr.Action, mr.State),
+	}, nil
+}
+
+// determineBuildStatus maps GitLab pipeline and job statuses to build statuses
+func (p *provider) determineBuildStatus(status string) buildDomain.BuildStatus {
+	switch status {
+	case "success":
+		return buildDomain.BuildStatusSuccess
+	case "failed":
+		return buildDomain.BuildStatusFailed
+	case "canceled", "cancelled":
+		return buildDomain.BuildStatusCancelled
+	case "skipped":
+		return buildDomain.BuildStatusSkipped
+	case "running":
+		return buildDomain.BuildStatusInProgress
+	default:
+		// created, waiting_for_resource, preparing, pending, manual, scheduled
+		return buildDomain.BuildStatusPending
+	}
+}
+
+// determineEventType derives the build event type from a GitLab status
+func (p *provider) determineEventType(status buildDomain.BuildStatus) buildDomain.EventType {
+	switch status {
+	case buildDomain.BuildStatusPending, buildDomain.BuildStatusInProgress:
+		return buildDomain.EventTypeBuildStarted
+	default:
+		return buildDomain.EventTypeBuildCompleted
+	}
+}
+
+// determineMergeRequestStatus determines the build status based on merge request action and state
+func (p *provider) determineMergeRequestStatus(action, state string) buildDomain.BuildStatus {
+	switch {
+	case action == "merge" || state == "merged":
+		return buildDomain.BuildStatusSuccess
+	case action == "close" || state == "closed":
+		return buildDomain.BuildStatusCancelled
+	default:
+		return buildDomain.BuildStatusPending
+	}
+}
+
+// getMergeRequestActionText returns the action text for merge request notifications
+func (p *provider) getMergeRequestActionText(action, state string) string {
+	switch {
+	case action == "merge" || state == "merged":
+		return "merged"
+	case action == "close":
+		return "closed"
+	case action == "reopen":
+		return "reopened"
+	case action == "update":
+		return "updated"
+	case action == "approved":
+		return "approved"
+	default:
+		return "opened"
+	}
+}
+
+// extractHookCommit extracts commit details from pipeline and job hooks
+func (p *provider) extractHookCommit(payload dto.GitLabPayload) (message, authorName, authorEmail string) {
+	if payload.Commit != nil {
+		message = payload.Commit.Message
+		authorName = payload.Commit.AuthorName
+		authorEmail = payload.Commit.AuthorEmail
+		if payload.Commit.Author != nil {
+			authorName = payload.Commit.Author.Name
+			authorEmail = payload.Commit.Author.Email
+		}
+	}
+
+	// Fall back to the user who triggered the pipeline or job
+	if authorName == "" && payload.User != nil {
+		authorName = payload.User.Name
+		authorEmail = payload.User.Email
+	}
+
+	return message, authorName, authorEmail
+}
+
+// extractPushCommit extracts the head commit information from a push hook
+func (p *provider) extractPushCommit(payload dto.GitLabPayload) *dto.CIBuildEvent {
+	sha := payload.CheckoutSHA
+	if sha == "" {
+		sha = payload.After
+	}
+
+	// Prefer the commit that the branch now points to
+	for _, commit := range payload.Commits {
+		if commit.ID == sha {
+			return p.commitEvent(commit)
+		}
+	}
+	if len(payload.Commits) > 0 {
+		return p.commitEvent(payload.Commits[len(payload.Commits)-1])
+	}
+
+	// Final fallback
+	if sha == "" {
+		sha = "unknown"
+	}
+	buildURL := ""
+	if webURL := p.projectURL(payload); webURL != "" && sha != "unknown" {
+		buildURL = webURL + commitPath + sha
+	}
+
+	return &dto.CIBuildEvent{
+		CommitSHA:     sha,
+		CommitMessage: "Push to " + p.branchOrDefault(payload.Ref),
+		AuthorName:    payload.UserName,
+		AuthorEmail:   payload.UserEmail,
+		BuildURL:      buildURL,
+	}
+}
+
+// commitEvent converts a GitLab commit to build event commit details
+func (p *provider) commitEvent(commit dto.GitLabCommit) *dto.CIBuildEvent {
+	return &dto.CIBuildEvent{
+		CommitSHA:     commit.ID,
+		CommitMessage: commit.Message,
+		AuthorName:    commit.Author.Name,
+		AuthorEmail:   commit.Author.Email,
+		BuildURL:      commit.URL,
+	}
+}
+
+// branchOrDefault extracts branch name from a GitLab ref, which may or may not be fully qualified
+func (p *provider) branchOrDefault(ref string) string {
+	if ref == "" {
+		return defaultBranch
+	}
+	return strings.TrimPrefix(ref, branchRefPath)
+}
+
+// projectName safely extracts the project name from payload
+func (p *provider) projectName(payload dto.GitLabPayload) string {
+	if payload.Project.PathWithNamespace != "" {
+		return payload.Project.PathWithNamespace
+	}
+	if payload.Project.Name != "" {
+		return payload.Project.Name
+	}
+	if payload.Repository.Name != "" {
+		return payload.Repository.Name
+	}
+	return "Unknown Repository"
+}
+
+// projectURL safely extracts the project web URL from payload
+func (p *provider) projectURL(payload dto.GitLabPayload) string {
+	if payload.Project.WebURL != "" {
+		return payload.Project.WebURL
+	}
+	return payload.Repository.Homepage
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"sync"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/provider/github"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/provider/gitlab"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/crypto"
+)
+
+// registry keeps CI provider adapters by name
+type registry struct {
+	mu        sync.RWMutex
+	providers map[domain.Provider]port.CIProvider
+}
+
+// NewRegistry creates a provider registry holding the given adapters
+func NewRegistry(providers ...port.CIProvider) port.ProviderRegistry {
+	r := &registry{
+		providers: make(map[domain.Provider]port.CIProvider, len(providers)),
+	}
+	for _, p := range providers {
+		r.Register(p)
+	}
+	return r
+}
+
+// NewDefaultRegistry creates a registry with the built-in GitHub and GitLab adapters.
+// gitLabSecret is an optional instance-wide GitLab secret token.
+func NewDefaultRegistry(gitLabSecret string) port.ProviderRegistry {
+	return NewRegistry(
+		github.NewProvider(crypto.NewGitHubSignatureVerifier()),
+		gitlab.NewProvider(crypto.NewGitLabTokenVerifier(), gitLabSecret),
+	)
+}
+
+// Register adds or replaces the adapter for its provider
+func (r *registry) Register(p port.CIProvider) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.providers[p.Name()] = p
+}
+
+// Get retrieves the adapter for a provider
+func (r *registry) Get(name domain.Provider) (port.CIProvider, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	p, ok := r.providers[name]
+	if !ok {
+		return nil, domain.NewWebhookUnknownProviderError(string(name))
+	}
+	return p, nil
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	buildDto "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/port"
+)
+
+// Limits applied when assembling release changelogs
+const (
+	maxChangelogPushes  = 100 // Push events scanned for a release
+	maxChangelogEntries = 20  // Commits listed in a release notification
+	shortSHALength      = 7
+)
+
+// releaseChangelog assembles the commits pushed to a project since its previous release.
+// The changelog is best effort: lookup failures and unreadable payloads leave it shorter
+// rather than holding back the release notification.
+func (s *webhookService) releaseChangelog(ctx context.Context, ciProvider port.CIProvider, projectID value_objects.ID) []dto.CICommit {
+	releaseType := buildDomain.EventTypeRelease
+	previous, err := s.BuildService.GetBuildEventsByProject(ctx, projectID, buildDto.ListBuildEventFilters{
+		EventType: &releaseType,
+		Limit:     1,
+	})
+	if err != nil {
+		return nil
+	}
+
+	pushType := buildDomain.EventTypePush
+	filters := buildDto.ListBuildEventFilters{
+		EventType: &pushType,
+		Limit:     maxChangelogPushes,
+	}
+	if len(previous) > 0 {
+		since := previous[0].CreatedAt().ToTime()
+		filters.DateFrom = &since
+	}
+
+	pushes, err := s.BuildService.GetBuildEventsByProject(ctx, projectID, filters)
+	if err != nil {
+		return nil
+	}
+
+	// Pushes are returned newest first, the changelog lists commits oldest first
+	seen := make(map[string]bool)
+	var changelog []dto.CICommit
+	for i := len(pushes) - 1; i >= 0; i-- {
+		commits, err := ciProvider.PushCommits(pushes[i].WebhookPayload())
+		if err != nil {
+			continue
+		}
+		for _, commit := range commits {
+			if commit.SHA == "" || seen[commit.SHA] {
+				continue
+			}
+			seen[commit.SHA] = true
+			changelog = append(changelog, commit)
+		}
+	}
+
+	return changelog
+}
+
+// releaseChangelogText lists the commits of a release changelog, one line per commit
+func releaseChangelogText(changelog []dto.CICommit) string {
+	var b strings.Builder
+	for i, commit := range changelog {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		if i == maxChangelogEntries {
+			fmt.Fprintf(&b, "…and %d more commits", len(changelog)-maxChangelogEntries)
+			break
+		}
+		fmt.Fprintf(&b, "• %s (%s)", commitSummary(commit.Message), shortSHA(commit.SHA))
+	}
+	return b.String()
+}
+
+// commitSummary returns the first line of a commit message
+func commitSummary(message string) string {
+	summary, _, _ := strings.Cut(strings.TrimSpace(message), "\n")
+	return strings.TrimSpace(summary)
+}
+
+// shortSHA abbreviates a commit SHA for display
+func shortSHA(sha string) string {
+	if len(sha) > shortSHALength {
+		return sha[:shortSHALength]
+	}
+	return sha
+}
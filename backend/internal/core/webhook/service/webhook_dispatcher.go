@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/workerpool"
+	"github.com/sirupsen/logrus"
+)
+
+// WorkerPool runs webhook processing tasks in the background
+type WorkerPool interface {
+	Submit(task workerpool.Task) error
+}
+
+// DispatcherDep defines the dependencies for the webhook dispatcher
+type DispatcherDep struct {
+	WebhookService port.WebhookService
+	Pool           WorkerPool
+	Logger         *logrus.Logger
+}
+
+// webhookDispatcher processes stored webhook events on a worker pool
+type webhookDispatcher struct {
+	DispatcherDep
+
+	mu       sync.Mutex
+	inFlight map[string]struct{}
+}
+
+// NewWebhookDispatcher creates a dispatcher that processes webhook events on the given worker pool
+func NewWebhookDispatcher(d DispatcherDep) port.WebhookDispatcher {
+	if d.Logger == nil {
+		d.Logger = logrus.New()
+	}
+
+	return &webhookDispatcher{
+		DispatcherDep: d,
+		inFlight:      make(map[string]struct{}),
+	}
+}
+
+// Dispatch queues a webhook event for processing. Events that are already
+// processed or already queued, e.g. redeliveries, are not queued again.
+func (d *webhookDispatcher) Dispatch(event *domain.WebhookEvent) error {
+	return d.dispatch(event, d.process)
+}
+
+// DispatchUnprocessed queues the stored webhook events left unprocessed, skipping events that are
+// already queued or running. Events are reloaded before processing, as a worker may have
+// processed one between loading the list and queueing it.
+func (d *webhookDispatcher) DispatchUnprocessed(ctx context.Context, limit int) error {
+	events, err := d.WebhookService.GetUnprocessedWebhookEvents(ctx, limit)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		if err := d.dispatch(event, d.reprocess); err != nil {
+			// Events left over stay unprocessed and are queued on the next run
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dispatch queues a webhook event to be processed by run
+func (d *webhookDispatcher) dispatch(event *domain.WebhookEvent, run func(ctx context.Context, event *domain.WebhookEvent)) error {
+	if event.IsProcessed() {
+		return nil
+	}
+
+	id := event.ID().String()
+	d.mu.Lock()
+	if _, queued := d.inFlight[id]; queued {
+		d.mu.Unlock()
+		return nil
+	}
+	d.inFlight[id] = struct{}{}
+	d.mu.Unlock()
+
+	err := d.Pool.Submit(func(ctx context.Context) {
+		defer d.release(id)
+		run(ctx, event)
+	})
+	if err == nil {
+		return nil
+	}
+
+	d.release(id)
+	if errors.Is(err, workerpool.ErrQueueFull) || errors.Is(err, workerpool.ErrPoolClosed) {
+		// The event stays unprocessed and is picked up by the reprocessing job
+		return domain.ErrWebhookQueueFull
+	}
+	return domain.NewWebhookProcessingFailedError(err.Error())
+}
+
+// process runs the webhook pipeline for a stored event
+func (d *webhookDispatcher) process(ctx context.Context, event *domain.WebhookEvent) {
+	logger := d.Logger.WithFields(logrus.Fields{
+		"webhook_event_id": event.ID().String(),
+		"event_type":       event.EventType(),
+		"delivery_id":      event.DeliveryID(),
+	})
+
+	if err := d.WebhookService.ProcessWebhookEvent(ctx, event); err != nil {
+		logger.WithError(err).Error("Failed to process webhook event")
+		return
+	}
+	logger.Debug("Webhook event processed")
+}
+
+// reprocess runs the webhook pipeline for the current state of a stored event
+func (d *webhookDispatcher) reprocess(ctx context.Context, event *domain.WebhookEvent) {
+	current, err := d.WebhookService.GetWebhookEvent(ctx, event.ID())
+	if err != nil {
+		d.Logger.WithError(err).WithField("webhook_event_id", event.ID().String()).Error("Failed to reload webhook event")
+		return
+	}
+	if current.IsProcessed() {
+		return
+	}
+
+	d.process(ctx, current)
+}
+
+// release removes an event from the in-flight set
+func (d *webhookDispatcher) release(id string) {
+	d.mu.Lock()
+	delete(d.inFlight, id)
+	d.mu.Unlock()
+}
@@ -3,27 +3,42 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"html"
+	"strings"
+	"time"
 
 	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
 	buildDto "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/dto"
 	buildPort "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/port"
+	notificationDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
 	notificationPort "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	projectDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/project/domain"
 	projectPort "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/project/port"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/dto"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/provider"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/provider/github"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/provider/gitlab"
 	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/crypto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
 )
 
-// Constants for error messages and URL patterns
+// Constants for error messages
 const (
-	commitURLPath                 = "/commit/"
 	errFailedToCreateBuildEvent   = "failed to create build event: %w"
+	errFailedToUpdateBuildEvent   = "failed to update build event: %w"
+	errFailedToRecordBuildJob     = "failed to record build job: %w"
 	errFailedToCreateNotification = "failed to create notification: %w"
 )
 
+// webhookReprocessMinAge keeps reprocessing away from webhooks the request or worker that received them
+// may still be processing
+const webhookReprocessMinAge = time.Minute
+
 // Dep defines the dependencies for WebhookService
 type Dep struct {
 	WebhookEventRepo       port.WebhookEventRepository
@@ -31,6 +46,12 @@ type Dep struct {
 	BuildService           buildPort.BuildEventService
 	NotificationLogService notificationPort.NotificationLogService
 	SignatureVerifier      crypto.SignatureVerifier
+	Providers              port.ProviderRegistry
+	NotificationFormatter  notificationPort.NotificationFormatterService // Optional, renders stored templates
+	ChannelNotifications   notificationPort.ChannelNotificationService   // Optional, notifies project channel recipients
+	ProjectChannels        []notificationDomain.NotificationChannel      // Channels notified through project settings
+	WebhookSubscriptions   notificationPort.WebhookSubscriptionService   // Optional, resolves the webhook channel's subscribers
+	BuildTransitions       buildPort.BuildTransitionService              // Optional, picks the template of a completed build's transition
 }
 
 // webhookService handles webhook business logic
@@ -40,6 +61,14 @@ type webhookService struct {
 
 // NewWebhookService creates a new webhook service
 func NewWebhookService(d Dep) port.WebhookService {
+	if d.Providers == nil {
+		// Fall back to the built-in adapters, verifying GitHub signatures with the given verifier
+		d.Providers = provider.NewRegistry(
+			github.NewProvider(d.SignatureVerifier),
+			gitlab.NewProvider(crypto.NewGitLabTokenVerifier(), ""),
+		)
+	}
+
 	return &webhookService{
 		Dep: d,
 	}
@@ -47,67 +76,110 @@ func NewWebhookService(d Dep) port.WebhookService {
 
 // ProcessWebhook processes an incoming webhook request
 func (s *webhookService) ProcessWebhook(ctx context.Context, req dto.ProcessWebhookRequest) (*domain.WebhookEvent, error) {
+	ciProvider, webhookEvent, duplicate, err := s.acceptWebhook(ctx, req)
+	if err != nil || duplicate {
+		return webhookEvent, err
+	}
+
+	// 5. Process the webhook through the provider adapter
+	if err := s.processWebhookEvent(ctx, ciProvider, webhookEvent, req.Payload); err != nil {
+		// Log error but don't fail the webhook processing
+		// The webhook event is already stored, so we can retry processing later
+		return webhookEvent, nil
+	}
+
+	// 6. Mark as processed
+	webhookEvent.MarkAsProcessed()
+	if err := s.WebhookEventRepo.Update(ctx, webhookEvent); err != nil {
+		// Log error but don't fail - the main processing is done
+		return webhookEvent, nil
+	}
+
+	return webhookEvent, nil
+}
+
+// AcceptWebhook verifies and stores an incoming webhook request without processing it
+func (s *webhookService) AcceptWebhook(ctx context.Context, req dto.ProcessWebhookRequest) (*domain.WebhookEvent, error) {
+	_, webhookEvent, _, err := s.acceptWebhook(ctx, req)
+	return webhookEvent, err
+}
+
+// acceptWebhook verifies the request against its project and CI provider and stores
+// the webhook event. duplicate is set when the delivery was already received.
+func (s *webhookService) acceptWebhook(ctx context.Context, req dto.ProcessWebhookRequest) (port.CIProvider, *domain.WebhookEvent, bool, error) {
 	// 1. Verify project exists
 	project, err := s.ProjectService.GetProject(ctx, req.ProjectID)
 	if err != nil {
-		return nil, domain.NewWebhookProjectNotFoundError(req.ProjectID.String())
+		return nil, nil, false, domain.NewWebhookProjectNotFoundError(req.ProjectID.String())
+	}
+
+	// 2. Resolve the CI provider adapter
+	providerName := req.Provider
+	if providerName == "" {
+		providerName = req.EventType.Provider()
+	}
+	ciProvider, err := s.Providers.Get(providerName)
+	if err != nil {
+		return nil, nil, false, err
 	}
 
-	// 2. Verify webhook signature
-	if !s.SignatureVerifier.VerifySignature(project.WebhookSecret(), req.Signature, req.Body) {
-		return nil, domain.ErrWebhookInvalidSignature
+	// 3. Verify webhook signature
+	if !ciProvider.VerifySignature(project.WebhookSecret(), req.Signature, req.Body) {
+		return nil, nil, false, domain.ErrWebhookInvalidSignature
 	}
 
-	// 3. Check if this webhook has already been processed (idempotency)
-	if req.DeliveryID != "" {
-		exists, err := s.WebhookEventRepo.ExistsByDeliveryID(ctx, req.DeliveryID)
+	// 4. Store webhook event, skipping deliveries that were already received
+	signature := ciProvider.RedactSignature(req.Signature)
+	webhookEvent, duplicate, err := s.recordWebhookEvent(ctx, req.ProjectID, req.EventType, req.Payload, signature, req.DeliveryID)
+	return ciProvider, webhookEvent, duplicate, err
+}
+
+// recordWebhookEvent stores an incoming webhook event. When the delivery has
+// already been received the existing event is returned with duplicate set.
+func (s *webhookService) recordWebhookEvent(
+	ctx context.Context,
+	projectID value_objects.ID,
+	eventType domain.WebhookEventType,
+	payload interface{},
+	signature, deliveryID string,
+) (event *domain.WebhookEvent, duplicate bool, err error) {
+	// Check if this webhook has already been processed (idempotency)
+	if deliveryID != "" {
+		exists, err := s.WebhookEventRepo.ExistsByDeliveryID(ctx, deliveryID)
 		if err != nil {
-			return nil, domain.NewWebhookProcessingFailedError("failed to check duplicate delivery")
+			return nil, false, domain.NewWebhookProcessingFailedError("failed to check duplicate delivery")
 		}
 		if exists {
 			// Return existing webhook event
-			return s.WebhookEventRepo.GetByDeliveryID(ctx, req.DeliveryID)
+			event, err := s.WebhookEventRepo.GetByDeliveryID(ctx, deliveryID)
+			return event, true, err
 		}
 	}
 
-	// 4. Convert payload to JSON string
-	payloadBytes, err := json.Marshal(req.Payload)
+	// Convert payload to JSON string
+	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
-		return nil, domain.NewWebhookInvalidPayloadError("failed to marshal payload")
+		return nil, false, domain.NewWebhookInvalidPayloadError("failed to marshal payload")
 	}
 
-	// 5. Create webhook event domain entity
+	// Create webhook event domain entity
 	webhookEvent, err := domain.NewWebhookEvent(
-		req.ProjectID,
-		req.EventType,
+		projectID,
+		eventType,
 		string(payloadBytes),
-		req.Signature,
-		req.DeliveryID,
+		signature,
+		deliveryID,
 	)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
-	// 6. Store webhook event
+	// Store webhook event
 	if err := s.WebhookEventRepo.Create(ctx, webhookEvent); err != nil {
-		return nil, domain.NewWebhookProcessingFailedError("failed to store webhook event")
+		return nil, false, domain.NewWebhookProcessingFailedError("failed to store webhook event")
 	}
 
-	// 7. Process the webhook based on event type
-	if err := s.processWebhookEvent(ctx, webhookEvent, req.Payload); err != nil {
-		// Log error but don't fail the webhook processing
-		// The webhook event is already stored, so we can retry processing later
-		return webhookEvent, nil
-	}
-
-	// 8. Mark as processed
-	webhookEvent.MarkAsProcessed()
-	if err := s.WebhookEventRepo.Update(ctx, webhookEvent); err != nil {
-		// Log error but don't fail - the main processing is done
-		return webhookEvent, nil
-	}
-
-	return webhookEvent, nil
+	return webhookEvent, false, nil
 }
 
 // VerifyWebhookSignature verifies the webhook signature
@@ -125,169 +197,208 @@ func (s *webhookService) GetWebhookEventsByProject(ctx context.Context, projectI
 	return s.WebhookEventRepo.GetByProjectID(ctx, projectID, limit, offset)
 }
 
+// GetUnprocessedWebhookEvents retrieves stored webhook events that are still unprocessed, oldest first.
+// Events received within webhookReprocessMinAge are left out.
+func (s *webhookService) GetUnprocessedWebhookEvents(ctx context.Context, limit int) ([]*domain.WebhookEvent, error) {
+	events, err := s.WebhookEventRepo.GetUnprocessedEvents(ctx, time.Now().Add(-webhookReprocessMinAge), limit)
+	if err != nil {
+		return nil, domain.NewWebhookProcessingFailedError("failed to get unprocessed events")
+	}
+	return events, nil
+}
+
 // ReprocessFailedWebhooks reprocesses failed webhook events
 func (s *webhookService) ReprocessFailedWebhooks(ctx context.Context, limit int) error {
-	unprocessedEvents, err := s.WebhookEventRepo.GetUnprocessedEvents(ctx, limit)
+	unprocessedEvents, err := s.GetUnprocessedWebhookEvents(ctx, limit)
 	if err != nil {
-		return domain.NewWebhookProcessingFailedError("failed to get unprocessed events")
+		return err
 	}
 
 	for _, event := range unprocessedEvents {
-		// Parse the payload
-		var payload dto.GitHubActionsPayload
-		if err := json.Unmarshal([]byte(event.Payload()), &payload); err != nil {
-			continue // Skip invalid payloads
-		}
-
-		// Process the event
-		if err := s.processWebhookEvent(ctx, event, payload); err != nil {
-			continue // Skip failed processing
-		}
-
-		// Mark as processed
-		event.MarkAsProcessed()
-		if err := s.WebhookEventRepo.Update(ctx, event); err != nil {
-			continue // Log error but continue
-		}
+		// Skip invalid payloads and failed processing, they stay unprocessed
+		_ = s.ProcessWebhookEvent(ctx, event)
 	}
 
 	return nil
 }
 
-// processWebhookEvent processes the webhook event based on its type
-func (s *webhookService) processWebhookEvent(ctx context.Context, webhookEvent *domain.WebhookEvent, payload dto.GitHubActionsPayload) error {
-	switch webhookEvent.EventType() {
-	case domain.WorkflowRunEvent:
-		return s.processWorkflowRunEvent(ctx, webhookEvent, payload)
-	case domain.PushEvent:
-		return s.processPushEvent(ctx, webhookEvent, payload)
-	case domain.PullRequestEvent:
-		return s.processPullRequestEvent(ctx, webhookEvent, payload)
-	default:
-		return domain.NewWebhookInvalidEventError(string(webhookEvent.EventType()))
+// ProcessWebhookEvent processes a stored webhook event and marks it as processed
+func (s *webhookService) ProcessWebhookEvent(ctx context.Context, event *domain.WebhookEvent) error {
+	if event.IsProcessed() {
+		return nil
 	}
-}
 
-// processWorkflowRunEvent processes workflow_run events
-func (s *webhookService) processWorkflowRunEvent(ctx context.Context, webhookEvent *domain.WebhookEvent, payload dto.GitHubActionsPayload) error {
-	// Validate payload
-	if payload.WorkflowRun == nil {
-		return fmt.Errorf("invalid workflow run payload: workflow_run is nil")
+	if err := s.reprocessWebhookEvent(ctx, event); err != nil {
+		return err
 	}
 
-	// Extract workflow information
-	workflowInfo := s.extractWorkflowInfo(payload)
+	event.MarkAsProcessed()
+	if err := s.WebhookEventRepo.Update(ctx, event); err != nil {
+		return domain.NewWebhookProcessingFailedError("failed to mark webhook event as processed")
+	}
 
-	// Create build event
-	buildEvent, err := s.createWorkflowBuildEvent(ctx, webhookEvent, payload, workflowInfo)
+	return nil
+}
+
+// reprocessWebhookEvent decodes a stored payload and processes it again
+func (s *webhookService) reprocessWebhookEvent(ctx context.Context, event *domain.WebhookEvent) error {
+	ciProvider, err := s.Providers.Get(event.EventType().Provider())
 	if err != nil {
-		return fmt.Errorf(errFailedToCreateBuildEvent, err)
+		return err
 	}
 
-	// Create and send notifications
-	if err := s.createAndSendWorkflowNotifications(ctx, buildEvent, webhookEvent.ProjectID(), payload, workflowInfo); err != nil {
-		return fmt.Errorf(errFailedToCreateNotification, err)
+	payload, err := ciProvider.DecodePayload(event.EventType(), []byte(event.Payload()))
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return s.processWebhookEvent(ctx, ciProvider, event, payload)
 }
 
-// workflowInfo holds extracted workflow information
-type workflowInfo struct {
-	Branch      string
-	CommitSHA   string
-	BuildURL    string
-	BuildStatus buildDomain.BuildStatus
-	EventType   buildDomain.EventType
-}
+// processWebhookEvent converts the payload through its CI provider adapter,
+// stores the resulting build event and notifies subscribers.
+// Deliveries belonging to an already tracked CI run update that run's build event.
+func (s *webhookService) processWebhookEvent(ctx context.Context, ciProvider port.CIProvider, webhookEvent *domain.WebhookEvent, payload interface{}) error {
+	event, err := ciProvider.ToBuildEvent(webhookEvent.EventType(), payload)
+	if err != nil || event == nil {
+		return err
+	}
 
-// extractWorkflowInfo extracts workflow information from payload
-func (s *webhookService) extractWorkflowInfo(payload dto.GitHubActionsPayload) workflowInfo {
-	// Extract branch with fallback
-	branch := "main"
-	if payload.WorkflowRun.HeadBranch != "" {
-		branch = payload.WorkflowRun.HeadBranch
+	if event.Kind == dto.CIEventJob {
+		return s.recordRunJob(ctx, webhookEvent.ProjectID(), event)
 	}
 
-	// Extract commit SHA and build URL
-	commitSHA := payload.WorkflowRun.HeadSha
-	buildURL := payload.WorkflowRun.HTMLURL
+	if event.RunID != "" {
+		existing, err := s.BuildService.GetBuildEventByRunID(ctx, webhookEvent.ProjectID(), event.RunID)
+		if err == nil {
+			return s.updateRunBuildEvent(ctx, existing, event)
+		}
+		if !errors.Is(err, exception.ErrBuildEventNotFound) {
+			return fmt.Errorf(errFailedToUpdateBuildEvent, err)
+		}
+	}
 
-	// Use repository commit URL if available
-	repoURL := s.safeRepositoryURL(payload)
-	if repoURL != "" && commitSHA != "" {
-		buildURL = repoURL + commitURLPath + commitSHA
+	if event.Kind == dto.CIEventRelease {
+		event.Changelog = s.releaseChangelog(ctx, ciProvider, webhookEvent.ProjectID())
+	}
+
+	buildEvent, err := s.BuildService.CreateBuildEvent(ctx, buildDto.CreateBuildEventRequest{
+		ProjectID:       webhookEvent.ProjectID(),
+		RunID:           event.RunID,
+		EventType:       event.EventType,
+		Status:          event.Status,
+		Branch:          event.Branch,
+		CommitSHA:       event.CommitSHA,
+		CommitMessage:   event.CommitMessage,
+		AuthorName:      event.AuthorName,
+		AuthorEmail:     event.AuthorEmail,
+		BuildURL:        event.BuildURL,
+		DurationSeconds: event.DurationSeconds,
+		WebhookPayload:  json.RawMessage(webhookEvent.Payload()),
+	})
+	if errors.Is(err, exception.ErrBuildEventRunExists) {
+		// A concurrent delivery of the same run created its build event first
+		existing, err := s.BuildService.GetBuildEventByRunID(ctx, webhookEvent.ProjectID(), event.RunID)
+		if err != nil {
+			return fmt.Errorf(errFailedToUpdateBuildEvent, err)
+		}
+		return s.updateRunBuildEvent(ctx, existing, event)
+	}
+	if err != nil {
+		return fmt.Errorf(errFailedToCreateBuildEvent, err)
 	}
 
-	// Determine build status
-	buildStatus := s.determineBuildStatus(payload.WorkflowRun.Conclusion)
+	// Create notification if build event was created successfully
+	return s.notifyRunStatus(ctx, buildEvent, event)
+}
 
-	// Determine event type
-	eventType := s.determineEventType(payload.Action)
+// updateRunBuildEvent applies a later delivery of a CI run to the run's existing build event
+func (s *webhookService) updateRunBuildEvent(ctx context.Context, buildEvent *buildDomain.BuildEvent, event *dto.CIBuildEvent) error {
+	// Skip deliveries that repeat the current status or arrive out of order after the run finished
+	current := buildEvent.Status()
+	if current == event.Status || (current.IsTerminal() && !event.Status.IsTerminal()) {
+		return nil
+	}
 
-	return workflowInfo{
-		Branch:      branch,
-		CommitSHA:   commitSHA,
-		BuildURL:    buildURL,
-		BuildStatus: buildStatus,
-		EventType:   eventType,
+	if err := s.BuildService.UpdateBuildEventStatus(ctx, buildEvent.ID(), event.Status, event.DurationSeconds); err != nil {
+		return fmt.Errorf(errFailedToUpdateBuildEvent, err)
 	}
+
+	return s.notifyRunStatus(ctx, buildEvent, event)
 }
 
-// determineBuildStatus determines build status from workflow conclusion
-func (s *webhookService) determineBuildStatus(conclusion string) buildDomain.BuildStatus {
-	switch conclusion {
-	case "success":
-		return buildDomain.BuildStatusSuccess
-	case "failure":
-		return buildDomain.BuildStatusFailed
-	case "cancelled":
-		return buildDomain.BuildStatusCancelled
-	default:
-		return buildDomain.BuildStatusInProgress
+// notifyRunStatus notifies subscribers and project channels of the current status of a build event.
+// Failed runs list the jobs recorded for them so far.
+func (s *webhookService) notifyRunStatus(ctx context.Context, buildEvent *buildDomain.BuildEvent, event *dto.CIBuildEvent) error {
+	transition := s.buildTransition(ctx, buildEvent, event)
+	message := s.buildNotificationMessage(ctx, event, transition)
+	if event.Kind == dto.CIEventBuild && event.Status == buildDomain.BuildStatusFailed {
+		message += s.failedJobsSummary(ctx, buildEvent.ID())
+	}
+	if err := s.notifyBuildEvent(ctx, buildEvent, buildEvent.ProjectID(), message); err != nil {
+		return fmt.Errorf(errFailedToCreateNotification, err)
 	}
+	if err := s.notifyProjectChannels(ctx, buildEvent, event, transition); err != nil {
+		return fmt.Errorf(errFailedToCreateNotification, err)
+	}
+
+	return nil
 }
 
-// determineEventType determines event type from workflow action
-func (s *webhookService) determineEventType(action string) buildDomain.EventType {
-	switch action {
-	case "completed":
-		return buildDomain.EventTypeBuildCompleted
-	case "requested":
-		return buildDomain.EventTypeBuildStarted
-	default:
-		return buildDomain.EventTypeBuildCompleted
+// recordRunJob stores a job under the build event of its run. Jobs are not notified on their own,
+// failing jobs are listed in the failure notification of the run instead.
+// A job delivered before its run fails here and is picked up again when failed webhooks are reprocessed.
+func (s *webhookService) recordRunJob(ctx context.Context, projectID value_objects.ID, event *dto.CIBuildEvent) error {
+	buildEvent, err := s.BuildService.GetBuildEventByRunID(ctx, projectID, event.RunID)
+	if err != nil {
+		return fmt.Errorf(errFailedToRecordBuildJob, err)
 	}
+
+	if _, err := s.BuildService.RecordBuildJob(ctx, buildDto.RecordBuildJobRequest{
+		BuildEventID:    buildEvent.ID(),
+		JobID:           event.JobID,
+		Name:            event.Name,
+		Status:          event.Status,
+		FailedSteps:     event.FailedSteps,
+		URL:             event.BuildURL,
+		DurationSeconds: event.DurationSeconds,
+	}); err != nil {
+		return fmt.Errorf(errFailedToRecordBuildJob, err)
+	}
+
+	return nil
 }
 
-// createWorkflowBuildEvent creates a build event for workflow runs
-func (s *webhookService) createWorkflowBuildEvent(ctx context.Context, webhookEvent *domain.WebhookEvent, payload dto.GitHubActionsPayload, info workflowInfo) (*buildDomain.BuildEvent, error) {
-	buildEventReq := buildDto.CreateBuildEventRequest{
-		ProjectID:     webhookEvent.ProjectID(),
-		EventType:     info.EventType,
-		Status:        info.BuildStatus,
-		Branch:        info.Branch,
-		CommitSHA:     info.CommitSHA,
-		CommitMessage: "", // Not available in workflow run payload
-		AuthorName:    "",
-		AuthorEmail:   "",
-		BuildURL:      info.BuildURL,
+// failedJobsSummary lists the failed jobs of a run and their failing steps.
+// Notifications are sent as HTML, job and step names come from the CI provider and are escaped.
+func (s *webhookService) failedJobsSummary(ctx context.Context, buildEventID value_objects.ID) string {
+	jobs, err := s.BuildService.GetBuildJobs(ctx, buildEventID)
+	if err != nil {
+		return ""
 	}
 
-	return s.BuildService.CreateBuildEvent(ctx, buildEventReq)
+	var b strings.Builder
+	for _, job := range jobs {
+		if !job.IsFailed() {
+			continue
+		}
+		if b.Len() == 0 {
+			b.WriteString("\n<b>Failed jobs:</b>")
+		}
+		fmt.Fprintf(&b, "\n• %s", html.EscapeString(job.Name()))
+		if steps := job.FailedSteps(); len(steps) > 0 {
+			fmt.Fprintf(&b, " → %s", html.EscapeString(strings.Join(steps, ", ")))
+		}
+	}
+	return b.String()
 }
 
-// createAndSendWorkflowNotifications creates and sends notifications for workflow events
-func (s *webhookService) createAndSendWorkflowNotifications(ctx context.Context, buildEvent *buildDomain.BuildEvent, projectID value_objects.ID, payload dto.GitHubActionsPayload, info workflowInfo) error {
+// notifyBuildEvent creates notifications for a build event and sends them immediately
+func (s *webhookService) notifyBuildEvent(ctx context.Context, buildEvent *buildDomain.BuildEvent, projectID value_objects.ID, message string) error {
 	if buildEvent == nil || s.NotificationLogService == nil {
 		return nil
 	}
 
-	// Build notification message
-	statusText := s.buildStatusText(info.BuildStatus)
-	message := fmt.Sprintf("🔔 %s %s for %s on branch %s",
-		payload.WorkflowRun.Name, statusText, s.safeRepositoryName(payload), info.Branch)
-
 	// Create notifications
 	notifications, err := s.NotificationLogService.CreateNotificationForBuildEvent(
 		ctx,
@@ -299,341 +410,356 @@ func (s *webhookService) createAndSendWorkflowNotifications(ctx context.Context,
 		return err
 	}
 
-	// Immediately process the created notifications (same as original behavior)
-	if len(notifications) > 0 {
-		for _, notification := range notifications {
-			if err := s.NotificationLogService.SendNotification(ctx, notification.ID()); err != nil {
-				// Log the error but don't fail the entire webhook processing
-				// The notification will remain pending and can be retried later
-				continue
-			}
+	// Immediately process the created notifications
+	for _, notification := range notifications {
+		if err := s.NotificationLogService.SendNotification(ctx, notification.ID()); err != nil {
+			// Log the error but don't fail the entire webhook processing
+			// The notification will remain pending and can be retried later
+			continue
 		}
 	}
 
 	return nil
 }
 
-// buildStatusText returns the status text with emoji for notifications
-func (s *webhookService) buildStatusText(status buildDomain.BuildStatus) string {
-	switch status {
-	case buildDomain.BuildStatusSuccess:
-		return "✅ succeeded"
-	case buildDomain.BuildStatusFailed:
-		return "❌ failed"
-	case buildDomain.BuildStatusCancelled:
-		return "⏹️ cancelled"
-	default:
-		return "🔄 is running"
+// notifyProjectChannels notifies the recipients a project configures for its enabled channels.
+// Events are rendered through the channel's template, events without one are not sent to the channel.
+func (s *webhookService) notifyProjectChannels(
+	ctx context.Context,
+	buildEvent *buildDomain.BuildEvent,
+	event *dto.CIBuildEvent,
+	transition buildDomain.BuildTransition,
+) error {
+	if buildEvent == nil || s.ChannelNotifications == nil || s.NotificationLogService == nil || len(s.ProjectChannels) == 0 {
+		return nil
 	}
-}
 
-// processPushEvent processes push events
-func (s *webhookService) processPushEvent(ctx context.Context, webhookEvent *domain.WebhookEvent, payload dto.GitHubActionsPayload) error {
-	branch := s.extractBranchFromRef(payload.Ref)
-	commitInfo := s.extractCommitInfo(payload)
-
-	buildEventReq := buildDto.CreateBuildEventRequest{
-		ProjectID:     webhookEvent.ProjectID(),
-		EventType:     buildDomain.EventTypePush,
-		Status:        buildDomain.BuildStatusSuccess,
-		Branch:        branch,
-		CommitSHA:     commitInfo.SHA,
-		CommitMessage: commitInfo.Message,
-		AuthorName:    commitInfo.AuthorName,
-		AuthorEmail:   commitInfo.AuthorEmail,
-		BuildURL:      commitInfo.BuildURL,
-		WebhookPayload: func() []byte {
-			data, _ := json.Marshal(payload)
-			return data
-		}(),
+	templateType, ok := notificationTemplateType(event)
+	if !ok {
+		return nil
 	}
+	templateTypes := notificationTemplateTypes(templateType, transition)
 
-	buildEvent, err := s.BuildService.CreateBuildEvent(ctx, buildEventReq)
+	project, err := s.ProjectService.GetProject(ctx, buildEvent.ProjectID())
 	if err != nil {
-		return fmt.Errorf(errFailedToCreateBuildEvent, err)
+		return err
 	}
 
-	// Create notification if build event was created successfully
-	if buildEvent != nil && s.NotificationLogService != nil {
-		message := s.buildNotificationMessage(payload, branch, commitInfo)
-		notifications, err := s.NotificationLogService.CreateNotificationForBuildEvent(
-			ctx,
-			buildEvent.ID(),
-			webhookEvent.ProjectID(),
-			message,
-		)
-		if err != nil {
-			return fmt.Errorf(errFailedToCreateNotification, err)
+	params := notificationTemplateParams(event)
+	params.ProjectName = project.Name()
+	if event.Kind == dto.CIEventBuild && event.Status == buildDomain.BuildStatusFailed {
+		params.ErrorMessage = s.failedJobNames(ctx, buildEvent.ID())
+	}
+
+	for _, channel := range s.ProjectChannels {
+		if channel == notificationDomain.NotificationChannelWebhook {
+			if err := s.notifyWebhookSubscriptions(ctx, buildEvent, event, project, templateType, transition, params); err != nil {
+				return err
+			}
+			continue
+		}
+
+		recipients := projectChannelRecipients(project, channel)
+		if len(recipients) == 0 {
+			continue
+		}
+		// Emails are only sent once a build or deployment has finished
+		if channel == notificationDomain.NotificationChannelEmail && !event.Status.IsTerminal() {
+			continue
 		}
 
-		// Immediately process the created notifications
-		if len(notifications) > 0 {
-			for _, notification := range notifications {
-				if err := s.NotificationLogService.SendNotification(ctx, notification.ID()); err != nil {
-					// Log the error but don't fail the entire webhook processing
-					// The notification will remain pending and can be retried later
-					continue
-				}
+		subject, body, err := s.renderNotificationTemplates(ctx, templateTypes, channel, params)
+		if err != nil {
+			// The channel has no template for this event
+			continue
+		}
+		if channel.HasChannelMessages() {
+			// The channel styles the message from the event, not from the rendered text
+			body, err = notificationDomain.NewChannelMessage(body, event.Status, params).Encode()
+			if err != nil {
+				return err
 			}
 		}
+
+		if err := s.sendChannelNotifications(ctx, buildEvent, channel, recipients, subject, body); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-// extractBranchFromRef extracts branch name from git ref
-func (s *webhookService) extractBranchFromRef(ref string) string {
-	if ref == "" {
-		return "main"
-	}
-	if len(ref) > 11 && ref[:11] == "refs/heads/" {
-		return ref[11:]
+// notifyWebhookSubscriptions notifies the active webhook subscriptions of a project.
+// Each subscription is addressed by its ID and receives the event in its own payload format.
+func (s *webhookService) notifyWebhookSubscriptions(
+	ctx context.Context,
+	buildEvent *buildDomain.BuildEvent,
+	event *dto.CIBuildEvent,
+	project *projectDomain.Project,
+	templateType notificationDomain.NotificationTemplateType,
+	transition buildDomain.BuildTransition,
+	params notificationDomain.TemplateParams,
+) error {
+	if s.WebhookSubscriptions == nil {
+		return nil
 	}
-	return "main"
-}
 
-// commitInfo holds commit information
-type commitInfo struct {
-	SHA         string
-	Message     string
-	AuthorName  string
-	AuthorEmail string
-	BuildURL    string
-}
-
-// extractCommitInfo extracts commit information from payload with enhanced nil safety
-func (s *webhookService) extractCommitInfo(payload dto.GitHubActionsPayload) (result commitInfo) {
-	// Use named return and defer to ensure we always return something valid
-	defer func() {
-		if r := recover(); r != nil {
-			// If panic occurs, return the fallback commit info
-			result = s.createFallbackCommitInfo(payload)
-		}
-	}()
-
-	// Try HeadCommit first
-	if payload.HeadCommit != nil {
-		result = commitInfo{
-			SHA:         s.safeString(payload.HeadCommit.ID),
-			Message:     s.safeString(payload.HeadCommit.Message),
-			AuthorName:  s.safeString(payload.HeadCommit.Author.Name),
-			AuthorEmail: s.safeString(payload.HeadCommit.Author.Email),
-			BuildURL:    s.safeString(payload.HeadCommit.URL),
-		}
-		return result
+	eventType, ok := notificationDomain.WebhookEventTypeFor(templateType)
+	if !ok {
+		return nil
 	}
 
-	// Try Commits array as fallback
-	if len(payload.Commits) > 0 {
-		lastCommit := payload.Commits[len(payload.Commits)-1]
-		buildURL := ""
-		commitID := s.safeString(lastCommit.ID)
-		repoURL := s.safeRepositoryURL(payload)
-		if repoURL != "" && commitID != "" {
-			buildURL = repoURL + commitURLPath + commitID
-		}
+	subscriptions, err := s.WebhookSubscriptions.GetActiveWebhookSubscriptions(ctx, project.ID())
+	if err != nil {
+		return err
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
 
-		result = commitInfo{
-			SHA:         commitID,
-			Message:     s.safeString(lastCommit.Message),
-			AuthorName:  s.safeString(lastCommit.Author.Name),
-			AuthorEmail: s.safeString(lastCommit.Author.Email),
-			BuildURL:    buildURL,
-		}
-		return result
+	recipients := make([]string, len(subscriptions))
+	for i, subscription := range subscriptions {
+		recipients[i] = subscription.ID().String()
+	}
+
+	message, err := notificationDomain.NewOutgoingWebhookEvent(eventType, time.Now(), notificationDomain.WebhookEventData{
+		Project: notificationDomain.WebhookProject{
+			ID:   project.ID().String(),
+			Name: project.Name(),
+		},
+		Build: &notificationDomain.WebhookBuild{
+			ID:              buildEvent.ID().String(),
+			Status:          string(event.Status),
+			Branch:          event.Branch,
+			Commit:          event.CommitSHA,
+			Author:          event.AuthorName,
+			URL:             event.BuildURL,
+			Environment:     event.Environment,
+			DurationSeconds: event.DurationSeconds,
+			Error:           params.ErrorMessage,
+			Transition:      string(transition),
+		},
+	}).Encode()
+	if err != nil {
+		return err
 	}
 
-	// Final fallback
-	return s.createFallbackCommitInfo(payload)
+	return s.sendChannelNotifications(ctx, buildEvent, notificationDomain.NotificationChannelWebhook, recipients, eventType, message)
 }
 
-// safeString returns empty string if input is empty or contains only whitespace
-func (s *webhookService) safeString(str string) string {
-	if str == "" {
-		return ""
+// sendChannelNotifications records a notification for each recipient of a channel and sends it
+func (s *webhookService) sendChannelNotifications(
+	ctx context.Context,
+	buildEvent *buildDomain.BuildEvent,
+	channel notificationDomain.NotificationChannel,
+	recipients []string,
+	subject, body string,
+) error {
+	notifications, err := s.ChannelNotifications.CreateChannelNotificationsForBuildEvent(
+		ctx,
+		buildEvent.ID(),
+		buildEvent.ProjectID(),
+		channel,
+		recipients,
+		subject,
+		body,
+	)
+	if err != nil {
+		return err
 	}
-	// You could add additional sanitization here if needed
-	return str
-}
 
-// safeRepositoryName safely extracts repository name from payload
-func (s *webhookService) safeRepositoryName(payload dto.GitHubActionsPayload) string {
-	if payload.Repository.FullName != "" {
-		return payload.Repository.FullName
-	}
-	if payload.Repository.Name != "" {
-		return payload.Repository.Name
+	for _, notification := range notifications {
+		// Failed notifications stay in the notification log and are retried later
+		_ = s.NotificationLogService.SendNotification(ctx, notification.ID())
 	}
-	return "Unknown Repository"
+
+	return nil
 }
 
-// safeRepositoryURL safely extracts repository HTML URL from payload
-func (s *webhookService) safeRepositoryURL(payload dto.GitHubActionsPayload) string {
-	if payload.Repository.HTMLURL != "" {
-		return payload.Repository.HTMLURL
+// projectChannelRecipients returns the recipients a project configures for a channel
+func projectChannelRecipients(project *projectDomain.Project, channel notificationDomain.NotificationChannel) []string {
+	switch channel {
+	case notificationDomain.NotificationChannelEmail:
+		return project.EmailRecipients()
+	case notificationDomain.NotificationChannelSlack:
+		return project.SlackChannels()
+	case notificationDomain.NotificationChannelTeams:
+		return project.TeamsWebhooks()
+	case notificationDomain.NotificationChannelDiscord:
+		return project.DiscordWebhooks()
+	default:
+		return nil
 	}
-	return ""
 }
 
-// createFallbackCommitInfo creates fallback commit info when HeadCommit and Commits are not available
-func (s *webhookService) createFallbackCommitInfo(payload dto.GitHubActionsPayload) commitInfo {
-	sha := payload.After
-	if sha == "" {
-		sha = "unknown"
+// notificationTemplateType returns the notification template of a provider-neutral event
+func notificationTemplateType(event *dto.CIBuildEvent) (notificationDomain.NotificationTemplateType, bool) {
+	switch event.Kind {
+	case dto.CIEventDeployment:
+		return notificationDomain.TemplateTypeDeployment, true
+	case dto.CIEventPush:
+		return notificationDomain.TemplateTypePush, true
+	case dto.CIEventChangeRequest:
+		return notificationDomain.TemplateTypePullRequest, true
+	case dto.CIEventRelease:
+		return notificationDomain.TemplateTypeRelease, true
+	case dto.CIEventBuild:
+		switch event.Status {
+		case buildDomain.BuildStatusInProgress:
+			return notificationDomain.TemplateTypeBuildStarted, true
+		case buildDomain.BuildStatusSuccess:
+			return notificationDomain.TemplateTypeBuildSuccess, true
+		case buildDomain.BuildStatusFailed:
+			return notificationDomain.TemplateTypeBuildFailure, true
+		}
 	}
+	return "", false
+}
 
-	message := "Push to " + s.extractBranchFromRef(payload.Ref)
-
-	var authorName, authorEmail string
-	if payload.Pusher != nil {
-		authorName = payload.Pusher.Name
-		authorEmail = payload.Pusher.Email
-	}
+// notificationTemplateTypes returns the templates an event is rendered through, most specific first.
+// Completed builds use the template of their transition and fall back to the template of their status.
+func notificationTemplateTypes(
+	templateType notificationDomain.NotificationTemplateType,
+	transition buildDomain.BuildTransition,
+) []notificationDomain.NotificationTemplateType {
+	transitionType, ok := notificationDomain.TemplateTypeForBuildTransition(transition)
+	if !ok || transitionType == templateType {
+		return []notificationDomain.NotificationTemplateType{templateType}
+	}
+	return []notificationDomain.NotificationTemplateType{transitionType, templateType}
+}
 
-	buildURL := ""
-	// Safely access Repository fields
-	repoURL := s.safeRepositoryURL(payload)
-	if repoURL != "" && sha != "" && sha != "unknown" {
-		buildURL = repoURL + commitURLPath + sha
+// buildTransition classifies a completed build against the earlier builds of its branch,
+// empty for other events or when the history is not available
+func (s *webhookService) buildTransition(ctx context.Context, buildEvent *buildDomain.BuildEvent, event *dto.CIBuildEvent) buildDomain.BuildTransition {
+	if s.BuildTransitions == nil || buildEvent == nil || event.Kind != dto.CIEventBuild || !event.Status.IsTerminal() {
+		return ""
 	}
 
-	return commitInfo{
-		SHA:         sha,
-		Message:     message,
-		AuthorName:  authorName,
-		AuthorEmail: authorEmail,
-		BuildURL:    buildURL,
+	transition, ok, err := s.BuildTransitions.GetBuildTransition(ctx, buildEvent.ID())
+	if err != nil || !ok {
+		// The build is notified through the template of its status
+		return ""
 	}
+	return transition
 }
 
-// buildNotificationMessage builds notification message with safe string handling
-func (s *webhookService) buildNotificationMessage(payload dto.GitHubActionsPayload, branch string, commit commitInfo) string {
-	// Safely extract repository name with fallback
-	repoName := s.safeRepositoryName(payload)
-
-	// Safely extract author name with fallback
-	authorName := "Unknown Author"
-	if commit.AuthorName != "" {
-		authorName = commit.AuthorName
+// failedJobNames lists the failed jobs of a run for plain-text notifications
+func (s *webhookService) failedJobNames(ctx context.Context, buildEventID value_objects.ID) string {
+	jobs, err := s.BuildService.GetBuildJobs(ctx, buildEventID)
+	if err != nil {
+		return ""
 	}
 
-	// Safely extract commit message with fallback
-	commitMessage := "No message"
-	if commit.Message != "" {
-		commitMessage = commit.Message
+	var names []string
+	for _, job := range jobs {
+		if job.IsFailed() {
+			names = append(names, job.Name())
+		}
 	}
-
-	return fmt.Sprintf("📤 *Push Event*\n*Project:* %s\n*Branch:* %s\n*Commit:* %s\n*Author:* %s",
-		repoName, branch, commitMessage, authorName)
+	if len(names) == 0 {
+		return ""
+	}
+	return "failed jobs: " + strings.Join(names, ", ")
 }
 
-// processPullRequestEvent processes pull request events
-func (s *webhookService) processPullRequestEvent(ctx context.Context, webhookEvent *domain.WebhookEvent, payload dto.GitHubActionsPayload) error {
-	if payload.PullRequest == nil {
-		return fmt.Errorf("pull request data is missing")
+// buildNotificationMessage renders the Telegram notification of a provider-neutral event through its template.
+// Events without a template, e.g. cancelled builds, fall back to a plain status line.
+func (s *webhookService) buildNotificationMessage(ctx context.Context, event *dto.CIBuildEvent, transition buildDomain.BuildTransition) string {
+	if templateType, ok := notificationTemplateType(event); ok {
+		_, body, err := s.renderNotificationTemplates(ctx, notificationTemplateTypes(templateType, transition),
+			notificationDomain.NotificationChannelTelegram, notificationTemplateParams(event))
+		if err == nil {
+			return body
+		}
 	}
 
-	pr := payload.PullRequest
+	return fmt.Sprintf("🔔 %s %s for %s on branch %s", html.EscapeString(event.Name),
+		s.buildStatusText(event.Status), html.EscapeString(event.Repository), html.EscapeString(event.Branch))
+}
 
-	// Determine status based on PR action
-	status := s.determinePRStatus(payload.Action, pr.State)
+// notificationTemplateParams fills the template parameters of a provider-neutral event
+func notificationTemplateParams(event *dto.CIBuildEvent) notificationDomain.TemplateParams {
+	params := notificationDomain.TemplateParams{
+		ProjectName:   event.Repository,
+		BuildStatus:   string(event.Status),
+		BuildBranch:   event.Branch,
+		BuildCommit:   event.CommitSHA,
+		BuildAuthor:   event.AuthorName,
+		BuildURL:      event.BuildURL,
+		Timestamp:     time.Now().Format("2006-01-02 15:04:05"),
+		Environment:   event.Environment,
+		EventName:     event.Name,
+		EventAction:   event.Action,
+		CommitMessage: event.CommitMessage,
+		Title:         event.Title,
+		TargetBranch:  event.TargetBranch,
+		Tag:           event.Tag,
+		Changelog:     releaseChangelogText(event.Changelog),
+	}
+	if event.DurationSeconds != nil {
+		params.BuildDuration = (time.Duration(*event.DurationSeconds) * time.Second).String()
+	}
+	// Providers report the failure description of a deployment as its message
+	if event.Kind == dto.CIEventDeployment && event.Status == buildDomain.BuildStatusFailed {
+		params.ErrorMessage = event.CommitMessage
+	}
+	return params
+}
 
-	// Create build event request
-	buildEventReq := s.createPRBuildEventRequest(webhookEvent, payload, pr, status)
+// renderNotificationTemplate renders a notification template of a channel.
+// Stored templates take precedence, the built-in default template is used when none is available.
+func (s *webhookService) renderNotificationTemplate(
+	ctx context.Context,
+	templateType notificationDomain.NotificationTemplateType,
+	channel notificationDomain.NotificationChannel,
+	params notificationDomain.TemplateParams,
+) (subject, body string, err error) {
+	if s.NotificationFormatter != nil {
+		subject, body, err := s.NotificationFormatter.FormatNotification(ctx, templateType, channel, params)
+		if err == nil {
+			return subject, body, nil
+		}
+	}
 
-	// Create build event
-	buildEvent, err := s.BuildService.CreateBuildEvent(ctx, buildEventReq)
+	defaults, ok := notificationDomain.GetDefaultTemplates()[templateType][channel]
+	if !ok {
+		return "", "", notificationDomain.ErrTemplateNotFound
+	}
+	tmpl, err := notificationDomain.NewNotificationTemplate(templateType, channel, defaults.Subject, defaults.Body)
 	if err != nil {
-		return fmt.Errorf(errFailedToCreateBuildEvent, err)
+		return "", "", err
 	}
 
-	// Create notification if build event was created successfully
-	if buildEvent != nil && s.NotificationLogService != nil {
-		message := s.createPRNotificationMessage(payload, pr)
-		notifications, err := s.NotificationLogService.CreateNotificationForBuildEvent(
-			ctx,
-			buildEvent.ID(),
-			webhookEvent.ProjectID(),
-			message,
-		)
-		if err != nil {
-			return fmt.Errorf(errFailedToCreateNotification, err)
-		}
+	return tmpl.RenderTemplate(params)
+}
 
-		// Immediately process the created notifications
-		if len(notifications) > 0 {
-			for _, notification := range notifications {
-				if err := s.NotificationLogService.SendNotification(ctx, notification.ID()); err != nil {
-					// Log the error but don't fail the entire webhook processing
-					// The notification will remain pending and can be retried later
-					continue
-				}
-			}
+// renderNotificationTemplates renders the first of the templates available for a channel
+func (s *webhookService) renderNotificationTemplates(
+	ctx context.Context,
+	templateTypes []notificationDomain.NotificationTemplateType,
+	channel notificationDomain.NotificationChannel,
+	params notificationDomain.TemplateParams,
+) (subject, body string, err error) {
+	for _, templateType := range templateTypes {
+		subject, body, err = s.renderNotificationTemplate(ctx, templateType, channel, params)
+		if err == nil {
+			return subject, body, nil
 		}
 	}
-
-	return nil
+	return "", "", err
 }
 
-// determinePRStatus determines the build status based on PR action and state
-func (s *webhookService) determinePRStatus(action, state string) buildDomain.BuildStatus {
-	status := buildDomain.BuildStatusPending
-	if action == "closed" {
-		if state == "merged" {
-			status = buildDomain.BuildStatusSuccess
-		} else {
-			status = buildDomain.BuildStatusCancelled
-		}
-	}
-	return status
-}
-
-// createPRBuildEventRequest creates build event request for pull request
-func (s *webhookService) createPRBuildEventRequest(webhookEvent *domain.WebhookEvent, payload dto.GitHubActionsPayload, pr *dto.PullRequest, status buildDomain.BuildStatus) buildDto.CreateBuildEventRequest {
-	branch := pr.Head.Ref
-	commitSHA := pr.Head.SHA
-	commitMessage := fmt.Sprintf("Pull Request: %s", pr.Title)
-	authorName := pr.User.Name
-	authorEmail := pr.User.Email
-	buildURL := pr.HTMLURL
-
-	return buildDto.CreateBuildEventRequest{
-		ProjectID:     webhookEvent.ProjectID(),
-		EventType:     buildDomain.EventTypePullRequest,
-		Status:        status,
-		Branch:        branch,
-		CommitSHA:     commitSHA,
-		CommitMessage: commitMessage,
-		AuthorName:    authorName,
-		AuthorEmail:   authorEmail,
-		BuildURL:      buildURL,
-		WebhookPayload: func() []byte {
-			data, _ := json.Marshal(payload)
-			return data
-		}(),
-	}
-}
-
-// createPRNotificationMessage creates notification message for pull request
-func (s *webhookService) createPRNotificationMessage(payload dto.GitHubActionsPayload, pr *dto.PullRequest) string {
-	actionText := s.getPRActionText(payload.Action, pr.State)
-	return fmt.Sprintf("📋 *Pull Request %s*\n*Project:* %s\n*Title:* %s\n*Branch:* %s → %s\n*Author:* %s",
-		actionText, s.safeRepositoryName(payload), pr.Title, pr.Head.Ref, pr.Base.Ref, pr.User.Name)
-}
-
-// getPRActionText returns the action text for pull request notifications
-func (s *webhookService) getPRActionText(action, state string) string {
-	switch action {
-	case "closed":
-		if state == "merged" {
-			return "merged"
-		}
-		return "closed"
-	case "reopened":
-		return "reopened"
-	case "synchronize":
-		return "updated"
+// buildStatusText returns the status text with emoji for notifications
+func (s *webhookService) buildStatusText(status buildDomain.BuildStatus) string {
+	switch status {
+	case buildDomain.BuildStatusSuccess:
+		return "✅ succeeded"
+	case buildDomain.BuildStatusFailed:
+		return "❌ failed"
+	case buildDomain.BuildStatusCancelled:
+		return "⏹️ cancelled"
 	default:
-		return "opened"
+		return "🔄 is running"
 	}
 }
@@ -2,12 +2,17 @@ package app
 
 import (
 	d "github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/dashboard"
+	dl "github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/deadletter"
+	e "github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/escalation"
 	h "github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/health"
 	p "github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/project"
 	t "github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/telegram"
 	w "github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/webhook"
+	ws "github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/webhooksubscription"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/config"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/server/middleware"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/scheduler"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/workerpool"
 	"github.com/gofiber/fiber/v2"
 	"github.com/sirupsen/logrus"
 )
@@ -18,13 +23,18 @@ const (
 )
 
 type Dep struct {
-	AppConfig        *config.AppConfig
-	HealthHandler    *h.HealthHandler
-	ProjectHandler   *p.Handler
-	WebhookHandler   *w.WebhookHandler
-	TelegramHandler  *t.TelegramHandler
-	DashboardHandler *d.Handler
-	Logger           *logrus.Logger
+	AppConfig                  *config.AppConfig
+	HealthHandler              *h.HealthHandler
+	ProjectHandler             *p.Handler
+	WebhookHandler             *w.WebhookHandler
+	TelegramHandler            *t.TelegramHandler
+	DashboardHandler           *d.Handler
+	DeadLetterHandler          *dl.Handler
+	WebhookSubscriptionHandler *ws.Handler
+	EscalationHandler          *e.Handler
+	Scheduler                  *scheduler.Scheduler // Optional, runs background jobs while the server is up
+	WebhookWorkers             *workerpool.Pool     // Optional, processes accepted webhooks, drained on shutdown
+	Logger                     *logrus.Logger
 }
 
 type service struct {
@@ -6,11 +6,14 @@ import (
 
 func (s *service) createRoutes() {
 	router.NewRoutes(router.Dep{
-		App:              s.HTTPServer,
-		HealthHandler:    s.HealthHandler,
-		ProjectHandler:   s.ProjectHandler,
-		WebhookHandler:   s.WebhookHandler,
-		TelegramHandler:  s.TelegramHandler,
-		DashboardHandler: s.DashboardHandler,
+		App:                        s.HTTPServer,
+		HealthHandler:              s.HealthHandler,
+		ProjectHandler:             s.ProjectHandler,
+		WebhookHandler:             s.WebhookHandler,
+		TelegramHandler:            s.TelegramHandler,
+		DashboardHandler:           s.DashboardHandler,
+		DeadLetterHandler:          s.DeadLetterHandler,
+		WebhookSubscriptionHandler: s.WebhookSubscriptionHandler,
+		EscalationHandler:          s.EscalationHandler,
 	}).RegisterRoutes()
 }
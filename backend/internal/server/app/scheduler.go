@@ -0,0 +1,106 @@
+package app
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/config"
+	notificationPort "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	webhookPort "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/scheduler"
+)
+
+// Background job names
+const (
+	JobPendingNotifications  = "pending_notifications"
+	JobFailedNotifications   = "failed_notifications"
+	JobUnprocessedWebhooks   = "unprocessed_webhooks"
+	JobNotificationDigests   = "notification_digests"
+	JobDeferredNotifications = "deferred_notifications"
+	JobEscalations           = "escalations"
+	JobQueuedNotifications   = "queued_notifications"
+)
+
+// SchedulerDep defines the dependencies of the background job scheduler
+type SchedulerDep struct {
+	Config                 config.SchedulerConfig
+	NotificationLogService notificationPort.NotificationLogService
+	WebhookService         webhookPort.WebhookService
+	WebhookDispatcher      webhookPort.WebhookDispatcher // Optional, reprocesses webhooks on the worker pool when set
+	EscalationService      notificationPort.EscalationService
+	DeliveryService        notificationPort.NotificationDeliveryService
+	Logger                 *logrus.Logger
+}
+
+// NewScheduler creates the scheduler running the notification and webhook retry jobs
+func NewScheduler(d SchedulerDep) (*scheduler.Scheduler, error) {
+	s := scheduler.New(d.Logger)
+
+	jobs := []scheduler.Job{
+		{
+			Name:     JobPendingNotifications,
+			Interval: d.Config.PendingNotifications.Interval,
+			Run: func(ctx context.Context) error {
+				return d.NotificationLogService.ProcessPendingNotifications(ctx, d.Config.PendingNotifications.BatchSize)
+			},
+		},
+		{
+			Name:     JobFailedNotifications,
+			Interval: d.Config.FailedNotifications.Interval,
+			Run: func(ctx context.Context) error {
+				return d.NotificationLogService.ProcessFailedNotifications(ctx, d.Config.FailedNotifications.BatchSize)
+			},
+		},
+		{
+			Name:     JobUnprocessedWebhooks,
+			Interval: d.Config.UnprocessedWebhooks.Interval,
+			Run: func(ctx context.Context) error {
+				if d.WebhookDispatcher != nil {
+					return d.WebhookDispatcher.DispatchUnprocessed(ctx, d.Config.UnprocessedWebhooks.BatchSize)
+				}
+				return d.WebhookService.ReprocessFailedWebhooks(ctx, d.Config.UnprocessedWebhooks.BatchSize)
+			},
+		},
+		{
+			Name:     JobNotificationDigests,
+			Interval: d.Config.NotificationDigests.Interval,
+			Run: func(ctx context.Context) error {
+				return d.NotificationLogService.SendDueDigests(ctx, d.Config.NotificationDigests.BatchSize)
+			},
+		},
+		{
+			Name:     JobDeferredNotifications,
+			Interval: d.Config.DeferredNotifications.Interval,
+			Run: func(ctx context.Context) error {
+				return d.NotificationLogService.SendDeferredNotifications(ctx, d.Config.DeferredNotifications.BatchSize)
+			},
+		},
+		{
+			Name:     JobEscalations,
+			Interval: d.Config.Escalations.Interval,
+			Run: func(ctx context.Context) error {
+				return d.EscalationService.EvaluateEscalations(ctx, d.Config.Escalations.BatchSize)
+			},
+		},
+		{
+			Name:     JobQueuedNotifications,
+			Interval: d.Config.QueuedNotifications.Interval,
+			Run: func(ctx context.Context) error {
+				// Failed deliveries are rescheduled first so due retries go out in the same run
+				if err := d.DeliveryService.ProcessRetryQueue(ctx, d.Config.QueuedNotifications.BatchSize); err != nil {
+					return err
+				}
+				return d.DeliveryService.ProcessQueue(ctx, d.Config.QueuedNotifications.BatchSize)
+			},
+		},
+	}
+
+	for _, job := range jobs {
+		if err := s.Register(job); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
@@ -12,7 +12,7 @@ func (s *service) startServerWithGracefulShutdown() {
 	// Create channel for idle connections.
 	idleConnsClosed := make(chan struct{})
 
-	// Create context for Telegram bot
+	// Create context for Telegram bot and background jobs
 	ctx, cancel := context.WithCancel(context.Background())
 
 	go func() {
@@ -23,6 +23,12 @@ func (s *service) startServerWithGracefulShutdown() {
 		// Received an interrupt signal, shutdown.
 		s.Logger.Info("Shutting down server...")
 
+		// Stop background jobs, waiting for running jobs to finish
+		if s.Scheduler != nil {
+			s.Logger.Info("Stopping background jobs...")
+			s.Scheduler.Stop()
+		}
+
 		// Stop Telegram bot
 		if s.TelegramBotManager != nil {
 			s.Logger.Info("Stopping Telegram bot...")
@@ -36,9 +42,26 @@ func (s *service) startServerWithGracefulShutdown() {
 			s.Logger.Error(fmt.Sprintf("Oops... Server is not shutting down!, err: %s", err.Error()))
 		}
 
+		// Drain accepted webhooks once no new requests come in
+		if s.WebhookWorkers != nil {
+			s.Logger.Info("Draining webhook queue...")
+			drainCtx, cancelDrain := context.WithTimeout(context.Background(), s.AppConfig.WebhookProcessing.ShutdownTimeout)
+			if err := s.WebhookWorkers.Shutdown(drainCtx); err != nil {
+				s.Logger.Error(fmt.Sprintf("Oops... Webhook queue is not drained!, err: %s", err.Error()))
+			}
+			cancelDrain()
+		}
+
 		close(idleConnsClosed)
 	}()
 
+	// Start background jobs
+	if s.Scheduler != nil {
+		if err := s.Scheduler.Start(ctx); err != nil {
+			s.Logger.Error(fmt.Sprintf("Oops... Background jobs are not running!, err: %s", err.Error()))
+		}
+	}
+
 	// Start Telegram bot in a separate goroutine if available
 	if s.TelegramBotManager != nil {
 		go func() {
@@ -58,6 +58,9 @@ func (tbm *TelegramBotManager) StartTelegramBot(ctx context.Context) {
 			if update.Message != nil {
 				go tbm.handleCommand(update.Message)
 			}
+			if update.CallbackQuery != nil {
+				go tbm.handleCallback(update.CallbackQuery)
+			}
 		}
 	}
 }
@@ -86,6 +89,26 @@ func (tbm *TelegramBotManager) handleCommand(msg *tgbotapi.Message) {
 	}
 }
 
+// handleCallback processes presses of inline keyboard buttons
+func (tbm *TelegramBotManager) handleCallback(query *tgbotapi.CallbackQuery) {
+	callbackCtx := &domain.CallbackContext{
+		QueryID:  query.ID,
+		Data:     query.Data,
+		UserID:   query.From.ID,
+		Username: query.From.UserName,
+	}
+	if query.Message != nil {
+		callbackCtx.ChatID = query.Message.Chat.ID
+		callbackCtx.MessageID = query.Message.MessageID
+		callbackCtx.MessageText = query.Message.Text
+	}
+
+	// Handle callback through bot service, which authorizes it and answers the query
+	if err := tbm.botService.HandleCallback(context.Background(), callbackCtx); err != nil {
+		log.Printf("Error handling callback query: %v", err)
+	}
+}
+
 // sendMessage sends a message using the bot
 func (tbm *TelegramBotManager) sendMessage(chatID int64, text string) {
 	msg := tgbotapi.NewMessage(chatID, text)
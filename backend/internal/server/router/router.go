@@ -4,19 +4,25 @@ import (
 	"github.com/gofiber/fiber/v2"
 
 	d "github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/dashboard"
+	dl "github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/deadletter"
+	e "github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/escalation"
 	h "github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/health"
 	p "github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/project"
 	t "github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/telegram"
 	w "github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/webhook"
+	ws "github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/webhooksubscription"
 )
 
 type Dep struct {
-	App              *fiber.App
-	HealthHandler    *h.HealthHandler
-	ProjectHandler   *p.Handler
-	WebhookHandler   *w.WebhookHandler
-	TelegramHandler  *t.TelegramHandler
-	DashboardHandler *d.Handler
+	App                        *fiber.App
+	HealthHandler              *h.HealthHandler
+	ProjectHandler             *p.Handler
+	WebhookHandler             *w.WebhookHandler
+	TelegramHandler            *t.TelegramHandler
+	DashboardHandler           *d.Handler
+	DeadLetterHandler          *dl.Handler
+	WebhookSubscriptionHandler *ws.Handler
+	EscalationHandler          *e.Handler
 }
 
 type router struct {
@@ -43,6 +49,15 @@ func (r *router) RegisterRoutes() {
 	// Dashboard routes
 	r.DashboardHandler.RegisterRoutes(api)
 
+	// Dead-letter queue routes
+	r.DeadLetterHandler.RegisterRoutes(api)
+
+	// Outgoing webhook subscription routes
+	r.WebhookSubscriptionHandler.RegisterRoutes(api)
+
+	// Escalation policy routes
+	r.EscalationHandler.RegisterRoutes(api)
+
 	// Telegram bot routes
 	r.TelegramHandler.RegisterRoutes(api)
 
@@ -0,0 +1,22 @@
+package crypto
+
+import (
+	"crypto/subtle"
+)
+
+// GitLabTokenVerifier handles GitLab webhook secret token verification
+type GitLabTokenVerifier struct{}
+
+// NewGitLabTokenVerifier creates a new GitLab token verifier
+func NewGitLabTokenVerifier() *GitLabTokenVerifier {
+	return &GitLabTokenVerifier{}
+}
+
+// VerifySignature verifies the X-Gitlab-Token header against the configured secret.
+// GitLab sends the secret verbatim, so the body is not part of the check.
+func (v *GitLabTokenVerifier) VerifySignature(secret, token string, _ []byte) bool {
+	if secret == "" || token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(secret), []byte(token)) == 1
+}
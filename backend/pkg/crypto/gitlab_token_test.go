@@ -0,0 +1,51 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitLabTokenVerifierVerifySignature(t *testing.T) {
+	verifier := NewGitLabTokenVerifier()
+	secret := "my_gitlab_secret"
+
+	tests := []struct {
+		name     string
+		secret   string
+		token    string
+		expected bool
+	}{
+		{
+			name:     "Valid token",
+			secret:   secret,
+			token:    secret,
+			expected: true,
+		},
+		{
+			name:     "Wrong token",
+			secret:   secret,
+			token:    "wrong_token",
+			expected: false,
+		},
+		{
+			name:     "Empty token",
+			secret:   secret,
+			token:    "",
+			expected: false,
+		},
+		{
+			name:     "Empty secret",
+			secret:   "",
+			token:    "",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := verifier.VerifySignature(tt.secret, tt.token, []byte(testBody))
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
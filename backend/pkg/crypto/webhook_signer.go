@@ -0,0 +1,39 @@
+package crypto
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+)
+
+// webhookSecretPrefix marks secrets generated for outgoing webhook subscriptions
+const webhookSecretPrefix = "whsec_"
+
+// SignOutgoingWebhook signs an outgoing webhook delivery using HMAC-SHA256.
+// The signed content is "<timestamp>.<body>", so a captured delivery cannot be
+// replayed with a fresh timestamp.
+func SignOutgoingWebhook(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyOutgoingWebhook verifies a signature created by SignOutgoingWebhook
+func VerifyOutgoingWebhook(secret, signature string, timestamp int64, body []byte) bool {
+	expected := SignOutgoingWebhook(secret, timestamp, body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// GenerateWebhookSecret generates a random secret for signing outgoing webhooks
+func GenerateWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return webhookSecretPrefix + hex.EncodeToString(buf), nil
+}
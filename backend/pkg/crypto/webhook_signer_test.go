@@ -0,0 +1,73 @@
+package crypto
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignOutgoingWebhook(t *testing.T) {
+	secret := "whsec_test"
+	timestamp := int64(1760000000)
+	signature := SignOutgoingWebhook(secret, timestamp, []byte(testBody))
+
+	// The signature covers "<timestamp>.<body>"
+	assert.Equal(t, generateSignature(secret, "1760000000."+testBody), signature)
+
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp int64
+		body      string
+		expected  bool
+	}{
+		{
+			name:      "Valid signature",
+			secret:    secret,
+			timestamp: timestamp,
+			body:      testBody,
+			expected:  true,
+		},
+		{
+			name:      "Replayed with another timestamp",
+			secret:    secret,
+			timestamp: timestamp + 300,
+			body:      testBody,
+			expected:  false,
+		},
+		{
+			name:      "Tampered body",
+			secret:    secret,
+			timestamp: timestamp,
+			body:      testBody + "!",
+			expected:  false,
+		},
+		{
+			name:      "Wrong secret",
+			secret:    "wrong_secret",
+			timestamp: timestamp,
+			body:      testBody,
+			expected:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := VerifyOutgoingWebhook(tt.secret, signature, tt.timestamp, []byte(tt.body))
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestGenerateWebhookSecret(t *testing.T) {
+	first, err := GenerateWebhookSecret()
+	require.NoError(t, err)
+	second, err := GenerateWebhookSecret()
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(first, "whsec_"))
+	assert.Len(t, first, len("whsec_")+64)
+	assert.NotEqual(t, first, second)
+}
@@ -69,8 +69,13 @@ func AutoMigrate(db *gorm.DB) error {
 	return db.AutoMigrate(
 		&projectdomain.ProjectModel{},
 		&builddomain.BuildEventModel{},
+		&builddomain.BuildStatusTransitionModel{},
+		&builddomain.BuildJobModel{},
 		&notificationdomain.TelegramSubscriptionModel{},
 		&notificationdomain.NotificationLogModel{},
+		&notificationdomain.DeliveryQueueModel{},
+		&notificationdomain.DeadLetterModel{},
+		&notificationdomain.WebhookSubscriptionModel{},
 	)
 }
 
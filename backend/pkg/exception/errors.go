@@ -77,6 +77,8 @@ var (
 	ErrProjectNotFound                   = errors.New("project not found")
 	ErrProjectAlreadyExists              = errors.New("project with this name already exists")
 	ErrBuildEventNotFound                = errors.New("build event not found")
+	ErrBuildEventRunExists               = errors.New("build event already exists for this run")
+	ErrBuildJobNotFound                  = errors.New("build job not found")
 	ErrTelegramSubscriptionNotFound      = errors.New("telegram subscription not found")
 	ErrTelegramSubscriptionAlreadyExists = errors.New("telegram subscription already exists for this project and chat")
 	ErrNotificationLogNotFound           = errors.New("notification log not found")
@@ -0,0 +1,220 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Scheduler errors
+var (
+	ErrInvalidJob      = errors.New("job requires a name, a positive interval and a run function")
+	ErrDuplicateJob    = errors.New("job with this name is already registered")
+	ErrAlreadyStarted  = errors.New("scheduler is already started")
+	ErrSchedulerClosed = errors.New("scheduler is stopped")
+)
+
+// Job is a unit of background work run at a fixed interval
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// JobStatus reports the outcome of the last run of a job
+type JobStatus struct {
+	Name         string        `json:"name"`
+	Interval     string        `json:"interval"`
+	Running      bool          `json:"running"`
+	LastRunAt    *time.Time    `json:"last_run_at,omitempty"`
+	LastDuration time.Duration `json:"last_duration_ns"`
+	LastError    string        `json:"last_error,omitempty"`
+	RunCount     int64         `json:"run_count"`
+	FailureCount int64         `json:"failure_count"`
+	NextRunAt    *time.Time    `json:"next_run_at,omitempty"`
+}
+
+// Scheduler runs registered jobs in the background until it is stopped.
+// Every job runs in its own goroutine, so a slow job never delays the others,
+// and runs of the same job never overlap.
+type Scheduler struct {
+	logger *logrus.Logger
+
+	mu       sync.RWMutex
+	jobs     []Job
+	statuses map[string]*JobStatus
+	started  bool
+	stopped  bool
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New creates a new scheduler
+func New(logger *logrus.Logger) *Scheduler {
+	if logger == nil {
+		logger = logrus.New()
+	}
+
+	return &Scheduler{
+		logger:   logger,
+		statuses: make(map[string]*JobStatus),
+	}
+}
+
+// Register adds a job to the scheduler. Jobs must be registered before Start.
+func (s *Scheduler) Register(job Job) error {
+	if job.Name == "" || job.Interval <= 0 || job.Run == nil {
+		return ErrInvalidJob
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return ErrAlreadyStarted
+	}
+	if _, exists := s.statuses[job.Name]; exists {
+		return fmt.Errorf("%w: %s", ErrDuplicateJob, job.Name)
+	}
+
+	s.jobs = append(s.jobs, job)
+	s.statuses[job.Name] = &JobStatus{
+		Name:     job.Name,
+		Interval: job.Interval.String(),
+	}
+	return nil
+}
+
+// Start runs every registered job at its interval until ctx is cancelled or Stop is called
+func (s *Scheduler) Start(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopped {
+		return ErrSchedulerClosed
+	}
+	if s.started {
+		return ErrAlreadyStarted
+	}
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.started = true
+
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, job)
+	}
+
+	s.logger.Infof("Scheduler started with %d jobs", len(s.jobs))
+	return nil
+}
+
+// Stop cancels all jobs and waits for running jobs to finish
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	if s.stopped {
+		s.mu.Unlock()
+		return
+	}
+	s.stopped = true
+	cancel := s.cancel
+	s.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	s.wg.Wait()
+
+	s.logger.Info("Scheduler stopped")
+}
+
+// Statuses returns the last-run status of every job ordered by name
+func (s *Scheduler) Statuses() []JobStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	statuses := make([]JobStatus, 0, len(s.statuses))
+	for _, status := range s.statuses {
+		statuses = append(statuses, *status)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses
+}
+
+// loop runs a job at its interval until the context is cancelled
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	s.setNextRun(job)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.runOnce(ctx, job)
+			s.setNextRun(job)
+		}
+	}
+}
+
+// runOnce executes a single run of a job and records its outcome
+func (s *Scheduler) runOnce(ctx context.Context, job Job) {
+	startedAt := time.Now()
+	s.mu.Lock()
+	s.statuses[job.Name].Running = true
+	s.mu.Unlock()
+
+	err := s.safeRun(ctx, job)
+	duration := time.Since(startedAt)
+
+	s.mu.Lock()
+	status := s.statuses[job.Name]
+	status.Running = false
+	status.LastRunAt = &startedAt
+	status.LastDuration = duration
+	status.RunCount++
+	status.LastError = ""
+	if err != nil {
+		status.FailureCount++
+		status.LastError = err.Error()
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		s.logger.WithError(err).WithField("job", job.Name).Error("Scheduled job failed")
+		return
+	}
+	s.logger.WithField("job", job.Name).WithField("duration", duration).Debug("Scheduled job completed")
+}
+
+// safeRun runs a job, turning a panic into an error so the job keeps its schedule
+func (s *Scheduler) safeRun(ctx context.Context, job Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+
+	return job.Run(ctx)
+}
+
+// setNextRun records when a job runs next
+func (s *Scheduler) setNextRun(job Job) {
+	next := time.Now().Add(job.Interval)
+
+	s.mu.Lock()
+	s.statuses[job.Name].NextRunAt = &next
+	s.mu.Unlock()
+}
@@ -0,0 +1,112 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestScheduler() *Scheduler {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return New(logger)
+}
+
+func TestSchedulerRegisterValidatesJobs(t *testing.T) {
+	s := newTestScheduler()
+	run := func(context.Context) error { return nil }
+
+	assert.ErrorIs(t, s.Register(Job{Interval: time.Second, Run: run}), ErrInvalidJob)
+	assert.ErrorIs(t, s.Register(Job{Name: "job", Run: run}), ErrInvalidJob)
+	assert.ErrorIs(t, s.Register(Job{Name: "job", Interval: time.Second}), ErrInvalidJob)
+
+	require.NoError(t, s.Register(Job{Name: "job", Interval: time.Second, Run: run}))
+	assert.ErrorIs(t, s.Register(Job{Name: "job", Interval: time.Second, Run: run}), ErrDuplicateJob)
+
+	require.NoError(t, s.Start(context.Background()))
+	defer s.Stop()
+	assert.ErrorIs(t, s.Register(Job{Name: "late", Interval: time.Second, Run: run}), ErrAlreadyStarted)
+}
+
+func TestSchedulerRunsJobsAndReportsStatus(t *testing.T) {
+	s := newTestScheduler()
+
+	var okRuns, failedRuns atomic.Int64
+	require.NoError(t, s.Register(Job{
+		Name:     "ok",
+		Interval: 5 * time.Millisecond,
+		Run: func(context.Context) error {
+			okRuns.Add(1)
+			return nil
+		},
+	}))
+	require.NoError(t, s.Register(Job{
+		Name:     "failing",
+		Interval: 5 * time.Millisecond,
+		Run: func(context.Context) error {
+			failedRuns.Add(1)
+			if failedRuns.Load() == 1 {
+				panic("boom")
+			}
+			return errors.New("send failed")
+		},
+	}))
+
+	require.NoError(t, s.Start(context.Background()))
+	require.Eventually(t, func() bool {
+		return okRuns.Load() >= 2 && failedRuns.Load() >= 2
+	}, time.Second, time.Millisecond)
+	s.Stop()
+
+	statuses := s.Statuses()
+	require.Len(t, statuses, 2)
+
+	failing, ok := statuses[0], statuses[1]
+	assert.Equal(t, "failing", failing.Name)
+	assert.Equal(t, "send failed", failing.LastError)
+	assert.Equal(t, failing.RunCount, failing.FailureCount)
+	assert.GreaterOrEqual(t, failing.FailureCount, int64(2))
+
+	assert.Equal(t, "ok", ok.Name)
+	assert.Equal(t, "5ms", ok.Interval)
+	assert.Empty(t, ok.LastError)
+	assert.Zero(t, ok.FailureCount)
+	assert.Equal(t, okRuns.Load(), ok.RunCount)
+	assert.NotNil(t, ok.LastRunAt)
+	assert.False(t, ok.Running)
+}
+
+func TestSchedulerStopWaitsForRunningJob(t *testing.T) {
+	s := newTestScheduler()
+
+	started := make(chan struct{})
+	var finished atomic.Bool
+	require.NoError(t, s.Register(Job{
+		Name:     "slow",
+		Interval: time.Millisecond,
+		Run: func(ctx context.Context) error {
+			select {
+			case started <- struct{}{}:
+			default:
+			}
+			<-ctx.Done()
+			time.Sleep(10 * time.Millisecond)
+			finished.Store(true)
+			return ctx.Err()
+		},
+	}))
+
+	require.NoError(t, s.Start(context.Background()))
+	<-started
+	s.Stop()
+
+	assert.True(t, finished.Load())
+	assert.ErrorIs(t, s.Start(context.Background()), ErrSchedulerClosed)
+}
@@ -0,0 +1,189 @@
+package workerpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Worker pool errors
+var (
+	ErrInvalidConfig = errors.New("worker pool requires at least one worker and a positive queue size")
+	ErrQueueFull     = errors.New("worker pool queue is full")
+	ErrPoolClosed    = errors.New("worker pool is shut down")
+)
+
+// Task is a unit of work run by the pool. The context is cancelled when a
+// shutdown runs past its deadline.
+type Task func(ctx context.Context)
+
+// Config holds the size of a worker pool
+type Config struct {
+	Workers   int
+	QueueSize int
+}
+
+// Stats reports the current load of a worker pool
+type Stats struct {
+	Workers       int   `json:"workers"`
+	QueueSize     int   `json:"queue_size"`
+	Queued        int   `json:"queued"`
+	Active        int64 `json:"active"`
+	Completed     int64 `json:"completed"`
+	Rejected      int64 `json:"rejected"`
+	PanickedTasks int64 `json:"panicked_tasks"`
+}
+
+// Pool runs submitted tasks on a fixed number of workers fed by a bounded queue.
+// Submit never blocks: when the queue is full the task is rejected so callers
+// can apply backpressure.
+type Pool struct {
+	config Config
+	logger *logrus.Logger
+
+	tasks  chan Task
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu     sync.RWMutex
+	closed bool
+
+	statsMu   sync.Mutex
+	active    int64
+	completed int64
+	rejected  int64
+	panicked  int64
+}
+
+// New creates a worker pool and starts its workers
+func New(config Config, logger *logrus.Logger) (*Pool, error) {
+	if config.Workers <= 0 || config.QueueSize <= 0 {
+		return nil, ErrInvalidConfig
+	}
+	if logger == nil {
+		logger = logrus.New()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &Pool{
+		config: config,
+		logger: logger,
+		tasks:  make(chan Task, config.QueueSize),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	for i := 0; i < config.Workers; i++ {
+		p.wg.Add(1)
+		go p.work()
+	}
+
+	logger.Infof("Worker pool started with %d workers and a queue of %d", config.Workers, config.QueueSize)
+	return p, nil
+}
+
+// Submit queues a task, returning ErrQueueFull when no queue slot is free
+func (p *Pool) Submit(task Task) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.closed {
+		return ErrPoolClosed
+	}
+
+	select {
+	case p.tasks <- task:
+		return nil
+	default:
+		p.statsMu.Lock()
+		p.rejected++
+		p.statsMu.Unlock()
+		return ErrQueueFull
+	}
+}
+
+// Shutdown stops accepting tasks and waits until every queued task has run.
+// When ctx expires first, the context passed to the remaining tasks is
+// cancelled and ctx's error is returned once the workers have exited.
+func (p *Pool) Shutdown(ctx context.Context) error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil
+	}
+	p.closed = true
+	close(p.tasks)
+	p.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		p.cancel()
+		p.logger.Info("Worker pool drained")
+		return nil
+	case <-ctx.Done():
+		p.cancel()
+		<-done
+		p.logger.Warn("Worker pool shutdown deadline exceeded, remaining tasks were cancelled")
+		return ctx.Err()
+	}
+}
+
+// Stats returns the current load of the pool
+func (p *Pool) Stats() Stats {
+	p.statsMu.Lock()
+	defer p.statsMu.Unlock()
+
+	return Stats{
+		Workers:       p.config.Workers,
+		QueueSize:     p.config.QueueSize,
+		Queued:        len(p.tasks),
+		Active:        p.active,
+		Completed:     p.completed,
+		Rejected:      p.rejected,
+		PanickedTasks: p.panicked,
+	}
+}
+
+// work runs queued tasks until the queue is closed and empty
+func (p *Pool) work() {
+	defer p.wg.Done()
+
+	for task := range p.tasks {
+		p.run(task)
+	}
+}
+
+// run executes a single task, recovering from panics so the worker survives
+func (p *Pool) run(task Task) {
+	p.statsMu.Lock()
+	p.active++
+	p.statsMu.Unlock()
+
+	defer func() {
+		r := recover()
+
+		p.statsMu.Lock()
+		p.active--
+		p.completed++
+		if r != nil {
+			p.panicked++
+		}
+		p.statsMu.Unlock()
+
+		if r != nil {
+			p.logger.WithError(fmt.Errorf("task panicked: %v", r)).Error("Worker pool task failed")
+		}
+	}()
+
+	task(p.ctx)
+}
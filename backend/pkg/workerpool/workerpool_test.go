@@ -0,0 +1,92 @@
+package workerpool
+
+import (
+	"context"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPool(t *testing.T, workers, queueSize int) *Pool {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	p, err := New(Config{Workers: workers, QueueSize: queueSize}, logger)
+	require.NoError(t, err)
+	return p
+}
+
+func TestNewValidatesConfig(t *testing.T) {
+	_, err := New(Config{Workers: 0, QueueSize: 1}, nil)
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+
+	_, err = New(Config{Workers: 1, QueueSize: 0}, nil)
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+}
+
+func TestSubmitRejectsWhenQueueIsFull(t *testing.T) {
+	p := newTestPool(t, 1, 1)
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	require.NoError(t, p.Submit(func(context.Context) {
+		close(started)
+		<-release
+	}))
+	<-started
+
+	// The single worker is busy, so one task fits in the queue and the next is rejected
+	require.NoError(t, p.Submit(func(context.Context) {}))
+	assert.ErrorIs(t, p.Submit(func(context.Context) {}), ErrQueueFull)
+
+	stats := p.Stats()
+	assert.Equal(t, int64(1), stats.Active)
+	assert.Equal(t, 1, stats.Queued)
+	assert.Equal(t, int64(1), stats.Rejected)
+
+	close(release)
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.Equal(t, int64(2), p.Stats().Completed)
+}
+
+func TestShutdownDrainsQueuedTasks(t *testing.T) {
+	p := newTestPool(t, 2, 20)
+
+	var ran atomic.Int64
+	for i := 0; i < 10; i++ {
+		require.NoError(t, p.Submit(func(context.Context) {
+			time.Sleep(time.Millisecond)
+			ran.Add(1)
+		}))
+	}
+	require.NoError(t, p.Submit(func(context.Context) { panic("boom") }))
+
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.Equal(t, int64(10), ran.Load())
+	assert.Equal(t, int64(1), p.Stats().PanickedTasks)
+	assert.ErrorIs(t, p.Submit(func(context.Context) {}), ErrPoolClosed)
+}
+
+func TestShutdownCancelsTasksPastDeadline(t *testing.T) {
+	p := newTestPool(t, 1, 1)
+
+	started := make(chan struct{})
+	var cancelled atomic.Bool
+	require.NoError(t, p.Submit(func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+		cancelled.Store(true)
+	}))
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, p.Shutdown(ctx), context.DeadlineExceeded)
+	assert.True(t, cancelled.Load())
+}
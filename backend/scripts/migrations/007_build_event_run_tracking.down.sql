@@ -0,0 +1,7 @@
+-- Migration 007: Rollback - Remove build lifecycle tracking
+
+DROP TABLE IF EXISTS build_status_transitions;
+
+DROP INDEX IF EXISTS idx_build_events_run_id;
+
+ALTER TABLE build_events DROP COLUMN IF EXISTS run_id;
@@ -0,0 +1,32 @@
+-- Migration 007: Track build lifecycle per CI run
+-- Correlates webhook deliveries of the same CI run to a single build event
+-- and keeps the status history of every run
+
+-- Add provider run identifier to build_events
+ALTER TABLE build_events ADD COLUMN IF NOT EXISTS run_id VARCHAR(100);
+
+-- Lookups happen per project, so index both columns together.
+-- The index is unique so concurrent deliveries of the same run cannot create two build events,
+-- events without a run store an empty run_id and are left out.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_build_events_run_id ON build_events(project_id, run_id)
+    WHERE run_id IS NOT NULL AND run_id <> '' AND deleted_at IS NULL;
+
+-- Create build_status_transitions table
+CREATE TABLE IF NOT EXISTS build_status_transitions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    build_event_id UUID NOT NULL,
+    from_status VARCHAR(20),
+    to_status VARCHAR(20) NOT NULL,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    -- Foreign key constraints
+    CONSTRAINT fk_build_status_transitions_build_event_id
+        FOREIGN KEY (build_event_id) REFERENCES build_events(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_build_status_transitions_build_event_id ON build_status_transitions(build_event_id);
+
+-- Comments for documentation
+COMMENT ON COLUMN build_events.run_id IS 'CI provider run identifier (GitHub workflow run ID, GitLab pipeline ID)';
+COMMENT ON TABLE build_status_transitions IS 'Status history of build events';
+COMMENT ON COLUMN build_status_transitions.from_status IS 'Previous status, empty for the initial status';
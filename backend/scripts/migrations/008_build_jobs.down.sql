@@ -0,0 +1,3 @@
+-- Migration 008: Rollback - Remove build jobs
+
+DROP TABLE IF EXISTS build_jobs;
@@ -0,0 +1,31 @@
+-- Migration 008: Store CI jobs as children of run-level build events
+-- Keeps per-job status and failing steps so failure notifications can name what broke
+
+CREATE TABLE IF NOT EXISTS build_jobs (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    build_event_id UUID NOT NULL,
+    job_id VARCHAR(100) NOT NULL,
+    name VARCHAR(255),
+    status VARCHAR(20) NOT NULL,
+    failed_steps JSONB,
+    url VARCHAR(500),
+    duration_seconds INTEGER,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+
+    -- Foreign key constraints
+    CONSTRAINT fk_build_jobs_build_event_id
+        FOREIGN KEY (build_event_id) REFERENCES build_events(id) ON DELETE CASCADE
+);
+
+-- Every delivery of a job updates the same row
+CREATE UNIQUE INDEX IF NOT EXISTS idx_build_jobs_build_event_job ON build_jobs(build_event_id, job_id);
+
+CREATE TRIGGER update_build_jobs_updated_at
+    BEFORE UPDATE ON build_jobs
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Comments for documentation
+COMMENT ON TABLE build_jobs IS 'Jobs of CI runs, children of the run-level build event';
+COMMENT ON COLUMN build_jobs.job_id IS 'CI provider job identifier (GitHub workflow job or check run ID)';
+COMMENT ON COLUMN build_jobs.failed_steps IS 'Names of the job steps that failed';
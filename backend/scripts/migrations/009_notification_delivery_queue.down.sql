@@ -0,0 +1,3 @@
+-- Migration 009: Rollback - Remove notification delivery queue
+
+DROP TABLE IF EXISTS notification_delivery_queue;
@@ -0,0 +1,48 @@
+-- Migration 009: Persist the notification delivery queue
+-- Queued deliveries survive restarts and are claimed with FOR UPDATE SKIP LOCKED,
+-- so several bot instances can share one queue
+
+CREATE TABLE IF NOT EXISTS notification_delivery_queue (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    notification_id UUID NOT NULL,
+    channel VARCHAR(20) NOT NULL,
+    recipient VARCHAR(255) NOT NULL,
+    message TEXT NOT NULL,
+    subject VARCHAR(255),
+    priority INTEGER NOT NULL DEFAULT 0,
+    scheduled_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    attempt_count INTEGER NOT NULL DEFAULT 0,
+    max_attempts INTEGER NOT NULL DEFAULT 3,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    last_error TEXT,
+    locked_until TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+
+    CONSTRAINT check_delivery_queue_status CHECK (
+        status IN ('pending', 'processing', 'delivered', 'failed', 'retrying', 'cancelled', 'expired')
+    )
+);
+
+-- Claiming scans due rows by priority
+CREATE INDEX IF NOT EXISTS idx_delivery_queue_claimable
+    ON notification_delivery_queue(priority DESC, scheduled_at)
+    WHERE status IN ('pending', 'retrying');
+
+-- Finds processing rows whose visibility timeout expired
+CREATE INDEX IF NOT EXISTS idx_delivery_queue_locked_until
+    ON notification_delivery_queue(locked_until)
+    WHERE status = 'processing';
+
+CREATE INDEX IF NOT EXISTS idx_delivery_queue_status_updated_at ON notification_delivery_queue(status, updated_at);
+CREATE INDEX IF NOT EXISTS idx_delivery_queue_notification ON notification_delivery_queue(notification_id);
+
+CREATE TRIGGER update_notification_delivery_queue_updated_at
+    BEFORE UPDATE ON notification_delivery_queue
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Comments for documentation
+COMMENT ON TABLE notification_delivery_queue IS 'Notification deliveries waiting to be sent by a worker';
+COMMENT ON COLUMN notification_delivery_queue.priority IS 'Higher priorities are delivered first';
+COMMENT ON COLUMN notification_delivery_queue.scheduled_at IS 'Earliest time the delivery may be attempted';
+COMMENT ON COLUMN notification_delivery_queue.locked_until IS 'Visibility timeout of the worker processing the delivery';
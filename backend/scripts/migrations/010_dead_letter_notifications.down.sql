@@ -0,0 +1,8 @@
+-- Migration 010: Rollback - Remove notification dead-letter queue
+
+DROP TABLE IF EXISTS dead_letter_notifications;
+
+ALTER TABLE retry_configurations DROP COLUMN IF EXISTS enable_dead_letter_queue;
+
+ALTER TABLE notification_logs DROP COLUMN IF EXISTS error_history;
+ALTER TABLE notification_logs DROP COLUMN IF EXISTS max_retries;
@@ -0,0 +1,51 @@
+-- Migration 010: Dead-letter queue for notifications
+-- Notifications that exhaust their retries or hit a permanent error are moved here
+-- with their error history, so missed alerts can be inspected, requeued or discarded
+
+ALTER TABLE notification_logs ADD COLUMN IF NOT EXISTS max_retries INTEGER NOT NULL DEFAULT 3;
+ALTER TABLE notification_logs ADD COLUMN IF NOT EXISTS error_history JSONB;
+
+ALTER TABLE retry_configurations ADD COLUMN IF NOT EXISTS enable_dead_letter_queue BOOLEAN NOT NULL DEFAULT true;
+
+CREATE TABLE IF NOT EXISTS dead_letter_notifications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    notification_log_id UUID NOT NULL,
+    build_event_id UUID NOT NULL,
+    channel VARCHAR(20) NOT NULL,
+    recipient VARCHAR(255) NOT NULL,
+    message TEXT NOT NULL,
+    reason VARCHAR(30) NOT NULL,
+    last_error TEXT,
+    error_history JSONB,
+    attempt_count INTEGER NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    resolved_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+
+    -- Foreign key constraints
+    CONSTRAINT fk_dead_letter_notification_log_id
+        FOREIGN KEY (notification_log_id) REFERENCES notification_logs(id) ON DELETE CASCADE,
+
+    CONSTRAINT check_dead_letter_reason CHECK (
+        reason IN ('retries_exhausted', 'permanent_error')
+    ),
+    CONSTRAINT check_dead_letter_status CHECK (
+        status IN ('pending', 'requeued', 'discarded')
+    )
+);
+
+CREATE INDEX IF NOT EXISTS idx_dead_letter_status_created_at ON dead_letter_notifications(status, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_dead_letter_channel ON dead_letter_notifications(channel);
+CREATE INDEX IF NOT EXISTS idx_dead_letter_notification_log ON dead_letter_notifications(notification_log_id);
+
+CREATE TRIGGER update_dead_letter_notifications_updated_at
+    BEFORE UPDATE ON dead_letter_notifications
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Comments for documentation
+COMMENT ON TABLE dead_letter_notifications IS 'Notifications that will not be retried and are kept for inspection';
+COMMENT ON COLUMN dead_letter_notifications.reason IS 'Why delivery stopped: retries_exhausted or permanent_error';
+COMMENT ON COLUMN dead_letter_notifications.error_history IS 'Failed delivery attempts with their errors, oldest first';
+COMMENT ON COLUMN dead_letter_notifications.status IS 'pending until the entry is requeued or discarded';
+COMMENT ON COLUMN notification_logs.error_history IS 'Failed delivery attempts with their errors, oldest first';
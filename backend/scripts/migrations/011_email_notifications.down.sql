@@ -0,0 +1,6 @@
+-- Migration 011: Rollback - Remove email notification columns
+
+ALTER TABLE notification_logs DROP COLUMN IF EXISTS subject;
+ALTER TABLE notification_logs DROP COLUMN IF EXISTS recipient;
+
+ALTER TABLE projects DROP COLUMN IF EXISTS email_recipients;
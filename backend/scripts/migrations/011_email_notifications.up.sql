@@ -0,0 +1,13 @@
+-- Migration 011: Email notifications
+-- Projects keep a list of email recipients, and notification logs store the recipient
+-- and subject of non-Telegram notifications so email deliveries can be retried
+
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS email_recipients JSONB NOT NULL DEFAULT '[]';
+
+ALTER TABLE notification_logs ADD COLUMN IF NOT EXISTS recipient VARCHAR(255);
+ALTER TABLE notification_logs ADD COLUMN IF NOT EXISTS subject VARCHAR(255);
+
+-- Comments for documentation
+COMMENT ON COLUMN projects.email_recipients IS 'Email addresses notified about build and deployment events';
+COMMENT ON COLUMN notification_logs.recipient IS 'Recipient address of the notification, chat_id is kept for Telegram';
+COMMENT ON COLUMN notification_logs.subject IS 'Subject line of email notifications';
@@ -0,0 +1,3 @@
+-- Migration 012: Rollback - Remove Slack notification columns
+
+ALTER TABLE projects DROP COLUMN IF EXISTS slack_channels;
@@ -0,0 +1,8 @@
+-- Migration 012: Slack notifications
+-- Projects keep a list of Slack channels, each a channel name or ID posted to
+-- with the bot token, or an incoming webhook URL
+
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS slack_channels JSONB NOT NULL DEFAULT '[]';
+
+-- Comments for documentation
+COMMENT ON COLUMN projects.slack_channels IS 'Slack channels or incoming webhook URLs notified about build and deployment events';
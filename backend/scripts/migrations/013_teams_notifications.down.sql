@@ -0,0 +1,3 @@
+-- Migration 013: Rollback - Remove Microsoft Teams notification columns
+
+ALTER TABLE projects DROP COLUMN IF EXISTS teams_webhooks;
@@ -0,0 +1,8 @@
+-- Migration 013: Microsoft Teams notifications
+-- Projects keep a list of Teams workflow or incoming webhook URLs that
+-- receive build and deployment notifications as Adaptive Cards
+
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS teams_webhooks JSONB NOT NULL DEFAULT '[]';
+
+-- Comments for documentation
+COMMENT ON COLUMN projects.teams_webhooks IS 'Microsoft Teams workflow or incoming webhook URLs notified about build and deployment events';
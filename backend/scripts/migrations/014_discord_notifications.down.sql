@@ -0,0 +1,3 @@
+-- Migration 014: Rollback - Remove Discord notification columns
+
+ALTER TABLE projects DROP COLUMN IF EXISTS discord_webhooks;
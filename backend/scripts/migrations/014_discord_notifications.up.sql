@@ -0,0 +1,8 @@
+-- Migration 014: Discord notifications
+-- Projects keep a list of Discord channel webhook URLs that receive
+-- build and deployment notifications as embeds
+
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS discord_webhooks JSONB NOT NULL DEFAULT '[]';
+
+-- Comments for documentation
+COMMENT ON COLUMN projects.discord_webhooks IS 'Discord channel webhook URLs notified about build and deployment events';
@@ -0,0 +1,3 @@
+-- Migration 015: Rollback - Remove outgoing webhook subscriptions
+
+DROP TABLE IF EXISTS webhook_subscriptions;
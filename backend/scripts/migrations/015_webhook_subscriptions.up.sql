@@ -0,0 +1,33 @@
+-- Migration 015: Outgoing webhook subscriptions
+-- Projects can subscribe HTTP endpoints to their build and deployment events.
+-- Deliveries are signed with the subscription secret and sent as plain JSON or CloudEvents
+
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    url TEXT NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    format VARCHAR(20) NOT NULL DEFAULT 'json',
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+
+    -- Foreign key constraints
+    CONSTRAINT fk_webhook_subscription_project_id
+        FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+
+    CONSTRAINT check_webhook_subscription_format CHECK (
+        format IN ('json', 'cloudevents')
+    )
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_project ON webhook_subscriptions(project_id);
+
+CREATE TRIGGER update_webhook_subscriptions_updated_at
+    BEFORE UPDATE ON webhook_subscriptions
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Comments for documentation
+COMMENT ON TABLE webhook_subscriptions IS 'HTTP endpoints receiving signed build and deployment events of a project';
+COMMENT ON COLUMN webhook_subscriptions.secret IS 'HMAC-SHA256 key deliveries are signed with';
+COMMENT ON COLUMN webhook_subscriptions.format IS 'Payload format of deliveries: json or cloudevents';
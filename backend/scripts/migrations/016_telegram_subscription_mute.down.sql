@@ -0,0 +1,4 @@
+-- Migration 016: Rollback - Remove Telegram subscription mutes
+
+ALTER TABLE telegram_subscriptions
+DROP COLUMN IF EXISTS muted_until;
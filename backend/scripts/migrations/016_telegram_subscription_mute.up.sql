@@ -0,0 +1,5 @@
+-- Migration 016: Mute Telegram subscriptions
+-- The "Mute project 1h" button of build notifications silences a project in a chat until muted_until
+
+ALTER TABLE telegram_subscriptions
+ADD COLUMN IF NOT EXISTS muted_until TIMESTAMP WITH TIME ZONE;
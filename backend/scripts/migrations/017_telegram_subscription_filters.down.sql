@@ -0,0 +1,14 @@
+-- Migration 017: Rollback - Remove Telegram subscription filters
+
+ALTER TABLE telegram_subscriptions
+DROP COLUMN IF EXISTS statuses,
+DROP COLUMN IF EXISTS branch_patterns;
+
+-- Subqueries are not allowed in a column type conversion, the array is rebuilt in a new column
+ALTER TABLE telegram_subscriptions ADD COLUMN event_types_array TEXT[];
+
+UPDATE telegram_subscriptions
+SET event_types_array = ARRAY(SELECT jsonb_array_elements_text(event_types));
+
+ALTER TABLE telegram_subscriptions DROP COLUMN event_types;
+ALTER TABLE telegram_subscriptions RENAME COLUMN event_types_array TO event_types;
@@ -0,0 +1,18 @@
+-- Migration 017: Telegram subscription filters
+-- Subscriptions select the build events they are notified about by event type,
+-- build status and branch glob pattern. An empty list matches every value.
+
+ALTER TABLE telegram_subscriptions
+ALTER COLUMN event_types DROP DEFAULT,
+ALTER COLUMN event_types TYPE JSONB USING COALESCE(to_jsonb(event_types), '[]'::jsonb),
+ALTER COLUMN event_types SET DEFAULT '[]',
+ALTER COLUMN event_types SET NOT NULL;
+
+ALTER TABLE telegram_subscriptions
+ADD COLUMN IF NOT EXISTS statuses JSONB NOT NULL DEFAULT '[]',
+ADD COLUMN IF NOT EXISTS branch_patterns JSONB NOT NULL DEFAULT '[]';
+
+-- Comments for documentation
+COMMENT ON COLUMN telegram_subscriptions.event_types IS 'Build event types the chat is notified about, empty for all';
+COMMENT ON COLUMN telegram_subscriptions.statuses IS 'Build statuses the chat is notified about, empty for all';
+COMMENT ON COLUMN telegram_subscriptions.branch_patterns IS 'Branch glob patterns the chat is notified about, empty for all';
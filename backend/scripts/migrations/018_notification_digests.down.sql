@@ -0,0 +1,14 @@
+-- Migration 018: Rollback - Remove notification digests
+
+DROP INDEX IF EXISTS idx_notification_logs_digest_at;
+
+ALTER TABLE notification_logs
+DROP COLUMN IF EXISTS digest_at;
+
+ALTER TABLE webhook_subscriptions
+DROP CONSTRAINT IF EXISTS check_webhook_subscription_digest_mode,
+DROP COLUMN IF EXISTS digest_mode;
+
+ALTER TABLE telegram_subscriptions
+DROP CONSTRAINT IF EXISTS check_telegram_subscription_digest_mode,
+DROP COLUMN IF EXISTS digest_mode;
@@ -0,0 +1,26 @@
+-- Migration 018: Notification digests
+-- Subscriptions choose between instant delivery and an hourly or daily digest.
+-- Notifications of digest subscriptions are held back as 'batched' and sent together at digest_at
+
+ALTER TABLE telegram_subscriptions
+ADD COLUMN IF NOT EXISTS digest_mode VARCHAR(10) NOT NULL DEFAULT 'instant',
+ADD CONSTRAINT check_telegram_subscription_digest_mode CHECK (
+    digest_mode IN ('instant', 'hourly', 'daily')
+);
+
+ALTER TABLE webhook_subscriptions
+ADD COLUMN IF NOT EXISTS digest_mode VARCHAR(10) NOT NULL DEFAULT 'instant',
+ADD CONSTRAINT check_webhook_subscription_digest_mode CHECK (
+    digest_mode IN ('instant', 'hourly', 'daily')
+);
+
+ALTER TABLE notification_logs
+ADD COLUMN IF NOT EXISTS digest_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_notification_logs_digest_at ON notification_logs(digest_at)
+WHERE status = 'batched';
+
+-- Comments for documentation
+COMMENT ON COLUMN telegram_subscriptions.digest_mode IS 'Delivery of notifications: instant, hourly or daily digest';
+COMMENT ON COLUMN webhook_subscriptions.digest_mode IS 'Delivery of notifications: instant, hourly or daily digest';
+COMMENT ON COLUMN notification_logs.digest_at IS 'End of the digest window a batched notification is sent with';
@@ -0,0 +1,7 @@
+-- Migration 019: Rollback - Remove quiet hours of Telegram subscriptions
+
+ALTER TABLE telegram_subscriptions
+DROP COLUMN IF EXISTS quiet_hours_bypass,
+DROP COLUMN IF EXISTS quiet_hours_end,
+DROP COLUMN IF EXISTS quiet_hours_start,
+DROP COLUMN IF EXISTS quiet_hours_timezone;
@@ -0,0 +1,15 @@
+-- Migration 019: Quiet hours of Telegram subscriptions
+-- Notifications during the daily quiet hours of a chat are deferred through the delivery queue
+-- until the quiet hours end, unless the build event passes the bypass filter
+
+ALTER TABLE telegram_subscriptions
+ADD COLUMN IF NOT EXISTS quiet_hours_timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+ADD COLUMN IF NOT EXISTS quiet_hours_start VARCHAR(5) NOT NULL DEFAULT '',
+ADD COLUMN IF NOT EXISTS quiet_hours_end VARCHAR(5) NOT NULL DEFAULT '',
+ADD COLUMN IF NOT EXISTS quiet_hours_bypass JSONB NOT NULL DEFAULT '{}';
+
+-- Comments for documentation
+COMMENT ON COLUMN telegram_subscriptions.quiet_hours_timezone IS 'IANA timezone of the quiet hours';
+COMMENT ON COLUMN telegram_subscriptions.quiet_hours_start IS 'Local HH:MM the quiet hours start, empty without quiet hours';
+COMMENT ON COLUMN telegram_subscriptions.quiet_hours_end IS 'Local HH:MM the quiet hours end, empty without quiet hours';
+COMMENT ON COLUMN telegram_subscriptions.quiet_hours_bypass IS 'Filter of build events sent during quiet hours, empty for none';
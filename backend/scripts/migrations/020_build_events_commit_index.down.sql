@@ -0,0 +1,3 @@
+-- Migration 020: Rollback - Remove the commit index of build events
+
+DROP INDEX IF EXISTS idx_build_events_project_commit;
@@ -0,0 +1,5 @@
+-- Migration 020: Index build events by commit
+-- Deferred notifications about the builds of one commit are coalesced into a single message per recipient,
+-- looked up by project and commit SHA
+
+CREATE INDEX IF NOT EXISTS idx_build_events_project_commit ON build_events(project_id, commit_sha);
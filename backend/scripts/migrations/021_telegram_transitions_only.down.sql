@@ -0,0 +1,4 @@
+-- Migration 021: Rollback - Remove transition-only Telegram subscriptions
+
+ALTER TABLE telegram_subscriptions
+DROP COLUMN IF EXISTS transitions_only;
@@ -0,0 +1,9 @@
+-- Migration 021: Transition-only Telegram subscriptions
+-- Chats with transitions_only are only notified about builds breaking or fixing a branch,
+-- compared with the previous completed build of the project and branch
+
+ALTER TABLE telegram_subscriptions
+ADD COLUMN IF NOT EXISTS transitions_only BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Comments for documentation
+COMMENT ON COLUMN telegram_subscriptions.transitions_only IS 'Only notify about new failures and fixed builds';
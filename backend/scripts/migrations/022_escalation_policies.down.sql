@@ -0,0 +1,4 @@
+-- Migration 022: Rollback - Remove escalation policies
+
+DROP TABLE IF EXISTS escalations;
+DROP TABLE IF EXISTS escalation_policies;
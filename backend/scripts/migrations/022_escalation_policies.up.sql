@@ -0,0 +1,68 @@
+-- Migration 022: Escalation policies
+-- Projects can escalate a branch that keeps failing to an escalation chat or email list,
+-- once for each step the branch is still failing after its first failed build.
+-- Escalations track each failure until a successful build resolves it or someone acknowledges it
+
+CREATE TABLE IF NOT EXISTS escalation_policies (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL,
+    branch VARCHAR(255) NOT NULL DEFAULT 'main',
+    step_minutes JSONB NOT NULL DEFAULT '[]',
+    telegram_chat_id BIGINT,
+    email_recipients JSONB NOT NULL DEFAULT '[]',
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+
+    -- Foreign key constraints
+    CONSTRAINT fk_escalation_policy_project_id
+        FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_escalation_policies_project ON escalation_policies(project_id);
+CREATE INDEX IF NOT EXISTS idx_escalation_policies_active ON escalation_policies(is_active);
+
+CREATE TRIGGER update_escalation_policies_updated_at
+    BEFORE UPDATE ON escalation_policies
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS escalations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    policy_id UUID NOT NULL,
+    project_id UUID NOT NULL,
+    branch VARCHAR(255) NOT NULL,
+    build_event_id UUID NOT NULL,
+    failing_since TIMESTAMP WITH TIME ZONE NOT NULL,
+    step INTEGER NOT NULL DEFAULT 0,
+    acknowledged_at TIMESTAMP WITH TIME ZONE,
+    acknowledged_by VARCHAR(255) NOT NULL DEFAULT '',
+    resolved_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+
+    -- Foreign key constraints
+    CONSTRAINT fk_escalation_policy_id
+        FOREIGN KEY (policy_id) REFERENCES escalation_policies(id) ON DELETE CASCADE,
+    CONSTRAINT fk_escalation_project_id
+        FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_escalations_policy ON escalations(policy_id);
+CREATE INDEX IF NOT EXISTS idx_escalations_project ON escalations(project_id);
+-- Only one escalation of a policy is open at a time
+CREATE UNIQUE INDEX IF NOT EXISTS idx_escalations_policy_open ON escalations(policy_id) WHERE resolved_at IS NULL;
+
+CREATE TRIGGER update_escalations_updated_at
+    BEFORE UPDATE ON escalations
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Comments for documentation
+COMMENT ON TABLE escalation_policies IS 'Escalation of branches that keep failing to an escalation chat or email list';
+COMMENT ON COLUMN escalation_policies.step_minutes IS 'Minutes after the first failed build each escalation step is sent, shortest first';
+COMMENT ON COLUMN escalation_policies.telegram_chat_id IS 'Telegram chat escalations are sent to';
+COMMENT ON COLUMN escalation_policies.email_recipients IS 'Email addresses escalations are sent to';
+COMMENT ON TABLE escalations IS 'Failures of a branch escalated under an escalation policy';
+COMMENT ON COLUMN escalations.build_event_id IS 'First failed build of the failure';
+COMMENT ON COLUMN escalations.step IS 'Number of escalation steps sent';
+COMMENT ON COLUMN escalations.acknowledged_at IS 'When someone took over the failure, no further steps are sent';
+COMMENT ON COLUMN escalations.resolved_at IS 'When a successful build resolved the failure';
@@ -0,0 +1,9 @@
+-- Migration 023: Rollback - Remove delivery queue kinds
+
+DROP INDEX IF EXISTS idx_delivery_queue_claimable;
+CREATE INDEX IF NOT EXISTS idx_delivery_queue_claimable
+    ON notification_delivery_queue(priority DESC, scheduled_at)
+    WHERE status IN ('pending', 'retrying');
+
+ALTER TABLE notification_delivery_queue DROP CONSTRAINT IF EXISTS check_delivery_queue_kind;
+ALTER TABLE notification_delivery_queue DROP COLUMN IF EXISTS kind;
@@ -0,0 +1,25 @@
+-- Migration 023: Separate delivery queue kinds
+-- Deferred Telegram notifications and deliveries of the delivery service share the queue,
+-- each worker only claims the rows of its own kind
+
+ALTER TABLE notification_delivery_queue
+    ADD COLUMN IF NOT EXISTS kind VARCHAR(20) NOT NULL DEFAULT 'delivery';
+
+-- Rows queued so far for notification logs that are still deferred belong to the deferred notifications job.
+-- Rows the delivery service queued can reference notification logs as well, those stay deliveries.
+UPDATE notification_delivery_queue AS q
+SET kind = 'deferred'
+FROM notification_logs AS l
+WHERE l.id = q.notification_id
+  AND l.status = 'deferred';
+
+ALTER TABLE notification_delivery_queue
+    ADD CONSTRAINT check_delivery_queue_kind CHECK (kind IN ('delivery', 'deferred'));
+
+-- Claiming scans due rows of one kind by priority
+DROP INDEX IF EXISTS idx_delivery_queue_claimable;
+CREATE INDEX IF NOT EXISTS idx_delivery_queue_claimable
+    ON notification_delivery_queue(kind, priority DESC, scheduled_at)
+    WHERE status IN ('pending', 'retrying');
+
+COMMENT ON COLUMN notification_delivery_queue.kind IS 'Worker claiming the row: delivery for the delivery service, deferred for deferred notifications';
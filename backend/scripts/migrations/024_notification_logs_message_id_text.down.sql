@@ -0,0 +1,8 @@
+-- Migration 024: Rollback - Store message IDs as integers
+-- Message IDs that are not numbers, such as Slack message timestamps, are lost
+
+ALTER TABLE notification_logs
+    ALTER COLUMN message_id TYPE INTEGER
+    USING CASE WHEN message_id ~ '^[0-9]+$' THEN message_id::INTEGER END;
+
+COMMENT ON COLUMN notification_logs.message_id IS NULL;
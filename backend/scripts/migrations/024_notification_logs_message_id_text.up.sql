@@ -0,0 +1,7 @@
+-- Migration 024: Store provider message IDs as text
+-- Telegram message IDs are numbers, but Slack identifies messages by a timestamp such as 1700000000.123456
+
+ALTER TABLE notification_logs
+    ALTER COLUMN message_id TYPE TEXT USING message_id::TEXT;
+
+COMMENT ON COLUMN notification_logs.message_id IS 'Message ID of the provider, e.g. the Telegram message ID or the Slack message ts';
@@ -0,0 +1,6 @@
+-- Migration 025: Rollback - Limit notification recipients to 255 characters
+-- Longer recipients are truncated
+
+ALTER TABLE notification_logs ALTER COLUMN recipient TYPE VARCHAR(255) USING LEFT(recipient, 255);
+ALTER TABLE dead_letter_notifications ALTER COLUMN recipient TYPE VARCHAR(255) USING LEFT(recipient, 255);
+ALTER TABLE notification_delivery_queue ALTER COLUMN recipient TYPE VARCHAR(255) USING LEFT(recipient, 255);
@@ -0,0 +1,6 @@
+-- Migration 025: Store notification recipients as text
+-- Recipients of Slack, Teams, Discord and outgoing webhooks are URLs that can be longer than 255 characters
+
+ALTER TABLE notification_logs ALTER COLUMN recipient TYPE TEXT;
+ALTER TABLE dead_letter_notifications ALTER COLUMN recipient TYPE TEXT;
+ALTER TABLE notification_delivery_queue ALTER COLUMN recipient TYPE TEXT;
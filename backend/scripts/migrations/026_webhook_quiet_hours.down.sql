@@ -0,0 +1,7 @@
+-- Migration 026: Rollback - Remove quiet hours of webhook subscriptions
+
+ALTER TABLE webhook_subscriptions
+DROP COLUMN IF EXISTS quiet_hours_bypass,
+DROP COLUMN IF EXISTS quiet_hours_end,
+DROP COLUMN IF EXISTS quiet_hours_start,
+DROP COLUMN IF EXISTS quiet_hours_timezone;
@@ -36,6 +36,11 @@ func (m *MockBotService) HandleCommand(ctx context.Context, commandCtx *domain.C
 	return args.Error(0)
 }
 
+func (m *MockBotService) HandleCallback(ctx context.Context, callbackCtx *domain.CallbackContext) error {
+	args := m.Called(ctx, callbackCtx)
+	return args.Error(0)
+}
+
 func (m *MockBotService) SendMessage(ctx context.Context, chatID int64, message string) error {
 	args := m.Called(ctx, chatID, message)
 	return args.Error(0)
@@ -155,6 +160,34 @@ func TestTelegramWebhookHandlerHandleTelegramWebhook(t *testing.T) {
 			expectedStatus: 200,
 			expectedBody:   map[string]interface{}{"status": "ok"},
 		},
+		{
+			name: "should handle webhook with callback query",
+			requestBody: tgbotapi.Update{
+				CallbackQuery: &tgbotapi.CallbackQuery{
+					ID: "query-1",
+					From: &tgbotapi.User{
+						ID:       12345,
+						UserName: "testuser",
+					},
+					Message: &tgbotapi.Message{
+						MessageID: 4711,
+						Chat: &tgbotapi.Chat{
+							ID: 67890,
+						},
+						Text: "Build failed",
+					},
+					Data: "ack:6f1c2b8e-3f5a-4c1d-9e2b-7a8d9c0b1e2f",
+				},
+			},
+			mockSetup: func(botService *MockBotService, validator *MockCommandValidator) {
+				botService.On("HandleCallback", mock.Anything, mock.MatchedBy(func(ctx *domain.CallbackContext) bool {
+					return ctx.QueryID == "query-1" && ctx.UserID == 12345 && ctx.ChatID == 67890 &&
+						ctx.MessageID == 4711 && ctx.Data == "ack:6f1c2b8e-3f5a-4c1d-9e2b-7a8d9c0b1e2f"
+				})).Return(nil)
+			},
+			expectedStatus: 200,
+			expectedBody:   map[string]interface{}{"status": "ok"},
+		},
 		{
 			name:        "should handle invalid JSON payload",
 			requestBody: invalidJSONPayload,
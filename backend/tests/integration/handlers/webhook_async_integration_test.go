@@ -0,0 +1,184 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/handler/webhook"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/provider"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/service"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/workerpool"
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const asyncTestProjectID = "550e8400-e29b-41d4-a716-446655440000"
+
+// newAsyncWebhookApp wires a webhook handler that processes webhooks on a worker pool
+func newAsyncWebhookApp(t *testing.T, mockService *MockWebhookService, workers, queueSize int) (*fiber.App, *workerpool.Pool, port.WebhookDispatcher) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	pool, err := workerpool.New(workerpool.Config{Workers: workers, QueueSize: queueSize}, logger)
+	require.NoError(t, err)
+
+	dispatcher := service.NewWebhookDispatcher(service.DispatcherDep{
+		WebhookService: mockService,
+		Pool:           pool,
+		Logger:         logger,
+	})
+	webhookHandler := webhook.NewAsyncWebhookHandler(mockService, provider.NewDefaultRegistry(""), dispatcher, logger)
+
+	app := fiber.New()
+	webhookHandler.RegisterRoutes(app.Group("/api/v1/webhooks"))
+	return app, pool, dispatcher
+}
+
+// postGitHubWorkflowRun sends a workflow_run delivery to the async webhook app
+func postGitHubWorkflowRun(t *testing.T, app *fiber.App, deliveryID string) *http.Response {
+	body, _ := json.Marshal(dto.GitHubActionsPayload{Action: "completed", Workflow: "CI"})
+	req := httptest.NewRequest("POST", "/api/v1/webhooks/github/"+asyncTestProjectID, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Hub-Signature-256", "sha256=test")
+	req.Header.Set("X-GitHub-Event", "workflow_run")
+	req.Header.Set("X-GitHub-Delivery", deliveryID)
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	return resp
+}
+
+// acceptDelivery stubs AcceptWebhook to store a new event for a delivery
+func acceptDelivery(t *testing.T, mockService *MockWebhookService, deliveryID string) *domain.WebhookEvent {
+	event, err := domain.NewWebhookEvent(value_objects.NewID(), domain.WorkflowRunEvent, `{}`, "sha256=***", deliveryID)
+	require.NoError(t, err)
+
+	mockService.On("AcceptWebhook", mock.Anything, mock.MatchedBy(func(req dto.ProcessWebhookRequest) bool {
+		return req.DeliveryID == deliveryID
+	})).Return(event, nil).Once()
+	return event
+}
+
+func TestAsyncWebhookEndpointAcceptsAndProcessesInBackground(t *testing.T) {
+	mockService := &MockWebhookService{}
+	app, pool, _ := newAsyncWebhookApp(t, mockService, 2, 10)
+
+	event := acceptDelivery(t, mockService, "delivery-async-1")
+	processed := make(chan struct{})
+	mockService.On("ProcessWebhookEvent", mock.Anything, event).
+		Run(func(mock.Arguments) { close(processed) }).
+		Return(nil).Once()
+
+	resp := postGitHubWorkflowRun(t, app, "delivery-async-1")
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	var response map[string]interface{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+	assert.Equal(t, webhook.MessageWebhookQueued, response["message"])
+
+	select {
+	case <-processed:
+	case <-time.After(time.Second):
+		t.Fatal("webhook event was not processed in the background")
+	}
+
+	require.NoError(t, pool.Shutdown(context.Background()))
+	mockService.AssertNotCalled(t, "ProcessWebhook", mock.Anything, mock.Anything)
+	mockService.AssertExpectations(t)
+}
+
+func TestAsyncWebhookEndpointRejectsWhenQueueIsFull(t *testing.T) {
+	mockService := &MockWebhookService{}
+	app, pool, _ := newAsyncWebhookApp(t, mockService, 1, 1)
+
+	// The first delivery occupies the only worker, the second fills the queue
+	release := make(chan struct{})
+	started := make(chan struct{})
+	busy := acceptDelivery(t, mockService, "delivery-busy")
+	mockService.On("ProcessWebhookEvent", mock.Anything, busy).
+		Run(func(mock.Arguments) {
+			close(started)
+			<-release
+		}).
+		Return(nil).Once()
+	queued := acceptDelivery(t, mockService, "delivery-queued")
+	mockService.On("ProcessWebhookEvent", mock.Anything, queued).Return(nil).Once()
+	acceptDelivery(t, mockService, "delivery-rejected")
+
+	assert.Equal(t, http.StatusAccepted, postGitHubWorkflowRun(t, app, "delivery-busy").StatusCode)
+	<-started
+	assert.Equal(t, http.StatusAccepted, postGitHubWorkflowRun(t, app, "delivery-queued").StatusCode)
+
+	resp := postGitHubWorkflowRun(t, app, "delivery-rejected")
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, webhook.RetryAfterQueueFull, resp.Header.Get(fiber.HeaderRetryAfter))
+
+	var response map[string]interface{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+	assert.Equal(t, webhook.ErrorWebhookQueueFull, response["error"])
+
+	// Shutdown drains the queued delivery
+	close(release)
+	require.NoError(t, pool.Shutdown(context.Background()))
+	mockService.AssertExpectations(t)
+}
+
+func TestDispatchUnprocessedSkipsQueuedAndAlreadyProcessedEvents(t *testing.T) {
+	mockService := &MockWebhookService{}
+	app, pool, dispatcher := newAsyncWebhookApp(t, mockService, 1, 10)
+
+	// A delivery still being processed must not be queued a second time
+	release := make(chan struct{})
+	started := make(chan struct{})
+	busy := acceptDelivery(t, mockService, "delivery-in-flight")
+	mockService.On("ProcessWebhookEvent", mock.Anything, busy).
+		Run(func(mock.Arguments) {
+			close(started)
+			<-release
+		}).
+		Return(nil).Once()
+	assert.Equal(t, http.StatusAccepted, postGitHubWorkflowRun(t, app, "delivery-in-flight").StatusCode)
+	<-started
+
+	// A stale copy of an event processed since it was listed is reloaded and skipped
+	stale, err := domain.NewWebhookEvent(value_objects.NewID(), domain.WorkflowRunEvent, `{}`, "sha256=***", "delivery-stale")
+	require.NoError(t, err)
+	processedAt := time.Now()
+	mockService.On("GetWebhookEvent", mock.Anything, stale.ID()).Return(domain.NewWebhookEventFromData(domain.WebhookEventData{
+		ID:          stale.ID(),
+		ProjectID:   stale.ProjectID(),
+		EventType:   stale.EventType(),
+		Payload:     stale.Payload(),
+		Signature:   stale.Signature(),
+		DeliveryID:  stale.DeliveryID(),
+		ProcessedAt: &processedAt,
+		CreatedAt:   stale.CreatedAt(),
+	}), nil).Once()
+
+	pending, err := domain.NewWebhookEvent(value_objects.NewID(), domain.WorkflowRunEvent, `{}`, "sha256=***", "delivery-pending")
+	require.NoError(t, err)
+	mockService.On("GetWebhookEvent", mock.Anything, pending.ID()).Return(pending, nil).Once()
+	mockService.On("ProcessWebhookEvent", mock.Anything, pending).Return(nil).Once()
+
+	mockService.On("GetUnprocessedWebhookEvents", mock.Anything, 10).Return([]*domain.WebhookEvent{busy, stale, pending}, nil).Once()
+
+	require.NoError(t, dispatcher.DispatchUnprocessed(context.Background(), 10))
+
+	close(release)
+	require.NoError(t, pool.Shutdown(context.Background()))
+	mockService.AssertNumberOfCalls(t, "ProcessWebhookEvent", 2)
+	mockService.AssertExpectations(t)
+}
@@ -31,6 +31,19 @@ func (m *MockWebhookService) ProcessWebhook(ctx context.Context, req dto.Process
 	return args.Get(0).(*domain.WebhookEvent), args.Error(1)
 }
 
+func (m *MockWebhookService) AcceptWebhook(ctx context.Context, req dto.ProcessWebhookRequest) (*domain.WebhookEvent, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WebhookEvent), args.Error(1)
+}
+
+func (m *MockWebhookService) ProcessWebhookEvent(ctx context.Context, event *domain.WebhookEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
 func (m *MockWebhookService) VerifyWebhookSignature(secret, signature string, body []byte) bool {
 	args := m.Called(secret, signature, body)
 	return args.Bool(0)
@@ -52,6 +65,14 @@ func (m *MockWebhookService) GetWebhookEventsByProject(ctx context.Context, proj
 	return args.Get(0).([]*domain.WebhookEvent), args.Error(1)
 }
 
+func (m *MockWebhookService) GetUnprocessedWebhookEvents(ctx context.Context, limit int) ([]*domain.WebhookEvent, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.WebhookEvent), args.Error(1)
+}
+
 func (m *MockWebhookService) ReprocessFailedWebhooks(ctx context.Context, limit int) error {
 	args := m.Called(ctx, limit)
 	return args.Error(0)
@@ -169,3 +190,135 @@ func TestWebhookEndpointHealthCheck(t *testing.T) {
 		webhook.NewWebhookHandler(mockService, logger)
 	})
 }
+
+func TestGitLabWebhookEndpointIntegration(t *testing.T) {
+	app := fiber.New()
+	mockService := &MockWebhookService{}
+	webhookHandler := webhook.NewWebhookHandler(mockService, logrus.New())
+
+	api := app.Group("/api/v1")
+	webhookHandler.RegisterRoutes(api.Group("/webhooks"))
+
+	projectID := "550e8400-e29b-41d4-a716-446655440000"
+	payload := dto.GitLabPayload{
+		ObjectKind: "pipeline",
+		ObjectAttributes: &dto.GitLabObjectAttributes{
+			ID:     42,
+			Ref:    "main",
+			SHA:    "abc123",
+			Status: "success",
+		},
+	}
+
+	tests := []struct {
+		name           string
+		headers        map[string]string
+		setupMock      func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "Missing token header",
+			headers: map[string]string{
+				"X-Gitlab-Event": "Pipeline Hook",
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedError:  "missing X-Gitlab-Token header",
+		},
+		{
+			name: "Missing event header",
+			headers: map[string]string{
+				"X-Gitlab-Token": "secret-token",
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "missing X-Gitlab-Event header",
+		},
+		{
+			name: "Unsupported event type",
+			headers: map[string]string{
+				"X-Gitlab-Token": "secret-token",
+				"X-Gitlab-Event": "Wiki Page Hook",
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "unsupported event type: Wiki Page Hook",
+		},
+		{
+			name: "Invalid token",
+			headers: map[string]string{
+				"X-Gitlab-Token": "wrong-token",
+				"X-Gitlab-Event": "Pipeline Hook",
+			},
+			setupMock: func() {
+				mockService.On("ProcessWebhook", mock.Anything, mock.MatchedBy(func(req dto.ProcessWebhookRequest) bool {
+					return req.Signature == "wrong-token"
+				})).Return(nil, domain.ErrWebhookInvalidSignature).Once()
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedError:  "invalid webhook signature",
+		},
+		{
+			name: "Pipeline hook accepted",
+			headers: map[string]string{
+				"X-Gitlab-Token":      "secret-token",
+				"X-Gitlab-Event":      "Pipeline Hook",
+				"X-Gitlab-Event-UUID": "gitlab-delivery-1",
+			},
+			setupMock: func() {
+				event, _ := domain.NewWebhookEvent(value_objects.NewID(), domain.GitLabPipelineEvent, `{}`, "x-gitlab-token", "gitlab-delivery-1")
+				mockService.On("ProcessWebhook", mock.Anything, mock.MatchedBy(func(req dto.ProcessWebhookRequest) bool {
+					payload, ok := req.Payload.(dto.GitLabPayload)
+					return req.Provider == domain.ProviderGitLab &&
+						req.EventType == domain.GitLabPipelineEvent &&
+						req.Signature == "secret-token" &&
+						req.DeliveryID == "gitlab-delivery-1" &&
+						ok && payload.ObjectAttributes != nil &&
+						payload.ObjectAttributes.ID == 42
+				})).Return(event, nil).Once()
+			},
+			expectedStatus: http.StatusAccepted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setupMock != nil {
+				tt.setupMock()
+			}
+
+			body, _ := json.Marshal(payload)
+			req := httptest.NewRequest("POST", "/api/v1/webhooks/gitlab/"+projectID, bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+
+			resp, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedError != "" {
+				var response map[string]interface{}
+				err := json.NewDecoder(resp.Body).Decode(&response)
+				assert.NoError(t, err)
+				assert.Contains(t, response["error"], tt.expectedError)
+			}
+		})
+	}
+
+	mockService.AssertExpectations(t)
+}
+
+func TestUnknownProviderWebhookEndpoint(t *testing.T) {
+	app := fiber.New()
+	webhookHandler := webhook.NewWebhookHandler(&MockWebhookService{}, logrus.New())
+	webhookHandler.RegisterRoutes(app.Group("/api/v1/webhooks"))
+
+	req := httptest.NewRequest("POST", "/api/v1/webhooks/bitbucket/550e8400-e29b-41d4-a716-446655440000", bytes.NewReader([]byte(`{}`)))
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	var response map[string]interface{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+	assert.Contains(t, response["error"], "unsupported CI provider: bitbucket")
+}
@@ -104,8 +104,8 @@ func (m *MockWebhookEventRepository) GetByProjectID(ctx context.Context, project
 	return args.Get(0).([]*webhookDomain.WebhookEvent), args.Error(1)
 }
 
-func (m *MockWebhookEventRepository) GetUnprocessedEvents(ctx context.Context, limit int) ([]*webhookDomain.WebhookEvent, error) {
-	args := m.Called(ctx, limit)
+func (m *MockWebhookEventRepository) GetUnprocessedEvents(ctx context.Context, receivedBefore time.Time, limit int) ([]*webhookDomain.WebhookEvent, error) {
+	args := m.Called(ctx, receivedBefore, limit)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -253,6 +253,35 @@ func (m *MockBuildEventService) GetBuildEvent(ctx context.Context, id value_obje
 	return args.Get(0).(*buildDomain.BuildEvent), args.Error(1)
 }
 
+func (m *MockBuildEventService) GetBuildEventByRunID(ctx context.Context, projectID value_objects.ID, runID string) (*buildDomain.BuildEvent, error) {
+	args := m.Called(ctx, projectID, runID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*buildDomain.BuildEvent), args.Error(1)
+}
+
+func (m *MockBuildEventService) GetStatusTransitions(ctx context.Context, buildEventID value_objects.ID) ([]*buildDomain.BuildStatusTransition, error) {
+	args := m.Called(ctx, buildEventID)
+	return args.Get(0).([]*buildDomain.BuildStatusTransition), args.Error(1)
+}
+
+func (m *MockBuildEventService) RecordBuildJob(ctx context.Context, req buildDto.RecordBuildJobRequest) (*buildDomain.BuildJob, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*buildDomain.BuildJob), args.Error(1)
+}
+
+func (m *MockBuildEventService) GetBuildJobs(ctx context.Context, buildEventID value_objects.ID) ([]*buildDomain.BuildJob, error) {
+	args := m.Called(ctx, buildEventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*buildDomain.BuildJob), args.Error(1)
+}
+
 func (m *MockBuildEventService) GetBuildEventsByProject(ctx context.Context, projectID value_objects.ID, filters buildDto.ListBuildEventFilters) ([]*buildDomain.BuildEvent, error) {
 	args := m.Called(ctx, projectID, filters)
 	if args.Get(0) == nil {
@@ -269,6 +298,11 @@ func (m *MockBuildEventService) GetBuildMetrics(ctx context.Context, projectID v
 	return args.Get(0).(*buildDomain.BuildMetrics), args.Error(1)
 }
 
+func (m *MockBuildEventService) GetBuildTransition(ctx context.Context, buildEventID value_objects.ID) (buildDomain.BuildTransition, bool, error) {
+	args := m.Called(ctx, buildEventID)
+	return args.Get(0).(buildDomain.BuildTransition), args.Bool(1), args.Error(2)
+}
+
 func (m *MockBuildEventService) GetLatestBuildEvent(ctx context.Context, projectID value_objects.ID) (*buildDomain.BuildEvent, error) {
 	args := m.Called(ctx, projectID)
 	if args.Get(0) == nil {
@@ -355,6 +389,16 @@ func (m *MockNotificationLogService) ProcessFailedNotifications(ctx context.Cont
 	return args.Error(0)
 }
 
+func (m *MockNotificationLogService) SendDueDigests(ctx context.Context, limit int) error {
+	args := m.Called(ctx, limit)
+	return args.Error(0)
+}
+
+func (m *MockNotificationLogService) SendDeferredNotifications(ctx context.Context, limit int) error {
+	args := m.Called(ctx, limit)
+	return args.Error(0)
+}
+
 func (m *MockNotificationLogService) ProcessPendingNotifications(ctx context.Context, limit int) error {
 	// Process pending notifications
 	args := m.Called(ctx, limit)
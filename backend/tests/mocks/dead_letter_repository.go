@@ -0,0 +1,64 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/stretchr/testify/mock"
+)
+
+// DeadLetterRepository is a mock of port.DeadLetterRepository interface
+type DeadLetterRepository struct {
+	mock.Mock
+}
+
+// NewDeadLetterRepository creates a new mock instance
+func NewDeadLetterRepository(t mock.TestingT) *DeadLetterRepository {
+	mock := &DeadLetterRepository{}
+	mock.Test(t)
+	return mock
+}
+
+// Create provides a mock function with given fields: ctx, deadLetter
+func (m *DeadLetterRepository) Create(ctx context.Context, deadLetter *domain.DeadLetterNotification) error {
+	ret := m.Called(ctx, deadLetter)
+	return ret.Error(0)
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (m *DeadLetterRepository) GetByID(ctx context.Context, id value_objects.ID) (*domain.DeadLetterNotification, error) {
+	ret := m.Called(ctx, id)
+
+	var r0 *domain.DeadLetterNotification
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.DeadLetterNotification)
+	}
+
+	return r0, ret.Error(1)
+}
+
+// List provides a mock function with given fields: ctx, filters
+func (m *DeadLetterRepository) List(ctx context.Context, filters dto.ListDeadLetterFilters) ([]*domain.DeadLetterNotification, error) {
+	ret := m.Called(ctx, filters)
+
+	var r0 []*domain.DeadLetterNotification
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]*domain.DeadLetterNotification)
+	}
+
+	return r0, ret.Error(1)
+}
+
+// Count provides a mock function with given fields: ctx, filters
+func (m *DeadLetterRepository) Count(ctx context.Context, filters dto.ListDeadLetterFilters) (int64, error) {
+	ret := m.Called(ctx, filters)
+	return ret.Get(0).(int64), ret.Error(1)
+}
+
+// Update provides a mock function with given fields: ctx, deadLetter
+func (m *DeadLetterRepository) Update(ctx context.Context, deadLetter *domain.DeadLetterNotification) error {
+	ret := m.Called(ctx, deadLetter)
+	return ret.Error(0)
+}
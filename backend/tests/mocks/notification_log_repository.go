@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
@@ -176,6 +177,52 @@ func (m *NotificationLogRepository) GetPendingNotifications(ctx context.Context,
 	return r0, r1
 }
 
+// GetDueDigestNotifications provides a mock function with given fields: ctx, before, limit
+func (m *NotificationLogRepository) GetDueDigestNotifications(ctx context.Context, before time.Time, limit int) ([]*domain.NotificationLog, error) {
+	ret := m.Called(ctx, before, limit)
+
+	var r0 []*domain.NotificationLog
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*domain.NotificationLog); ok {
+		r0 = rf(ctx, before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.NotificationLog)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeferredByCommit provides a mock function with given fields: ctx, projectID, commitSHA, channel, recipient
+func (m *NotificationLogRepository) GetDeferredByCommit(ctx context.Context, projectID value_objects.ID, commitSHA string, channel domain.NotificationChannel, recipient string) ([]*domain.NotificationLog, error) {
+	ret := m.Called(ctx, projectID, commitSHA, channel, recipient)
+
+	var r0 []*domain.NotificationLog
+	if rf, ok := ret.Get(0).(func(context.Context, value_objects.ID, string, domain.NotificationChannel, string) []*domain.NotificationLog); ok {
+		r0 = rf(ctx, projectID, commitSHA, channel, recipient)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.NotificationLog)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, value_objects.ID, string, domain.NotificationChannel, string) error); ok {
+		r1 = rf(ctx, projectID, commitSHA, channel, recipient)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Count provides a mock function with given fields: ctx, projectID, status
 func (m *NotificationLogRepository) Count(ctx context.Context, projectID *value_objects.ID, status *domain.NotificationStatus) (int64, error) {
 	ret := m.Called(ctx, projectID, status)
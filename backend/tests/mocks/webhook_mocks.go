@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
@@ -52,8 +53,8 @@ func (m *MockWebhookEventRepository) ExistsByDeliveryID(ctx context.Context, del
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockWebhookEventRepository) GetUnprocessedEvents(ctx context.Context, limit int) ([]*domain.WebhookEvent, error) {
-	args := m.Called(ctx, limit)
+func (m *MockWebhookEventRepository) GetUnprocessedEvents(ctx context.Context, receivedBefore time.Time, limit int) ([]*domain.WebhookEvent, error) {
+	args := m.Called(ctx, receivedBefore, limit)
 	return args.Get(0).([]*domain.WebhookEvent), args.Error(1)
 }
 
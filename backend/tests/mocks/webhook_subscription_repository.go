@@ -0,0 +1,75 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/stretchr/testify/mock"
+)
+
+// WebhookSubscriptionRepository is a mock of port.WebhookSubscriptionRepository interface
+type WebhookSubscriptionRepository struct {
+	mock.Mock
+}
+
+// NewWebhookSubscriptionRepository creates a new mock instance
+func NewWebhookSubscriptionRepository(t mock.TestingT) *WebhookSubscriptionRepository {
+	mock := &WebhookSubscriptionRepository{}
+	mock.Test(t)
+	return mock
+}
+
+// Create provides a mock function with given fields: ctx, subscription
+func (m *WebhookSubscriptionRepository) Create(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	ret := m.Called(ctx, subscription)
+	return ret.Error(0)
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (m *WebhookSubscriptionRepository) GetByID(ctx context.Context, id value_objects.ID) (*domain.WebhookSubscription, error) {
+	ret := m.Called(ctx, id)
+
+	var r0 *domain.WebhookSubscription
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*domain.WebhookSubscription)
+	}
+
+	return r0, ret.Error(1)
+}
+
+// GetByProjectID provides a mock function with given fields: ctx, projectID
+func (m *WebhookSubscriptionRepository) GetByProjectID(ctx context.Context, projectID value_objects.ID) ([]*domain.WebhookSubscription, error) {
+	ret := m.Called(ctx, projectID)
+
+	var r0 []*domain.WebhookSubscription
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]*domain.WebhookSubscription)
+	}
+
+	return r0, ret.Error(1)
+}
+
+// GetActiveByProjectID provides a mock function with given fields: ctx, projectID
+func (m *WebhookSubscriptionRepository) GetActiveByProjectID(ctx context.Context, projectID value_objects.ID) ([]*domain.WebhookSubscription, error) {
+	ret := m.Called(ctx, projectID)
+
+	var r0 []*domain.WebhookSubscription
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]*domain.WebhookSubscription)
+	}
+
+	return r0, ret.Error(1)
+}
+
+// Update provides a mock function with given fields: ctx, subscription
+func (m *WebhookSubscriptionRepository) Update(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	ret := m.Called(ctx, subscription)
+	return ret.Error(0)
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (m *WebhookSubscriptionRepository) Delete(ctx context.Context, id value_objects.ID) error {
+	ret := m.Called(ctx, id)
+	return ret.Error(0)
+}
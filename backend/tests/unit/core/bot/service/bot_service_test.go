@@ -39,6 +39,11 @@ func (m *MockTelegramAPI) SendMessageWithMarkdown(chatID int64, text string) err
 	return nil
 }
 
+func (m *MockTelegramAPI) AnswerCallbackQuery(callbackQueryID, text string) error {
+	args := m.Called(callbackQueryID, text)
+	return args.Error(0)
+}
+
 func (m *MockTelegramAPI) SetWebhook(webhookURL string) error {
 	args := m.Called(webhookURL)
 	return args.Error(0)
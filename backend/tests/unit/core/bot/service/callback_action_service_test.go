@@ -0,0 +1,337 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/bot/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/bot/service"
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	buildPort "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/port"
+	notificationDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	notificationPort "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/tests/mocks"
+)
+
+const (
+	callbackChatID    = int64(-100123)
+	callbackMessageID = 4711
+	callbackBuildURL  = "https://github.com/org/repo/actions/runs/1"
+)
+
+// MockBuildEventService mocks the build event lookups of callback actions
+type MockBuildEventService struct {
+	buildPort.BuildEventService
+	mock.Mock
+}
+
+func (m *MockBuildEventService) GetBuildEvent(ctx context.Context, id value_objects.ID) (*buildDomain.BuildEvent, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*buildDomain.BuildEvent), args.Error(1)
+}
+
+func (m *MockBuildEventService) GetBuildJobs(ctx context.Context, buildEventID value_objects.ID) ([]*buildDomain.BuildJob, error) {
+	args := m.Called(ctx, buildEventID)
+	return args.Get(0).([]*buildDomain.BuildJob), args.Error(1)
+}
+
+// editRecordingSender records the telegram message edits of callback actions
+type editRecordingSender struct {
+	notificationPort.NotificationSender
+	chatID    int64
+	messageID string
+	message   string
+	keyboard  *notificationDomain.TelegramInlineKeyboard
+}
+
+func (s *editRecordingSender) EditTelegramNotification(
+	ctx context.Context,
+	chatID int64,
+	messageID, message string,
+	keyboard *notificationDomain.TelegramInlineKeyboard,
+) error {
+	s.chatID, s.messageID, s.message, s.keyboard = chatID, messageID, message, keyboard
+	return nil
+}
+
+type callbackFixture struct {
+	buildEvent       *buildDomain.BuildEvent
+	subscription     *notificationDomain.TelegramSubscription
+	buildService     *MockBuildEventService
+	subscriptionRepo *mocks.TelegramSubscriptionRepository
+	logRepo          *mocks.NotificationLogRepository
+	sender           *editRecordingSender
+	actions          service.CallbackActionDep
+}
+
+// newCallbackFixture sets up a failed build notified to a subscribed chat
+func newCallbackFixture(t *testing.T) *callbackFixture {
+	buildEvent, err := buildDomain.NewBuildEvent(buildDomain.BuildEventParams{
+		ProjectID: value_objects.NewID(),
+		EventType: buildDomain.EventTypePush,
+		Status:    buildDomain.BuildStatusFailed,
+		Branch:    "main",
+		BuildURL:  callbackBuildURL,
+	})
+	require.NoError(t, err)
+
+	subscription, err := notificationDomain.NewTelegramSubscription(buildEvent.ProjectID(), callbackChatID)
+	require.NoError(t, err)
+
+	notification, err := notificationDomain.NewNotificationLog(buildEvent.ID(), buildEvent.ProjectID(),
+		notificationDomain.NotificationChannelTelegram, "-100123", "<b>Build failed</b>", 3)
+	require.NoError(t, err)
+	messageID := "4711"
+	require.NoError(t, notification.MarkAsSent(&messageID))
+
+	f := &callbackFixture{
+		buildEvent:       buildEvent,
+		subscription:     subscription,
+		buildService:     &MockBuildEventService{},
+		subscriptionRepo: mocks.NewTelegramSubscriptionRepository(t),
+		logRepo:          mocks.NewNotificationLogRepository(t),
+		sender:           &editRecordingSender{},
+	}
+	f.buildService.On("GetBuildEvent", mock.Anything, buildEvent.ID()).Return(buildEvent, nil)
+	f.subscriptionRepo.On("GetByProjectAndChatID", mock.Anything, buildEvent.ProjectID(), callbackChatID).Return(subscription, nil)
+	f.logRepo.On("GetByBuildEventID", mock.Anything, buildEvent.ID()).Return([]*notificationDomain.NotificationLog{notification}, nil).Maybe()
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	f.actions = service.CallbackActionDep{
+		BuildService:             f.buildService,
+		TelegramSubscriptionRepo: f.subscriptionRepo,
+		NotificationRepo:         f.logRepo,
+		NotificationSender:       f.sender,
+		Logger:                   logger,
+	}
+
+	return f
+}
+
+func (f *callbackFixture) press(t *testing.T, action string) (string, error) {
+	t.Helper()
+	return service.NewCallbackActionService(f.actions).HandleCallback(context.Background(), &domain.CallbackContext{
+		QueryID:     "query-1",
+		Data:        notificationDomain.NewTelegramCallbackData(action, f.buildEvent.ID()),
+		UserID:      12345,
+		ChatID:      callbackChatID,
+		MessageID:   callbackMessageID,
+		MessageText: "Build failed",
+		Username:    "alice",
+	})
+}
+
+func TestCallbackActionService_MutesProject(t *testing.T) {
+	f := newCallbackFixture(t)
+	f.subscriptionRepo.On("Update", mock.Anything, f.subscription).Return(nil).Once()
+
+	notice, err := f.press(t, notificationDomain.TelegramActionMute)
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, notice)
+	assert.True(t, f.subscription.IsMuted(time.Now()))
+	assert.False(t, f.subscription.IsMuted(time.Now().Add(notificationDomain.TelegramMuteDuration+time.Minute)))
+	assert.Contains(t, f.sender.message, "Muted until")
+	assert.Contains(t, f.sender.message, "@alice")
+}
+
+func TestCallbackActionService_AcknowledgesBuild(t *testing.T) {
+	f := newCallbackFixture(t)
+
+	_, err := f.press(t, notificationDomain.TelegramActionAcknowledge)
+
+	require.NoError(t, err)
+	assert.Equal(t, callbackChatID, f.sender.chatID)
+	assert.Equal(t, "4711", f.sender.messageID)
+	assert.Equal(t, "<b>Build failed</b>\n\n👀 Acknowledged by @alice", f.sender.message)
+	assert.Equal(t, notificationDomain.NewTelegramBuildKeyboard(f.buildEvent.ID(), callbackBuildURL), f.sender.keyboard)
+}
+
+func TestCallbackActionService_ShowsFailingJobs(t *testing.T) {
+	f := newCallbackFixture(t)
+
+	failed, err := buildDomain.NewBuildJob(buildDomain.BuildJobParams{
+		BuildEventID: f.buildEvent.ID(),
+		JobID:        "1",
+		Name:         "test",
+		Status:       buildDomain.BuildStatusFailed,
+		FailedSteps:  []string{"go test"},
+	})
+	require.NoError(t, err)
+	passed, err := buildDomain.NewBuildJob(buildDomain.BuildJobParams{
+		BuildEventID: f.buildEvent.ID(),
+		JobID:        "2",
+		Name:         "lint",
+		Status:       buildDomain.BuildStatusSuccess,
+	})
+	require.NoError(t, err)
+	f.buildService.On("GetBuildJobs", mock.Anything, f.buildEvent.ID()).Return([]*buildDomain.BuildJob{failed, passed}, nil)
+
+	_, err = f.press(t, notificationDomain.TelegramActionFailingJobs)
+
+	require.NoError(t, err)
+	assert.Contains(t, f.sender.message, "• test → go test")
+	assert.NotContains(t, f.sender.message, "lint")
+}
+
+func TestCallbackActionService_RejectsChatsNotSubscribedToProject(t *testing.T) {
+	f := newCallbackFixture(t)
+	f.subscriptionRepo.ExpectedCalls = nil
+	f.subscriptionRepo.On("GetByProjectAndChatID", mock.Anything, f.buildEvent.ProjectID(), callbackChatID).
+		Return(nil, notificationDomain.ErrTelegramSubscriptionNotFound)
+
+	_, err := f.press(t, notificationDomain.TelegramActionAcknowledge)
+
+	assert.ErrorIs(t, err, domain.ErrCallbackNotAuthorized)
+	assert.Empty(t, f.sender.message)
+}
+
+// stubEscalations records acknowledged branches and sends escalations of every project to one chat
+type stubEscalations struct {
+	notificationPort.EscalationService
+	chatID       int64
+	acknowledged []string
+}
+
+func (s *stubEscalations) AcknowledgeEscalations(
+	ctx context.Context,
+	projectID value_objects.ID,
+	branch, acknowledgedBy string,
+) ([]*notificationDomain.Escalation, error) {
+	s.acknowledged = append(s.acknowledged, branch+" by "+acknowledgedBy)
+	return nil, nil
+}
+
+func (s *stubEscalations) IsEscalationChat(ctx context.Context, projectID value_objects.ID, chatID int64) (bool, error) {
+	return chatID == s.chatID, nil
+}
+
+// escalationChatFixture sets up a failed build escalated to a chat that is not subscribed to its project
+func escalationChatFixture(t *testing.T) (*callbackFixture, *stubEscalations) {
+	f := newCallbackFixture(t)
+	f.subscriptionRepo.ExpectedCalls = nil
+	f.subscriptionRepo.On("GetByProjectAndChatID", mock.Anything, f.buildEvent.ProjectID(), callbackChatID).
+		Return(nil, notificationDomain.ErrTelegramSubscriptionNotFound)
+
+	escalations := &stubEscalations{chatID: callbackChatID}
+	f.actions.Escalations = escalations
+	return f, escalations
+}
+
+func TestCallbackActionService_AcknowledgesEscalations(t *testing.T) {
+	f := newCallbackFixture(t)
+	escalations := &stubEscalations{}
+	f.actions.Escalations = escalations
+
+	_, err := f.press(t, notificationDomain.TelegramActionAcknowledge)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"main by @alice"}, escalations.acknowledged)
+}
+
+func TestCallbackActionService_EscalationChatAcknowledges(t *testing.T) {
+	f, escalations := escalationChatFixture(t)
+
+	_, err := f.press(t, notificationDomain.TelegramActionAcknowledge)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"main by @alice"}, escalations.acknowledged)
+	assert.Contains(t, f.sender.message, "👀 Acknowledged by @alice")
+	assert.Equal(t, notificationDomain.NewTelegramEscalationKeyboard(f.buildEvent.ID(), callbackBuildURL), f.sender.keyboard)
+}
+
+func TestCallbackActionService_EscalationChatOnlyAcknowledges(t *testing.T) {
+	f, escalations := escalationChatFixture(t)
+
+	_, err := f.press(t, notificationDomain.TelegramActionMute)
+
+	assert.ErrorIs(t, err, domain.ErrCallbackNotAuthorized)
+	assert.Empty(t, escalations.acknowledged)
+	assert.Empty(t, f.sender.message)
+}
+
+// MockCallbackActionService mocks the actions of inline buttons
+type MockCallbackActionService struct {
+	mock.Mock
+}
+
+func (m *MockCallbackActionService) HandleCallback(ctx context.Context, callbackCtx *domain.CallbackContext) (string, error) {
+	args := m.Called(ctx, callbackCtx)
+	return args.String(0), args.Error(1)
+}
+
+func TestBotServiceHandleCallback(t *testing.T) {
+	tests := []struct {
+		name          string
+		actionSetup   func(*MockCallbackActionService)
+		expectedText  string
+		expectedError bool
+	}{
+		{
+			name: "should answer with the action notice",
+			actionSetup: func(actions *MockCallbackActionService) {
+				actions.On("HandleCallback", mock.Anything, mock.Anything).Return("👀 Build acknowledged", nil)
+			},
+			expectedText: "👀 Build acknowledged",
+		},
+		{
+			name: "should answer unauthorized chats with the reason",
+			actionSetup: func(actions *MockCallbackActionService) {
+				actions.On("HandleCallback", mock.Anything, mock.Anything).Return("", domain.ErrCallbackNotAuthorized)
+			},
+			expectedText:  "❌ Error: " + domain.ErrCallbackNotAuthorized.Error(),
+			expectedError: true,
+		},
+		{
+			name: "should not leak internal errors",
+			actionSetup: func(actions *MockCallbackActionService) {
+				actions.On("HandleCallback", mock.Anything, mock.Anything).Return("", assert.AnError)
+			},
+			expectedText:  "❌ Action failed. Please try again.",
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAPI := &MockTelegramAPI{}
+			mockRouter := &MockCommandRouter{}
+			mockActions := &MockCallbackActionService{}
+
+			mockRouter.On("RegisterHandler", mock.AnythingOfType("string"), mock.Anything).Return().Times(5)
+			mockAPI.On("AnswerCallbackQuery", "query-1", tt.expectedText).Return(nil).Once()
+			tt.actionSetup(mockActions)
+
+			botService := service.NewBotServiceWithCallbackActions(
+				mockAPI,
+				&MockCommandValidator{},
+				mockRouter,
+				&MockProjectService{},
+				&MockSubscriptionService{},
+				mockActions,
+			)
+
+			err := botService.HandleCallback(context.Background(), &domain.CallbackContext{QueryID: "query-1"})
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockAPI.AssertExpectations(t)
+			mockActions.AssertExpectations(t)
+		})
+	}
+}
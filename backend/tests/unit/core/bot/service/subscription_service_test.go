@@ -0,0 +1,238 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/bot/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/bot/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/bot/service"
+	notificationDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	projectDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/project/domain"
+	projectPort "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/project/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/tests/mocks"
+)
+
+const subscriberChatID = int64(-100456)
+
+// stubProjectRepository resolves the project names of subscription commands
+type stubProjectRepository struct {
+	projectPort.ProjectRepository
+	project *projectDomain.Project
+}
+
+func (r *stubProjectRepository) GetByName(ctx context.Context, name string) (*projectDomain.Project, error) {
+	if r.project == nil || r.project.Name() != name {
+		return nil, projectDomain.ErrProjectNotFound
+	}
+	return r.project, nil
+}
+
+// newSubscriptionTestDep sets up a project "my-app" and the dependencies of the bot subscription service
+func newSubscriptionTestDep(t *testing.T) (*projectDomain.Project, *mocks.TelegramSubscriptionRepository, service.SubscriptionDep) {
+	project, err := projectDomain.NewProject("my-app", "https://github.com/org/my-app", "webhook-secret-0123456789", nil)
+	require.NoError(t, err)
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	subscriptionRepo := mocks.NewTelegramSubscriptionRepository(t)
+	return project, subscriptionRepo, service.SubscriptionDep{
+		ProjectRepo:              &stubProjectRepository{project: project},
+		TelegramSubscriptionRepo: subscriptionRepo,
+		Logger:                   logger,
+	}
+}
+
+func TestSubscriptionService_SubscribeCreatesFilteredSubscription(t *testing.T) {
+	project, subscriptionRepo, dep := newSubscriptionTestDep(t)
+	subscriptionRepo.On("GetByProjectAndChatID", mock.Anything, project.ID(), subscriberChatID).
+		Return(nil, notificationDomain.ErrTelegramSubscriptionNotFound)
+
+	var created *notificationDomain.TelegramSubscription
+	subscriptionRepo.On("Create", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			created = args.Get(1).(*notificationDomain.TelegramSubscription)
+		}).Return(nil).Once()
+
+	subscriptions := service.NewSubscriptionService(dep)
+
+	err := subscriptions.Subscribe(context.Background(), &dto.SubscribeCommandRequest{
+		ProjectName: "my-app",
+		ChatID:      subscriberChatID,
+		Filter: dto.TelegramSubscriptionFilter{
+			Statuses: []string{"failed"},
+			Branches: []string{"main", "release/*"},
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, created)
+	assert.Equal(t, project.ID(), created.ProjectID())
+	assert.Equal(t, []string{"failed"}, created.Statuses())
+	assert.Equal(t, []string{"main", "release/*"}, created.BranchPatterns())
+	assert.Empty(t, created.EventTypes())
+}
+
+func TestSubscriptionService_SubscribeAgainReplacesFilter(t *testing.T) {
+	project, subscriptionRepo, dep := newSubscriptionTestDep(t)
+
+	existing, err := notificationDomain.NewTelegramSubscription(project.ID(), subscriberChatID)
+	require.NoError(t, err)
+	filter, err := notificationDomain.NewSubscriptionFilter([]string{"push"}, nil, nil)
+	require.NoError(t, err)
+	existing.UpdateFilter(filter)
+	existing.Deactivate()
+
+	subscriptionRepo.On("GetByProjectAndChatID", mock.Anything, project.ID(), subscriberChatID).Return(existing, nil)
+	subscriptionRepo.On("Update", mock.Anything, existing).Return(nil).Once()
+
+	subscriptions := service.NewSubscriptionService(dep)
+
+	err = subscriptions.Subscribe(context.Background(), &dto.SubscribeCommandRequest{
+		ProjectName: "my-app",
+		ChatID:      subscriberChatID,
+		Filter:      dto.TelegramSubscriptionFilter{Statuses: []string{"failed"}},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, existing.IsActive())
+	assert.Empty(t, existing.EventTypes())
+	assert.Equal(t, []string{"failed"}, existing.Statuses())
+}
+
+func TestSubscriptionService_SubscribeSetsDigestMode(t *testing.T) {
+	project, subscriptionRepo, dep := newSubscriptionTestDep(t)
+	subscriptionRepo.On("GetByProjectAndChatID", mock.Anything, project.ID(), subscriberChatID).
+		Return(nil, notificationDomain.ErrTelegramSubscriptionNotFound)
+
+	var created *notificationDomain.TelegramSubscription
+	subscriptionRepo.On("Create", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			created = args.Get(1).(*notificationDomain.TelegramSubscription)
+		}).Return(nil).Once()
+
+	subscriptions := service.NewSubscriptionService(dep)
+
+	err := subscriptions.Subscribe(context.Background(), &dto.SubscribeCommandRequest{
+		ProjectName: "my-app",
+		ChatID:      subscriberChatID,
+		DigestMode:  "daily",
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, created)
+	assert.Equal(t, notificationDomain.DigestModeDaily, created.DigestMode())
+}
+
+func TestSubscriptionService_SubscribeRejectsUnknownProjects(t *testing.T) {
+	_, _, dep := newSubscriptionTestDep(t)
+
+	subscriptions := service.NewSubscriptionService(dep)
+
+	err := subscriptions.Subscribe(context.Background(), &dto.SubscribeCommandRequest{
+		ProjectName: "unknown",
+		ChatID:      subscriberChatID,
+	})
+
+	assert.ErrorIs(t, err, domain.ErrSubscriptionProjectNotFound)
+}
+
+func TestSubscribeCommand_ParsesFilters(t *testing.T) {
+	tests := []struct {
+		name            string
+		args            []string
+		expectedFilter  *dto.TelegramSubscriptionFilter
+		expectedDigest  string
+		transitionsOnly bool
+		expectedReply   string
+	}{
+		{
+			name: "should subscribe with the given filters",
+			args: []string{"my-app", "events=push,pull_request", "status=failed", "branches=main,release/*"},
+			expectedFilter: &dto.TelegramSubscriptionFilter{
+				EventTypes: []string{"push", "pull_request"},
+				Statuses:   []string{"failed"},
+				Branches:   []string{"main", "release/*"},
+			},
+			expectedReply: "• Branches: `main`, `release/*`",
+		},
+		{
+			name:           "should subscribe to all events without filters",
+			args:           []string{"my-app"},
+			expectedFilter: &dto.TelegramSubscriptionFilter{},
+			expectedReply:  "Successfully subscribed",
+		},
+		{
+			name:           "should subscribe to a daily digest",
+			args:           []string{"my-app", "status=failed", "digest=daily"},
+			expectedFilter: &dto.TelegramSubscriptionFilter{Statuses: []string{"failed"}},
+			expectedDigest: "daily",
+			expectedReply:  "sent as a daily digest",
+		},
+		{
+			name:            "should subscribe to build transitions only",
+			args:            []string{"my-app", "transitions=only"},
+			expectedFilter:  &dto.TelegramSubscriptionFilter{},
+			transitionsOnly: true,
+			expectedReply:   "Only builds breaking or fixing a branch are notified",
+		},
+		{
+			name:          "should reject unknown transitions options",
+			args:          []string{"my-app", "transitions=some"},
+			expectedReply: "❌ Error: " + domain.ErrInvalidSubscriptionFilter.Error(),
+		},
+		{
+			name:          "should reject unknown digest modes",
+			args:          []string{"my-app", "digest=weekly"},
+			expectedReply: "❌ Error: unknown digest mode: weekly",
+		},
+		{
+			name:          "should reject unknown filter keys",
+			args:          []string{"my-app", "colour=red"},
+			expectedReply: "❌ Error: " + domain.ErrInvalidSubscriptionFilter.Error(),
+		},
+		{
+			name:          "should reject unknown statuses",
+			args:          []string{"my-app", "status=broken"},
+			expectedReply: "❌ Error: unknown build status: broken",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAPI := &MockTelegramAPI{}
+			mockSubscriptions := &MockSubscriptionService{}
+			router := domain.NewCommandRouter()
+
+			var reply string
+			capture := func(args mock.Arguments) { reply = args.String(1) }
+			mockAPI.On("SendMessage", subscriberChatID, mock.Anything).Run(capture).Return(nil).Maybe()
+			mockAPI.On("SendMessageWithMarkdown", subscriberChatID, mock.Anything).Run(capture).Return(nil).Maybe()
+			if tt.expectedFilter != nil {
+				mockSubscriptions.On("Subscribe", mock.Anything, mock.MatchedBy(func(req *dto.SubscribeCommandRequest) bool {
+					return req.ProjectName == "my-app" && assert.ObjectsAreEqual(*tt.expectedFilter, req.Filter) &&
+						req.DigestMode == tt.expectedDigest && req.TransitionsOnly == tt.transitionsOnly
+				})).Return(nil).Once()
+			}
+
+			service.NewBotService(mockAPI, &MockCommandValidator{}, router, &MockProjectService{}, mockSubscriptions)
+
+			_ = router.RouteCommand(&domain.CommandContext{
+				Command: "subscribe",
+				Args:    tt.args,
+				ChatID:  subscriberChatID,
+				UserID:  12345,
+			})
+
+			assert.Contains(t, reply, tt.expectedReply)
+			mockSubscriptions.AssertExpectations(t)
+		})
+	}
+}
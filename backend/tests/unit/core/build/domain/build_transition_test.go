@@ -0,0 +1,150 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+)
+
+// completedBuild returns a build of main that completed with the status minutes ago
+func completedBuild(eventType domain.EventType, status domain.BuildStatus, minutesAgo int) *domain.BuildEvent {
+	return domain.RestoreBuildEvent(domain.RestoreBuildEventParams{
+		ID:        value_objects.NewID(),
+		ProjectID: value_objects.NewID(),
+		EventType: eventType,
+		Status:    status,
+		Branch:    "main",
+		CreatedAt: value_objects.NewTimestampFromTime(time.Now().Add(-time.Duration(minutesAgo) * time.Minute)),
+	})
+}
+
+func TestClassifyBuildTransition(t *testing.T) {
+	passed := completedBuild(domain.EventTypeBuildCompleted, domain.BuildStatusSuccess, 10)
+	failed := completedBuild(domain.EventTypeBuildCompleted, domain.BuildStatusFailed, 10)
+	cancelled := completedBuild(domain.EventTypeBuildCompleted, domain.BuildStatusCancelled, 5)
+
+	tests := []struct {
+		name               string
+		build              *domain.BuildEvent
+		history            []*domain.BuildEvent
+		expectedTransition domain.BuildTransition
+		expectedOK         bool
+	}{
+		{
+			name:               "failure after a successful build is a new failure",
+			build:              completedBuild(domain.EventTypeBuildCompleted, domain.BuildStatusFailed, 0),
+			history:            []*domain.BuildEvent{passed},
+			expectedTransition: domain.BuildTransitionNewFailure,
+			expectedOK:         true,
+		},
+		{
+			name:               "first failure of a branch is a new failure",
+			build:              completedBuild(domain.EventTypeBuildCompleted, domain.BuildStatusFailed, 0),
+			expectedTransition: domain.BuildTransitionNewFailure,
+			expectedOK:         true,
+		},
+		{
+			name:               "failure after a failed build is still failing",
+			build:              completedBuild(domain.EventTypeBuildCompleted, domain.BuildStatusFailed, 0),
+			history:            []*domain.BuildEvent{failed, passed},
+			expectedTransition: domain.BuildTransitionStillFailing,
+			expectedOK:         true,
+		},
+		{
+			name:               "success after a failed build fixes the branch",
+			build:              completedBuild(domain.EventTypeBuildCompleted, domain.BuildStatusSuccess, 0),
+			history:            []*domain.BuildEvent{failed},
+			expectedTransition: domain.BuildTransitionFixed,
+			expectedOK:         true,
+		},
+		{
+			name:               "success after a successful build is a success",
+			build:              completedBuild(domain.EventTypeBuildCompleted, domain.BuildStatusSuccess, 0),
+			history:            []*domain.BuildEvent{passed},
+			expectedTransition: domain.BuildTransitionSuccess,
+			expectedOK:         true,
+		},
+		{
+			name:               "cancelled builds are skipped in the history",
+			build:              completedBuild(domain.EventTypeBuildCompleted, domain.BuildStatusSuccess, 0),
+			history:            []*domain.BuildEvent{cancelled, failed},
+			expectedTransition: domain.BuildTransitionFixed,
+			expectedOK:         true,
+		},
+		{
+			name:       "cancelled builds are not classified",
+			build:      cancelled,
+			history:    []*domain.BuildEvent{failed},
+			expectedOK: false,
+		},
+		{
+			name:       "builds in progress are not classified",
+			build:      completedBuild(domain.EventTypeBuildStarted, domain.BuildStatusInProgress, 0),
+			history:    []*domain.BuildEvent{failed},
+			expectedOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The build itself is part of its history, e.g. when created in the same second as it is classified
+			history := append([]*domain.BuildEvent{tt.build}, tt.history...)
+
+			transition, ok := domain.ClassifyBuildTransition(tt.build, history)
+
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expectedTransition, transition)
+		})
+	}
+}
+
+func TestBuildTransition_IsStatusChange(t *testing.T) {
+	assert.True(t, domain.BuildTransitionNewFailure.IsStatusChange())
+	assert.True(t, domain.BuildTransitionFixed.IsStatusChange())
+	assert.False(t, domain.BuildTransitionStillFailing.IsStatusChange())
+	assert.False(t, domain.BuildTransitionSuccess.IsStatusChange())
+}
+
+func TestFailureStreak(t *testing.T) {
+	newest := completedBuild(domain.EventTypeBuildCompleted, domain.BuildStatusFailed, 0)
+	cancelled := completedBuild(domain.EventTypeBuildCompleted, domain.BuildStatusCancelled, 5)
+	first := completedBuild(domain.EventTypeBuildCompleted, domain.BuildStatusFailed, 10)
+	passed := completedBuild(domain.EventTypeBuildCompleted, domain.BuildStatusSuccess, 20)
+	older := completedBuild(domain.EventTypeBuildCompleted, domain.BuildStatusFailed, 30)
+
+	tests := []struct {
+		name     string
+		history  []*domain.BuildEvent
+		expected []*domain.BuildEvent
+	}{
+		{
+			name:     "failed builds since the last successful build, newest first",
+			history:  []*domain.BuildEvent{newest, cancelled, first, passed, older},
+			expected: []*domain.BuildEvent{newest, first},
+		},
+		{
+			name:     "a passing branch has no streak",
+			history:  []*domain.BuildEvent{passed, older},
+			expected: nil,
+		},
+		{
+			name:     "a branch that never passed fails since its first build",
+			history:  []*domain.BuildEvent{newest, first},
+			expected: []*domain.BuildEvent{newest, first},
+		},
+		{
+			name:     "no builds",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, domain.FailureStreak(tt.history))
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/service"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
+)
+
+// MockBuildEventRepository is a mock implementation of port.BuildEventRepository
+type MockBuildEventRepository struct {
+	mock.Mock
+}
+
+func (m *MockBuildEventRepository) Create(ctx context.Context, buildEvent *domain.BuildEvent) error {
+	return m.Called(ctx, buildEvent).Error(0)
+}
+
+func (m *MockBuildEventRepository) GetByID(ctx context.Context, id value_objects.ID) (*domain.BuildEvent, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.BuildEvent), args.Error(1)
+}
+
+func (m *MockBuildEventRepository) GetByRunID(ctx context.Context, projectID value_objects.ID, runID string) (*domain.BuildEvent, error) {
+	args := m.Called(ctx, projectID, runID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.BuildEvent), args.Error(1)
+}
+
+func (m *MockBuildEventRepository) GetByProjectID(ctx context.Context, projectID value_objects.ID, filters dto.ListBuildEventFilters) ([]*domain.BuildEvent, error) {
+	args := m.Called(ctx, projectID, filters)
+	return args.Get(0).([]*domain.BuildEvent), args.Error(1)
+}
+
+func (m *MockBuildEventRepository) List(ctx context.Context, filters dto.ListBuildEventFilters) ([]*domain.BuildEvent, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]*domain.BuildEvent), args.Error(1)
+}
+
+func (m *MockBuildEventRepository) Update(ctx context.Context, buildEvent *domain.BuildEvent) error {
+	return m.Called(ctx, buildEvent).Error(0)
+}
+
+func (m *MockBuildEventRepository) Delete(ctx context.Context, id value_objects.ID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockBuildEventRepository) GetLatestByProjectID(ctx context.Context, projectID value_objects.ID) (*domain.BuildEvent, error) {
+	args := m.Called(ctx, projectID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.BuildEvent), args.Error(1)
+}
+
+func (m *MockBuildEventRepository) Count(ctx context.Context, filters dto.ListBuildEventFilters) (int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockBuildEventRepository) GetBuildMetrics(ctx context.Context, projectID value_objects.ID) (*domain.BuildMetrics, error) {
+	args := m.Called(ctx, projectID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.BuildMetrics), args.Error(1)
+}
+
+// MockBuildStatusTransitionRepository is a mock implementation of port.BuildStatusTransitionRepository
+type MockBuildStatusTransitionRepository struct {
+	mock.Mock
+}
+
+func (m *MockBuildStatusTransitionRepository) Create(ctx context.Context, transition *domain.BuildStatusTransition) error {
+	return m.Called(ctx, transition).Error(0)
+}
+
+func (m *MockBuildStatusTransitionRepository) GetByBuildEventID(ctx context.Context, buildEventID value_objects.ID) ([]*domain.BuildStatusTransition, error) {
+	args := m.Called(ctx, buildEventID)
+	return args.Get(0).([]*domain.BuildStatusTransition), args.Error(1)
+}
+
+// MockBuildJobRepository is a mock implementation of port.BuildJobRepository
+type MockBuildJobRepository struct {
+	mock.Mock
+}
+
+func (m *MockBuildJobRepository) Create(ctx context.Context, job *domain.BuildJob) error {
+	return m.Called(ctx, job).Error(0)
+}
+
+func (m *MockBuildJobRepository) Update(ctx context.Context, job *domain.BuildJob) error {
+	return m.Called(ctx, job).Error(0)
+}
+
+func (m *MockBuildJobRepository) GetByJobID(ctx context.Context, buildEventID value_objects.ID, jobID string) (*domain.BuildJob, error) {
+	args := m.Called(ctx, buildEventID, jobID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.BuildJob), args.Error(1)
+}
+
+func (m *MockBuildJobRepository) GetByBuildEventID(ctx context.Context, buildEventID value_objects.ID) ([]*domain.BuildJob, error) {
+	args := m.Called(ctx, buildEventID)
+	return args.Get(0).([]*domain.BuildJob), args.Error(1)
+}
+
+func transitionTo(from, to domain.BuildStatus) interface{} {
+	return mock.MatchedBy(func(t *domain.BuildStatusTransition) bool {
+		return t.FromStatus() == from && t.ToStatus() == to
+	})
+}
+
+func TestCreateBuildEventRecordsInitialStatus(t *testing.T) {
+	buildRepo := &MockBuildEventRepository{}
+	transitionRepo := &MockBuildStatusTransitionRepository{}
+	svc := service.NewBuildEventService(service.Dep{BuildEventRepo: buildRepo, TransitionRepo: transitionRepo})
+
+	buildRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.BuildEvent")).Return(nil).Once()
+	transitionRepo.On("Create", mock.Anything, transitionTo("", domain.BuildStatusPending)).Return(nil).Once()
+
+	buildEvent, err := svc.CreateBuildEvent(context.Background(), dto.CreateBuildEventRequest{
+		ProjectID: value_objects.NewID(),
+		RunID:     "42",
+		EventType: domain.EventTypeBuildStarted,
+		Status:    domain.BuildStatusPending,
+		Branch:    "main",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "42", buildEvent.RunID())
+	buildRepo.AssertExpectations(t)
+	transitionRepo.AssertExpectations(t)
+}
+
+func TestUpdateBuildEventStatusCompletesRun(t *testing.T) {
+	buildRepo := &MockBuildEventRepository{}
+	transitionRepo := &MockBuildStatusTransitionRepository{}
+	svc := service.NewBuildEventService(service.Dep{BuildEventRepo: buildRepo, TransitionRepo: transitionRepo})
+
+	buildEvent, err := domain.NewBuildEvent(domain.BuildEventParams{
+		ProjectID: value_objects.NewID(),
+		RunID:     "42",
+		EventType: domain.EventTypeBuildStarted,
+		Status:    domain.BuildStatusInProgress,
+		Branch:    "main",
+	})
+	require.NoError(t, err)
+
+	buildRepo.On("GetByID", mock.Anything, buildEvent.ID()).Return(buildEvent, nil)
+	buildRepo.On("Update", mock.Anything, buildEvent).Return(nil)
+	transitionRepo.On("Create", mock.Anything, transitionTo(domain.BuildStatusInProgress, domain.BuildStatusFailed)).Return(nil).Once()
+
+	duration := 300
+	require.NoError(t, svc.UpdateBuildEventStatus(context.Background(), buildEvent.ID(), domain.BuildStatusFailed, &duration))
+
+	assert.Equal(t, domain.BuildStatusFailed, buildEvent.Status())
+	assert.Equal(t, domain.EventTypeBuildCompleted, buildEvent.EventType())
+	require.NotNil(t, buildEvent.DurationSeconds())
+	assert.Equal(t, duration, *buildEvent.DurationSeconds())
+
+	// Repeating the current status does not add to the history
+	require.NoError(t, svc.UpdateBuildEventStatus(context.Background(), buildEvent.ID(), domain.BuildStatusFailed, nil))
+	transitionRepo.AssertExpectations(t)
+}
+
+func TestGetBuildEventByRunIDRequiresRunID(t *testing.T) {
+	svc := service.NewBuildEventService(service.Dep{BuildEventRepo: &MockBuildEventRepository{}})
+
+	_, err := svc.GetBuildEventByRunID(context.Background(), value_objects.NewID(), "")
+	assert.ErrorIs(t, err, exception.ErrBuildEventNotFound)
+}
+
+func TestRecordBuildJobCreatesThenUpdatesJob(t *testing.T) {
+	jobRepo := &MockBuildJobRepository{}
+	svc := service.NewBuildEventService(service.Dep{BuildEventRepo: &MockBuildEventRepository{}, JobRepo: jobRepo})
+	buildEventID := value_objects.NewID()
+
+	jobRepo.On("GetByJobID", mock.Anything, buildEventID, "900").Return(nil, exception.ErrBuildJobNotFound).Once()
+	jobRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.BuildJob")).Return(nil).Once()
+
+	job, err := svc.RecordBuildJob(context.Background(), dto.RecordBuildJobRequest{
+		BuildEventID: buildEventID,
+		JobID:        "900",
+		Name:         "unit-tests",
+		Status:       domain.BuildStatusInProgress,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "unit-tests", job.Name())
+
+	jobRepo.On("GetByJobID", mock.Anything, buildEventID, "900").Return(job, nil).Once()
+	jobRepo.On("Update", mock.Anything, job).Return(nil).Once()
+
+	updated, err := svc.RecordBuildJob(context.Background(), dto.RecordBuildJobRequest{
+		BuildEventID: buildEventID,
+		JobID:        "900",
+		Name:         "unit-tests",
+		Status:       domain.BuildStatusFailed,
+		FailedSteps:  []string{"Run tests"},
+	})
+	require.NoError(t, err)
+	assert.Same(t, job, updated)
+	assert.True(t, updated.IsFailed())
+	assert.Equal(t, []string{"Run tests"}, updated.FailedSteps())
+	jobRepo.AssertExpectations(t)
+}
+
+func TestGetBuildTransitionComparesEarlierBuildsOfBranch(t *testing.T) {
+	buildRepo := &MockBuildEventRepository{}
+	svc := service.NewBuildEventService(service.Dep{BuildEventRepo: buildRepo})
+	projectID := value_objects.NewID()
+
+	newBuild := func(status domain.BuildStatus) *domain.BuildEvent {
+		buildEvent, err := domain.NewBuildEvent(domain.BuildEventParams{
+			ProjectID: projectID,
+			EventType: domain.EventTypeBuildCompleted,
+			Status:    status,
+			Branch:    "main",
+		})
+		require.NoError(t, err)
+		return buildEvent
+	}
+	previous := newBuild(domain.BuildStatusFailed)
+	fixed := newBuild(domain.BuildStatusSuccess)
+
+	buildRepo.On("GetByID", mock.Anything, fixed.ID()).Return(fixed, nil)
+	buildRepo.On("GetByProjectID", mock.Anything, projectID, mock.MatchedBy(func(filters dto.ListBuildEventFilters) bool {
+		return *filters.EventType == domain.EventTypeBuildCompleted && *filters.Branch == "main" &&
+			filters.DateTo.Equal(fixed.CreatedAt().ToTime()) && filters.Limit > 0
+	})).Return([]*domain.BuildEvent{fixed, previous}, nil).Once()
+
+	transition, ok, err := svc.GetBuildTransition(context.Background(), fixed.ID())
+
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, domain.BuildTransitionFixed, transition)
+	buildRepo.AssertExpectations(t)
+}
@@ -102,6 +102,16 @@ func (m *MockDeliveryChannel) GetRateLimitInfo() (int, time.Duration) {
 	return args.Int(0), args.Get(1).(time.Duration)
 }
 
+// MockRateLimitedDeliveryChannel is a delivery channel that reports throttled recipients
+type MockRateLimitedDeliveryChannel struct {
+	MockDeliveryChannel
+}
+
+func (m *MockRateLimitedDeliveryChannel) RetryAfter(recipient string) time.Duration {
+	args := m.Called(recipient)
+	return args.Get(0).(time.Duration)
+}
+
 // DeliveryServiceTestSuite defines the test suite
 type DeliveryServiceTestSuite struct {
 	suite.Suite
@@ -260,6 +270,38 @@ func (suite *DeliveryServiceTestSuite) TestRegisterDeliveryChannelValidation() {
 	assert.Contains(suite.T(), err.Error(), "channel type cannot be empty")
 }
 
+func (suite *DeliveryServiceTestSuite) TestProcessQueueReschedulesThrottledRecipient() {
+	// Arrange
+	recipient := "https://discord.com/api/webhooks/1/token"
+	mockChannel := new(MockRateLimitedDeliveryChannel)
+	mockChannel.On("GetChannelType").Return(domain.NotificationChannelDiscord)
+	mockChannel.On("IsAvailable", mock.Anything).Return(true)
+	mockChannel.On("RetryAfter", recipient).Return(30 * time.Second)
+	assert.NoError(suite.T(), suite.service.RegisterDeliveryChannel(mockChannel))
+
+	notification := domain.NewQueuedNotification(
+		value_objects.NewID(),
+		domain.NotificationChannelDiscord,
+		recipient,
+		TestMessage,
+		TestSubject,
+		1,
+		3,
+	)
+	assert.NoError(suite.T(), suite.service.QueueNotification(suite.ctx, notification))
+
+	// Act
+	err := suite.service.ProcessQueue(suite.ctx, 10)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	saved, err := suite.queueRepo.GetByID(suite.ctx, notification.ID)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), domain.DeliveryStatusRetrying, saved.Status)
+	assert.True(suite.T(), saved.ScheduledAt.After(time.Now().Add(20*time.Second)))
+	mockChannel.AssertNotCalled(suite.T(), "Send", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestDeliveryServiceTestSuite(t *testing.T) {
 	suite.Run(t, new(DeliveryServiceTestSuite))
 }
@@ -320,6 +362,8 @@ func TestDefaultRateLimitRules(t *testing.T) {
 	assert.Contains(t, rules, domain.NotificationChannelEmail)
 	assert.Contains(t, rules, domain.NotificationChannelSlack)
 	assert.Contains(t, rules, domain.NotificationChannelWebhook)
+	assert.Contains(t, rules, domain.NotificationChannelTeams)
+	assert.Contains(t, rules, domain.NotificationChannelDiscord)
 
 	telegramRule := rules[domain.NotificationChannelTelegram]
 	assert.Equal(t, 30, telegramRule.MaxRequests)
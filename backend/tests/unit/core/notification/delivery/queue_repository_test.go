@@ -137,6 +137,89 @@ func (suite *QueueRepositoryTestSuite) TestGetPendingByPriority() {
 	assert.Equal(suite.T(), lowPriority.ID, pending[1].ID)
 }
 
+func (suite *QueueRepositoryTestSuite) TestClaimPending() {
+	// Arrange
+	newNotification := func(priority int) *domain.QueuedNotification {
+		notification := domain.NewQueuedNotification(
+			value_objects.NewID(),
+			domain.NotificationChannelTelegram,
+			"123456789",
+			"Test message",
+			"Test subject",
+			priority,
+			3,
+		)
+		suite.repo.Create(suite.ctx, notification)
+		return notification
+	}
+
+	low := newNotification(1)
+	high := newNotification(5)
+	scheduled := newNotification(10)
+	scheduled.ScheduledAt = time.Now().Add(time.Hour)
+	retrying := newNotification(3)
+	retrying.ScheduleRetry(-time.Second)
+
+	// Act
+	claimed, err := suite.repo.ClaimPending(suite.ctx, domain.QueueKindDelivery, 2, time.Minute)
+
+	// Assert: highest priority due notifications first, future ones untouched
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), claimed, 2)
+	assert.Equal(suite.T(), high.ID, claimed[0].ID)
+	assert.Equal(suite.T(), retrying.ID, claimed[1].ID)
+	for _, notification := range claimed {
+		assert.Equal(suite.T(), domain.DeliveryStatusProcessing, notification.Status)
+		assert.NotNil(suite.T(), notification.LockedUntil)
+	}
+
+	// Claimed notifications are hidden from the next claim
+	claimed, err = suite.repo.ClaimPending(suite.ctx, domain.QueueKindDelivery, 10, time.Minute)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), claimed, 1)
+	assert.Equal(suite.T(), low.ID, claimed[0].ID)
+
+	// A notification whose visibility timeout expired is claimed again
+	expired := time.Now().Add(-time.Second)
+	high.LockedUntil = &expired
+	claimed, err = suite.repo.ClaimPending(suite.ctx, domain.QueueKindDelivery, 10, time.Minute)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), claimed, 1)
+	assert.Equal(suite.T(), high.ID, claimed[0].ID)
+
+	// A processing notification without a lock is claimed again as well
+	high.LockedUntil = nil
+	claimed, err = suite.repo.ClaimPending(suite.ctx, domain.QueueKindDelivery, 10, time.Minute)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), claimed, 1)
+	assert.Equal(suite.T(), high.ID, claimed[0].ID)
+
+	// Finishing a delivery releases the claim
+	assert.NoError(suite.T(), suite.repo.UpdateStatus(suite.ctx, high.ID, domain.DeliveryStatusDelivered, ""))
+	delivered, _ := suite.repo.GetByID(suite.ctx, high.ID)
+	assert.Nil(suite.T(), delivered.LockedUntil)
+}
+
+func (suite *QueueRepositoryTestSuite) TestClaimPendingOnlyClaimsTheKind() {
+	// Arrange
+	delivery := domain.NewQueuedNotification(value_objects.NewID(), domain.NotificationChannelTelegram,
+		"123456789", "Test message", "Test subject", 0, 3)
+	deferred := domain.NewQueuedNotification(value_objects.NewID(), domain.NotificationChannelTelegram,
+		"123456789", "Test message", "Test subject", 0, 3)
+	deferred.Kind = domain.QueueKindDeferred
+	suite.repo.Create(suite.ctx, delivery)
+	suite.repo.Create(suite.ctx, deferred)
+
+	// Act
+	claimed, err := suite.repo.ClaimPending(suite.ctx, domain.QueueKindDeferred, 10, time.Minute)
+
+	// Assert
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), claimed, 1)
+	assert.Equal(suite.T(), deferred.ID, claimed[0].ID)
+	assert.Equal(suite.T(), domain.DeliveryStatusPending, delivery.Status)
+}
+
 func (suite *QueueRepositoryTestSuite) TestUpdateStatus() {
 	// Arrange
 	notification := domain.NewQueuedNotification(
@@ -217,7 +300,7 @@ func (suite *QueueRepositoryTestSuite) TestGetFailedNotifications() {
 	suite.repo.Create(suite.ctx, pendingNotification)
 
 	// Act
-	failed, err := suite.repo.GetFailedNotifications(suite.ctx, 10)
+	failed, err := suite.repo.GetFailedNotifications(suite.ctx, domain.QueueKindDelivery, 10)
 
 	// Assert
 	assert.NoError(suite.T(), err)
@@ -0,0 +1,54 @@
+package domain_test
+
+import (
+	"testing"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelMessage_EncodeAndDecode(t *testing.T) {
+	params := domain.TemplateParams{ProjectName: "api", BuildBranch: "main", BuildURL: "https://ci.example.com/runs/42"}
+	encoded, err := domain.NewChannelMessage("✅ Build completed", buildDomain.BuildStatusSuccess, params).Encode()
+	require.NoError(t, err)
+
+	decoded := domain.DecodeChannelMessage(encoded)
+	assert.Equal(t, "✅ Build completed", decoded.Text)
+	assert.Equal(t, buildDomain.BuildStatusSuccess, decoded.Status)
+	assert.Equal(t, params, decoded.Params)
+
+	// Plain-text notifications have no status
+	plain := domain.DecodeChannelMessage("Deploy finished: {maybe}")
+	assert.Equal(t, "Deploy finished: {maybe}", plain.Text)
+	assert.Empty(t, plain.Status)
+	assert.Empty(t, plain.Params)
+}
+
+func TestDiscordMessage_ColorFollowsBuildStatus(t *testing.T) {
+	// The text of a custom template mentions failures, the build itself succeeded
+	message := domain.NewChannelMessage("🚨 No more ❌ on main", buildDomain.BuildStatusSuccess, domain.TemplateParams{
+		ProjectName: "api",
+		BuildBranch: "main",
+		BuildURL:    "/runs/42",
+	})
+
+	discordMessage, err := domain.NewDiscordMessage("Build Success: api", message)
+	require.NoError(t, err)
+	require.Len(t, discordMessage.Embeds, 1)
+
+	embed := discordMessage.Embeds[0]
+	assert.Equal(t, domain.DiscordColorSuccess, embed.Color)
+	assert.Equal(t, "🚨 No more ❌ on main", embed.Description)
+	assert.Equal(t, []domain.DiscordEmbedField{
+		{Name: "Project", Value: "api", Inline: true},
+		{Name: "Branch", Value: "main", Inline: true},
+	}, embed.Fields)
+	// Relative build URLs cannot be opened from a button
+	assert.Empty(t, embed.URL)
+	assert.Empty(t, discordMessage.Components)
+
+	_, err = domain.NewDiscordMessage("", domain.DecodeChannelMessage(""))
+	assert.ErrorIs(t, err, domain.ErrInvalidMessage)
+}
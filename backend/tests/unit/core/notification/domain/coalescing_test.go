@@ -0,0 +1,45 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoalesceNotifications_MergesBuildsOfACommit(t *testing.T) {
+	projectID := value_objects.NewID()
+	pushID, runID := value_objects.NewID(), value_objects.NewID()
+
+	var logs []*domain.NotificationLog
+	for _, build := range []struct {
+		buildEventID value_objects.ID
+		message      string
+	}{
+		{buildEventID: pushID, message: "Pushed abc1234"},
+		{buildEventID: runID, message: "Workflow requested"},
+		{buildEventID: runID, message: "Workflow passed"},
+	} {
+		log, err := domain.NewNotificationLog(build.buildEventID, projectID, domain.NotificationChannelTelegram, "123456789", build.message, 3)
+		require.NoError(t, err)
+		logs = append(logs, log)
+	}
+
+	latest, superseded, message := domain.CoalesceNotifications(logs)
+
+	require.NotNil(t, latest)
+	assert.Equal(t, logs[2].ID(), latest.ID())
+	assert.Equal(t, "Pushed abc1234\n\nWorkflow passed", message)
+	assert.Equal(t, "Workflow passed", latest.Message())
+	assert.ElementsMatch(t, []*domain.NotificationLog{logs[0], logs[1]}, superseded)
+}
+
+func TestCoalesceNotifications_WithoutNotifications(t *testing.T) {
+	latest, superseded, message := domain.CoalesceNotifications(nil)
+
+	assert.Nil(t, latest)
+	assert.Empty(t, superseded)
+	assert.Empty(t, message)
+}
@@ -0,0 +1,102 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFailingTelegramLog(t *testing.T) *domain.NotificationLog {
+	log, err := domain.NewNotificationLog(value_objects.NewID(), value_objects.NewID(), domain.NotificationChannelTelegram, "123456789", "Build failed", 3)
+	require.NoError(t, err)
+	return log
+}
+
+func TestNotificationLog_RecordsErrorHistory(t *testing.T) {
+	log := newFailingTelegramLog(t)
+
+	require.NoError(t, log.MarkAsFailed("timeout"))
+	require.NoError(t, log.MarkAsRetrying())
+	require.NoError(t, log.MarkAsFailed("network error"))
+
+	history := log.ErrorHistory()
+	require.Len(t, history, 2)
+	assert.Equal(t, 1, history[0].Attempt)
+	assert.Equal(t, "timeout", history[0].Error)
+	assert.Equal(t, 2, history[1].Attempt)
+	assert.Equal(t, "network error", history[1].Error)
+	assert.Equal(t, 2, log.AttemptCount())
+}
+
+func TestNotificationLog_DeadLetterAndRequeue(t *testing.T) {
+	log := newFailingTelegramLog(t)
+
+	// Only failed notifications can be dead-lettered
+	assert.Error(t, log.MarkAsDeadLettered())
+
+	require.NoError(t, log.MarkAsFailed("Forbidden: bot was blocked by the user"))
+	require.NoError(t, log.MarkAsRetrying())
+	require.NoError(t, log.MarkAsFailed("Forbidden: bot was blocked by the user"))
+	require.NoError(t, log.MarkAsDeadLettered())
+	assert.Equal(t, domain.NotificationStatusDeadLettered, log.Status())
+
+	require.NoError(t, log.Requeue())
+	assert.Equal(t, domain.NotificationStatusPending, log.Status())
+	assert.Equal(t, 0, log.RetryCount())
+	assert.Empty(t, log.ErrorMessage())
+	assert.Len(t, log.ErrorHistory(), 2, "requeueing keeps the error history")
+
+	// A pending notification is not in the dead-letter queue
+	assert.Error(t, log.Requeue())
+}
+
+func TestNewDeadLetterNotification(t *testing.T) {
+	log := newFailingTelegramLog(t)
+	require.NoError(t, log.MarkAsFailed("chat not found"))
+
+	deadLetter, err := domain.NewDeadLetterNotification(log, domain.DeadLetterReasonPermanentError)
+	require.NoError(t, err)
+
+	assert.Equal(t, log.ID(), deadLetter.NotificationLogID())
+	assert.Equal(t, log.BuildEventID(), deadLetter.BuildEventID())
+	assert.Equal(t, "123456789", deadLetter.Recipient())
+	assert.Equal(t, "chat not found", deadLetter.LastError())
+	assert.Equal(t, 1, deadLetter.AttemptCount())
+	assert.Len(t, deadLetter.ErrorHistory(), 1)
+	assert.Equal(t, domain.DeadLetterStatusPending, deadLetter.Status())
+	assert.Nil(t, deadLetter.ResolvedAt())
+
+	_, err = domain.NewDeadLetterNotification(log, domain.DeadLetterReason("unknown"))
+	assert.Error(t, err)
+}
+
+func TestDeadLetterNotification_ResolvesOnce(t *testing.T) {
+	log := newFailingTelegramLog(t)
+	require.NoError(t, log.MarkAsFailed("timeout"))
+
+	deadLetter, err := domain.NewDeadLetterNotification(log, domain.DeadLetterReasonRetriesExhausted)
+	require.NoError(t, err)
+
+	require.NoError(t, deadLetter.Discard())
+	assert.Equal(t, domain.DeadLetterStatusDiscarded, deadLetter.Status())
+	assert.NotNil(t, deadLetter.ResolvedAt())
+	assert.True(t, deadLetter.IsResolved())
+
+	assert.Equal(t, domain.ErrDeadLetterAlreadyResolved, deadLetter.Requeue())
+}
+
+func TestRetryConfiguration_DeadLetterDecisions(t *testing.T) {
+	config := domain.GetDefaultRetryConfiguration()
+
+	assert.True(t, config.IsPermanentError(errors.New("Forbidden: bot was blocked by the user")))
+	assert.True(t, config.IsPermanentError(errors.New("Bad Request: chat not found")))
+	assert.False(t, config.IsPermanentError(errors.New("timeout")))
+	assert.False(t, config.IsPermanentError(nil))
+
+	assert.False(t, config.IsExhausted(config.MaxRetryAttempts()))
+	assert.True(t, config.IsExhausted(config.MaxRetryAttempts()+1))
+}
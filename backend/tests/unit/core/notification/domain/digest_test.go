@@ -0,0 +1,99 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDigestMode(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected domain.DigestMode
+	}{
+		{value: "", expected: domain.DigestModeInstant},
+		{value: "instant", expected: domain.DigestModeInstant},
+		{value: " Hourly ", expected: domain.DigestModeHourly},
+		{value: "daily", expected: domain.DigestModeDaily},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			mode, err := domain.ParseDigestMode(tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, mode)
+		})
+	}
+
+	_, err := domain.ParseDigestMode("weekly")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), domain.ErrCodeInvalidDigestMode)
+}
+
+func TestDigestMode_WindowEnd(t *testing.T) {
+	at := time.Date(2024, 3, 9, 14, 25, 0, 0, time.FixedZone("UTC+7", 7*60*60))
+
+	assert.Equal(t, time.Date(2024, 3, 9, 8, 0, 0, 0, time.UTC), domain.DigestModeHourly.WindowEnd(at))
+	assert.Equal(t, time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC), domain.DigestModeDaily.WindowEnd(at))
+	assert.False(t, domain.DigestModeInstant.IsDigest())
+}
+
+func TestNewDigest_GroupsByProjectAndBranch(t *testing.T) {
+	windowEnd := time.Date(2024, 3, 9, 15, 0, 0, 0, time.UTC)
+	digest := domain.NewDigest(windowEnd, []domain.DigestEntry{
+		{ProjectName: "web", Branch: "main", EventType: buildDomain.EventTypeBuildCompleted, Status: buildDomain.BuildStatusSuccess},
+		{ProjectName: "api", Branch: "main", EventType: buildDomain.EventTypeBuildCompleted, Status: buildDomain.BuildStatusFailed,
+			CommitSHA: "abc1234def", BuildURL: "https://ci.example.com/runs/1"},
+		{ProjectName: "api", Branch: "main", EventType: buildDomain.EventTypeBuildCompleted, Status: buildDomain.BuildStatusSuccess},
+		{ProjectName: "api", Branch: "develop", EventType: buildDomain.EventTypePush, Status: buildDomain.BuildStatusSuccess},
+	})
+
+	assert.Equal(t, 2, digest.Passed)
+	assert.Equal(t, 1, digest.Failed)
+	require.Len(t, digest.Projects, 2)
+	assert.Equal(t, "api", digest.Projects[0].Name)
+	require.Len(t, digest.Projects[0].Branches, 2)
+	assert.Equal(t, "develop", digest.Projects[0].Branches[0].Branch)
+	assert.Equal(t, 1, digest.Projects[0].Branches[0].Other)
+
+	assert.Equal(t, "📬 <b>Build digest</b> until 2024-03-09 15:00 UTC\n"+
+		"✅ 2 passed · ❌ 1 failed\n\n"+
+		"<b>api</b>\n"+
+		"<code>develop</code>: ✅ 0 · ❌ 0 · 1 other\n"+
+		"<code>main</code>: ✅ 1 · ❌ 1\n"+
+		"  • <a href=\"https://ci.example.com/runs/1\">Failed build abc1234</a>\n\n"+
+		"<b>web</b>\n"+
+		"<code>main</code>: ✅ 1 · ❌ 0", digest.RenderTelegram())
+}
+
+func TestDigest_RenderTelegramEscapesNames(t *testing.T) {
+	windowEnd := time.Date(2024, 3, 9, 15, 0, 0, 0, time.UTC)
+	digest := domain.NewDigest(windowEnd, []domain.DigestEntry{
+		{ProjectName: "R&D <tools>", Branch: "fix/a<b", EventType: buildDomain.EventTypeBuildCompleted, Status: buildDomain.BuildStatusFailed,
+			BuildURL: "https://ci.example.com/runs?id=1&attempt=2"},
+	})
+
+	assert.Equal(t, "📬 <b>Build digest</b> until 2024-03-09 15:00 UTC\n"+
+		"✅ 0 passed · ❌ 1 failed\n\n"+
+		"<b>R&amp;D &lt;tools&gt;</b>\n"+
+		"<code>fix/a&lt;b</code>: ✅ 0 · ❌ 1\n"+
+		"  • <a href=\"https://ci.example.com/runs?id=1&amp;attempt=2\">Failed build</a>", digest.RenderTelegram())
+}
+
+func TestNotificationLog_HoldForDigest(t *testing.T) {
+	log, err := domain.NewNotificationLog(value_objects.NewID(), value_objects.NewID(), domain.NotificationChannelTelegram, "123456789", "Build passed", 3)
+	require.NoError(t, err)
+	windowEnd := time.Date(2024, 3, 9, 15, 0, 0, 0, time.UTC)
+
+	require.NoError(t, log.HoldForDigest(windowEnd))
+	assert.True(t, log.IsBatched())
+	require.NotNil(t, log.DigestAt())
+	assert.Equal(t, windowEnd, *log.DigestAt())
+
+	assert.Error(t, log.HoldForDigest(windowEnd))
+}
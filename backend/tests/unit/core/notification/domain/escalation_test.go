@@ -0,0 +1,180 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEscalationPolicy(t *testing.T, steps ...time.Duration) *domain.EscalationPolicy {
+	t.Helper()
+	chatID := int64(-100200)
+	policy, err := domain.NewEscalationPolicy(value_objects.NewID(), "", steps, &chatID, nil)
+	require.NoError(t, err)
+	return policy
+}
+
+func TestNewEscalationPolicy(t *testing.T) {
+	chatID := int64(-100200)
+	zeroChatID := int64(0)
+
+	tests := []struct {
+		name          string
+		projectID     value_objects.ID
+		steps         []time.Duration
+		chatID        *int64
+		emails        []string
+		expectedError bool
+	}{
+		{
+			name:      "escalation chat",
+			projectID: value_objects.NewID(),
+			steps:     []time.Duration{time.Hour, 15 * time.Minute},
+			chatID:    &chatID,
+		},
+		{
+			name:      "email recipients",
+			projectID: value_objects.NewID(),
+			steps:     []time.Duration{time.Hour},
+			emails:    []string{"Oncall <oncall@example.com>", "lead@example.com"},
+		},
+		{
+			name:          "nil project",
+			steps:         []time.Duration{time.Hour},
+			chatID:        &chatID,
+			expectedError: true,
+		},
+		{
+			name:          "no steps",
+			projectID:     value_objects.NewID(),
+			chatID:        &chatID,
+			expectedError: true,
+		},
+		{
+			name:          "step shorter than a minute",
+			projectID:     value_objects.NewID(),
+			steps:         []time.Duration{30 * time.Second},
+			chatID:        &chatID,
+			expectedError: true,
+		},
+		{
+			name:          "duplicate steps",
+			projectID:     value_objects.NewID(),
+			steps:         []time.Duration{time.Hour, time.Hour},
+			chatID:        &chatID,
+			expectedError: true,
+		},
+		{
+			name:          "too many steps",
+			projectID:     value_objects.NewID(),
+			steps:         []time.Duration{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+			chatID:        &chatID,
+			expectedError: true,
+		},
+		{
+			name:          "no target",
+			projectID:     value_objects.NewID(),
+			steps:         []time.Duration{time.Hour},
+			expectedError: true,
+		},
+		{
+			name:          "zero chat ID",
+			projectID:     value_objects.NewID(),
+			steps:         []time.Duration{time.Hour},
+			chatID:        &zeroChatID,
+			expectedError: true,
+		},
+		{
+			name:          "invalid email recipient",
+			projectID:     value_objects.NewID(),
+			steps:         []time.Duration{time.Hour},
+			emails:        []string{"not an email"},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := domain.NewEscalationPolicy(tt.projectID, "", tt.steps, tt.chatID, tt.emails)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, policy)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, domain.DefaultEscalationBranch, policy.Branch())
+			assert.True(t, policy.IsActive())
+		})
+	}
+}
+
+func TestEscalationPolicy_NormalizesStepsAndRecipients(t *testing.T) {
+	policy, err := domain.NewEscalationPolicy(value_objects.NewID(), " release ",
+		[]time.Duration{4 * time.Hour, 30 * time.Minute},
+		nil,
+		[]string{"Oncall <oncall@example.com>", "ONCALL@example.com", "lead@example.com"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "release", policy.Branch())
+	assert.Equal(t, []time.Duration{30 * time.Minute, 4 * time.Hour}, policy.Steps())
+	assert.Equal(t, []string{"oncall@example.com", "lead@example.com"}, policy.EmailRecipients())
+	assert.False(t, policy.IsEscalationChat(-100200))
+}
+
+func TestEscalationPolicy_DueStep(t *testing.T) {
+	policy := newTestEscalationPolicy(t, 30*time.Minute, 2*time.Hour)
+	failingSince := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 0, policy.DueStep(failingSince, failingSince.Add(29*time.Minute)))
+	assert.Equal(t, 1, policy.DueStep(failingSince, failingSince.Add(30*time.Minute)))
+	assert.Equal(t, 1, policy.DueStep(failingSince, failingSince.Add(time.Hour)))
+	assert.Equal(t, 2, policy.DueStep(failingSince, failingSince.Add(3*time.Hour)))
+}
+
+func TestEscalation_NextStep(t *testing.T) {
+	policy := newTestEscalationPolicy(t, 30*time.Minute, 2*time.Hour)
+	escalation := domain.NewEscalation(policy, value_objects.NewID(), time.Now().Add(-time.Hour))
+
+	_, due := escalation.NextStep(0)
+	assert.False(t, due, "no step is due yet")
+
+	// Missed steps are skipped, only the latest due step is sent
+	step, due := escalation.NextStep(2)
+	require.True(t, due)
+	assert.Equal(t, 2, step)
+
+	escalation.Escalate(step)
+	_, due = escalation.NextStep(2)
+	assert.False(t, due, "a step is sent once")
+}
+
+func TestEscalation_AcknowledgeStopsEscalating(t *testing.T) {
+	policy := newTestEscalationPolicy(t, 30*time.Minute, 2*time.Hour)
+	escalation := domain.NewEscalation(policy, value_objects.NewID(), time.Now().Add(-time.Hour))
+
+	escalation.Acknowledge("@alice")
+	escalation.Acknowledge("@bob")
+
+	assert.True(t, escalation.IsAcknowledged())
+	assert.Equal(t, "@alice", escalation.AcknowledgedBy())
+	_, due := escalation.NextStep(1)
+	assert.False(t, due)
+}
+
+func TestEscalation_ResolveStopsEscalating(t *testing.T) {
+	policy := newTestEscalationPolicy(t, 30*time.Minute)
+	escalation := domain.NewEscalation(policy, value_objects.NewID(), time.Now().Add(-time.Hour))
+
+	escalation.Resolve()
+
+	assert.True(t, escalation.IsResolved())
+	assert.Equal(t, policy.ID(), escalation.PolicyID())
+	assert.Equal(t, policy.Branch(), escalation.Branch())
+	_, due := escalation.NextStep(1)
+	assert.False(t, due)
+}
@@ -454,6 +454,21 @@ func TestRestoreNotificationMetrics(t *testing.T) {
 }
 
 // Helper function to create a test notification log
+func TestNotificationLogModel_KeepsSlackMessageTimestamp(t *testing.T) {
+	log, err := domain.NewNotificationLog(value_objects.NewID(), value_objects.NewID(),
+		domain.NotificationChannelSlack, "#builds", "Test notification", 3)
+	assert.NoError(t, err)
+	messageID := "1700000000.123456"
+	assert.NoError(t, log.MarkAsSent(&messageID))
+
+	model := &domain.NotificationLogModel{}
+	model.FromEntity(log)
+	restored := model.ToEntity()
+
+	assert.NotNil(t, restored.MessageID())
+	assert.Equal(t, messageID, *restored.MessageID())
+}
+
 func createTestNotificationLog(t *testing.T) *domain.NotificationLog {
 	buildEventID := value_objects.NewID()
 	projectID := value_objects.NewID()
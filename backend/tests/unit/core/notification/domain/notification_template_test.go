@@ -3,6 +3,7 @@ package domain_test
 import (
 	"testing"
 
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
 	"github.com/stretchr/testify/assert"
@@ -294,6 +295,37 @@ func TestNotificationTemplate_RenderInactiveTemplate(t *testing.T) {
 	assert.Contains(t, err.Error(), "template is inactive and cannot be rendered")
 }
 
+func TestNotificationTemplate_RenderTelegramTemplateEscapesParams(t *testing.T) {
+	defaults := domain.GetDefaultTemplates()[domain.TemplateTypePush][domain.NotificationChannelTelegram]
+	template, err := domain.NewNotificationTemplate(domain.TemplateTypePush, domain.NotificationChannelTelegram, defaults.Subject, defaults.Body)
+	assert.NoError(t, err)
+
+	_, body, err := template.RenderTemplate(domain.TemplateParams{
+		ProjectName:   "org/repo",
+		BuildBranch:   "main",
+		BuildAuthor:   "Tom & Jerry",
+		CommitMessage: "Render <b> tags & fix List<T> parsing",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "📤 <b>Push Event</b>\n"+
+		"<b>Project:</b> org/repo\n"+
+		"<b>Branch:</b> main\n"+
+		"<b>Commit:</b> Render &lt;b&gt; tags &amp; fix List&lt;T&gt; parsing\n"+
+		"<b>Author:</b> Tom &amp; Jerry", body)
+}
+
+func TestNotificationTemplate_RenderEmailTemplateKeepsParams(t *testing.T) {
+	template, err := domain.NewNotificationTemplate(domain.TemplateTypePush, domain.NotificationChannelEmail,
+		"Push to {{.BuildBranch}}", "{{.CommitMessage}}")
+	assert.NoError(t, err)
+
+	_, body, err := template.RenderTemplate(domain.TemplateParams{BuildBranch: "main", CommitMessage: "Fix List<T> & Map<K, V>"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Fix List<T> & Map<K, V>", body)
+}
+
 func TestNotificationTemplateType_IsValid(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -304,6 +336,9 @@ func TestNotificationTemplateType_IsValid(t *testing.T) {
 		{"valid_build_failure", domain.TemplateTypeBuildFailure, true},
 		{"valid_build_started", domain.TemplateTypeBuildStarted, true},
 		{"valid_deployment", domain.TemplateTypeDeployment, true},
+		{"valid_push", domain.TemplateTypePush, true},
+		{"valid_pull_request", domain.TemplateTypePullRequest, true},
+		{"valid_release", domain.TemplateTypeRelease, true},
 		{"invalid_type", domain.NotificationTemplateType("invalid"), false},
 	}
 
@@ -341,6 +376,21 @@ func TestGetDefaultTemplates(t *testing.T) {
 			assert.NotEmpty(t, emailTemplate.Subject)
 			assert.NotEmpty(t, emailTemplate.Body)
 		}
+
+		// Test that teams templates with a card title exist for all types
+		assert.Contains(t, defaultTemplates[templateType], domain.NotificationChannelTeams)
+		teamsTemplate := defaultTemplates[templateType][domain.NotificationChannelTeams]
+		assert.NotEmpty(t, teamsTemplate.Subject)
+		assert.NotEmpty(t, teamsTemplate.Body)
+		// Facts and the build button come from the template parameters
+		assert.NotContains(t, teamsTemplate.Body, "{{.BuildURL}}")
+
+		// Test that discord templates with an embed title exist for all types
+		assert.Contains(t, defaultTemplates[templateType], domain.NotificationChannelDiscord)
+		discordTemplate := defaultTemplates[templateType][domain.NotificationChannelDiscord]
+		assert.NotEmpty(t, discordTemplate.Subject)
+		// Fields and the build link come from the template parameters
+		assert.NotContains(t, discordTemplate.Body, "{{.BuildURL}}")
 	}
 }
 
@@ -388,3 +438,39 @@ func TestRestoreNotificationTemplate_InvalidTemplate(t *testing.T) {
 	assert.Nil(t, template)
 	assert.Contains(t, err.Error(), "template compilation failed")
 }
+
+func TestTemplateTypeForBuildTransition(t *testing.T) {
+	tests := []struct {
+		transition   buildDomain.BuildTransition
+		templateType domain.NotificationTemplateType
+	}{
+		{buildDomain.BuildTransitionNewFailure, domain.TemplateTypeBuildNewFailure},
+		{buildDomain.BuildTransitionStillFailing, domain.TemplateTypeBuildStillFailing},
+		{buildDomain.BuildTransitionFixed, domain.TemplateTypeBuildFixed},
+		{buildDomain.BuildTransitionSuccess, domain.TemplateTypeBuildSuccess},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.transition.String(), func(t *testing.T) {
+			templateType, ok := domain.TemplateTypeForBuildTransition(tt.transition)
+			assert.True(t, ok)
+			assert.Equal(t, tt.templateType, templateType)
+			assert.True(t, templateType.IsValid())
+
+			// Every channel has a default template for the transition
+			defaults := domain.GetDefaultTemplates()[templateType]
+			for _, channel := range []domain.NotificationChannel{
+				domain.NotificationChannelTelegram,
+				domain.NotificationChannelEmail,
+				domain.NotificationChannelSlack,
+				domain.NotificationChannelTeams,
+				domain.NotificationChannelDiscord,
+			} {
+				assert.Contains(t, defaults, channel)
+			}
+		})
+	}
+
+	_, ok := domain.TemplateTypeForBuildTransition("")
+	assert.False(t, ok)
+}
@@ -0,0 +1,121 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewQuietHours_RejectsInvalidValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		timezone string
+		start    string
+		end      string
+	}{
+		{name: "unknown timezone", timezone: "Mars/Olympus", start: "22:00", end: "07:00"},
+		{name: "malformed start", start: "10pm", end: "07:00"},
+		{name: "missing end", start: "22:00"},
+		{name: "empty window", start: "22:00", end: "22:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewQuietHours(tt.timezone, tt.start, tt.end, domain.SubscriptionFilter{})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), domain.ErrCodeInvalidQuietHours)
+		})
+	}
+
+	quietHours, err := domain.NewQuietHours("", "", "", domain.SubscriptionFilter{})
+	require.NoError(t, err)
+	assert.True(t, quietHours.IsEmpty())
+}
+
+func TestQuietHours_DeferUntil(t *testing.T) {
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	require.NoError(t, err)
+
+	overnight, err := domain.NewQuietHours("Asia/Jakarta", "22:00", "07:00", domain.SubscriptionFilter{})
+	require.NoError(t, err)
+	lunch, err := domain.NewQuietHours("Asia/Jakarta", "12:00", "13:00", domain.SubscriptionFilter{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		quietHours domain.QuietHours
+		at         time.Time
+		expected   time.Time
+		deferred   bool
+	}{
+		{
+			name:       "before midnight",
+			quietHours: overnight,
+			at:         time.Date(2024, 3, 9, 23, 30, 0, 0, jakarta),
+			expected:   time.Date(2024, 3, 10, 7, 0, 0, 0, jakarta),
+			deferred:   true,
+		},
+		{
+			name:       "after midnight",
+			quietHours: overnight,
+			at:         time.Date(2024, 3, 10, 3, 0, 0, 0, jakarta),
+			expected:   time.Date(2024, 3, 10, 7, 0, 0, 0, jakarta),
+			deferred:   true,
+		},
+		{
+			name:       "outside an overnight window",
+			quietHours: overnight,
+			at:         time.Date(2024, 3, 10, 7, 0, 0, 0, jakarta),
+		},
+		{
+			name:       "within a daytime window",
+			quietHours: lunch,
+			at:         time.Date(2024, 3, 9, 12, 15, 0, 0, jakarta),
+			expected:   time.Date(2024, 3, 9, 13, 0, 0, 0, jakarta),
+			deferred:   true,
+		},
+		{
+			name:       "given in another timezone",
+			quietHours: lunch,
+			at:         time.Date(2024, 3, 9, 5, 30, 0, 0, time.UTC),
+			expected:   time.Date(2024, 3, 9, 13, 0, 0, 0, jakarta),
+			deferred:   true,
+		},
+		{
+			name:       "without quiet hours",
+			quietHours: domain.QuietHours{},
+			at:         time.Date(2024, 3, 9, 23, 30, 0, 0, jakarta),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			until, deferred := tt.quietHours.DeferUntil(tt.at)
+			assert.Equal(t, tt.deferred, deferred)
+			if tt.deferred {
+				assert.True(t, tt.expected.Equal(until), "expected %s, got %s", tt.expected, until)
+			}
+		})
+	}
+}
+
+func TestQuietHours_Bypasses(t *testing.T) {
+	failedMain := newFilterTestBuildEvent(t, buildDomain.EventTypeBuildCompleted, buildDomain.BuildStatusFailed, "main")
+	failedFeature := newFilterTestBuildEvent(t, buildDomain.EventTypeBuildCompleted, buildDomain.BuildStatusFailed, "feature/login")
+
+	bypass, err := domain.NewSubscriptionFilter(nil, []string{"failed"}, []string{"main"})
+	require.NoError(t, err)
+	quietHours, err := domain.NewQuietHours("UTC", "22:00", "07:00", bypass)
+	require.NoError(t, err)
+
+	assert.True(t, quietHours.Bypasses(failedMain))
+	assert.False(t, quietHours.Bypasses(failedFeature))
+
+	withoutBypass, err := domain.NewQuietHours("UTC", "22:00", "07:00", domain.SubscriptionFilter{})
+	require.NoError(t, err)
+	assert.False(t, withoutBypass.Bypasses(failedMain))
+}
@@ -2,6 +2,7 @@ package domain_test
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -244,6 +245,31 @@ func TestRetryConfiguration_ShouldRetry(t *testing.T) {
 			isActive:     true,
 			expected:     false,
 		},
+		{
+			name:         "should_not_retry_telegram_chat_not_found",
+			attemptCount: 1,
+			lastError: fmt.Errorf("failed to send telegram message: %w", &domain.TelegramAPIError{
+				Method: "sendMessage", ErrorCode: 400, Description: "Bad Request: chat not found",
+			}),
+			isActive: true,
+			expected: false,
+		},
+		{
+			name:         "should_retry_telegram_flood_limit",
+			attemptCount: 1,
+			lastError: &domain.TelegramAPIError{
+				Method: "sendMessage", ErrorCode: 429, Description: "Too Many Requests: retry after 5", RetryAfter: 5 * time.Second,
+			},
+			isActive: true,
+			expected: true,
+		},
+		{
+			name:         "should_retry_telegram_server_error",
+			attemptCount: 1,
+			lastError:    &domain.TelegramAPIError{Method: "sendMessage", ErrorCode: 502, Description: "Bad Gateway"},
+			isActive:     true,
+			expected:     true,
+		},
 		{
 			name:         "should_not_retry_config_inactive",
 			attemptCount: 1,
@@ -358,6 +384,8 @@ func TestGetDefaultRetryPolicies(t *testing.T) {
 		domain.NotificationChannelEmail,
 		domain.NotificationChannelSlack,
 		domain.NotificationChannelWebhook,
+		domain.NotificationChannelTeams,
+		domain.NotificationChannelDiscord,
 	}
 
 	for _, channel := range expectedChannels {
@@ -0,0 +1,123 @@
+package domain_test
+
+import (
+	"testing"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFilterTestBuildEvent(t *testing.T, eventType buildDomain.EventType, status buildDomain.BuildStatus, branch string) *buildDomain.BuildEvent {
+	buildEvent, err := buildDomain.NewBuildEvent(buildDomain.BuildEventParams{
+		ProjectID: value_objects.NewID(),
+		EventType: eventType,
+		Status:    status,
+		Branch:    branch,
+	})
+	require.NoError(t, err)
+	return buildEvent
+}
+
+func TestNewSubscriptionFilter(t *testing.T) {
+	filter, err := domain.NewSubscriptionFilter(
+		[]string{" Push ", "push", ""},
+		[]string{"FAILED"},
+		[]string{"main", " release/* "},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"push"}, filter.EventTypes)
+	assert.Equal(t, []string{"failed"}, filter.Statuses)
+	assert.Equal(t, []string{"main", "release/*"}, filter.BranchPatterns)
+	assert.False(t, filter.IsEmpty())
+
+	empty, err := domain.NewSubscriptionFilter(nil, nil, nil)
+	require.NoError(t, err)
+	assert.True(t, empty.IsEmpty())
+}
+
+func TestNewSubscriptionFilter_RejectsInvalidValues(t *testing.T) {
+	tests := []struct {
+		name           string
+		eventTypes     []string
+		statuses       []string
+		branchPatterns []string
+	}{
+		{name: "unknown event type", eventTypes: []string{"build_failure"}},
+		{name: "unknown status", statuses: []string{"broken"}},
+		{name: "malformed branch pattern", branchPatterns: []string{"release/["}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewSubscriptionFilter(tt.eventTypes, tt.statuses, tt.branchPatterns)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), domain.ErrCodeInvalidSubscriptionFilter)
+		})
+	}
+}
+
+func TestSubscriptionFilter_Matches(t *testing.T) {
+	failedMain := newFilterTestBuildEvent(t, buildDomain.EventTypePush, buildDomain.BuildStatusFailed, "main")
+	passedRelease := newFilterTestBuildEvent(t, buildDomain.EventTypeBuildCompleted, buildDomain.BuildStatusSuccess, "release/1.2")
+	failedFeature := newFilterTestBuildEvent(t, buildDomain.EventTypePullRequest, buildDomain.BuildStatusFailed, "feature/login")
+
+	tests := []struct {
+		name     string
+		filter   domain.SubscriptionFilter
+		expected []bool // failedMain, passedRelease, failedFeature
+	}{
+		{
+			name:     "empty filter matches every event",
+			filter:   domain.SubscriptionFilter{},
+			expected: []bool{true, true, true},
+		},
+		{
+			name:     "failures only",
+			filter:   domain.SubscriptionFilter{Statuses: []string{"failed"}},
+			expected: []bool{true, false, true},
+		},
+		{
+			name:     "event types",
+			filter:   domain.SubscriptionFilter{EventTypes: []string{"push", "build_completed"}},
+			expected: []bool{true, true, false},
+		},
+		{
+			name:     "branch patterns",
+			filter:   domain.SubscriptionFilter{BranchPatterns: []string{"main", "release/*"}},
+			expected: []bool{true, true, false},
+		},
+		{
+			name: "all filters must match",
+			filter: domain.SubscriptionFilter{
+				Statuses:       []string{"failed"},
+				BranchPatterns: []string{"main", "release/*"},
+			},
+			expected: []bool{true, false, false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected[0], tt.filter.Matches(failedMain))
+			assert.Equal(t, tt.expected[1], tt.filter.Matches(passedRelease))
+			assert.Equal(t, tt.expected[2], tt.filter.Matches(failedFeature))
+		})
+	}
+}
+
+func TestTelegramSubscriptionUpdateFilter(t *testing.T) {
+	subscription, err := domain.NewTelegramSubscription(value_objects.NewID(), 123456789)
+	require.NoError(t, err)
+	assert.True(t, subscription.Filter().IsEmpty())
+
+	filter, err := domain.NewSubscriptionFilter(nil, []string{"failed"}, []string{"main"})
+	require.NoError(t, err)
+	subscription.UpdateFilter(filter)
+
+	assert.Equal(t, filter, subscription.Filter())
+	assert.Equal(t, []string{"failed"}, subscription.Statuses())
+	assert.Equal(t, []string{"main"}, subscription.BranchPatterns())
+}
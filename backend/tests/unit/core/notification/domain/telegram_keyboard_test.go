@@ -0,0 +1,82 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTelegramBuildKeyboard(t *testing.T) {
+	buildEventID := value_objects.NewID()
+
+	t.Run("links the build when it has a URL", func(t *testing.T) {
+		keyboard := domain.NewTelegramBuildKeyboard(buildEventID, "https://github.com/org/repo/actions/runs/1")
+
+		require.Len(t, keyboard.InlineKeyboard, 2)
+		require.Len(t, keyboard.InlineKeyboard[0], 2)
+		assert.Equal(t, "https://github.com/org/repo/actions/runs/1", keyboard.InlineKeyboard[0][0].URL)
+		assert.Empty(t, keyboard.InlineKeyboard[0][0].CallbackData)
+
+		var callbacks []string
+		for _, row := range keyboard.InlineKeyboard {
+			for _, button := range row {
+				if button.CallbackData != "" {
+					callbacks = append(callbacks, button.CallbackData)
+					assert.LessOrEqual(t, len(button.CallbackData), 64, "telegram limits callback data to 64 bytes")
+				}
+			}
+		}
+		assert.ElementsMatch(t, []string{
+			domain.NewTelegramCallbackData(domain.TelegramActionFailingJobs, buildEventID),
+			domain.NewTelegramCallbackData(domain.TelegramActionAcknowledge, buildEventID),
+			domain.NewTelegramCallbackData(domain.TelegramActionMute, buildEventID),
+		}, callbacks)
+	})
+
+	t.Run("leaves out the view button without a URL", func(t *testing.T) {
+		keyboard := domain.NewTelegramBuildKeyboard(buildEventID, "")
+
+		require.Len(t, keyboard.InlineKeyboard[0], 1)
+		assert.Equal(t, domain.TelegramButtonFailingJobs, keyboard.InlineKeyboard[0][0].Text)
+	})
+}
+
+func TestNewTelegramEscalationKeyboard(t *testing.T) {
+	buildEventID := value_objects.NewID()
+
+	keyboard := domain.NewTelegramEscalationKeyboard(buildEventID, "https://github.com/org/repo/actions/runs/1")
+
+	require.Len(t, keyboard.InlineKeyboard, 1)
+	require.Len(t, keyboard.InlineKeyboard[0], 2)
+	assert.Equal(t, "https://github.com/org/repo/actions/runs/1", keyboard.InlineKeyboard[0][0].URL)
+	assert.Equal(t, domain.NewTelegramCallbackData(domain.TelegramActionAcknowledge, buildEventID), keyboard.InlineKeyboard[0][1].CallbackData)
+
+	withoutURL := domain.NewTelegramEscalationKeyboard(buildEventID, "")
+	require.Len(t, withoutURL.InlineKeyboard[0], 1)
+	assert.Equal(t, domain.TelegramButtonAcknowledge, withoutURL.InlineKeyboard[0][0].Text)
+}
+
+func TestParseTelegramCallbackData(t *testing.T) {
+	buildEventID := value_objects.NewID()
+
+	action, parsedID, err := domain.ParseTelegramCallbackData(
+		domain.NewTelegramCallbackData(domain.TelegramActionMute, buildEventID))
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.TelegramActionMute, action)
+	assert.Equal(t, buildEventID, parsedID)
+
+	for _, data := range []string{
+		"",
+		"mute",
+		"delete:" + buildEventID.String(),
+		"ack:not-a-uuid",
+		"jobs:00000000-0000-0000-0000-000000000000",
+	} {
+		_, _, err := domain.ParseTelegramCallbackData(data)
+		assert.ErrorIs(t, err, domain.ErrInvalidTelegramCallback, data)
+	}
+}
@@ -182,3 +182,19 @@ func TestTelegramSubscriptionValidationEdgeCases(t *testing.T) {
 		assert.Equal(t, chatID, subscription.ChatID())
 	})
 }
+
+func TestTelegramSubscriptionMute(t *testing.T) {
+	subscription, err := domain.NewTelegramSubscription(value_objects.NewID(), 123456789)
+	require.NoError(t, err)
+
+	now := time.Now()
+	assert.False(t, subscription.IsMuted(now))
+	assert.Nil(t, subscription.MutedUntil())
+
+	subscription.MuteUntil(now.Add(time.Hour))
+
+	require.NotNil(t, subscription.MutedUntil())
+	assert.True(t, subscription.IsMuted(now))
+	assert.True(t, subscription.IsMuted(now.Add(59*time.Minute)))
+	assert.False(t, subscription.IsMuted(now.Add(time.Hour)))
+}
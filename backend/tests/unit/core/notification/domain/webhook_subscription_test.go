@@ -0,0 +1,117 @@
+package domain_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testWebhookSecret = "whsec_0123456789abcdef"
+
+func newTestWebhookEvent() *domain.OutgoingWebhookEvent {
+	duration := 95
+	return domain.NewOutgoingWebhookEvent(domain.WebhookEventBuildFailed, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), domain.WebhookEventData{
+		Project: domain.WebhookProject{ID: "8f2c0a4e-6a3b-4c1d-9e2f-1a2b3c4d5e6f", Name: "api"},
+		Build: &domain.WebhookBuild{
+			ID:              "build-1",
+			Status:          "failed",
+			Branch:          "main",
+			Commit:          "abc123",
+			DurationSeconds: &duration,
+			Error:           "tests failed",
+		},
+	})
+}
+
+func TestNewWebhookSubscription(t *testing.T) {
+	projectID := value_objects.NewID()
+
+	subscription, err := domain.NewWebhookSubscription(projectID, " https://hooks.example.com/ci ", testWebhookSecret, "")
+	require.NoError(t, err)
+	assert.Equal(t, "https://hooks.example.com/ci", subscription.URL())
+	assert.Equal(t, domain.WebhookPayloadFormatJSON, subscription.Format())
+	assert.True(t, subscription.IsActive())
+
+	_, err = domain.NewWebhookSubscription(projectID, "ftp://hooks.example.com", testWebhookSecret, domain.WebhookPayloadFormatJSON)
+	assert.Error(t, err)
+
+	_, err = domain.NewWebhookSubscription(projectID, "https://hooks.example.com", "short", domain.WebhookPayloadFormatJSON)
+	assert.Error(t, err)
+
+	_, err = domain.NewWebhookSubscription(projectID, "https://hooks.example.com", testWebhookSecret, "xml")
+	assert.Error(t, err)
+
+	_, err = domain.NewWebhookSubscription(value_objects.ID{}, "https://hooks.example.com", testWebhookSecret, domain.WebhookPayloadFormatJSON)
+	assert.ErrorIs(t, err, domain.ErrInvalidProjectID)
+}
+
+func TestWebhookSubscription_RotateSecret(t *testing.T) {
+	subscription, err := domain.NewWebhookSubscription(value_objects.NewID(), "https://hooks.example.com", testWebhookSecret, domain.WebhookPayloadFormatJSON)
+	require.NoError(t, err)
+
+	assert.Error(t, subscription.RotateSecret("short"))
+	require.NoError(t, subscription.RotateSecret("whsec_fedcba9876543210"))
+	assert.Equal(t, "whsec_fedcba9876543210", subscription.Secret())
+}
+
+func TestOutgoingWebhookEvent_EncodeDecode(t *testing.T) {
+	message, err := newTestWebhookEvent().Encode()
+	require.NoError(t, err)
+
+	event, err := domain.DecodeOutgoingWebhookEvent(message)
+	require.NoError(t, err)
+	assert.Equal(t, domain.WebhookEventBuildFailed, event.Type)
+	assert.Equal(t, domain.WebhookSchemaVersion, event.Version)
+	assert.Equal(t, "api", event.Data.Project.Name)
+
+	_, err = domain.DecodeOutgoingWebhookEvent("Build failed")
+	assert.Error(t, err)
+}
+
+func TestNewWebhookDelivery_JSON(t *testing.T) {
+	subscription, err := domain.NewWebhookSubscription(value_objects.NewID(), "https://hooks.example.com", testWebhookSecret, domain.WebhookPayloadFormatJSON)
+	require.NoError(t, err)
+
+	delivery, err := domain.NewWebhookDelivery("delivery-1", subscription, newTestWebhookEvent())
+	require.NoError(t, err)
+	assert.Equal(t, "https://hooks.example.com", delivery.URL)
+	assert.Equal(t, testWebhookSecret, delivery.Secret)
+	assert.Equal(t, domain.WebhookContentTypeJSON, delivery.ContentType)
+	assert.Equal(t, domain.WebhookEventBuildFailed, delivery.EventType)
+
+	var payload map[string]interface{}
+	require.NoError(t, json.Unmarshal(delivery.Body, &payload))
+	assert.Equal(t, "delivery-1", payload["id"])
+	assert.Equal(t, "build.failed", payload["type"])
+	assert.Equal(t, "1", payload["version"])
+	assert.Equal(t, "2026-01-02T03:04:05Z", payload["timestamp"])
+
+	build := payload["data"].(map[string]interface{})["build"].(map[string]interface{})
+	assert.Equal(t, "failed", build["status"])
+	assert.Equal(t, float64(95), build["duration_seconds"])
+	assert.NotContains(t, build, "author")
+}
+
+func TestNewWebhookDelivery_CloudEvents(t *testing.T) {
+	subscription, err := domain.NewWebhookSubscription(value_objects.NewID(), "https://hooks.example.com", testWebhookSecret, domain.WebhookPayloadFormatCloudEvents)
+	require.NoError(t, err)
+
+	delivery, err := domain.NewWebhookDelivery("delivery-1", subscription, newTestWebhookEvent())
+	require.NoError(t, err)
+	assert.Equal(t, domain.WebhookContentTypeCloudEvents, delivery.ContentType)
+
+	var event domain.CloudEvent
+	require.NoError(t, json.Unmarshal(delivery.Body, &event))
+	assert.Equal(t, "1.0", event.SpecVersion)
+	assert.Equal(t, "delivery-1", event.ID)
+	assert.Equal(t, "/projects/8f2c0a4e-6a3b-4c1d-9e2f-1a2b3c4d5e6f", event.Source)
+	assert.Equal(t, "dev.cicd-notifier.build.failed", event.Type)
+	assert.Equal(t, "build-1", event.Subject)
+	assert.Equal(t, domain.WebhookContentTypeJSON, event.DataContentType)
+	assert.Equal(t, "api", event.Data.Project.Name)
+}
@@ -0,0 +1,224 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/deadletter"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/log"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/tests/mocks"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const deadLetterType = "*domain.DeadLetterNotification"
+
+// stubTelegramSender fails every telegram delivery with the configured error
+type stubTelegramSender struct {
+	err error
+}
+
+func (s *stubTelegramSender) SendTelegramNotification(
+	ctx context.Context,
+	chatID int64,
+	message string,
+	keyboard *domain.TelegramInlineKeyboard,
+) (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+	return "42", nil
+}
+
+func (s *stubTelegramSender) EditTelegramNotification(
+	ctx context.Context,
+	chatID int64,
+	messageID, message string,
+	keyboard *domain.TelegramInlineKeyboard,
+) error {
+	return s.err
+}
+
+func (s *stubTelegramSender) SendEmailNotification(ctx context.Context, email, subject, message string) error {
+	return s.err
+}
+
+func (s *stubTelegramSender) SendSlackNotification(ctx context.Context, channel, message string) (string, error) {
+	return "", s.err
+}
+
+func (s *stubTelegramSender) SendWebhookNotification(ctx context.Context, delivery domain.WebhookDelivery) error {
+	return s.err
+}
+
+func newDeadLetterTestLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+	return logger
+}
+
+// failedTelegramLog restores a telegram notification that already failed retryCount+1 times
+func failedTelegramLog(retryCount int) *domain.NotificationLog {
+	history := make([]domain.DeliveryAttempt, 0, retryCount+1)
+	for i := 0; i <= retryCount; i++ {
+		history = append(history, domain.DeliveryAttempt{Attempt: i + 1, Error: "timeout", FailedAt: time.Now()})
+	}
+
+	return domain.RestoreNotificationLog(domain.RestoreNotificationLogParams{
+		ID:           value_objects.NewID(),
+		BuildEventID: value_objects.NewID(),
+		ProjectID:    value_objects.NewID(),
+		Channel:      domain.NotificationChannelTelegram,
+		Recipient:    "123456789",
+		Message:      "Build failed",
+		Status:       domain.NotificationStatusFailed,
+		ErrorMessage: "timeout",
+		RetryCount:   retryCount,
+		MaxRetries:   3,
+		ErrorHistory: history,
+		CreatedAt:    value_objects.NewTimestamp(),
+		UpdatedAt:    value_objects.NewTimestamp(),
+	})
+}
+
+func TestSendNotificationDeadLettersPermanentErrors(t *testing.T) {
+	mockLogRepo := mocks.NewNotificationLogRepository(t)
+	mockDeadLetterRepo := mocks.NewDeadLetterRepository(t)
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:   mockLogRepo,
+		NotificationSender: &stubTelegramSender{err: errors.New("Forbidden: bot was blocked by the user")},
+		DeadLetterRepo:     mockDeadLetterRepo,
+		Logger:             newDeadLetterTestLogger(),
+	})
+
+	notification, err := domain.NewNotificationLog(value_objects.NewID(), value_objects.NewID(), domain.NotificationChannelTelegram, "123456789", "Build failed", 3)
+	require.NoError(t, err)
+
+	mockLogRepo.On("GetByID", mock.Anything, notification.ID()).Return(notification, nil)
+	mockLogRepo.On("Update", mock.Anything, notification).Return(nil).Once()
+	mockDeadLetterRepo.On("Create", mock.Anything, mock.MatchedBy(func(dl *domain.DeadLetterNotification) bool {
+		return dl.Reason() == domain.DeadLetterReasonPermanentError &&
+			dl.NotificationLogID() == notification.ID() &&
+			dl.AttemptCount() == 1 &&
+			len(dl.ErrorHistory()) == 1
+	})).Return(nil).Once()
+
+	err = service.SendNotification(context.Background(), notification.ID())
+
+	assert.Error(t, err)
+	assert.Equal(t, domain.NotificationStatusDeadLettered, notification.Status())
+	mockDeadLetterRepo.AssertExpectations(t)
+}
+
+func TestSendNotificationKeepsRetryableFailures(t *testing.T) {
+	mockLogRepo := mocks.NewNotificationLogRepository(t)
+	mockDeadLetterRepo := mocks.NewDeadLetterRepository(t)
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:   mockLogRepo,
+		NotificationSender: &stubTelegramSender{err: errors.New("timeout")},
+		DeadLetterRepo:     mockDeadLetterRepo,
+		Logger:             newDeadLetterTestLogger(),
+	})
+
+	notification, err := domain.NewNotificationLog(value_objects.NewID(), value_objects.NewID(), domain.NotificationChannelTelegram, "123456789", "Build failed", 3)
+	require.NoError(t, err)
+
+	mockLogRepo.On("GetByID", mock.Anything, notification.ID()).Return(notification, nil)
+	mockLogRepo.On("Update", mock.Anything, notification).Return(nil).Once()
+
+	assert.Error(t, service.SendNotification(context.Background(), notification.ID()))
+	assert.Equal(t, domain.NotificationStatusFailed, notification.Status())
+	mockDeadLetterRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestRetryFailedNotificationDeadLettersAfterLastRetry(t *testing.T) {
+	mockLogRepo := mocks.NewNotificationLogRepository(t)
+	mockDeadLetterRepo := mocks.NewDeadLetterRepository(t)
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:   mockLogRepo,
+		NotificationSender: &stubTelegramSender{err: errors.New("timeout")},
+		DeadLetterRepo:     mockDeadLetterRepo,
+		Logger:             newDeadLetterTestLogger(),
+	})
+
+	// The initial attempt and two retries failed, the third retry is the last one
+	notification := failedTelegramLog(2)
+
+	mockLogRepo.On("GetByID", mock.Anything, notification.ID()).Return(notification, nil)
+	mockLogRepo.On("Update", mock.Anything, notification).Return(nil)
+	mockDeadLetterRepo.On("Create", mock.Anything, mock.MatchedBy(func(dl *domain.DeadLetterNotification) bool {
+		return dl.Reason() == domain.DeadLetterReasonRetriesExhausted &&
+			dl.AttemptCount() == 4 &&
+			len(dl.ErrorHistory()) == 4
+	})).Return(nil).Once()
+
+	err := service.RetryFailedNotification(context.Background(), notification.ID())
+
+	assert.Error(t, err)
+	assert.Equal(t, domain.NotificationStatusDeadLettered, notification.Status())
+	assert.Equal(t, 3, notification.RetryCount())
+	mockDeadLetterRepo.AssertExpectations(t)
+}
+
+func TestSendNotificationSkipsDeadLetterWhenDisabled(t *testing.T) {
+	disabled, err := domain.NewRetryConfiguration(3, time.Second, time.Minute, time.Hour, 2.0, true, false)
+	require.NoError(t, err)
+
+	mockLogRepo := mocks.NewNotificationLogRepository(t)
+	mockDeadLetterRepo := mocks.NewDeadLetterRepository(t)
+	mockRetryRepo := &MockRetryConfigurationRepository{}
+	mockRetryRepo.On("GetByChannel", mock.Anything, domain.NotificationChannelTelegram).Return(disabled, nil)
+
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:   mockLogRepo,
+		NotificationSender: &stubTelegramSender{err: errors.New("chat not found")},
+		RetryConfigRepo:    mockRetryRepo,
+		DeadLetterRepo:     mockDeadLetterRepo,
+		Logger:             newDeadLetterTestLogger(),
+	})
+
+	notification := failedTelegramLog(0)
+	mockLogRepo.On("GetByID", mock.Anything, notification.ID()).Return(notification, nil)
+	mockLogRepo.On("Update", mock.Anything, notification).Return(nil).Once()
+
+	assert.Error(t, service.SendNotification(context.Background(), notification.ID()))
+	assert.Equal(t, domain.NotificationStatusFailed, notification.Status())
+	mockDeadLetterRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestRequeueDeadLetterResetsNotification(t *testing.T) {
+	mockLogRepo := mocks.NewNotificationLogRepository(t)
+	mockDeadLetterRepo := mocks.NewDeadLetterRepository(t)
+	service := deadletter.NewDeadLetterService(deadletter.Dep{
+		DeadLetterRepo:   mockDeadLetterRepo,
+		NotificationRepo: mockLogRepo,
+		Logger:           newDeadLetterTestLogger(),
+	})
+
+	notification := failedTelegramLog(3)
+	entry, err := domain.NewDeadLetterNotification(notification, domain.DeadLetterReasonRetriesExhausted)
+	require.NoError(t, err)
+	require.NoError(t, notification.MarkAsDeadLettered())
+
+	mockDeadLetterRepo.On("GetByID", mock.Anything, entry.ID()).Return(entry, nil)
+	mockDeadLetterRepo.On("Update", mock.Anything, mock.AnythingOfType(deadLetterType)).Return(nil).Once()
+	mockLogRepo.On("GetByID", mock.Anything, notification.ID()).Return(notification, nil).Once()
+	mockLogRepo.On("Update", mock.Anything, notification).Return(nil).Once()
+
+	requeued, err := service.RequeueDeadLetter(context.Background(), entry.ID())
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.DeadLetterStatusRequeued, requeued.Status())
+	assert.Equal(t, domain.NotificationStatusPending, notification.Status())
+	assert.Equal(t, 0, notification.RetryCount())
+
+	// Resolved entries cannot be requeued or discarded again
+	_, err = service.DiscardDeadLetter(context.Background(), entry.ID())
+	assert.Equal(t, domain.ErrDeadLetterAlreadyResolved, err)
+}
@@ -0,0 +1,161 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/sender"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// discordTestMessage is the stored message of a failed build notified to Discord
+func discordTestMessage(t *testing.T) string {
+	message, err := domain.NewChannelMessage("❌ Build failed!", buildDomain.BuildStatusFailed, domain.TemplateParams{
+		BuildBranch:  "main",
+		BuildCommit:  "abc1234",
+		BuildAuthor:  "octocat",
+		ErrorMessage: "go test ./... exited with code 1 after the integration suite timed out",
+		BuildURL:     "https://ci.example.com/runs/42",
+	}).Encode()
+	require.NoError(t, err)
+	return message
+}
+
+func newDiscordChannel() port.DeliveryChannel {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return sender.NewDiscordChannel(sender.DiscordConfig{}, logger)
+}
+
+func TestDiscordChannel_PostsEmbed(t *testing.T) {
+	var payload domain.DiscordMessage
+	server := newTeamsTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("wait"))
+		assert.Equal(t, "true", r.URL.Query().Get("with_components"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		_, _ = w.Write([]byte(`{"id":"1180000000000000000","channel_id":"42"}`))
+	})
+
+	channel := newDiscordChannel()
+	messageID, err := channel.Send(context.Background(), server.URL+"/api/webhooks/1/token", "🚨 Build Failed: api", discordTestMessage(t))
+	require.NoError(t, err)
+	assert.Equal(t, "1180000000000000000", messageID)
+
+	require.Len(t, payload.Embeds, 1)
+	embed := payload.Embeds[0]
+	assert.Equal(t, "🚨 Build Failed: api", embed.Title)
+	assert.Equal(t, "❌ Build failed!", embed.Description)
+	assert.Equal(t, "https://ci.example.com/runs/42", embed.URL)
+	assert.Equal(t, domain.DiscordColorFailure, embed.Color)
+	require.Len(t, embed.Fields, 4)
+	assert.Equal(t, domain.DiscordEmbedField{Name: "Branch", Value: "main", Inline: true}, embed.Fields[0])
+	assert.Equal(t, domain.DiscordEmbedField{Name: "Commit", Value: "abc1234", Inline: true}, embed.Fields[1])
+	assert.Equal(t, domain.DiscordEmbedField{Name: "Author", Value: "octocat", Inline: true}, embed.Fields[2])
+	assert.Equal(t, "Error", embed.Fields[3].Name)
+	assert.False(t, embed.Fields[3].Inline)
+
+	require.Len(t, payload.Components, 1)
+	assert.Equal(t, domain.DiscordComponentActionRow, payload.Components[0].Type)
+	assert.Equal(t, []domain.DiscordComponent{{
+		Type:  domain.DiscordComponentButton,
+		Style: domain.DiscordButtonStyleLink,
+		Label: "View Build",
+		URL:   "https://ci.example.com/runs/42",
+	}}, payload.Components[0].Components)
+}
+
+func TestDiscordChannel_PostsPlainTextMessage(t *testing.T) {
+	var payload domain.DiscordMessage
+	server := newTeamsTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		_, _ = w.Write([]byte(`{"id":"1"}`))
+	})
+
+	// Messages not created for a build event have no status, fields or buttons
+	_, err := newDiscordChannel().Send(context.Background(), server.URL, "Test notification", "❌ nothing failed, this is a test")
+	require.NoError(t, err)
+
+	require.Len(t, payload.Embeds, 1)
+	assert.Equal(t, "❌ nothing failed, this is a test", payload.Embeds[0].Description)
+	assert.Equal(t, domain.DiscordColorNeutral, payload.Embeds[0].Color)
+	assert.Empty(t, payload.Embeds[0].Fields)
+	assert.Empty(t, payload.Components)
+}
+
+func TestDiscordChannel_RateLimits(t *testing.T) {
+	t.Run("rate limited webhook is held back", func(t *testing.T) {
+		server := newTeamsTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message":"You are being rate limited.","retry_after":2.5,"global":false}`))
+		})
+
+		channel := newDiscordChannel()
+		_, err := channel.Send(context.Background(), server.URL, "Build Started", "Branch: main")
+		require.Error(t, err)
+
+		var rateLimitErr *domain.RecipientRateLimitError
+		require.True(t, errors.As(err, &rateLimitErr))
+		assert.Equal(t, 2500*time.Millisecond, rateLimitErr.RetryAfter)
+
+		limiter, ok := channel.(port.RecipientRateLimiter)
+		require.True(t, ok)
+		assert.Greater(t, limiter.RetryAfter(server.URL), time.Second)
+		assert.Zero(t, limiter.RetryAfter(server.URL+"/other"))
+	})
+
+	t.Run("exhausted bucket holds back the webhook until it resets", func(t *testing.T) {
+		server := newTeamsTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset-After", "1.5")
+			_, _ = w.Write([]byte(`{"id":"1"}`))
+		})
+
+		channel := newDiscordChannel()
+		_, err := channel.Send(context.Background(), server.URL, "Build Started", "Branch: main")
+		require.NoError(t, err)
+
+		wait := channel.(port.RecipientRateLimiter).RetryAfter(server.URL)
+		assert.Greater(t, wait, time.Second)
+		assert.LessOrEqual(t, wait, 1500*time.Millisecond)
+	})
+
+	t.Run("rejected payload", func(t *testing.T) {
+		server := newTeamsTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"Invalid Form Body","code":50035}`))
+		})
+
+		_, err := newDiscordChannel().Send(context.Background(), server.URL, "Build Started", "Branch: main")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Invalid Form Body")
+	})
+
+	t.Run("plain http webhook URL", func(t *testing.T) {
+		_, err := newDiscordChannel().Send(context.Background(), "http://discord.com/api/webhooks/1/token", "Build Started", "Branch: main")
+		require.Error(t, err)
+	})
+}
+
+func TestDiscordChannel_DeliveryChannelInfo(t *testing.T) {
+	channel := newDiscordChannel()
+
+	maxRequests, window := channel.GetRateLimitInfo()
+	rule := domain.DefaultRateLimitRules()[domain.NotificationChannelDiscord]
+	assert.Equal(t, domain.NotificationChannelDiscord, channel.GetChannelType())
+	assert.Equal(t, 3, channel.GetMaxRetries())
+	assert.Equal(t, rule.MaxRequests, maxRequests)
+	assert.Equal(t, rule.WindowSize, window)
+	assert.True(t, channel.IsAvailable(context.Background()))
+}
@@ -0,0 +1,316 @@
+package service_test
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/sender"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	smtpTestUsername = "notifier"
+	smtpTestPassword = "s3cret"
+	smtpTestFrom     = "ci@example.com"
+	smtpTestTo       = "dev@example.com"
+)
+
+// receivedEmail is an email accepted by the fake SMTP server
+type receivedEmail struct {
+	from     string
+	to       []string
+	data     string
+	authUser string
+	tls      bool
+}
+
+// fakeSMTPServer is a minimal local SMTP stand-in supporting STARTTLS, implicit TLS and AUTH PLAIN
+type fakeSMTPServer struct {
+	listener  net.Listener
+	tlsConfig *tls.Config
+	implicit  bool
+
+	mu     sync.Mutex
+	emails []receivedEmail
+}
+
+// newFakeSMTPServer starts a fake SMTP server and returns a client TLS config trusting its certificate
+func newFakeSMTPServer(t *testing.T, implicitTLS bool) (*fakeSMTPServer, *tls.Config) {
+	t.Helper()
+
+	// Reuse the self-signed certificate of httptest, it is valid for 127.0.0.1
+	certServer := httptest.NewTLSServer(http.NotFoundHandler())
+	t.Cleanup(certServer.Close)
+	serverTLS := &tls.Config{Certificates: certServer.TLS.Certificates}
+	clientTLS := certServer.Client().Transport.(*http.Transport).TLSClientConfig.Clone()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	if implicitTLS {
+		listener = tls.NewListener(listener, serverTLS)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	server := &fakeSMTPServer{listener: listener, tlsConfig: serverTLS, implicit: implicitTLS}
+	go server.serve()
+	return server, clientTLS
+}
+
+func (s *fakeSMTPServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSMTPServer) received() []receivedEmail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]receivedEmail{}, s.emails...)
+}
+
+func (s *fakeSMTPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeSMTPServer) handle(conn net.Conn) {
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	reader := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = io.WriteString(conn, line+"\r\n") }
+
+	email := receivedEmail{tls: s.implicit}
+	reply("220 localhost ESMTP fake")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.TrimRight(line, "\r\n")
+		verb := strings.ToUpper(strings.SplitN(command, " ", 2)[0])
+
+		switch verb {
+		case "EHLO":
+			reply("250-localhost")
+			if !email.tls {
+				reply("250-STARTTLS")
+			}
+			reply("250 AUTH PLAIN")
+		case "STARTTLS":
+			reply("220 Ready to start TLS")
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+			reader = bufio.NewReader(conn)
+			email.tls = true
+		case "AUTH":
+			fields := strings.Fields(command)
+			decoded, _ := base64.StdEncoding.DecodeString(fields[len(fields)-1])
+			credentials := strings.Split(string(decoded), "\x00")
+			if len(credentials) != 3 || credentials[1] != smtpTestUsername || credentials[2] != smtpTestPassword {
+				reply("535 Authentication failed")
+				continue
+			}
+			email.authUser = credentials[1]
+			reply("235 Authentication successful")
+		case "MAIL":
+			email.from = strings.Trim(strings.TrimPrefix(command, "MAIL FROM:"), "<>")
+			reply("250 OK")
+		case "RCPT":
+			email.to = append(email.to, strings.Trim(strings.TrimPrefix(command, "RCPT TO:"), "<>"))
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(dataLine, "."))
+			}
+			email.data = data.String()
+			s.mu.Lock()
+			s.emails = append(s.emails, email)
+			s.mu.Unlock()
+			reply("250 OK queued")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func newEmailSender(server *fakeSMTPServer, clientTLS *tls.Config, security sender.EmailSecurity) interface {
+	SendEmailNotification(ctx context.Context, to, subject, body string) error
+} {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	return sender.NewNotificationSenderService(sender.Dep{
+		EmailConfig: sender.EmailConfig{
+			SMTPHost:     "127.0.0.1",
+			SMTPPort:     server.port(),
+			SMTPUsername: smtpTestUsername,
+			SMTPPassword: smtpTestPassword,
+			FromEmail:    smtpTestFrom,
+			FromName:     "CI Notifier",
+			Security:     security,
+			Timeout:      5 * time.Second,
+			TLSConfig:    clientTLS,
+		},
+		Logger: logger,
+	})
+}
+
+// emailParts parses a received email into its headers and MIME parts by content type
+func emailParts(t *testing.T, data string) (mail.Header, map[string]string) {
+	t.Helper()
+
+	message, err := mail.ReadMessage(strings.NewReader(data))
+	require.NoError(t, err)
+
+	mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/alternative", mediaType)
+
+	parts := make(map[string]string)
+	reader := multipart.NewReader(message.Body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		contentType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		content, err := io.ReadAll(part)
+		require.NoError(t, err)
+		parts[contentType] = string(content)
+	}
+	return message.Header, parts
+}
+
+func TestSendEmailNotification_DeliversMultipartEmailOverSTARTTLS(t *testing.T) {
+	server, clientTLS := newFakeSMTPServer(t, false)
+	emailSender := newEmailSender(server, clientTLS, sender.EmailSecurityStartTLS)
+
+	body := "Build failed!\n\nProject: api <core>\nBranch: main\n\nView Build: https://ci.example.com/runs/42"
+	err := emailSender.SendEmailNotification(context.Background(), smtpTestTo, "[BUILD FAILED] api - main ❌", body)
+	require.NoError(t, err)
+
+	emails := server.received()
+	require.Len(t, emails, 1)
+	email := emails[0]
+	assert.True(t, email.tls)
+	assert.Equal(t, smtpTestUsername, email.authUser)
+	assert.Equal(t, smtpTestFrom, email.from)
+	assert.Equal(t, []string{smtpTestTo}, email.to)
+
+	header, parts := emailParts(t, email.data)
+	subject, err := new(mime.WordDecoder).DecodeHeader(header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "[BUILD FAILED] api - main ❌", subject)
+	assert.Contains(t, header.Get("From"), smtpTestFrom)
+	assert.Equal(t, "<"+smtpTestTo+">", header.Get("To"))
+	assert.NotEmpty(t, header.Get("Message-ID"))
+
+	assert.Contains(t, parts["text/plain"], "Project: api <core>")
+	assert.Contains(t, parts["text/html"], "Project: api &lt;core&gt;")
+	assert.Contains(t, parts["text/html"], `<a href="https://ci.example.com/runs/42">`)
+}
+
+func TestSendEmailNotification_DeliversOverImplicitTLS(t *testing.T) {
+	server, clientTLS := newFakeSMTPServer(t, true)
+	emailSender := newEmailSender(server, clientTLS, sender.EmailSecurityTLS)
+
+	err := emailSender.SendEmailNotification(context.Background(), smtpTestTo, "Deployment succeeded", "Deployment success!")
+	require.NoError(t, err)
+
+	emails := server.received()
+	require.Len(t, emails, 1)
+	assert.True(t, emails[0].tls)
+	assert.Equal(t, smtpTestUsername, emails[0].authUser)
+}
+
+func TestSendEmailNotification_Failures(t *testing.T) {
+	t.Run("rejected credentials", func(t *testing.T) {
+		server, clientTLS := newFakeSMTPServer(t, false)
+		logger := logrus.New()
+		logger.SetOutput(io.Discard)
+		emailSender := sender.NewNotificationSenderService(sender.Dep{
+			EmailConfig: sender.EmailConfig{
+				SMTPHost:     "127.0.0.1",
+				SMTPPort:     server.port(),
+				SMTPUsername: smtpTestUsername,
+				SMTPPassword: "wrong",
+				FromEmail:    smtpTestFrom,
+				TLSConfig:    clientTLS,
+			},
+			Logger: logger,
+		})
+
+		err := emailSender.SendEmailNotification(context.Background(), smtpTestTo, "Subject", "Body")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "authentication failed")
+		assert.Empty(t, server.received())
+	})
+
+	t.Run("invalid recipient", func(t *testing.T) {
+		server, clientTLS := newFakeSMTPServer(t, false)
+		emailSender := newEmailSender(server, clientTLS, sender.EmailSecurityStartTLS)
+
+		err := emailSender.SendEmailNotification(context.Background(), "not-an-address", "Subject", "Body")
+		require.Error(t, err)
+		assert.Empty(t, server.received())
+	})
+
+	t.Run("unreachable server", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		port := listener.Addr().(*net.TCPAddr).Port
+		require.NoError(t, listener.Close())
+
+		logger := logrus.New()
+		logger.SetOutput(io.Discard)
+		emailSender := sender.NewNotificationSenderService(sender.Dep{
+			EmailConfig: sender.EmailConfig{
+				SMTPHost:  "127.0.0.1",
+				SMTPPort:  port,
+				FromEmail: smtpTestFrom,
+				Timeout:   time.Second,
+			},
+			Logger: logger,
+		})
+
+		err = emailSender.SendEmailNotification(context.Background(), smtpTestTo, "Subject", "Body")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "127.0.0.1:"+strconv.Itoa(port))
+	})
+}
@@ -0,0 +1,393 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	buildDto "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/escalation"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const escalationChatID = int64(-100300)
+
+// memEscalationPolicyRepo keeps escalation policies in memory
+type memEscalationPolicyRepo struct {
+	port.EscalationPolicyRepository
+	policies []*domain.EscalationPolicy
+}
+
+func (r *memEscalationPolicyRepo) Create(ctx context.Context, policy *domain.EscalationPolicy) error {
+	r.policies = append(r.policies, policy)
+	return nil
+}
+
+func (r *memEscalationPolicyRepo) GetByID(ctx context.Context, id value_objects.ID) (*domain.EscalationPolicy, error) {
+	for _, policy := range r.policies {
+		if policy.ID() == id {
+			return policy, nil
+		}
+	}
+	return nil, domain.ErrEscalationPolicyNotFound
+}
+
+func (r *memEscalationPolicyRepo) GetByProjectID(ctx context.Context, projectID value_objects.ID) ([]*domain.EscalationPolicy, error) {
+	var policies []*domain.EscalationPolicy
+	for _, policy := range r.policies {
+		if policy.ProjectID() == projectID {
+			policies = append(policies, policy)
+		}
+	}
+	return policies, nil
+}
+
+func (r *memEscalationPolicyRepo) GetActive(ctx context.Context, limit, offset int) ([]*domain.EscalationPolicy, error) {
+	var policies []*domain.EscalationPolicy
+	for _, policy := range r.policies {
+		if policy.IsActive() {
+			policies = append(policies, policy)
+		}
+	}
+	if offset >= len(policies) {
+		return nil, nil
+	}
+	return policies[offset:min(offset+limit, len(policies))], nil
+}
+
+func (r *memEscalationPolicyRepo) Update(ctx context.Context, policy *domain.EscalationPolicy) error {
+	return nil
+}
+
+// memEscalationRepo keeps escalations in memory
+type memEscalationRepo struct {
+	escalations []*domain.Escalation
+}
+
+func (r *memEscalationRepo) Create(ctx context.Context, escalation *domain.Escalation) error {
+	r.escalations = append(r.escalations, escalation)
+	return nil
+}
+
+func (r *memEscalationRepo) GetOpenByPolicyID(ctx context.Context, policyID value_objects.ID) (*domain.Escalation, error) {
+	for _, escalation := range r.escalations {
+		if escalation.PolicyID() == policyID && !escalation.IsResolved() {
+			return escalation, nil
+		}
+	}
+	return nil, domain.ErrEscalationNotFound
+}
+
+func (r *memEscalationRepo) GetOpenByProjectID(ctx context.Context, projectID value_objects.ID) ([]*domain.Escalation, error) {
+	var escalations []*domain.Escalation
+	for _, escalation := range r.escalations {
+		if escalation.ProjectID() == projectID && !escalation.IsResolved() {
+			escalations = append(escalations, escalation)
+		}
+	}
+	return escalations, nil
+}
+
+func (r *memEscalationRepo) Update(ctx context.Context, escalation *domain.Escalation) error {
+	return nil
+}
+
+// historyBuildEventRepo returns the same build history, newest first, for every branch
+type historyBuildEventRepo struct {
+	stubBuildEventRepo
+	history []*buildDomain.BuildEvent
+}
+
+func (r *historyBuildEventRepo) GetByProjectID(
+	ctx context.Context,
+	projectID value_objects.ID,
+	filters buildDto.ListBuildEventFilters,
+) ([]*buildDomain.BuildEvent, error) {
+	return r.history, nil
+}
+
+// escalationSender records the escalations sent to Telegram and email
+type escalationSender struct {
+	stubTelegramSender
+	telegram  []string
+	keyboards []*domain.TelegramInlineKeyboard
+	subjects  []string
+	emailedTo []string
+}
+
+func (s *escalationSender) SendTelegramNotification(
+	ctx context.Context,
+	chatID int64,
+	message string,
+	keyboard *domain.TelegramInlineKeyboard,
+) (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+	s.telegram = append(s.telegram, message)
+	s.keyboards = append(s.keyboards, keyboard)
+	return "42", nil
+}
+
+func (s *escalationSender) SendEmailNotification(ctx context.Context, email, subject, message string) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.emailedTo = append(s.emailedTo, email)
+	s.subjects = append(s.subjects, subject)
+	return nil
+}
+
+type escalationFixture struct {
+	projectID   value_objects.ID
+	policies    *memEscalationPolicyRepo
+	escalations *memEscalationRepo
+	builds      *historyBuildEventRepo
+	sender      *escalationSender
+	service     port.EscalationService
+}
+
+func newEscalationFixture() *escalationFixture {
+	f := &escalationFixture{
+		projectID:   value_objects.NewID(),
+		policies:    &memEscalationPolicyRepo{},
+		escalations: &memEscalationRepo{},
+		builds:      &historyBuildEventRepo{},
+		sender:      &escalationSender{},
+	}
+	f.service = escalation.NewEscalationService(escalation.Dep{
+		EscalationPolicyRepo: f.policies,
+		EscalationRepo:       f.escalations,
+		BuildEventRepo:       f.builds,
+		NotificationSender:   f.sender,
+		Logger:               newDeadLetterTestLogger(),
+	})
+	return f
+}
+
+// createPolicy escalates main to the escalation chat and an email recipient after each of the steps
+func (f *escalationFixture) createPolicy(t *testing.T, minutes ...int) *domain.EscalationPolicy {
+	t.Helper()
+	chatID := escalationChatID
+	policy, err := f.service.CreateEscalationPolicy(context.Background(), f.projectID, dto.CreateEscalationPolicyRequest{
+		EscalateAfterMinutes: minutes,
+		TelegramChatID:       &chatID,
+		EmailRecipients:      []string{"oncall@example.com"},
+	})
+	require.NoError(t, err)
+	return policy
+}
+
+// escalationTestRunTime is how long the builds of the fixture run before they finish
+const escalationTestRunTime = 30 * time.Minute
+
+// build returns a completed build of main that finished with the status minutes ago
+func (f *escalationFixture) build(status buildDomain.BuildStatus, minutesAgo int) *buildDomain.BuildEvent {
+	finishedAt := time.Now().Add(-time.Duration(minutesAgo) * time.Minute)
+	return buildDomain.RestoreBuildEvent(buildDomain.RestoreBuildEventParams{
+		ID:         value_objects.NewID(),
+		ProjectID:  f.projectID,
+		EventType:  buildDomain.EventTypeBuildCompleted,
+		Status:     status,
+		Branch:     "main",
+		CommitSHA:  "0123456789abcdef",
+		AuthorName: "alice",
+		BuildURL:   "https://github.com/org/repo/actions/runs/1",
+		CreatedAt:  value_objects.NewTimestampFromTime(finishedAt.Add(-escalationTestRunTime)),
+		UpdatedAt:  value_objects.NewTimestampFromTime(finishedAt),
+	})
+}
+
+func (f *escalationFixture) evaluate(t *testing.T) {
+	t.Helper()
+	require.NoError(t, f.service.EvaluateEscalations(context.Background(), 10))
+}
+
+func TestEvaluateEscalations_SendsDueStepOnce(t *testing.T) {
+	f := newEscalationFixture()
+	f.createPolicy(t, 15, 60)
+	first := f.build(buildDomain.BuildStatusFailed, 20)
+	latest := f.build(buildDomain.BuildStatusFailed, 5)
+	f.builds.history = []*buildDomain.BuildEvent{latest, first, f.build(buildDomain.BuildStatusSuccess, 30)}
+
+	f.evaluate(t)
+	f.evaluate(t)
+
+	require.Len(t, f.sender.telegram, 1)
+	assert.Contains(t, f.sender.telegram[0], "Escalation 1/2")
+	assert.Contains(t, f.sender.telegram[0], "failing for 20m (2 failed builds)")
+	assert.Contains(t, f.sender.telegram[0], "<code>0123456</code> by alice")
+	assert.Equal(t, domain.NewTelegramEscalationKeyboard(latest.ID(), latest.BuildURL()), f.sender.keyboards[0])
+	assert.Equal(t, []string{"oncall@example.com"}, f.sender.emailedTo)
+	assert.Contains(t, f.sender.subjects[0], "[ESCALATION]")
+
+	require.Len(t, f.escalations.escalations, 1)
+	escalation := f.escalations.escalations[0]
+	assert.Equal(t, first.ID(), escalation.BuildEventID())
+	assert.Equal(t, 1, escalation.Step())
+}
+
+func TestEvaluateEscalations_RejectsNonPositiveBatchSize(t *testing.T) {
+	f := newEscalationFixture()
+	f.createPolicy(t, 15)
+
+	for _, batchSize := range []int{0, -1} {
+		err := f.service.EvaluateEscalations(context.Background(), batchSize)
+		assert.ErrorIs(t, err, domain.ErrInvalidEscalationBatchSize)
+	}
+	assert.Empty(t, f.sender.telegram)
+}
+
+func TestEvaluateEscalations_SendsOnlyLatestDueStep(t *testing.T) {
+	f := newEscalationFixture()
+	f.createPolicy(t, 15, 60)
+	f.builds.history = []*buildDomain.BuildEvent{f.build(buildDomain.BuildStatusFailed, 120)}
+
+	f.evaluate(t)
+
+	require.Len(t, f.sender.telegram, 1)
+	assert.Contains(t, f.sender.telegram[0], "Escalation 2/2")
+	assert.Equal(t, 2, f.escalations.escalations[0].Step())
+}
+
+func TestEvaluateEscalations_WaitsForFirstStep(t *testing.T) {
+	f := newEscalationFixture()
+	f.createPolicy(t, 15)
+	// The build started long before the first step is due, it has only been failing since it finished
+	failed := f.build(buildDomain.BuildStatusFailed, 5)
+	f.builds.history = []*buildDomain.BuildEvent{failed}
+
+	f.evaluate(t)
+
+	assert.Empty(t, f.sender.telegram)
+	require.Len(t, f.escalations.escalations, 1)
+	assert.Equal(t, 0, f.escalations.escalations[0].Step())
+	assert.Equal(t, failed.UpdatedAt().ToTime(), f.escalations.escalations[0].FailingSince())
+}
+
+func TestEvaluateEscalations_SuccessfulBuildResolvesEscalation(t *testing.T) {
+	f := newEscalationFixture()
+	f.createPolicy(t, 15, 60)
+	failed := f.build(buildDomain.BuildStatusFailed, 20)
+	f.builds.history = []*buildDomain.BuildEvent{failed}
+	f.evaluate(t)
+
+	f.builds.history = []*buildDomain.BuildEvent{f.build(buildDomain.BuildStatusSuccess, 0), failed}
+	f.evaluate(t)
+
+	require.Len(t, f.escalations.escalations, 1)
+	assert.True(t, f.escalations.escalations[0].IsResolved())
+	assert.Len(t, f.sender.telegram, 1)
+
+	open, err := f.service.GetOpenEscalations(context.Background(), f.projectID)
+	require.NoError(t, err)
+	assert.Empty(t, open)
+}
+
+func TestEvaluateEscalations_NewFailureStartsNewEscalation(t *testing.T) {
+	f := newEscalationFixture()
+	f.createPolicy(t, 15)
+	earlier := f.build(buildDomain.BuildStatusFailed, 60)
+	f.builds.history = []*buildDomain.BuildEvent{earlier}
+	f.evaluate(t)
+
+	// The branch was fixed and broke again in between evaluations
+	broken := f.build(buildDomain.BuildStatusFailed, 20)
+	f.builds.history = []*buildDomain.BuildEvent{broken, f.build(buildDomain.BuildStatusSuccess, 30), earlier}
+	f.evaluate(t)
+
+	require.Len(t, f.escalations.escalations, 2)
+	assert.True(t, f.escalations.escalations[0].IsResolved())
+	assert.Equal(t, broken.ID(), f.escalations.escalations[1].BuildEventID())
+	assert.Len(t, f.sender.telegram, 2)
+}
+
+func TestAcknowledgeEscalations_StopsEscalating(t *testing.T) {
+	f := newEscalationFixture()
+	f.createPolicy(t, 15, 60)
+	f.builds.history = []*buildDomain.BuildEvent{f.build(buildDomain.BuildStatusFailed, 20)}
+
+	// Acknowledging before the job first runs keeps every step from being sent
+	acknowledged, err := f.service.AcknowledgeEscalations(context.Background(), f.projectID, "main", "@alice")
+	require.NoError(t, err)
+	require.Len(t, acknowledged, 1)
+	assert.Equal(t, "@alice", acknowledged[0].AcknowledgedBy())
+
+	f.evaluate(t)
+
+	assert.Empty(t, f.sender.telegram)
+	assert.Empty(t, f.sender.emailedTo)
+	assert.Len(t, f.escalations.escalations, 1)
+}
+
+func TestAcknowledgeEscalations_IgnoresOtherBranchesAndPassingBranches(t *testing.T) {
+	f := newEscalationFixture()
+	f.createPolicy(t, 15)
+	f.builds.history = []*buildDomain.BuildEvent{f.build(buildDomain.BuildStatusFailed, 5)}
+
+	acknowledged, err := f.service.AcknowledgeEscalations(context.Background(), f.projectID, "develop", "@alice")
+	require.NoError(t, err)
+	assert.Empty(t, acknowledged)
+
+	f.builds.history = []*buildDomain.BuildEvent{f.build(buildDomain.BuildStatusSuccess, 5)}
+	acknowledged, err = f.service.AcknowledgeEscalations(context.Background(), f.projectID, "", "@alice")
+	require.NoError(t, err)
+	assert.Empty(t, acknowledged)
+}
+
+func TestEvaluateEscalations_RetriesStepWhenNothingWasSent(t *testing.T) {
+	f := newEscalationFixture()
+	f.createPolicy(t, 15)
+	f.builds.history = []*buildDomain.BuildEvent{f.build(buildDomain.BuildStatusFailed, 20)}
+	f.sender.err = errors.New("telegram unavailable")
+
+	err := f.service.EvaluateEscalations(context.Background(), 10)
+
+	assert.Error(t, err)
+	assert.Equal(t, 0, f.escalations.escalations[0].Step())
+
+	f.sender.err = nil
+	f.evaluate(t)
+
+	assert.Len(t, f.sender.telegram, 1)
+	assert.Equal(t, 1, f.escalations.escalations[0].Step())
+}
+
+func TestUpdateEscalationPolicy(t *testing.T) {
+	f := newEscalationFixture()
+	policy := f.createPolicy(t, 15)
+	f.builds.history = []*buildDomain.BuildEvent{f.build(buildDomain.BuildStatusFailed, 5)}
+	f.evaluate(t)
+
+	noChat := int64(0)
+	inactive := false
+	updated, err := f.service.UpdateEscalationPolicy(context.Background(), policy.ID(), dto.UpdateEscalationPolicyRequest{
+		EscalateAfterMinutes: []int{60, 30},
+		TelegramChatID:       &noChat,
+		IsActive:             &inactive,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []time.Duration{30 * time.Minute, time.Hour}, updated.Steps())
+	assert.Nil(t, updated.TelegramChatID())
+	assert.Equal(t, []string{"oncall@example.com"}, updated.EmailRecipients())
+	// Deactivating the policy resolves the failure it was tracking
+	assert.True(t, f.escalations.escalations[0].IsResolved())
+
+	isEscalationChat, err := f.service.IsEscalationChat(context.Background(), f.projectID, escalationChatID)
+	require.NoError(t, err)
+	assert.False(t, isEscalationChat)
+
+	emptyRecipients := []string{}
+	_, err = f.service.UpdateEscalationPolicy(context.Background(), policy.ID(), dto.UpdateEscalationPolicyRequest{
+		EmailRecipients: &emptyRecipients,
+	})
+	assert.Error(t, err, "a policy needs an escalation chat or email recipient")
+}
@@ -0,0 +1,300 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/repository/memory"
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/log"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newCommitBuildEvent creates a build event of the project for commit abc1234def
+func newCommitBuildEvent(t *testing.T, projectID value_objects.ID, eventType buildDomain.EventType, status buildDomain.BuildStatus) *buildDomain.BuildEvent {
+	buildEvent, err := buildDomain.NewBuildEvent(buildDomain.BuildEventParams{
+		ProjectID: projectID,
+		EventType: eventType,
+		Status:    status,
+		Branch:    "main",
+		CommitSHA: "abc1234def",
+	})
+	require.NoError(t, err)
+	return buildEvent
+}
+
+func TestCreateNotificationForBuildEvent_DefersNotificationsForTheCoalescingWindow(t *testing.T) {
+	projectID := value_objects.NewID()
+	buildEvent := newCommitBuildEvent(t, projectID, buildDomain.EventTypePush, buildDomain.BuildStatusSuccess)
+
+	subscription := domain.RestoreTelegramSubscription(domain.RestoreTelegramSubscriptionParams{
+		ID:        value_objects.NewID(),
+		ProjectID: projectID,
+		ChatID:    int64(123456789),
+		IsActive:  true,
+		CreatedAt: value_objects.NewTimestamp(),
+		UpdatedAt: value_objects.NewTimestamp(),
+	})
+	mockLogRepo := mocks.NewNotificationLogRepository(t)
+	mockSubRepo := mocks.NewTelegramSubscriptionRepository(t)
+	mockSubRepo.On("GetActiveSubscriptionsByProject", mock.Anything, projectID).
+		Return([]*domain.TelegramSubscription{subscription}, nil)
+	mockLogRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.NotificationLog")).Return(nil).Once()
+	mockLogRepo.On("GetByBuildEventID", mock.Anything, buildEvent.ID()).Return([]*domain.NotificationLog{}, nil).Once()
+	queueRepo := memory.NewInMemoryDeliveryQueueRepository()
+
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:         mockLogRepo,
+		TelegramSubscriptionRepo: mockSubRepo,
+		BuildEventRepo:           &stubBuildEventRepo{buildEvent: buildEvent},
+		DeliveryQueueRepo:        queueRepo,
+		CoalesceWindow:           30 * time.Second,
+		Logger:                   newDeadLetterTestLogger(),
+	})
+
+	notifications, err := service.CreateNotificationForBuildEvent(context.Background(), buildEvent.ID(), projectID, "Pushed abc1234")
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+
+	assert.True(t, notifications[0].IsDeferred())
+	queued, err := queueRepo.GetPendingNotifications(context.Background(), 10)
+	require.NoError(t, err)
+	require.Len(t, queued, 1)
+	assert.WithinDuration(t, time.Now().Add(30*time.Second), queued[0].ScheduledAt, 5*time.Second)
+}
+
+func TestSendDeferredNotifications_SendsOneMessagePerCommit(t *testing.T) {
+	projectID := value_objects.NewID()
+	push := newCommitBuildEvent(t, projectID, buildDomain.EventTypePush, buildDomain.BuildStatusSuccess)
+	run := newCommitBuildEvent(t, projectID, buildDomain.EventTypeBuildCompleted, buildDomain.BuildStatusSuccess)
+
+	queueRepo := memory.NewInMemoryDeliveryQueueRepository()
+	mockLogRepo := mocks.NewNotificationLogRepository(t)
+
+	var logs []*domain.NotificationLog
+	for i, deferred := range []struct {
+		buildEventID value_objects.ID
+		message      string
+	}{
+		{buildEventID: push.ID(), message: "Pushed abc1234"},
+		{buildEventID: run.ID(), message: "Workflow requested"},
+		{buildEventID: run.ID(), message: "Workflow passed"},
+	} {
+		notification, err := domain.NewNotificationLog(deferred.buildEventID, projectID,
+			domain.NotificationChannelTelegram, "123456789", deferred.message, 3)
+		require.NoError(t, err)
+		require.NoError(t, notification.Defer())
+		logs = append(logs, notification)
+		mockLogRepo.On("GetByID", mock.Anything, notification.ID()).Return(notification, nil)
+
+		queued := domain.NewQueuedNotification(notification.ID(), notification.Channel(), notification.Recipient(),
+			notification.Message(), "", 0, notification.MaxRetries())
+		queued.Kind = domain.QueueKindDeferred
+		queued.ScheduledAt = time.Now().Add(time.Duration(i-3) * time.Minute)
+		require.NoError(t, queueRepo.Create(context.Background(), queued))
+	}
+
+	// The superseded notifications are cancelled once and then no longer deferred
+	mockLogRepo.On("GetDeferredByCommit", mock.Anything, projectID, "abc1234def", domain.NotificationChannelTelegram, "123456789").
+		Return(func(context.Context, value_objects.ID, string, domain.NotificationChannel, string) []*domain.NotificationLog {
+			var deferred []*domain.NotificationLog
+			for _, notification := range logs {
+				if notification.IsDeferred() {
+					deferred = append(deferred, notification)
+				}
+			}
+			return deferred
+		}, nil)
+	mockLogRepo.On("GetByBuildEventID", mock.Anything, mock.AnythingOfType("value_objects.ID")).
+		Return(func(_ context.Context, buildEventID value_objects.ID) []*domain.NotificationLog {
+			var ofBuild []*domain.NotificationLog
+			for _, notification := range logs {
+				if notification.BuildEventID() == buildEventID {
+					ofBuild = append(ofBuild, notification)
+				}
+			}
+			return ofBuild
+		}, nil)
+	mockLogRepo.On("Update", mock.Anything, mock.AnythingOfType("*domain.NotificationLog")).Return(nil)
+
+	telegramSender := &recordingTelegramSender{}
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:   mockLogRepo,
+		NotificationSender: telegramSender,
+		BuildEventRepo: &digestBuildEventRepo{buildEvents: map[value_objects.ID]*buildDomain.BuildEvent{
+			push.ID(): push,
+			run.ID():  run,
+		}},
+		DeliveryQueueRepo: queueRepo,
+		CoalesceWindow:    30 * time.Second,
+		Logger:            newDeadLetterTestLogger(),
+	})
+
+	require.NoError(t, service.SendDeferredNotifications(context.Background(), 10))
+
+	assert.Equal(t, []string{"Pushed abc1234\n\nWorkflow passed"}, telegramSender.sent)
+	assert.Equal(t, domain.NotificationStatusCancelled, logs[0].Status())
+	assert.Equal(t, domain.NotificationStatusCancelled, logs[1].Status())
+	assert.Equal(t, domain.NotificationStatusSent, logs[2].Status())
+
+	remaining, err := queueRepo.GetPendingNotifications(context.Background(), 10)
+	require.NoError(t, err)
+	assert.Empty(t, remaining)
+}
+
+func TestCreateNotificationForBuildEvent_SendsFailuresWithoutCoalescing(t *testing.T) {
+	projectID := value_objects.NewID()
+	buildEvent := newCommitBuildEvent(t, projectID, buildDomain.EventTypeBuildCompleted, buildDomain.BuildStatusFailed)
+
+	subscription := domain.RestoreTelegramSubscription(domain.RestoreTelegramSubscriptionParams{
+		ID:        value_objects.NewID(),
+		ProjectID: projectID,
+		ChatID:    int64(123456789),
+		IsActive:  true,
+		CreatedAt: value_objects.NewTimestamp(),
+		UpdatedAt: value_objects.NewTimestamp(),
+	})
+	mockLogRepo := mocks.NewNotificationLogRepository(t)
+	mockSubRepo := mocks.NewTelegramSubscriptionRepository(t)
+	mockSubRepo.On("GetActiveSubscriptionsByProject", mock.Anything, projectID).
+		Return([]*domain.TelegramSubscription{subscription}, nil)
+	mockLogRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.NotificationLog")).Return(nil).Once()
+	mockLogRepo.On("GetByBuildEventID", mock.Anything, buildEvent.ID()).Return([]*domain.NotificationLog{}, nil).Once()
+	queueRepo := memory.NewInMemoryDeliveryQueueRepository()
+
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:         mockLogRepo,
+		TelegramSubscriptionRepo: mockSubRepo,
+		BuildEventRepo:           &stubBuildEventRepo{buildEvent: buildEvent},
+		DeliveryQueueRepo:        queueRepo,
+		CoalesceWindow:           30 * time.Second,
+		Logger:                   newDeadLetterTestLogger(),
+	})
+
+	notifications, err := service.CreateNotificationForBuildEvent(context.Background(), buildEvent.ID(), projectID, "Build failed")
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+
+	assert.Equal(t, domain.NotificationStatusPending, notifications[0].Status())
+	queued, err := queueRepo.GetPendingNotifications(context.Background(), 10)
+	require.NoError(t, err)
+	assert.Empty(t, queued)
+}
+
+// recordingLogRepo mocks the notification log repository on top of the logs it records as created
+func recordingLogRepo(t *testing.T, logs *[]*domain.NotificationLog) *mocks.NotificationLogRepository {
+	mockLogRepo := mocks.NewNotificationLogRepository(t)
+	mockLogRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.NotificationLog")).
+		Run(func(args mock.Arguments) { *logs = append(*logs, args.Get(1).(*domain.NotificationLog)) }).
+		Return(nil)
+	mockLogRepo.On("GetByID", mock.Anything, mock.AnythingOfType("value_objects.ID")).
+		Return(func(_ context.Context, id value_objects.ID) *domain.NotificationLog {
+			for _, notification := range *logs {
+				if notification.ID() == id {
+					return notification
+				}
+			}
+			return nil
+		}, nil)
+	mockLogRepo.On("GetByBuildEventID", mock.Anything, mock.AnythingOfType("value_objects.ID")).
+		Return(func(context.Context, value_objects.ID) []*domain.NotificationLog { return *logs }, nil)
+	mockLogRepo.On("Update", mock.Anything, mock.AnythingOfType("*domain.NotificationLog")).Return(nil)
+	return mockLogRepo
+}
+
+func TestSendDeferredNotifications_SkipsStartedRunThatFailedSince(t *testing.T) {
+	projectID := value_objects.NewID()
+	run := newCommitBuildEvent(t, projectID, buildDomain.EventTypeBuildStarted, buildDomain.BuildStatusInProgress)
+
+	subscription := domain.RestoreTelegramSubscription(domain.RestoreTelegramSubscriptionParams{
+		ID:        value_objects.NewID(),
+		ProjectID: projectID,
+		ChatID:    int64(123456789),
+		IsActive:  true,
+		CreatedAt: value_objects.NewTimestamp(),
+		UpdatedAt: value_objects.NewTimestamp(),
+	})
+	mockSubRepo := mocks.NewTelegramSubscriptionRepository(t)
+	mockSubRepo.On("GetActiveSubscriptionsByProject", mock.Anything, projectID).
+		Return([]*domain.TelegramSubscription{subscription}, nil)
+
+	var logs []*domain.NotificationLog
+	queueRepo := memory.NewInMemoryDeliveryQueueRepository()
+	telegramSender := &recordingTelegramSender{}
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:         recordingLogRepo(t, &logs),
+		TelegramSubscriptionRepo: mockSubRepo,
+		NotificationSender:       telegramSender,
+		BuildEventRepo:           &stubBuildEventRepo{buildEvent: run},
+		DeliveryQueueRepo:        queueRepo,
+		CoalesceWindow:           30 * time.Second,
+		EditTelegramMessages:     true,
+		Logger:                   newDeadLetterTestLogger(),
+	})
+	ctx := context.Background()
+
+	// The started run waits for the coalescing window
+	started, err := service.CreateNotificationForBuildEvent(ctx, run.ID(), projectID, "Build Started")
+	require.NoError(t, err)
+	require.Len(t, started, 1)
+	require.True(t, started[0].IsDeferred())
+
+	// Its failure is sent right away
+	run.UpdateStatus(buildDomain.BuildStatusFailed)
+	failed, err := service.CreateNotificationForBuildEvent(ctx, run.ID(), projectID, "Build Failed")
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	require.NoError(t, service.SendNotification(ctx, failed[0].ID()))
+
+	// Once the window closes, the failure is neither resent nor edited back to the started run
+	queued, err := queueRepo.GetPendingNotifications(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, queued, 1)
+	queued[0].ScheduledAt = time.Now().Add(-time.Second)
+	require.NoError(t, service.SendDeferredNotifications(ctx, 10))
+
+	assert.Equal(t, []string{"Build Failed"}, telegramSender.sent)
+	assert.Empty(t, telegramSender.edited)
+	assert.Equal(t, domain.NotificationStatusCancelled, started[0].Status())
+	assert.Equal(t, domain.NotificationStatusSent, failed[0].Status())
+}
+
+func TestSendDeferredNotifications_CancelsNotificationSupersededWhileDeferred(t *testing.T) {
+	buildEventID, projectID := value_objects.NewID(), value_objects.NewID()
+
+	started, err := domain.NewNotificationLog(buildEventID, projectID, domain.NotificationChannelTelegram, "123456789", "Build Started", 3)
+	require.NoError(t, err)
+	require.NoError(t, started.Defer())
+	failed, err := domain.NewNotificationLog(buildEventID, projectID, domain.NotificationChannelTelegram, "123456789", "Build Failed", 3)
+	require.NoError(t, err)
+	messageID := "4711"
+	require.NoError(t, failed.MarkAsSent(&messageID))
+
+	logs := []*domain.NotificationLog{started, failed}
+	queueRepo := memory.NewInMemoryDeliveryQueueRepository()
+	queued := domain.NewQueuedNotification(started.ID(), started.Channel(), started.Recipient(), started.Message(), "", 0, 3)
+	queued.Kind = domain.QueueKindDeferred
+	queued.ScheduledAt = time.Now().Add(-time.Second)
+	require.NoError(t, queueRepo.Create(context.Background(), queued))
+
+	telegramSender := &recordingTelegramSender{}
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:     recordingLogRepo(t, &logs),
+		NotificationSender:   telegramSender,
+		DeliveryQueueRepo:    queueRepo,
+		EditTelegramMessages: true,
+		Logger:               newDeadLetterTestLogger(),
+	})
+
+	require.NoError(t, service.SendDeferredNotifications(context.Background(), 10))
+
+	assert.Empty(t, telegramSender.sent)
+	assert.Empty(t, telegramSender.edited)
+	assert.Equal(t, domain.NotificationStatusCancelled, started.Status())
+}
@@ -0,0 +1,115 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/log"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateNotificationForBuildEvent_HoldsNotificationsOfDigestSubscriptions(t *testing.T) {
+	projectID := value_objects.NewID()
+	mockLogRepo := mocks.NewNotificationLogRepository(t)
+	mockSubRepo := mocks.NewTelegramSubscriptionRepository(t)
+
+	subscription := domain.RestoreTelegramSubscription(domain.RestoreTelegramSubscriptionParams{
+		ID:         value_objects.NewID(),
+		ProjectID:  projectID,
+		ChatID:     int64(123456789),
+		IsActive:   true,
+		DigestMode: domain.DigestModeHourly,
+		CreatedAt:  value_objects.NewTimestamp(),
+		UpdatedAt:  value_objects.NewTimestamp(),
+	})
+	mockSubRepo.On("GetActiveSubscriptionsByProject", mock.Anything, projectID).
+		Return([]*domain.TelegramSubscription{subscription}, nil)
+	mockLogRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.NotificationLog")).Return(nil).Once()
+
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:         mockLogRepo,
+		TelegramSubscriptionRepo: mockSubRepo,
+		Logger:                   newDeadLetterTestLogger(),
+	})
+
+	notifications, err := service.CreateNotificationForBuildEvent(context.Background(), value_objects.NewID(), projectID, "Build passed")
+
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	assert.True(t, notifications[0].IsBatched())
+	require.NotNil(t, notifications[0].DigestAt())
+	assert.True(t, notifications[0].DigestAt().After(time.Now()))
+	assert.WithinDuration(t, time.Now(), *notifications[0].DigestAt(), time.Hour)
+
+	// Batched notifications are only sent with their digest
+	mockLogRepo.On("GetByID", mock.Anything, notifications[0].ID()).Return(notifications[0], nil).Once()
+	require.NoError(t, service.SendNotification(context.Background(), notifications[0].ID()))
+}
+
+func TestSendDueDigests_SendsOneTelegramMessagePerChat(t *testing.T) {
+	projectID := value_objects.NewID()
+	windowEnd := time.Date(2024, 3, 9, 15, 0, 0, 0, time.UTC)
+
+	var logs []*domain.NotificationLog
+	buildEvents := make(map[value_objects.ID]*buildDomain.BuildEvent)
+	for _, status := range []buildDomain.BuildStatus{buildDomain.BuildStatusSuccess, buildDomain.BuildStatusFailed} {
+		buildEvent, err := buildDomain.NewBuildEvent(buildDomain.BuildEventParams{
+			ProjectID: projectID,
+			EventType: buildDomain.EventTypeBuildCompleted,
+			Status:    status,
+			Branch:    "main",
+			CommitSHA: "abc1234def",
+		})
+		require.NoError(t, err)
+		buildEvents[buildEvent.ID()] = buildEvent
+
+		notification, err := domain.NewNotificationLog(buildEvent.ID(), projectID, domain.NotificationChannelTelegram, "123456789", "Build", 3)
+		require.NoError(t, err)
+		require.NoError(t, notification.HoldForDigest(windowEnd))
+		logs = append(logs, notification)
+	}
+
+	mockLogRepo := mocks.NewNotificationLogRepository(t)
+	mockLogRepo.On("GetDueDigestNotifications", mock.Anything, mock.AnythingOfType("time.Time"), 100).Return(logs, nil).Once()
+	mockLogRepo.On("Update", mock.Anything, mock.AnythingOfType("*domain.NotificationLog")).Return(nil).Twice()
+
+	telegramSender := &recordingTelegramSender{}
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:   mockLogRepo,
+		NotificationSender: telegramSender,
+		BuildEventRepo:     &digestBuildEventRepo{buildEvents: buildEvents},
+		Logger:             newDeadLetterTestLogger(),
+	})
+
+	require.NoError(t, service.SendDueDigests(context.Background(), 100))
+
+	require.Len(t, telegramSender.sent, 1)
+	assert.Contains(t, telegramSender.sent[0], "✅ 1 passed · ❌ 1 failed")
+	assert.Contains(t, telegramSender.sent[0], "Failed build abc1234")
+	for _, notification := range logs {
+		assert.Equal(t, domain.NotificationStatusSent, notification.Status())
+		require.NotNil(t, notification.MessageID())
+		assert.Equal(t, "5000", *notification.MessageID())
+	}
+}
+
+// digestBuildEventRepo resolves the build events summarized in digests
+type digestBuildEventRepo struct {
+	stubBuildEventRepo
+	buildEvents map[value_objects.ID]*buildDomain.BuildEvent
+}
+
+func (r *digestBuildEventRepo) GetByID(ctx context.Context, id value_objects.ID) (*buildDomain.BuildEvent, error) {
+	buildEvent, ok := r.buildEvents[id]
+	if !ok {
+		return nil, buildDomain.ErrBuildEventNotFound
+	}
+	return buildEvent, nil
+}
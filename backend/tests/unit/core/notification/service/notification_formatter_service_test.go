@@ -295,17 +295,22 @@ func TestNotificationFormatterServiceGetAvailableTemplateVariables(t *testing.T)
 		{
 			name:         "build_success_variables",
 			templateType: domain.TemplateTypeBuildSuccess,
-			expected:     []string{"ProjectName", "BuildStatus", "BuildBranch", "BuildCommit", "BuildDuration", "BuildURL", "Timestamp"},
+			expected:     []string{"ProjectName", "BuildStatus", "BuildBranch", "BuildCommit", "BuildAuthor", "BuildDuration", "BuildURL", "Timestamp", "EventName"},
 		},
 		{
 			name:         "build_failure_variables",
 			templateType: domain.TemplateTypeBuildFailure,
-			expected:     []string{"ProjectName", "BuildStatus", "BuildBranch", "BuildCommit", "BuildDuration", "BuildURL", "ErrorMessage", "Timestamp"},
+			expected:     []string{"ProjectName", "BuildStatus", "BuildBranch", "BuildCommit", "BuildAuthor", "BuildDuration", "BuildURL", "ErrorMessage", "Timestamp", "EventName"},
 		},
 		{
 			name:         "deployment_variables",
 			templateType: domain.TemplateTypeDeployment,
-			expected:     []string{"ProjectName", "BuildStatus", "BuildBranch", "BuildCommit", "BuildDuration", "BuildURL", "Environment", "Timestamp"},
+			expected:     []string{"ProjectName", "BuildStatus", "BuildBranch", "BuildCommit", "BuildAuthor", "BuildDuration", "BuildURL", "Environment", "Timestamp"},
+		},
+		{
+			name:         "release_variables",
+			templateType: domain.TemplateTypeRelease,
+			expected:     []string{"ProjectName", "BuildAuthor", "BuildURL", "Title", "Tag", "Changelog", "Timestamp"},
 		},
 	}
 
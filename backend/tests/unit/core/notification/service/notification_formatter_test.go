@@ -190,7 +190,7 @@ func TestGetAvailableTemplateVariables(t *testing.T) {
 	})
 
 	variables := formatterService.GetAvailableTemplateVariables(domain.TemplateTypeBuildSuccess)
-	expected := []string{"ProjectName", "BuildStatus", "BuildBranch", "BuildCommit", "BuildDuration", "BuildURL", "Timestamp"}
+	expected := []string{"ProjectName", "BuildStatus", "BuildBranch", "BuildCommit", "BuildAuthor", "BuildDuration", "BuildURL", "Timestamp", "EventName"}
 
 	assert.ElementsMatch(t, expected, variables)
 
@@ -4,6 +4,8 @@ import (
 	"context"
 	"testing"
 
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	buildPort "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/port"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/log"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
@@ -14,6 +16,19 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// stubBuildEventRepo resolves the build event matched against subscription filters
+type stubBuildEventRepo struct {
+	buildPort.BuildEventRepository
+	buildEvent *buildDomain.BuildEvent
+}
+
+func (r *stubBuildEventRepo) GetByID(ctx context.Context, id value_objects.ID) (*buildDomain.BuildEvent, error) {
+	if r.buildEvent == nil || r.buildEvent.ID() != id {
+		return nil, buildDomain.ErrBuildEventNotFound
+	}
+	return r.buildEvent, nil
+}
+
 func TestCreateNotificationForBuildEvent(t *testing.T) {
 	buildEventID := value_objects.NewID()
 	projectID := value_objects.NewID()
@@ -177,25 +192,34 @@ func TestNotificationFiltering(t *testing.T) {
 		logger := logrus.New()
 		logger.SetLevel(logrus.FatalLevel)
 
+		buildEvent, err := buildDomain.NewBuildEvent(buildDomain.BuildEventParams{
+			ProjectID: projectID,
+			EventType: buildDomain.EventTypeBuildCompleted,
+			Status:    buildDomain.BuildStatusSuccess,
+			Branch:    "main",
+		})
+		require.NoError(t, err)
+
 		service := log.NewNotificationLogService(log.Dep{
 			NotificationRepo:         mockLogRepo,
 			TelegramSubscriptionRepo: mockSubRepo,
+			BuildEventRepo:           &stubBuildEventRepo{buildEvent: buildEvent},
 			Logger:                   logger,
 		})
 
 		// Create subscriptions with different event type preferences
 		subscriptions := []*domain.TelegramSubscription{
-			// This subscription should receive build_success notifications
+			// This subscription should receive build_completed notifications
 			domain.RestoreTelegramSubscription(domain.RestoreTelegramSubscriptionParams{
 				ID:         value_objects.NewID(),
 				ProjectID:  projectID,
 				ChatID:     int64(123456789),
-				EventTypes: []string{"build_success", "build_failure"},
+				EventTypes: []string{"build_started", "build_completed"},
 				IsActive:   true,
 				CreatedAt:  value_objects.NewTimestamp(),
 				UpdatedAt:  value_objects.NewTimestamp(),
 			}),
-			// This subscription should NOT receive build_success notifications
+			// This subscription should NOT receive build_completed notifications
 			domain.RestoreTelegramSubscription(domain.RestoreTelegramSubscriptionParams{
 				ID:         value_objects.NewID(),
 				ProjectID:  projectID,
@@ -210,23 +234,106 @@ func TestNotificationFiltering(t *testing.T) {
 		mockSubRepo.On("GetActiveSubscriptionsByProject", mock.Anything, projectID).
 			Return(subscriptions, nil)
 
-		// For this test, we'll need a method that filters by event type
 		// Only the first subscription should create a notification
 		mockLogRepo.On("Create", mock.Anything, mock.AnythingOfType(notificationLogType)).
-			Return(nil).Times(2) // Currently creates notifications for all active subscriptions
+			Return(nil).Once()
 
-		// This test would require extending the service to accept event type parameter
-		// For now, let's test the current behavior
-		result, err := service.CreateNotificationForBuildEvent(context.Background(), buildEventID, projectID, message)
+		result, err := service.CreateNotificationForBuildEvent(context.Background(), buildEvent.ID(), projectID, message)
 
 		require.NoError(t, err)
-		// Currently creates notifications for all active subscriptions
-		assert.Len(t, result, 2)
+		require.Len(t, result, 1)
+		assert.Equal(t, "123456789", result[0].Recipient())
 
 		mockSubRepo.AssertExpectations(t)
 		mockLogRepo.AssertExpectations(t)
 	})
 
+	t.Run("filter by subscription preferences - statuses and branches", func(t *testing.T) {
+		mockLogRepo := mocks.NewNotificationLogRepository(t)
+		mockSubRepo := mocks.NewTelegramSubscriptionRepository(t)
+		logger := logrus.New()
+		logger.SetLevel(logrus.FatalLevel)
+
+		buildEvent, err := buildDomain.NewBuildEvent(buildDomain.BuildEventParams{
+			ProjectID: projectID,
+			EventType: buildDomain.EventTypePush,
+			Status:    buildDomain.BuildStatusFailed,
+			Branch:    "feature/login",
+		})
+		require.NoError(t, err)
+
+		service := log.NewNotificationLogService(log.Dep{
+			NotificationRepo:         mockLogRepo,
+			TelegramSubscriptionRepo: mockSubRepo,
+			BuildEventRepo:           &stubBuildEventRepo{buildEvent: buildEvent},
+			Logger:                   logger,
+		})
+
+		newSubscription := func(chatID int64, statuses, branchPatterns []string) *domain.TelegramSubscription {
+			return domain.RestoreTelegramSubscription(domain.RestoreTelegramSubscriptionParams{
+				ID:             value_objects.NewID(),
+				ProjectID:      projectID,
+				ChatID:         chatID,
+				Statuses:       statuses,
+				BranchPatterns: branchPatterns,
+				IsActive:       true,
+				CreatedAt:      value_objects.NewTimestamp(),
+				UpdatedAt:      value_objects.NewTimestamp(),
+			})
+		}
+
+		mockSubRepo.On("GetActiveSubscriptionsByProject", mock.Anything, projectID).
+			Return([]*domain.TelegramSubscription{
+				newSubscription(111, []string{"failed"}, nil),                    // failures of any branch
+				newSubscription(222, []string{"failed"}, []string{"main"}),       // failures of main only
+				newSubscription(333, nil, []string{"feature/*"}),                 // anything on feature branches
+				newSubscription(444, []string{"success"}, []string{"feature/*"}), // successes only
+			}, nil)
+		mockLogRepo.On("Create", mock.Anything, mock.AnythingOfType(notificationLogType)).
+			Return(nil).Twice()
+
+		result, err := service.CreateNotificationForBuildEvent(context.Background(), buildEvent.ID(), projectID, message)
+
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, "111", result[0].Recipient())
+		assert.Equal(t, "333", result[1].Recipient())
+	})
+
+	t.Run("notify filtered subscriptions when the build event is unknown", func(t *testing.T) {
+		mockLogRepo := mocks.NewNotificationLogRepository(t)
+		mockSubRepo := mocks.NewTelegramSubscriptionRepository(t)
+		logger := logrus.New()
+		logger.SetLevel(logrus.FatalLevel)
+
+		service := log.NewNotificationLogService(log.Dep{
+			NotificationRepo:         mockLogRepo,
+			TelegramSubscriptionRepo: mockSubRepo,
+			BuildEventRepo:           &stubBuildEventRepo{},
+			Logger:                   logger,
+		})
+
+		mockSubRepo.On("GetActiveSubscriptionsByProject", mock.Anything, projectID).
+			Return([]*domain.TelegramSubscription{
+				domain.RestoreTelegramSubscription(domain.RestoreTelegramSubscriptionParams{
+					ID:        value_objects.NewID(),
+					ProjectID: projectID,
+					ChatID:    int64(123456789),
+					Statuses:  []string{"failed"},
+					IsActive:  true,
+					CreatedAt: value_objects.NewTimestamp(),
+					UpdatedAt: value_objects.NewTimestamp(),
+				}),
+			}, nil)
+		mockLogRepo.On("Create", mock.Anything, mock.AnythingOfType(notificationLogType)).
+			Return(nil).Once()
+
+		result, err := service.CreateNotificationForBuildEvent(context.Background(), buildEventID, projectID, message)
+
+		require.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
 	t.Run("filter inactive subscriptions", func(t *testing.T) {
 		mockLogRepo := mocks.NewNotificationLogRepository(t)
 		mockSubRepo := mocks.NewTelegramSubscriptionRepository(t)
@@ -0,0 +1,185 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/adapter/repository/memory"
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/log"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// quietSubscription returns a chat subscription whose quiet hours started an hour ago and end in an hour
+func quietSubscription(t *testing.T, projectID value_objects.ID, bypass domain.SubscriptionFilter) *domain.TelegramSubscription {
+	now := time.Now().UTC()
+	quietHours, err := domain.NewQuietHours("UTC",
+		now.Add(-time.Hour).Format("15:04"),
+		now.Add(time.Hour).Format("15:04"),
+		bypass,
+	)
+	require.NoError(t, err)
+
+	return domain.RestoreTelegramSubscription(domain.RestoreTelegramSubscriptionParams{
+		ID:         value_objects.NewID(),
+		ProjectID:  projectID,
+		ChatID:     int64(123456789),
+		IsActive:   true,
+		QuietHours: quietHours,
+		CreatedAt:  value_objects.NewTimestamp(),
+		UpdatedAt:  value_objects.NewTimestamp(),
+	})
+}
+
+// createQuietHoursNotification fans a failed build on main out to a chat in its quiet hours
+func createQuietHoursNotification(t *testing.T, bypass domain.SubscriptionFilter) (*domain.NotificationLog, []*domain.QueuedNotification) {
+	projectID := value_objects.NewID()
+	buildEvent, err := buildDomain.NewBuildEvent(buildDomain.BuildEventParams{
+		ProjectID: projectID,
+		EventType: buildDomain.EventTypeBuildCompleted,
+		Status:    buildDomain.BuildStatusFailed,
+		Branch:    "main",
+	})
+	require.NoError(t, err)
+
+	mockLogRepo := mocks.NewNotificationLogRepository(t)
+	mockSubRepo := mocks.NewTelegramSubscriptionRepository(t)
+	mockSubRepo.On("GetActiveSubscriptionsByProject", mock.Anything, projectID).
+		Return([]*domain.TelegramSubscription{quietSubscription(t, projectID, bypass)}, nil)
+	mockLogRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.NotificationLog")).Return(nil).Once()
+	mockLogRepo.On("GetByBuildEventID", mock.Anything, buildEvent.ID()).Return([]*domain.NotificationLog{}, nil).Once()
+	queueRepo := memory.NewInMemoryDeliveryQueueRepository()
+
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:         mockLogRepo,
+		TelegramSubscriptionRepo: mockSubRepo,
+		BuildEventRepo:           &stubBuildEventRepo{buildEvent: buildEvent},
+		DeliveryQueueRepo:        queueRepo,
+		Logger:                   newDeadLetterTestLogger(),
+	})
+
+	notifications, err := service.CreateNotificationForBuildEvent(context.Background(), buildEvent.ID(), projectID, "Build failed")
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+
+	queued, err := queueRepo.GetPendingNotifications(context.Background(), 10)
+	require.NoError(t, err)
+	return notifications[0], queued
+}
+
+func TestCreateNotificationForBuildEvent_DefersNotificationsDuringQuietHours(t *testing.T) {
+	notification, queued := createQuietHoursNotification(t, domain.SubscriptionFilter{})
+
+	assert.True(t, notification.IsDeferred())
+	require.Len(t, queued, 1)
+	assert.Equal(t, notification.ID(), queued[0].NotificationID)
+	assert.Equal(t, domain.QueueKindDeferred, queued[0].Kind)
+	assert.True(t, queued[0].ScheduledAt.After(time.Now()))
+	assert.WithinDuration(t, time.Now().Add(time.Hour), queued[0].ScheduledAt, time.Minute)
+}
+
+func TestCreateNotificationForBuildEvent_BypassesQuietHours(t *testing.T) {
+	bypass, err := domain.NewSubscriptionFilter(nil, []string{"failed"}, []string{"main"})
+	require.NoError(t, err)
+
+	notification, queued := createQuietHoursNotification(t, bypass)
+
+	assert.Equal(t, domain.NotificationStatusPending, notification.Status())
+	assert.Empty(t, queued)
+}
+
+func TestSendDeferredNotifications_SendsNotificationsWhoseQuietHoursEnded(t *testing.T) {
+	notification, err := domain.NewNotificationLog(value_objects.NewID(), value_objects.NewID(),
+		domain.NotificationChannelTelegram, "123456789", "Build failed", 3)
+	require.NoError(t, err)
+	require.NoError(t, notification.Defer())
+
+	queueRepo := memory.NewInMemoryDeliveryQueueRepository()
+	queued := domain.NewQueuedNotification(notification.ID(), notification.Channel(), notification.Recipient(),
+		notification.Message(), "", 0, notification.MaxRetries())
+	queued.Kind = domain.QueueKindDeferred
+	queued.ScheduledAt = time.Now().Add(-time.Minute)
+	require.NoError(t, queueRepo.Create(context.Background(), queued))
+
+	// Deliveries of the delivery service share the queue and are left to it
+	delivery := domain.NewQueuedNotification(value_objects.NewID(), domain.NotificationChannelTeams,
+		"https://example.com/webhook", "Build failed", "", 0, 3)
+	require.NoError(t, queueRepo.Create(context.Background(), delivery))
+
+	mockLogRepo := mocks.NewNotificationLogRepository(t)
+	mockLogRepo.On("GetByID", mock.Anything, notification.ID()).Return(notification, nil)
+	mockLogRepo.On("GetByBuildEventID", mock.Anything, notification.BuildEventID()).
+		Return([]*domain.NotificationLog{notification}, nil).Once()
+	mockLogRepo.On("Update", mock.Anything, notification).Return(nil).Twice()
+
+	telegramSender := &recordingTelegramSender{}
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:   mockLogRepo,
+		NotificationSender: telegramSender,
+		DeliveryQueueRepo:  queueRepo,
+		Logger:             newDeadLetterTestLogger(),
+	})
+
+	require.NoError(t, service.SendDeferredNotifications(context.Background(), 10))
+
+	assert.Equal(t, []string{"Build failed"}, telegramSender.sent)
+	assert.Equal(t, domain.NotificationStatusSent, notification.Status())
+
+	delivered, err := queueRepo.GetByID(context.Background(), queued.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DeliveryStatusDelivered, delivered.Status)
+	assert.Equal(t, domain.DeliveryStatusPending, delivery.Status)
+}
+
+func TestCreateChannelNotificationsForBuildEvent_DefersWebhookSubscriptionsDuringQuietHours(t *testing.T) {
+	projectID := value_objects.NewID()
+	buildEvent, err := buildDomain.NewBuildEvent(buildDomain.BuildEventParams{
+		ProjectID: projectID,
+		EventType: buildDomain.EventTypeBuildCompleted,
+		Status:    buildDomain.BuildStatusSuccess,
+		Branch:    "feature/login",
+	})
+	require.NoError(t, err)
+
+	subscription, err := domain.NewWebhookSubscription(projectID, "https://hooks.example.com/builds", "0123456789abcdef", "")
+	require.NoError(t, err)
+	subscription.UpdateQuietHours(quietSubscription(t, projectID, domain.SubscriptionFilter{}).QuietHours())
+
+	mockLogRepo := mocks.NewNotificationLogRepository(t)
+	mockLogRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.NotificationLog")).Return(nil).Once()
+	mockLogRepo.On("GetByBuildEventID", mock.Anything, buildEvent.ID()).Return([]*domain.NotificationLog{}, nil).Once()
+	mockWebhookRepo := mocks.NewWebhookSubscriptionRepository(t)
+	mockWebhookRepo.On("GetByID", mock.Anything, subscription.ID()).Return(subscription, nil).Once()
+	queueRepo := memory.NewInMemoryDeliveryQueueRepository()
+
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:        mockLogRepo,
+		WebhookSubscriptionRepo: mockWebhookRepo,
+		BuildEventRepo:          &stubBuildEventRepo{buildEvent: buildEvent},
+		DeliveryQueueRepo:       queueRepo,
+		Logger:                  newDeadLetterTestLogger(),
+	})
+
+	channelService, ok := service.(port.ChannelNotificationService)
+	require.True(t, ok)
+
+	notifications, err := channelService.CreateChannelNotificationsForBuildEvent(context.Background(), buildEvent.ID(), projectID,
+		domain.NotificationChannelWebhook, []string{subscription.ID().String()}, "", `{"event":"build.succeeded"}`)
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	assert.True(t, notifications[0].IsDeferred())
+
+	queued, err := queueRepo.GetPendingNotifications(context.Background(), 10)
+	require.NoError(t, err)
+	require.Len(t, queued, 1)
+	assert.Equal(t, notifications[0].ID(), queued[0].NotificationID)
+	assert.Equal(t, domain.QueueKindDeferred, queued[0].Kind)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), queued[0].ScheduledAt, time.Minute)
+}
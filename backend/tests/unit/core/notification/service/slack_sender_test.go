@@ -0,0 +1,131 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/sender"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const slackTestText = "🚨 *Build Failed*\n\n*Project:* api\n*Branch:* main\n\n<https://ci.example.com/runs/42|View Build>"
+
+// slackTestMessage is the stored message of a failed build notified to Slack
+func slackTestMessage(t *testing.T) string {
+	message, err := domain.NewChannelMessage(slackTestText, buildDomain.BuildStatusFailed, domain.TemplateParams{
+		ProjectName: "api",
+		BuildBranch: "main",
+	}).Encode()
+	require.NoError(t, err)
+	return message
+}
+
+func newSlackSender(cfg sender.SlackConfig) port.NotificationSender {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	return sender.NewNotificationSenderService(sender.Dep{
+		SlackConfig: cfg,
+		Logger:      logger,
+	})
+}
+
+func TestSendSlackNotification_PostsMessageWithBotToken(t *testing.T) {
+	var authorization string
+	var payload domain.SlackMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/chat.postMessage", r.URL.Path)
+		authorization = r.Header.Get("Authorization")
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		_, _ = w.Write([]byte(`{"ok":true,"channel":"C123","ts":"1700000000.000100"}`))
+	}))
+	defer server.Close()
+
+	slackSender := newSlackSender(sender.SlackConfig{BotToken: "xoxb-test", APIBaseURL: server.URL})
+
+	messageID, err := slackSender.SendSlackNotification(context.Background(), "#builds", slackTestMessage(t))
+	require.NoError(t, err)
+	assert.Equal(t, "1700000000.000100", messageID)
+	assert.Equal(t, "Bearer xoxb-test", authorization)
+
+	assert.Equal(t, "#builds", payload.Channel)
+	assert.Equal(t, "🚨 Build Failed", payload.Text)
+	require.Len(t, payload.Attachments, 1)
+	assert.Equal(t, domain.SlackColorFailure, payload.Attachments[0].Color)
+	require.Len(t, payload.Attachments[0].Blocks, 3)
+	assert.Equal(t, "section", payload.Attachments[0].Blocks[1].Type)
+	assert.Equal(t, "mrkdwn", payload.Attachments[0].Blocks[1].Text.Type)
+	assert.Equal(t, "*Project:* api\n*Branch:* main", payload.Attachments[0].Blocks[1].Text.Text)
+}
+
+func TestSendSlackNotification_PostsToIncomingWebhook(t *testing.T) {
+	var payload domain.SlackMessage
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	// Route the default transport to the TLS test server's trusted certificate
+	defaultTransport := http.DefaultTransport
+	http.DefaultTransport = server.Client().Transport
+	defer func() { http.DefaultTransport = defaultTransport }()
+
+	slackSender := newSlackSender(sender.SlackConfig{BotToken: "xoxb-test"})
+
+	// Messages not created for a build event have no status, whatever emoji they contain
+	messageID, err := slackSender.SendSlackNotification(context.Background(), server.URL+"/services/T000/B000/XXX",
+		"✅ *Test notification*\n\n*Project:* api")
+	require.NoError(t, err)
+	assert.Empty(t, messageID)
+	assert.Empty(t, payload.Channel)
+	assert.Equal(t, "✅ Test notification", payload.Text)
+	require.Len(t, payload.Attachments, 1)
+	assert.Equal(t, domain.SlackColorNeutral, payload.Attachments[0].Color)
+}
+
+func TestSendSlackNotification_Failures(t *testing.T) {
+	t.Run("slack API error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"ok":false,"error":"channel_not_found"}`))
+		}))
+		defer server.Close()
+
+		slackSender := newSlackSender(sender.SlackConfig{BotToken: "xoxb-test", APIBaseURL: server.URL})
+
+		_, err := slackSender.SendSlackNotification(context.Background(), "#missing", slackTestMessage(t))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "channel_not_found")
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		slackSender := newSlackSender(sender.SlackConfig{BotToken: "xoxb-test", APIBaseURL: server.URL})
+
+		_, err := slackSender.SendSlackNotification(context.Background(), "#builds", slackTestMessage(t))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "retry after 30s")
+	})
+
+	t.Run("missing configuration", func(t *testing.T) {
+		slackSender := newSlackSender(sender.SlackConfig{})
+
+		_, err := slackSender.SendSlackNotification(context.Background(), "#builds", slackTestMessage(t))
+		require.Error(t, err)
+	})
+}
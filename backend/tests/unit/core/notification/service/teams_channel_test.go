@@ -0,0 +1,170 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/log"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/sender"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/tests/mocks"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// teamsTestMessage is the stored message of a failed build notified to Teams
+func teamsTestMessage(t *testing.T) string {
+	message, err := domain.NewChannelMessage("❌ Build failed!", buildDomain.BuildStatusFailed, domain.TemplateParams{
+		ProjectName: "api",
+		BuildBranch: "main",
+		BuildURL:    "https://ci.example.com/runs/42",
+	}).Encode()
+	require.NoError(t, err)
+	return message
+}
+
+// newTeamsTestServer starts a TLS server standing in for a Teams webhook and routes the default transport to it
+func newTeamsTestServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewTLSServer(handler)
+	t.Cleanup(server.Close)
+
+	defaultTransport := http.DefaultTransport
+	http.DefaultTransport = server.Client().Transport
+	t.Cleanup(func() { http.DefaultTransport = defaultTransport })
+
+	return server
+}
+
+func newTeamsChannel() port.DeliveryChannel {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return sender.NewTeamsChannel(sender.TeamsConfig{}, logger)
+}
+
+func TestTeamsChannel_PostsAdaptiveCard(t *testing.T) {
+	var payload domain.TeamsMessage
+	server := newTeamsTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	channel := newTeamsChannel()
+	messageID, err := channel.Send(context.Background(), server.URL+"/workflows/builds", "🚨 Build Failed: api", teamsTestMessage(t))
+	require.NoError(t, err)
+	assert.Empty(t, messageID)
+
+	assert.Equal(t, domain.TeamsMessageType, payload.Type)
+	require.Len(t, payload.Attachments, 1)
+	assert.Equal(t, domain.AdaptiveCardContentType, payload.Attachments[0].ContentType)
+
+	card := payload.Attachments[0].Content
+	assert.Equal(t, "AdaptiveCard", card.Type)
+	require.Len(t, card.Body, 3)
+	assert.Equal(t, "🚨 Build Failed: api", card.Body[0].Text)
+	assert.Equal(t, domain.AdaptiveColorAttention, card.Body[0].Color)
+	assert.Equal(t, "❌ Build failed!", card.Body[1].Text)
+	assert.Equal(t, "FactSet", card.Body[2].Type)
+	assert.Equal(t, []domain.AdaptiveFact{{Title: "Project", Value: "api"}, {Title: "Branch", Value: "main"}}, card.Body[2].Facts)
+	assert.Equal(t, []domain.AdaptiveAction{{Type: "Action.OpenUrl", Title: "View Build", URL: "https://ci.example.com/runs/42"}}, card.Actions)
+}
+
+func TestTeamsChannel_PostsPlainTextMessage(t *testing.T) {
+	var payload domain.TeamsMessage
+	server := newTeamsTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	// Messages not created for a build event keep the default title color and have no facts or buttons
+	_, err := newTeamsChannel().Send(context.Background(), server.URL, "✅ Test notification", "Project: api\n\n[View Build](https://ci.example.com/runs/42)")
+	require.NoError(t, err)
+
+	card := payload.Attachments[0].Content
+	require.Len(t, card.Body, 3)
+	assert.Equal(t, domain.AdaptiveColorDefault, card.Body[0].Color)
+	assert.Equal(t, "Project: api", card.Body[1].Text)
+	assert.Equal(t, "[View Build](https://ci.example.com/runs/42)", card.Body[2].Text)
+	assert.Empty(t, card.Actions)
+}
+
+func TestTeamsChannel_Failures(t *testing.T) {
+	t.Run("rejected by legacy incoming webhook", func(t *testing.T) {
+		server := newTeamsTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("Webhook message delivery failed with error: Microsoft Teams endpoint returned HTTP error 413"))
+		})
+
+		_, err := newTeamsChannel().Send(context.Background(), server.URL, "Build Started", "Project: api")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "HTTP error 413")
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		server := newTeamsTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "10")
+			w.WriteHeader(http.StatusTooManyRequests)
+		})
+
+		_, err := newTeamsChannel().Send(context.Background(), server.URL, "Build Started", "Project: api")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "retry after 10s")
+	})
+
+	t.Run("plain http webhook URL", func(t *testing.T) {
+		_, err := newTeamsChannel().Send(context.Background(), "http://example.com/webhook", "Build Started", "Project: api")
+		require.Error(t, err)
+	})
+}
+
+func TestTeamsChannel_DeliveryChannelInfo(t *testing.T) {
+	channel := newTeamsChannel()
+
+	maxRequests, window := channel.GetRateLimitInfo()
+	rule := domain.DefaultRateLimitRules()[domain.NotificationChannelTeams]
+	assert.Equal(t, domain.NotificationChannelTeams, channel.GetChannelType())
+	assert.Equal(t, 3, channel.GetMaxRetries())
+	assert.Equal(t, rule.MaxRequests, maxRequests)
+	assert.Equal(t, rule.WindowSize, window)
+	assert.True(t, channel.IsAvailable(context.Background()))
+}
+
+func TestSendNotificationDeliversTeamsThroughDeliveryChannel(t *testing.T) {
+	var payload domain.TeamsMessage
+	server := newTeamsTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	mockLogRepo := mocks.NewNotificationLogRepository(t)
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:   mockLogRepo,
+		NotificationSender: &stubTelegramSender{},
+		DeliveryChannels:   []port.DeliveryChannel{newTeamsChannel()},
+		Logger:             newDeadLetterTestLogger(),
+	})
+
+	notification, err := domain.NewNotificationLog(value_objects.NewID(), value_objects.NewID(),
+		domain.NotificationChannelTeams, server.URL, teamsTestMessage(t), 3)
+	require.NoError(t, err)
+	notification.SetSubject("🚨 Build Failed: api")
+
+	mockLogRepo.On("GetByID", mock.Anything, notification.ID()).Return(notification, nil)
+	mockLogRepo.On("Update", mock.Anything, notification).Return(nil).Once()
+
+	err = service.SendNotification(context.Background(), notification.ID())
+	require.NoError(t, err)
+	assert.Equal(t, domain.NotificationStatusSent, notification.Status())
+	require.Len(t, payload.Attachments, 1)
+	assert.Equal(t, "🚨 Build Failed: api", payload.Attachments[0].Content.Body[0].Text)
+}
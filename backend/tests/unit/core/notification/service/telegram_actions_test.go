@@ -0,0 +1,81 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/log"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendNotification_AttachesTelegramActionButtons(t *testing.T) {
+	_, succeeded := newRunTelegramLogs(t)
+	telegramSender := &recordingTelegramSender{}
+	mockLogRepo := newTelegramEditRepo(t, succeeded)
+
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:     mockLogRepo,
+		NotificationSender:   telegramSender,
+		EditTelegramMessages: true,
+		TelegramActions:      true,
+		Logger:               newDeadLetterTestLogger(),
+	})
+
+	require.NoError(t, service.SendNotification(context.Background(), succeeded.ID()))
+	require.Len(t, telegramSender.keyboards, 1)
+	assert.Equal(t, domain.NewTelegramBuildKeyboard(succeeded.BuildEventID(), ""), telegramSender.keyboards[0])
+}
+
+func TestSendNotification_SendsTelegramWithoutButtonsByDefault(t *testing.T) {
+	_, succeeded := newRunTelegramLogs(t)
+	telegramSender := &recordingTelegramSender{}
+	mockLogRepo := newTelegramEditRepo(t, succeeded)
+
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:     mockLogRepo,
+		NotificationSender:   telegramSender,
+		EditTelegramMessages: true,
+		Logger:               newDeadLetterTestLogger(),
+	})
+
+	require.NoError(t, service.SendNotification(context.Background(), succeeded.ID()))
+	require.Len(t, telegramSender.keyboards, 1)
+	assert.Nil(t, telegramSender.keyboards[0])
+}
+
+func TestCreateNotificationForBuildEvent_SkipsMutedChats(t *testing.T) {
+	buildEventID, projectID := value_objects.NewID(), value_objects.NewID()
+
+	listening, err := domain.NewTelegramSubscription(projectID, 111)
+	require.NoError(t, err)
+	muted, err := domain.NewTelegramSubscription(projectID, 222)
+	require.NoError(t, err)
+	muted.MuteUntil(time.Now().Add(domain.TelegramMuteDuration))
+
+	mockSubscriptionRepo := mocks.NewTelegramSubscriptionRepository(t)
+	mockSubscriptionRepo.On("GetActiveSubscriptionsByProject", mock.Anything, projectID).
+		Return([]*domain.TelegramSubscription{listening, muted}, nil)
+
+	mockLogRepo := mocks.NewNotificationLogRepository(t)
+	mockLogRepo.On("Create", mock.Anything, mock.MatchedBy(func(log *domain.NotificationLog) bool {
+		return log.Recipient() == "111"
+	})).Return(nil).Once()
+
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:         mockLogRepo,
+		TelegramSubscriptionRepo: mockSubscriptionRepo,
+		Logger:                   newDeadLetterTestLogger(),
+	})
+
+	notifications, err := service.CreateNotificationForBuildEvent(context.Background(), buildEventID, projectID, "Build failed")
+
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	assert.Equal(t, "111", notifications[0].Recipient())
+}
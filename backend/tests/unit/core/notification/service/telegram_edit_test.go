@@ -0,0 +1,126 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/log"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingTelegramSender records telegram sends and edits
+type recordingTelegramSender struct {
+	stubTelegramSender
+	editErr   error
+	sent      []string
+	edited    []string
+	keyboards []*domain.TelegramInlineKeyboard
+}
+
+func (s *recordingTelegramSender) SendTelegramNotification(
+	ctx context.Context,
+	chatID int64,
+	message string,
+	keyboard *domain.TelegramInlineKeyboard,
+) (string, error) {
+	s.sent = append(s.sent, message)
+	s.keyboards = append(s.keyboards, keyboard)
+	return "5000", nil
+}
+
+func (s *recordingTelegramSender) EditTelegramNotification(
+	ctx context.Context,
+	chatID int64,
+	messageID, message string,
+	keyboard *domain.TelegramInlineKeyboard,
+) error {
+	s.edited = append(s.edited, messageID+":"+message)
+	s.keyboards = append(s.keyboards, keyboard)
+	return s.editErr
+}
+
+// newRunTelegramLogs returns a sent "running" notification and a pending "succeeded" one for the same run and chat
+func newRunTelegramLogs(t *testing.T) (*domain.NotificationLog, *domain.NotificationLog) {
+	buildEventID, projectID := value_objects.NewID(), value_objects.NewID()
+
+	running, err := domain.NewNotificationLog(buildEventID, projectID, domain.NotificationChannelTelegram, "123456789", "Build running", 3)
+	require.NoError(t, err)
+	messageID := "4711"
+	require.NoError(t, running.MarkAsSent(&messageID))
+
+	succeeded, err := domain.NewNotificationLog(buildEventID, projectID, domain.NotificationChannelTelegram, "123456789", "Build succeeded", 3)
+	require.NoError(t, err)
+
+	return running, succeeded
+}
+
+// newTelegramEditRepo mocks the notification log repository for sending the last of the logs
+func newTelegramEditRepo(t *testing.T, logs ...*domain.NotificationLog) *mocks.NotificationLogRepository {
+	mockLogRepo := mocks.NewNotificationLogRepository(t)
+	current := logs[len(logs)-1]
+	mockLogRepo.On("GetByID", mock.Anything, current.ID()).Return(current, nil)
+	mockLogRepo.On("GetByBuildEventID", mock.Anything, current.BuildEventID()).Return(logs, nil)
+	mockLogRepo.On("Update", mock.Anything, current).Return(nil).Once()
+	return mockLogRepo
+}
+
+func TestSendNotification_EditsTelegramMessageOfRun(t *testing.T) {
+	running, succeeded := newRunTelegramLogs(t)
+	telegramSender := &recordingTelegramSender{}
+	mockLogRepo := newTelegramEditRepo(t, running, succeeded)
+
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:     mockLogRepo,
+		NotificationSender:   telegramSender,
+		EditTelegramMessages: true,
+		Logger:               newDeadLetterTestLogger(),
+	})
+
+	require.NoError(t, service.SendNotification(context.Background(), succeeded.ID()))
+	assert.Equal(t, []string{"4711:Build succeeded"}, telegramSender.edited)
+	assert.Empty(t, telegramSender.sent)
+	require.NotNil(t, succeeded.MessageID())
+	assert.Equal(t, "4711", *succeeded.MessageID())
+}
+
+func TestSendNotification_SendsNewTelegramMessageWhenEditFails(t *testing.T) {
+	running, succeeded := newRunTelegramLogs(t)
+	telegramSender := &recordingTelegramSender{editErr: &domain.TelegramAPIError{
+		Method: "editMessageText", ErrorCode: 400, Description: "Bad Request: message to edit not found",
+	}}
+	mockLogRepo := newTelegramEditRepo(t, running, succeeded)
+
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:     mockLogRepo,
+		NotificationSender:   telegramSender,
+		EditTelegramMessages: true,
+		Logger:               newDeadLetterTestLogger(),
+	})
+
+	require.NoError(t, service.SendNotification(context.Background(), succeeded.ID()))
+	assert.Len(t, telegramSender.edited, 1)
+	assert.Equal(t, []string{"Build succeeded"}, telegramSender.sent)
+	assert.Equal(t, "5000", *succeeded.MessageID())
+}
+
+func TestSendNotification_SendsFirstTelegramMessageOfRun(t *testing.T) {
+	_, succeeded := newRunTelegramLogs(t)
+	telegramSender := &recordingTelegramSender{}
+	mockLogRepo := newTelegramEditRepo(t, succeeded)
+
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:     mockLogRepo,
+		NotificationSender:   telegramSender,
+		EditTelegramMessages: true,
+		Logger:               newDeadLetterTestLogger(),
+	})
+
+	require.NoError(t, service.SendNotification(context.Background(), succeeded.ID()))
+	assert.Empty(t, telegramSender.edited)
+	assert.Equal(t, []string{"Build succeeded"}, telegramSender.sent)
+}
@@ -0,0 +1,129 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/sender"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const telegramTestToken = "123456:test-token"
+
+func newTelegramSender(t *testing.T, handler http.HandlerFunc) port.NotificationSender {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return sender.NewNotificationSenderService(sender.Dep{
+		TelegramBotToken:   telegramTestToken,
+		TelegramAPIBaseURL: server.URL,
+		Logger:             newDeadLetterTestLogger(),
+	})
+}
+
+func TestSendTelegramNotification_ReturnsMessageID(t *testing.T) {
+	var payload map[string]interface{}
+	telegramSender := newTelegramSender(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/bot"+telegramTestToken+"/sendMessage", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"message_id":4711,"chat":{"id":-100123},"date":1700000000,"text":"Build failed"}}`))
+	})
+
+	messageID, err := telegramSender.SendTelegramNotification(context.Background(), -100123, "<b>Build \"failed\"</b>\nmain", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "4711", messageID)
+	assert.Equal(t, float64(-100123), payload["chat_id"])
+	assert.Equal(t, "<b>Build \"failed\"</b>\nmain", payload["text"])
+	assert.Equal(t, "HTML", payload["parse_mode"])
+	assert.NotContains(t, payload, "reply_markup")
+}
+
+func TestSendTelegramNotification_SendsInlineKeyboard(t *testing.T) {
+	var payload struct {
+		ReplyMarkup domain.TelegramInlineKeyboard `json:"reply_markup"`
+	}
+	telegramSender := newTelegramSender(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"message_id":4711,"chat":{"id":42},"date":1700000000}}`))
+	})
+
+	keyboard := domain.NewTelegramBuildKeyboard(value_objects.NewID(), "https://ci.example.com/runs/1")
+	_, err := telegramSender.SendTelegramNotification(context.Background(), 42, "Build failed", keyboard)
+	require.NoError(t, err)
+	assert.Equal(t, *keyboard, payload.ReplyMarkup)
+}
+
+func TestSendTelegramNotification_SurfacesAPIErrors(t *testing.T) {
+	telegramSender := newTelegramSender(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`))
+	})
+
+	_, err := telegramSender.SendTelegramNotification(context.Background(), 42, "Build failed", nil)
+
+	var apiErr *domain.TelegramAPIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 400, apiErr.ErrorCode)
+	assert.Equal(t, "Bad Request: chat not found", apiErr.Description)
+	assert.True(t, apiErr.IsPermanent())
+	assert.NotContains(t, err.Error(), telegramTestToken)
+}
+
+func TestSendTelegramNotification_ReportsFloodLimit(t *testing.T) {
+	telegramSender := newTelegramSender(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 7","parameters":{"retry_after":7}}`))
+	})
+
+	_, err := telegramSender.SendTelegramNotification(context.Background(), 42, "Build failed", nil)
+
+	var apiErr *domain.TelegramAPIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 7*time.Second, apiErr.RetryAfter)
+	assert.False(t, apiErr.IsPermanent())
+}
+
+func TestSendTelegramNotification_HandlesNonJSONErrors(t *testing.T) {
+	telegramSender := newTelegramSender(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "upstream unavailable", http.StatusBadGateway)
+	})
+
+	_, err := telegramSender.SendTelegramNotification(context.Background(), 42, "Build failed", nil)
+
+	var apiErr *domain.TelegramAPIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusBadGateway, apiErr.ErrorCode)
+	assert.False(t, apiErr.IsPermanent())
+}
+
+func TestEditTelegramNotification_EditsMessage(t *testing.T) {
+	var payload map[string]interface{}
+	telegramSender := newTelegramSender(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/bot"+telegramTestToken+"/editMessageText", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"message_id":4711,"chat":{"id":42},"date":1700000000}}`))
+	})
+
+	require.NoError(t, telegramSender.EditTelegramNotification(context.Background(), 42, "4711", "Build succeeded", nil))
+	assert.Equal(t, float64(42), payload["chat_id"])
+	assert.Equal(t, float64(4711), payload["message_id"])
+	assert.Equal(t, "Build succeeded", payload["text"])
+}
+
+func TestEditTelegramNotification_IgnoresUnchangedText(t *testing.T) {
+	telegramSender := newTelegramSender(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: message is not modified: specified new message content and reply markup are exactly the same"}`))
+	})
+
+	assert.NoError(t, telegramSender.EditTelegramNotification(context.Background(), 42, "4711", "Build succeeded", nil))
+}
@@ -81,7 +81,7 @@ func (suite *TelegramSubscriptionServiceTestSuite) TestCreateTelegramSubscriptio
 			service, mockRepo := suite.helpers.CreateTelegramSubscriptionService()
 			tt.setupMocks(mockRepo)
 
-			result, err := service.CreateTelegramSubscription(context.Background(), suite.projectID, suite.chatID1)
+			result, err := service.CreateTelegramSubscription(context.Background(), suite.projectID, suite.chatID1, domain.SubscriptionFilter{}, "", domain.QuietHours{}, false)
 
 			if tt.shouldSucceed {
 				require.NoError(suite.T(), err)
@@ -204,7 +204,7 @@ func (suite *TelegramSubscriptionServiceTestSuite) TestUpdateTelegramSubscriptio
 			service, mockRepo := suite.helpers.CreateTelegramSubscriptionService()
 			tt.setupMocks(mockRepo)
 
-			result, err := service.UpdateTelegramSubscription(context.Background(), subscriptionID, &newChatID, &isActive)
+			result, err := service.UpdateTelegramSubscription(context.Background(), subscriptionID, &newChatID, &isActive, nil, nil, nil, nil)
 
 			if tt.shouldSucceed {
 				require.NoError(suite.T(), err)
@@ -0,0 +1,124 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/log"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// stubBuildTransitions classifies every build event with the same transition and counts the classifications
+type stubBuildTransitions struct {
+	transition buildDomain.BuildTransition
+	ok         bool
+	err        error
+	calls      int
+}
+
+func (s *stubBuildTransitions) GetBuildTransition(ctx context.Context, buildEventID value_objects.ID) (buildDomain.BuildTransition, bool, error) {
+	s.calls++
+	return s.transition, s.ok, s.err
+}
+
+// notifyTransitionsOnlyChats fans a build event out to a chat getting every notification
+// and two chats getting transitions only, returning the notified chat IDs
+func notifyTransitionsOnlyChats(t *testing.T, transitions *stubBuildTransitions) []string {
+	projectID := value_objects.NewID()
+	newSubscription := func(chatID int64, transitionsOnly bool) *domain.TelegramSubscription {
+		return domain.RestoreTelegramSubscription(domain.RestoreTelegramSubscriptionParams{
+			ID:              value_objects.NewID(),
+			ProjectID:       projectID,
+			ChatID:          chatID,
+			IsActive:        true,
+			TransitionsOnly: transitionsOnly,
+			CreatedAt:       value_objects.NewTimestamp(),
+			UpdatedAt:       value_objects.NewTimestamp(),
+		})
+	}
+
+	mockLogRepo := mocks.NewNotificationLogRepository(t)
+	mockSubRepo := mocks.NewTelegramSubscriptionRepository(t)
+	mockSubRepo.On("GetActiveSubscriptionsByProject", mock.Anything, projectID).Return([]*domain.TelegramSubscription{
+		newSubscription(111, false),
+		newSubscription(222, true),
+		newSubscription(333, true),
+	}, nil)
+	mockLogRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.NotificationLog")).Return(nil).Maybe()
+
+	dep := log.Dep{
+		NotificationRepo:         mockLogRepo,
+		TelegramSubscriptionRepo: mockSubRepo,
+		Logger:                   newDeadLetterTestLogger(),
+	}
+	if transitions != nil {
+		dep.BuildTransitions = transitions
+	}
+	service := log.NewNotificationLogService(dep)
+
+	notifications, err := service.CreateNotificationForBuildEvent(context.Background(), value_objects.NewID(), projectID, "Build failed")
+	require.NoError(t, err)
+
+	var recipients []string
+	for _, notification := range notifications {
+		recipients = append(recipients, notification.Recipient())
+	}
+	return recipients
+}
+
+func TestCreateNotificationForBuildEvent_TransitionsOnly(t *testing.T) {
+	tests := []struct {
+		name               string
+		transitions        *stubBuildTransitions
+		expectedRecipients []string
+	}{
+		{
+			name:               "should notify transitions only chats about a new failure",
+			transitions:        &stubBuildTransitions{transition: buildDomain.BuildTransitionNewFailure, ok: true},
+			expectedRecipients: []string{"111", "222", "333"},
+		},
+		{
+			name:               "should notify transitions only chats about a fixed build",
+			transitions:        &stubBuildTransitions{transition: buildDomain.BuildTransitionFixed, ok: true},
+			expectedRecipients: []string{"111", "222", "333"},
+		},
+		{
+			name:               "should skip transitions only chats while a build is still failing",
+			transitions:        &stubBuildTransitions{transition: buildDomain.BuildTransitionStillFailing, ok: true},
+			expectedRecipients: []string{"111"},
+		},
+		{
+			name:               "should skip transitions only chats for events that are not completed builds",
+			transitions:        &stubBuildTransitions{},
+			expectedRecipients: []string{"111"},
+		},
+		{
+			name:               "should notify transitions only chats when the transition cannot be classified",
+			transitions:        &stubBuildTransitions{err: errors.New("database unavailable")},
+			expectedRecipients: []string{"111", "222", "333"},
+		},
+		{
+			name:               "should notify transitions only chats without a build transition service",
+			expectedRecipients: []string{"111", "222", "333"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipients := notifyTransitionsOnlyChats(t, tt.transitions)
+
+			assert.Equal(t, tt.expectedRecipients, recipients)
+			if tt.transitions != nil {
+				// The build is classified once per fan-out
+				assert.Equal(t, 1, tt.transitions.calls)
+			}
+		})
+	}
+}
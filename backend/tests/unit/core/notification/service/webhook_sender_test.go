@@ -0,0 +1,150 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/log"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/service/sender"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/crypto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const webhookTestSecret = "whsec_0123456789abcdef"
+
+// recordingWebhookSender records webhook deliveries and fails the other channels
+type recordingWebhookSender struct {
+	stubTelegramSender
+	deliveries []domain.WebhookDelivery
+}
+
+func (s *recordingWebhookSender) SendWebhookNotification(ctx context.Context, delivery domain.WebhookDelivery) error {
+	s.deliveries = append(s.deliveries, delivery)
+	return nil
+}
+
+func newWebhookNotificationLog(t *testing.T, recipient string) *domain.NotificationLog {
+	message, err := domain.NewOutgoingWebhookEvent(domain.WebhookEventBuildSucceeded, time.Now(), domain.WebhookEventData{
+		Project: domain.WebhookProject{ID: value_objects.NewID().String(), Name: "api"},
+		Build:   &domain.WebhookBuild{ID: "build-1", Status: "success"},
+	}).Encode()
+	require.NoError(t, err)
+
+	notification, err := domain.NewNotificationLog(value_objects.NewID(), value_objects.NewID(), domain.NotificationChannelWebhook, recipient, message, 3)
+	require.NoError(t, err)
+	return notification
+}
+
+func TestSendWebhookNotification_SignsDelivery(t *testing.T) {
+	var headers http.Header
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header.Clone()
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	webhookSender := sender.NewNotificationSenderService(sender.Dep{Logger: newDeadLetterTestLogger()})
+
+	err := webhookSender.SendWebhookNotification(context.Background(), domain.WebhookDelivery{
+		ID:          "delivery-1",
+		URL:         server.URL,
+		Secret:      webhookTestSecret,
+		EventType:   domain.WebhookEventBuildFailed,
+		ContentType: domain.WebhookContentTypeCloudEvents,
+		Body:        []byte(`{"type":"build.failed"}`),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, `{"type":"build.failed"}`, string(body))
+	assert.Equal(t, domain.WebhookContentTypeCloudEvents, headers.Get("Content-Type"))
+	assert.Equal(t, "delivery-1", headers.Get(domain.WebhookHeaderDelivery))
+	assert.Equal(t, domain.WebhookEventBuildFailed, headers.Get(domain.WebhookHeaderEvent))
+
+	timestamp, err := strconv.ParseInt(headers.Get(domain.WebhookHeaderTimestamp), 10, 64)
+	require.NoError(t, err)
+	assert.True(t, crypto.VerifyOutgoingWebhook(webhookTestSecret, headers.Get(domain.WebhookHeaderSignature), timestamp, body))
+}
+
+func TestSendWebhookNotification_FailsOnErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get(domain.WebhookHeaderSignature))
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	webhookSender := sender.NewNotificationSenderService(sender.Dep{Logger: newDeadLetterTestLogger()})
+
+	err := webhookSender.SendWebhookNotification(context.Background(), domain.WebhookDelivery{
+		URL:  server.URL,
+		Body: []byte(`{}`),
+	})
+	assert.ErrorContains(t, err, "502")
+}
+
+func TestSendNotification_DeliversToWebhookSubscription(t *testing.T) {
+	subscription, err := domain.NewWebhookSubscription(value_objects.NewID(), "https://hooks.example.com/ci", webhookTestSecret, domain.WebhookPayloadFormatCloudEvents)
+	require.NoError(t, err)
+
+	notification := newWebhookNotificationLog(t, subscription.ID().String())
+
+	mockLogRepo := mocks.NewNotificationLogRepository(t)
+	mockSubscriptionRepo := mocks.NewWebhookSubscriptionRepository(t)
+	webhookSender := &recordingWebhookSender{}
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:        mockLogRepo,
+		NotificationSender:      webhookSender,
+		WebhookSubscriptionRepo: mockSubscriptionRepo,
+		Logger:                  newDeadLetterTestLogger(),
+	})
+
+	mockLogRepo.On("GetByID", mock.Anything, notification.ID()).Return(notification, nil)
+	mockLogRepo.On("Update", mock.Anything, notification).Return(nil).Once()
+	mockSubscriptionRepo.On("GetByID", mock.Anything, subscription.ID()).Return(subscription, nil).Once()
+
+	require.NoError(t, service.SendNotification(context.Background(), notification.ID()))
+
+	require.Len(t, webhookSender.deliveries, 1)
+	delivery := webhookSender.deliveries[0]
+	assert.Equal(t, notification.ID().String(), delivery.ID)
+	assert.Equal(t, "https://hooks.example.com/ci", delivery.URL)
+	assert.Equal(t, webhookTestSecret, delivery.Secret)
+	assert.Equal(t, domain.WebhookContentTypeCloudEvents, delivery.ContentType)
+	assert.Equal(t, domain.NotificationStatusSent, notification.Status())
+}
+
+func TestSendNotification_SkipsInactiveWebhookSubscription(t *testing.T) {
+	subscription, err := domain.NewWebhookSubscription(value_objects.NewID(), "https://hooks.example.com/ci", webhookTestSecret, domain.WebhookPayloadFormatJSON)
+	require.NoError(t, err)
+	subscription.Deactivate()
+
+	notification := newWebhookNotificationLog(t, subscription.ID().String())
+
+	mockLogRepo := mocks.NewNotificationLogRepository(t)
+	mockSubscriptionRepo := mocks.NewWebhookSubscriptionRepository(t)
+	webhookSender := &recordingWebhookSender{}
+	service := log.NewNotificationLogService(log.Dep{
+		NotificationRepo:        mockLogRepo,
+		NotificationSender:      webhookSender,
+		WebhookSubscriptionRepo: mockSubscriptionRepo,
+		Logger:                  newDeadLetterTestLogger(),
+	})
+
+	mockLogRepo.On("GetByID", mock.Anything, notification.ID()).Return(notification, nil)
+	mockLogRepo.On("Update", mock.Anything, notification).Return(nil)
+	mockSubscriptionRepo.On("GetByID", mock.Anything, subscription.ID()).Return(subscription, nil).Once()
+
+	assert.Error(t, service.SendNotification(context.Background(), notification.ID()))
+	assert.Empty(t, webhookSender.deliveries)
+}
@@ -265,6 +265,148 @@ func (suite *ProjectServiceTestSuite) TestUpdateProjectSuccess() {
 	suite.mockRepo.AssertExpectations(suite.T())
 }
 
+func (suite *ProjectServiceTestSuite) TestCreateProjectWithEmailRecipients() {
+	// Test data
+	req := dto.CreateProjectRequest{
+		Name:            serviceTestProjectName,
+		RepositoryURL:   serviceTestRepositoryURL,
+		WebhookSecret:   serviceTestWebhookSecret,
+		EmailRecipients: []string{"Dev Team <dev@example.com>", "ops@example.com", "DEV@example.com"},
+	}
+
+	// Setup mock expectations
+	suite.mockRepo.On("ExistsByName", suite.ctx, req.Name).Return(false, nil)
+	suite.mockRepo.On("ExistsByRepositoryURL", suite.ctx, req.RepositoryURL).Return(false, nil)
+	suite.mockRepo.On("Create", suite.ctx, mock.AnythingOfType(domainProjectType)).Return(nil)
+
+	// Execute
+	project, err := suite.projectService.CreateProject(suite.ctx, req)
+
+	// Assert
+	suite.NoError(err)
+	assert.Equal(suite.T(), []string{"dev@example.com", "ops@example.com"}, project.EmailRecipients())
+	assert.True(suite.T(), project.HasEmailRecipients())
+
+	// Verify mock calls
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *ProjectServiceTestSuite) TestUpdateProjectInvalidEmailRecipient() {
+	// Test data
+	projectID := value_objects.NewID()
+	originalProject, _ := domain.NewProject("Original Project", serviceTestRepositoryURL, "secret", nil)
+	recipients := []string{"not-an-email"}
+
+	// Setup mock expectations
+	suite.mockRepo.On("GetByID", suite.ctx, projectID).Return(originalProject, nil)
+
+	// Execute
+	result, err := suite.projectService.UpdateProject(suite.ctx, projectID, dto.UpdateProjectRequest{
+		EmailRecipients: &recipients,
+	})
+
+	// Assert
+	suite.Error(err)
+	suite.Nil(result)
+	assert.Contains(suite.T(), err.Error(), domain.ErrCodeInvalidEmailRecipient)
+	suite.mockRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *ProjectServiceTestSuite) TestUpdateProjectSlackChannels() {
+	// Test data
+	projectID := value_objects.NewID()
+	originalProject, _ := domain.NewProject("Original Project", serviceTestRepositoryURL, "secret", nil)
+	channels := []string{"#builds", " C0123ABC ", "#builds", "https://hooks.slack.com/services/T000/B000/XXX"}
+
+	// Setup mock expectations
+	suite.mockRepo.On("GetByID", suite.ctx, projectID).Return(originalProject, nil)
+	suite.mockRepo.On("Update", suite.ctx, mock.AnythingOfType(domainProjectType)).Return(nil)
+
+	// Execute
+	result, err := suite.projectService.UpdateProject(suite.ctx, projectID, dto.UpdateProjectRequest{
+		SlackChannels: &channels,
+	})
+
+	// Assert
+	suite.NoError(err)
+	assert.Equal(suite.T(), []string{"#builds", "C0123ABC", "https://hooks.slack.com/services/T000/B000/XXX"}, result.SlackChannels())
+	assert.True(suite.T(), result.HasSlackChannels())
+
+	// Invalid channel names are rejected
+	invalid := []string{"#build status"}
+	result, err = suite.projectService.UpdateProject(suite.ctx, projectID, dto.UpdateProjectRequest{
+		SlackChannels: &invalid,
+	})
+	suite.Error(err)
+	suite.Nil(result)
+	assert.Contains(suite.T(), err.Error(), domain.ErrCodeInvalidSlackChannel)
+}
+
+func (suite *ProjectServiceTestSuite) TestCreateProjectWithTeamsWebhooks() {
+	// Test data
+	webhook := "https://example.webhook.office.com/webhookb2/abc"
+	req := dto.CreateProjectRequest{
+		Name:          serviceTestProjectName,
+		RepositoryURL: serviceTestRepositoryURL,
+		WebhookSecret: serviceTestWebhookSecret,
+		TeamsWebhooks: []string{webhook, " " + webhook + " "},
+	}
+
+	// Setup mock expectations
+	suite.mockRepo.On("ExistsByName", suite.ctx, req.Name).Return(false, nil)
+	suite.mockRepo.On("ExistsByRepositoryURL", suite.ctx, req.RepositoryURL).Return(false, nil)
+	suite.mockRepo.On("Create", suite.ctx, mock.AnythingOfType(domainProjectType)).Return(nil)
+
+	// Execute
+	project, err := suite.projectService.CreateProject(suite.ctx, req)
+
+	// Assert
+	suite.NoError(err)
+	assert.Equal(suite.T(), []string{webhook}, project.TeamsWebhooks())
+	assert.True(suite.T(), project.HasTeamsWebhooks())
+
+	// Plain http webhooks are rejected
+	_, err = suite.projectService.CreateProject(suite.ctx, dto.CreateProjectRequest{
+		Name:          serviceTestProjectName,
+		RepositoryURL: serviceTestRepositoryURL,
+		WebhookSecret: serviceTestWebhookSecret,
+		TeamsWebhooks: []string{"http://example.com/webhook"},
+	})
+	suite.Error(err)
+	assert.Contains(suite.T(), err.Error(), domain.ErrCodeInvalidTeamsWebhook)
+}
+
+func (suite *ProjectServiceTestSuite) TestUpdateProjectDiscordWebhooks() {
+	// Test data
+	projectID := value_objects.NewID()
+	originalProject, _ := domain.NewProject("Original Project", serviceTestRepositoryURL, "secret", nil)
+	webhook := "https://discord.com/api/webhooks/123/abc"
+	webhooks := []string{webhook, " " + webhook}
+
+	// Setup mock expectations
+	suite.mockRepo.On("GetByID", suite.ctx, projectID).Return(originalProject, nil)
+	suite.mockRepo.On("Update", suite.ctx, mock.AnythingOfType(domainProjectType)).Return(nil)
+
+	// Execute
+	result, err := suite.projectService.UpdateProject(suite.ctx, projectID, dto.UpdateProjectRequest{
+		DiscordWebhooks: &webhooks,
+	})
+
+	// Assert
+	suite.NoError(err)
+	assert.Equal(suite.T(), []string{webhook}, result.DiscordWebhooks())
+	assert.True(suite.T(), result.HasDiscordWebhooks())
+
+	// Plain http webhooks are rejected
+	invalid := []string{"http://discord.com/api/webhooks/123/abc"}
+	result, err = suite.projectService.UpdateProject(suite.ctx, projectID, dto.UpdateProjectRequest{
+		DiscordWebhooks: &invalid,
+	})
+	suite.Error(err)
+	suite.Nil(result)
+	assert.Contains(suite.T(), err.Error(), domain.ErrCodeInvalidDiscordWebhook)
+}
+
 func (suite *ProjectServiceTestSuite) TestDeleteProjectSuccess() {
 	// Test data
 	projectID := value_objects.NewID()
@@ -0,0 +1,368 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/provider"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
+)
+
+// headerFunc builds a header lookup function from a map
+func headerFunc(headers map[string]string) func(string) string {
+	return func(key string) string { return headers[key] }
+}
+
+func TestProviderRegistryGet(t *testing.T) {
+	registry := provider.NewDefaultRegistry("")
+
+	github, err := registry.Get(domain.ProviderGitHub)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ProviderGitHub, github.Name())
+
+	gitlab, err := registry.Get(domain.ProviderGitLab)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ProviderGitLab, gitlab.Name())
+
+	_, err = registry.Get("bitbucket")
+	var domainErr exception.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domain.WebhookErrUnknownProvider, domainErr.Code)
+}
+
+func TestGitHubProviderParseHeaders(t *testing.T) {
+	github, err := provider.NewDefaultRegistry("").Get(domain.ProviderGitHub)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		headers      map[string]string
+		expectedCode string
+		expectedType domain.WebhookEventType
+	}{
+		{
+			name:         "Missing signature",
+			headers:      map[string]string{"X-GitHub-Event": "push"},
+			expectedCode: domain.WebhookErrMissingSignature,
+		},
+		{
+			name:         "Missing event",
+			headers:      map[string]string{"X-Hub-Signature-256": "sha256=abc"},
+			expectedCode: domain.WebhookErrMissingEvent,
+		},
+		{
+			name:         "Unsupported event",
+			headers:      map[string]string{"X-Hub-Signature-256": "sha256=abc", "X-GitHub-Event": "issues"},
+			expectedCode: domain.WebhookErrInvalidEvent,
+		},
+		{
+			name:         "Workflow run event",
+			headers:      map[string]string{"X-Hub-Signature-256": "sha256=abc", "X-GitHub-Event": "workflow_run", "X-GitHub-Delivery": "d-1"},
+			expectedType: domain.WorkflowRunEvent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			headers, err := github.ParseHeaders(headerFunc(tt.headers))
+			if tt.expectedCode != "" {
+				var domainErr exception.DomainError
+				require.ErrorAs(t, err, &domainErr)
+				assert.Equal(t, tt.expectedCode, domainErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedType, headers.EventType)
+			assert.Equal(t, "d-1", headers.DeliveryID)
+		})
+	}
+}
+
+func TestGitHubProviderToBuildEvent(t *testing.T) {
+	github, err := provider.NewDefaultRegistry("").Get(domain.ProviderGitHub)
+	require.NoError(t, err)
+
+	payload, err := github.DecodePayload(domain.WorkflowRunEvent, []byte(`{
+		"action": "completed",
+		"repository": {"full_name": "octo/repo", "html_url": "https://github.com/octo/repo"},
+		"workflow_run": {"name": "CI", "conclusion": "failure", "head_branch": "develop", "head_sha": "abc123"}
+	}`))
+	require.NoError(t, err)
+
+	event, err := github.ToBuildEvent(domain.WorkflowRunEvent, payload)
+	require.NoError(t, err)
+	assert.Equal(t, dto.CIEventBuild, event.Kind)
+	assert.Equal(t, buildDomain.EventTypeBuildCompleted, event.EventType)
+	assert.Equal(t, buildDomain.BuildStatusFailed, event.Status)
+	assert.Equal(t, "develop", event.Branch)
+	assert.Equal(t, "https://github.com/octo/repo/commit/abc123", event.BuildURL)
+	assert.Equal(t, "octo/repo", event.Repository)
+	assert.Equal(t, "CI", event.Name)
+
+	_, err = github.ToBuildEvent(domain.WorkflowRunEvent, dto.GitLabPayload{})
+	assert.Error(t, err)
+}
+
+func TestGitHubProviderWorkflowRunLifecycle(t *testing.T) {
+	github, err := provider.NewDefaultRegistry("").Get(domain.ProviderGitHub)
+	require.NoError(t, err)
+
+	decode := func(body string) interface{} {
+		payload, err := github.DecodePayload(domain.WorkflowRunEvent, []byte(body))
+		require.NoError(t, err)
+		return payload
+	}
+
+	// In progress deliveries carry the run ID but no duration
+	event, err := github.ToBuildEvent(domain.WorkflowRunEvent, decode(`{
+		"action": "in_progress",
+		"workflow_run": {"id": 987654, "run_number": 12, "status": "in_progress", "head_branch": "main",
+			"created_at": "2024-01-01T10:00:00Z", "updated_at": "2024-01-01T10:01:00Z"}
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, "987654", event.RunID)
+	assert.Equal(t, buildDomain.BuildStatusInProgress, event.Status)
+	assert.Nil(t, event.DurationSeconds)
+
+	// Completed deliveries compute the duration from the run timestamps
+	event, err = github.ToBuildEvent(domain.WorkflowRunEvent, decode(`{
+		"action": "completed",
+		"workflow_run": {"id": 987654, "run_number": 12, "conclusion": "success", "head_branch": "main",
+			"created_at": "2024-01-01T10:00:00Z", "updated_at": "2024-01-01T10:04:05Z"}
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, "987654", event.RunID)
+	require.NotNil(t, event.DurationSeconds)
+	assert.Equal(t, 245, *event.DurationSeconds)
+
+	// Re-runs are tracked separately from the first attempt
+	event, err = github.ToBuildEvent(domain.WorkflowRunEvent, decode(`{
+		"action": "requested",
+		"workflow_run": {"id": 987654, "run_number": 12, "run_attempt": 2, "head_branch": "main"}
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, "987654#2", event.RunID)
+}
+
+func TestGitHubProviderDeploymentEvents(t *testing.T) {
+	github, err := provider.NewDefaultRegistry("").Get(domain.ProviderGitHub)
+	require.NoError(t, err)
+
+	headers, err := github.ParseHeaders(headerFunc(map[string]string{
+		"X-Hub-Signature-256": "sha256=abc",
+		"X-GitHub-Event":      "deployment_status",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, domain.DeploymentStatusEvent, headers.EventType)
+
+	payload, err := github.DecodePayload(domain.DeploymentEvent, []byte(`{
+		"action": "created",
+		"repository": {"full_name": "octo/repo", "html_url": "https://github.com/octo/repo"},
+		"deployment": {"id": 42, "sha": "abc123", "ref": "v1.2.0", "environment": "production",
+			"creator": {"login": "octocat"}}
+	}`))
+	require.NoError(t, err)
+
+	event, err := github.ToBuildEvent(domain.DeploymentEvent, payload)
+	require.NoError(t, err)
+	assert.Equal(t, dto.CIEventDeployment, event.Kind)
+	assert.Equal(t, "deployment-42", event.RunID)
+	assert.Equal(t, buildDomain.EventTypeDeploymentStarted, event.EventType)
+	assert.Equal(t, buildDomain.BuildStatusPending, event.Status)
+	assert.Equal(t, "v1.2.0", event.Branch)
+	assert.Equal(t, "production", event.Environment)
+	assert.Equal(t, "octocat", event.AuthorName)
+	assert.Equal(t, "https://github.com/octo/repo/deployments", event.BuildURL)
+
+	payload, err = github.DecodePayload(domain.DeploymentStatusEvent, []byte(`{
+		"action": "created",
+		"repository": {"full_name": "octo/repo", "html_url": "https://github.com/octo/repo"},
+		"deployment": {"id": 42, "sha": "abc123", "ref": "refs/heads/main", "environment": "production",
+			"created_at": "2024-01-01T10:00:00Z"},
+		"deployment_status": {"state": "failure", "environment": "production",
+			"target_url": "https://ci.example.com/deploy/42", "created_at": "2024-01-01T10:02:00Z"}
+	}`))
+	require.NoError(t, err)
+
+	event, err = github.ToBuildEvent(domain.DeploymentStatusEvent, payload)
+	require.NoError(t, err)
+	assert.Equal(t, "deployment-42", event.RunID)
+	assert.Equal(t, buildDomain.EventTypeDeploymentCompleted, event.EventType)
+	assert.Equal(t, buildDomain.BuildStatusFailed, event.Status)
+	assert.Equal(t, "main", event.Branch)
+	assert.Equal(t, "https://ci.example.com/deploy/42", event.BuildURL)
+	require.NotNil(t, event.DurationSeconds)
+	assert.Equal(t, 120, *event.DurationSeconds)
+
+	_, err = github.ToBuildEvent(domain.DeploymentStatusEvent, dto.GitHubActionsPayload{})
+	assert.Error(t, err)
+}
+
+func TestGitHubProviderJobEvents(t *testing.T) {
+	github, err := provider.NewDefaultRegistry("").Get(domain.ProviderGitHub)
+	require.NoError(t, err)
+
+	payload, err := github.DecodePayload(domain.WorkflowJobEvent, []byte(`{
+		"action": "completed",
+		"repository": {"full_name": "octo/repo"},
+		"workflow_job": {"id": 31, "run_id": 555, "run_attempt": 2, "name": "unit-tests",
+			"status": "completed", "conclusion": "failure", "head_branch": "feature/x",
+			"html_url": "https://github.com/octo/repo/actions/runs/555/job/31",
+			"started_at": "2024-01-01T10:00:00Z", "completed_at": "2024-01-01T10:01:30Z",
+			"steps": [
+				{"number": 1, "name": "Checkout", "status": "completed", "conclusion": "success"},
+				{"number": 2, "name": "Run tests", "status": "completed", "conclusion": "failure"},
+				{"number": 3, "name": "Upload coverage", "status": "completed", "conclusion": "skipped"}
+			]}
+	}`))
+	require.NoError(t, err)
+
+	event, err := github.ToBuildEvent(domain.WorkflowJobEvent, payload)
+	require.NoError(t, err)
+	assert.Equal(t, dto.CIEventJob, event.Kind)
+	assert.Equal(t, "555#2", event.RunID)
+	assert.Equal(t, "31", event.JobID)
+	assert.Equal(t, "unit-tests", event.Name)
+	assert.Equal(t, buildDomain.BuildStatusFailed, event.Status)
+	assert.Equal(t, "feature/x", event.Branch)
+	assert.Equal(t, []string{"Run tests"}, event.FailedSteps)
+	require.NotNil(t, event.DurationSeconds)
+	assert.Equal(t, 90, *event.DurationSeconds)
+
+	headers, err := github.ParseHeaders(headerFunc(map[string]string{
+		"X-Hub-Signature-256": "sha256=abc",
+		"X-GitHub-Event":      "check_run",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, domain.CheckRunEvent, headers.EventType)
+
+	event, err = github.ToBuildEvent(domain.CheckRunEvent, dto.GitHubActionsPayload{
+		Action: "created",
+		CheckRun: &dto.CheckRun{
+			ID:         32,
+			Name:       "lint",
+			Status:     "in_progress",
+			DetailsURL: "https://github.com/octo/repo/actions/runs/555/job/32",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "555", event.RunID)
+	assert.Equal(t, "32", event.JobID)
+	assert.Equal(t, buildDomain.BuildStatusInProgress, event.Status)
+
+	// Check runs of a re-run attach to the attempt named in their URL
+	event, err = github.ToBuildEvent(domain.CheckRunEvent, dto.GitHubActionsPayload{
+		CheckRun: &dto.CheckRun{ID: 34, Name: "lint", Status: "completed", Conclusion: "failure",
+			DetailsURL: "https://github.com/octo/repo/actions/runs/555/attempts/2/job/34"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "555#2", event.RunID)
+	assert.Equal(t, "34", event.JobID)
+
+	// Check runs of other apps have no workflow run to attach to
+	event, err = github.ToBuildEvent(domain.CheckRunEvent, dto.GitHubActionsPayload{
+		CheckRun: &dto.CheckRun{ID: 33, Name: "external-ci", Status: "completed", Conclusion: "success",
+			DetailsURL: "https://ci.example.com/builds/33"},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, event)
+}
+
+func TestGitHubProviderReleaseEvents(t *testing.T) {
+	github, err := provider.NewDefaultRegistry("").Get(domain.ProviderGitHub)
+	require.NoError(t, err)
+
+	headers, err := github.ParseHeaders(headerFunc(map[string]string{
+		"X-Hub-Signature-256": "sha256=abc",
+		"X-GitHub-Event":      "release",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, domain.ReleaseEvent, headers.EventType)
+
+	payload, err := github.DecodePayload(domain.ReleaseEvent, []byte(`{
+		"action": "published",
+		"repository": {"full_name": "octo/repo"},
+		"release": {"id": 9, "tag_name": "v1.3.0", "name": "Spring release", "target_commitish": "main",
+			"html_url": "https://github.com/octo/repo/releases/tag/v1.3.0", "author": {"login": "octocat"}}
+	}`))
+	require.NoError(t, err)
+
+	event, err := github.ToBuildEvent(domain.ReleaseEvent, payload)
+	require.NoError(t, err)
+	require.NotNil(t, event)
+	assert.Equal(t, dto.CIEventRelease, event.Kind)
+	assert.Equal(t, "release-9", event.RunID)
+	assert.Equal(t, buildDomain.EventTypeRelease, event.EventType)
+	assert.Equal(t, buildDomain.BuildStatusSuccess, event.Status)
+	assert.Equal(t, "v1.3.0", event.Tag)
+	assert.Equal(t, "Spring release", event.Title)
+	assert.Equal(t, "main", event.Branch)
+	assert.Equal(t, "octocat", event.AuthorName)
+	assert.Equal(t, "https://github.com/octo/repo/releases/tag/v1.3.0", event.BuildURL)
+
+	// Edits of an existing release are not recorded
+	event, err = github.ToBuildEvent(domain.ReleaseEvent, dto.GitHubActionsPayload{
+		Action:  "edited",
+		Release: &dto.Release{ID: 9, TagName: "v1.3.0"},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, event)
+
+	commits, err := github.PushCommits([]byte(`{
+		"ref": "refs/heads/main",
+		"commits": [
+			{"id": "111aaa", "message": "Add feature", "author": {"name": "Jane Dev"}},
+			{"id": "222bbb", "message": "Fix bug", "author": {"name": "John Dev"}}
+		]
+	}`))
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	assert.Equal(t, "111aaa", commits[0].SHA)
+	assert.Equal(t, "John Dev", commits[1].AuthorName)
+}
+
+func TestGitLabProviderParseHeadersAndVerify(t *testing.T) {
+	gitlab, err := provider.NewDefaultRegistry("instance-secret").Get(domain.ProviderGitLab)
+	require.NoError(t, err)
+
+	headers, err := gitlab.ParseHeaders(headerFunc(map[string]string{
+		"X-Gitlab-Token":      "project-secret",
+		"X-Gitlab-Event":      "Job Hook",
+		"X-Gitlab-Event-UUID": "uuid-1",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, domain.GitLabJobEvent, headers.EventType)
+	assert.Equal(t, "uuid-1", headers.DeliveryID)
+
+	assert.True(t, gitlab.VerifySignature("project-secret", "project-secret", nil))
+	assert.True(t, gitlab.VerifySignature("project-secret", "instance-secret", nil))
+	assert.False(t, gitlab.VerifySignature("project-secret", "other", nil))
+	assert.NotEqual(t, "project-secret", gitlab.RedactSignature("project-secret"))
+
+	event, err := gitlab.ToBuildEvent(domain.GitLabJobEvent, dto.GitLabPayload{
+		BuildID:     77,
+		PipelineID:  1200,
+		BuildName:   "unit",
+		BuildStage:  "test",
+		BuildStatus: "running",
+		Ref:         "main",
+		Project:     dto.GitLabProject{WebURL: "https://gitlab.example.com/g/r"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, dto.CIEventJob, event.Kind)
+	assert.Equal(t, "1200", event.RunID)
+	assert.Equal(t, "77", event.JobID)
+	assert.Equal(t, buildDomain.EventTypeBuildStarted, event.EventType)
+	assert.Equal(t, buildDomain.BuildStatusInProgress, event.Status)
+	assert.Equal(t, "https://gitlab.example.com/g/r/-/jobs/77", event.BuildURL)
+	assert.Equal(t, "unit (test)", event.Name)
+
+	// Jobs without their pipeline have no run to attach to
+	_, err = gitlab.ToBuildEvent(domain.GitLabJobEvent, dto.GitLabPayload{BuildID: 78, BuildName: "lint"})
+	assert.Error(t, err)
+}
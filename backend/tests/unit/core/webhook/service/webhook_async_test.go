@@ -0,0 +1,49 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/dto"
+)
+
+func TestAcceptWebhookStoresEventWithoutProcessing(t *testing.T) {
+	projectID := value_objects.NewID()
+	webhookService, buildService, notificationService := newLifecycleTestService(t, projectID)
+
+	startedAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	event, err := webhookService.AcceptWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		EventType:  domain.WorkflowRunEvent,
+		Payload:    workflowRunDelivery("completed", "success", startedAt, startedAt.Add(time.Minute)),
+		Signature:  workflowTestSignature,
+		DeliveryID: workflowTestDeliveryID,
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, event)
+	assert.False(t, event.IsProcessed())
+	assert.Equal(t, projectID, event.ProjectID())
+	buildService.AssertNotCalled(t, "GetBuildEventByRunID", mock.Anything, mock.Anything, mock.Anything)
+	notificationService.AssertNotCalled(t, "CreateNotificationForBuildEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// Processing the stored event later runs the pipeline and marks it processed
+	existing := trackedRunBuildEvent(t, projectID, buildDomain.BuildStatusSuccess)
+	buildService.On("GetBuildEventByRunID", mock.Anything, projectID, lifecycleTestRunID).Return(existing, nil).Once()
+
+	require.NoError(t, webhookService.ProcessWebhookEvent(context.Background(), event))
+	assert.True(t, event.IsProcessed())
+	buildService.AssertExpectations(t)
+
+	// Already processed events are left alone
+	require.NoError(t, webhookService.ProcessWebhookEvent(context.Background(), event))
+	buildService.AssertNumberOfCalls(t, "GetBuildEventByRunID", 1)
+}
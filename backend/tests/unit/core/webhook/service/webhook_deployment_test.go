@@ -0,0 +1,133 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	buildDto "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/dto"
+	notificationDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
+)
+
+// deploymentDelivery builds a deployment_status payload for the deployment tests
+func deploymentDelivery(state string) dto.GitHubActionsPayload {
+	createdAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	payload := dto.GitHubActionsPayload{
+		Action: "created",
+		Deployment: &dto.Deployment{
+			ID:          77,
+			SHA:         "abc123def456",
+			Ref:         "main",
+			Environment: "production",
+			Creator:     &dto.User{Login: "octocat"},
+			CreatedAt:   createdAt,
+		},
+		DeploymentStatus: &dto.DeploymentStatus{
+			State:       state,
+			Environment: "production",
+			TargetURL:   "https://ci.example.com/deployments/77",
+			CreatedAt:   createdAt.Add(90 * time.Second),
+		},
+	}
+	payload.Repository.FullName = "test/repo"
+	return payload
+}
+
+func TestProcessDeploymentStatusRendersDeploymentTemplate(t *testing.T) {
+	projectID := value_objects.NewID()
+	webhookService, buildService, notificationService := newLifecycleTestService(t, projectID)
+
+	buildEvent, err := buildDomain.NewBuildEvent(buildDomain.BuildEventParams{
+		ProjectID: projectID,
+		RunID:     "deployment-77",
+		EventType: buildDomain.EventTypeDeploymentCompleted,
+		Status:    buildDomain.BuildStatusSuccess,
+		Branch:    "main",
+	})
+	require.NoError(t, err)
+
+	buildService.On("GetBuildEventByRunID", mock.Anything, projectID, "deployment-77").
+		Return(nil, exception.ErrBuildEventNotFound).Once()
+	buildService.On("CreateBuildEvent", mock.Anything, mock.MatchedBy(func(req buildDto.CreateBuildEventRequest) bool {
+		return req.RunID == "deployment-77" &&
+			req.EventType == buildDomain.EventTypeDeploymentCompleted &&
+			req.Status == buildDomain.BuildStatusSuccess &&
+			req.BuildURL == "https://ci.example.com/deployments/77" &&
+			req.AuthorName == "octocat"
+	})).Return(buildEvent, nil).Once()
+
+	var capturedMessage string
+	notificationService.On("CreateNotificationForBuildEvent", mock.Anything, buildEvent.ID(), projectID,
+		mock.MatchedBy(func(message string) bool {
+			capturedMessage = message
+			return true
+		})).
+		Return([]*notificationDomain.NotificationLog{}, nil).Once()
+
+	result, err := webhookService.ProcessWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		EventType:  domain.DeploymentStatusEvent,
+		Payload:    deploymentDelivery("success"),
+		Signature:  workflowTestSignature,
+		DeliveryID: workflowTestDeliveryID,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.IsProcessed())
+	assert.Contains(t, capturedMessage, "🚀 <b>Deployment</b>")
+	assert.Contains(t, capturedMessage, "<b>Environment:</b> production")
+	assert.Contains(t, capturedMessage, "Successfully deployed to production")
+	assert.Contains(t, capturedMessage, "https://ci.example.com/deployments/77")
+	buildService.AssertExpectations(t)
+	notificationService.AssertExpectations(t)
+}
+
+func TestProcessDeploymentStatusFailureUpdatesDeployment(t *testing.T) {
+	projectID := value_objects.NewID()
+	webhookService, buildService, notificationService := newLifecycleTestService(t, projectID)
+
+	existing, err := buildDomain.NewBuildEvent(buildDomain.BuildEventParams{
+		ProjectID: projectID,
+		RunID:     "deployment-77",
+		EventType: buildDomain.EventTypeDeploymentStarted,
+		Status:    buildDomain.BuildStatusPending,
+		Branch:    "main",
+	})
+	require.NoError(t, err)
+
+	buildService.On("GetBuildEventByRunID", mock.Anything, projectID, "deployment-77").Return(existing, nil).Once()
+	buildService.On("UpdateBuildEventStatus", mock.Anything, existing.ID(), buildDomain.BuildStatusFailed,
+		mock.MatchedBy(func(duration *int) bool { return duration != nil && *duration == 90 })).
+		Return(nil).Once()
+
+	var capturedMessage string
+	notificationService.On("CreateNotificationForBuildEvent", mock.Anything, existing.ID(), projectID,
+		mock.MatchedBy(func(message string) bool {
+			capturedMessage = message
+			return true
+		})).
+		Return([]*notificationDomain.NotificationLog{}, nil).Once()
+
+	_, err = webhookService.ProcessWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		EventType:  domain.DeploymentStatusEvent,
+		Payload:    deploymentDelivery("failure"),
+		Signature:  workflowTestSignature,
+		DeliveryID: workflowTestDeliveryID,
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, capturedMessage, "Deployment to production failed")
+	buildService.AssertExpectations(t)
+	notificationService.AssertExpectations(t)
+}
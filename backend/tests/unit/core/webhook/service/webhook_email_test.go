@@ -0,0 +1,168 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	notificationDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	projectDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/project/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/service"
+	"github.com/dewisartika8/cicd-status-notifier-bot/tests/mocks"
+)
+
+// MockChannelNotificationService is a mock implementation of ChannelNotificationService
+type MockChannelNotificationService struct {
+	mock.Mock
+}
+
+func (m *MockChannelNotificationService) CreateChannelNotificationsForBuildEvent(
+	ctx context.Context,
+	buildEventID, projectID value_objects.ID,
+	channel notificationDomain.NotificationChannel,
+	recipients []string,
+	subject, body string,
+) ([]*notificationDomain.NotificationLog, error) {
+	args := m.Called(ctx, buildEventID, projectID, channel, recipients, subject, body)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*notificationDomain.NotificationLog), args.Error(1)
+}
+
+// newEmailTestService wires a webhook service for a project that has email recipients and Slack channels
+func newEmailTestService(t *testing.T, projectID value_objects.ID) (port.WebhookService, *MockBuildEventServiceTDD, *MockNotificationLogServiceTDD, *MockChannelNotificationService) {
+	webhookRepo := &mocks.MockWebhookEventRepository{}
+	projectService := &MockProjectServiceTDD{}
+	buildService := &MockBuildEventServiceTDD{}
+	notificationService := &MockNotificationLogServiceTDD{}
+	channelService := &MockChannelNotificationService{}
+	signatureVerifier := &mocks.MockSignatureVerifier{}
+
+	project, err := projectDomain.NewProject(workflowTestProjectName, workflowTestRepoURL, workflowTestWebhookSecret, nil)
+	require.NoError(t, err)
+	require.NoError(t, project.UpdateEmailRecipients([]string{"dev@example.com", "ops@example.com"}))
+	require.NoError(t, project.UpdateSlackChannels([]string{"#builds"}))
+
+	projectService.On("GetProject", mock.Anything, projectID).Return(project, nil)
+	signatureVerifier.On("VerifySignature", workflowTestWebhookSecret, workflowTestSignature, mock.Anything).Return(true)
+	webhookRepo.On("ExistsByDeliveryID", mock.Anything, workflowTestDeliveryID).Return(false, nil)
+	webhookRepo.On("Create", mock.Anything, mock.AnythingOfType(workflowWebhookEventType)).Return(nil)
+	webhookRepo.On("Update", mock.Anything, mock.AnythingOfType(workflowWebhookEventType)).Return(nil)
+
+	return service.NewWebhookService(service.Dep{
+		WebhookEventRepo:       webhookRepo,
+		ProjectService:         projectService,
+		BuildService:           buildService,
+		NotificationLogService: notificationService,
+		SignatureVerifier:      signatureVerifier,
+		ChannelNotifications:   channelService,
+		ProjectChannels: []notificationDomain.NotificationChannel{
+			notificationDomain.NotificationChannelEmail,
+			notificationDomain.NotificationChannelSlack,
+		},
+	}), buildService, notificationService, channelService
+}
+
+func TestWorkflowRunCompletionEmailsProjectRecipients(t *testing.T) {
+	projectID := value_objects.NewID()
+	webhookService, buildService, notificationService, channelService := newEmailTestService(t, projectID)
+
+	existing := trackedRunBuildEvent(t, projectID, buildDomain.BuildStatusInProgress)
+	buildService.On("GetBuildEventByRunID", mock.Anything, projectID, lifecycleTestRunID).Return(existing, nil).Once()
+	buildService.On("UpdateBuildEventStatus", mock.Anything, existing.ID(), buildDomain.BuildStatusSuccess, mock.Anything).
+		Return(nil).Once()
+	notificationService.On("CreateNotificationForBuildEvent", mock.Anything, existing.ID(), projectID, mock.Anything).
+		Return([]*notificationDomain.NotificationLog{}, nil).Once()
+
+	emailLog, err := notificationDomain.NewNotificationLog(existing.ID(), projectID,
+		notificationDomain.NotificationChannelEmail, "dev@example.com", "Build completed successfully!", 3)
+	require.NoError(t, err)
+
+	var subject, body string
+	channelService.On("CreateChannelNotificationsForBuildEvent", mock.Anything, existing.ID(), projectID,
+		notificationDomain.NotificationChannelEmail, []string{"dev@example.com", "ops@example.com"},
+		mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { subject, body = args.String(5), args.String(6) }).
+		Return([]*notificationDomain.NotificationLog{emailLog}, nil).Once()
+	notificationService.On("SendNotification", mock.Anything, emailLog.ID()).Return(nil).Once()
+
+	slackLog, err := notificationDomain.NewNotificationLog(existing.ID(), projectID,
+		notificationDomain.NotificationChannelSlack, "#builds", "Build Success", 3)
+	require.NoError(t, err)
+
+	var slackBody string
+	channelService.On("CreateChannelNotificationsForBuildEvent", mock.Anything, existing.ID(), projectID,
+		notificationDomain.NotificationChannelSlack, []string{"#builds"}, "", mock.Anything).
+		Run(func(args mock.Arguments) { slackBody = args.String(6) }).
+		Return([]*notificationDomain.NotificationLog{slackLog}, nil).Once()
+	notificationService.On("SendNotification", mock.Anything, slackLog.ID()).Return(nil).Once()
+
+	startedAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	_, err = webhookService.ProcessWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		EventType:  domain.WorkflowRunEvent,
+		Payload:    workflowRunDelivery("completed", "success", startedAt, startedAt.Add(150*time.Second)),
+		Signature:  workflowTestSignature,
+		DeliveryID: workflowTestDeliveryID,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "[BUILD SUCCESS] "+workflowTestProjectName+" - main", subject)
+	assert.Contains(t, body, "Build completed successfully!")
+	assert.Contains(t, body, "Duration: 2m30s")
+	slackMessage := notificationDomain.DecodeChannelMessage(slackBody)
+	assert.Contains(t, slackMessage.Text, "*Build Success*")
+	assert.Equal(t, buildDomain.BuildStatusSuccess, slackMessage.Status)
+	channelService.AssertExpectations(t)
+	notificationService.AssertExpectations(t)
+}
+
+func TestWorkflowRunInProgressIsOnlySentToSlack(t *testing.T) {
+	projectID := value_objects.NewID()
+	webhookService, buildService, notificationService, channelService := newEmailTestService(t, projectID)
+
+	existing := trackedRunBuildEvent(t, projectID, buildDomain.BuildStatusPending)
+	buildService.On("GetBuildEventByRunID", mock.Anything, projectID, lifecycleTestRunID).Return(existing, nil).Once()
+	buildService.On("UpdateBuildEventStatus", mock.Anything, existing.ID(), buildDomain.BuildStatusInProgress, mock.Anything).
+		Return(nil).Once()
+	notificationService.On("CreateNotificationForBuildEvent", mock.Anything, existing.ID(), projectID, mock.Anything).
+		Return([]*notificationDomain.NotificationLog{}, nil).Once()
+
+	slackLog, err := notificationDomain.NewNotificationLog(existing.ID(), projectID,
+		notificationDomain.NotificationChannelSlack, "#builds", "Build Started", 3)
+	require.NoError(t, err)
+
+	var slackBody string
+	channelService.On("CreateChannelNotificationsForBuildEvent", mock.Anything, existing.ID(), projectID,
+		notificationDomain.NotificationChannelSlack, []string{"#builds"}, "", mock.Anything).
+		Run(func(args mock.Arguments) { slackBody = args.String(6) }).
+		Return([]*notificationDomain.NotificationLog{slackLog}, nil).Once()
+	notificationService.On("SendNotification", mock.Anything, slackLog.ID()).Return(nil).Once()
+
+	startedAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	_, err = webhookService.ProcessWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		EventType:  domain.WorkflowRunEvent,
+		Payload:    workflowRunDelivery("in_progress", "", startedAt, startedAt),
+		Signature:  workflowTestSignature,
+		DeliveryID: workflowTestDeliveryID,
+	})
+
+	require.NoError(t, err)
+	slackMessage := notificationDomain.DecodeChannelMessage(slackBody)
+	assert.Contains(t, slackMessage.Text, "*Build Started*")
+	assert.Equal(t, buildDomain.BuildStatusInProgress, slackMessage.Status)
+	channelService.AssertNotCalled(t, "CreateChannelNotificationsForBuildEvent", mock.Anything, mock.Anything, mock.Anything,
+		notificationDomain.NotificationChannelEmail, mock.Anything, mock.Anything, mock.Anything)
+	notificationService.AssertExpectations(t)
+}
@@ -0,0 +1,314 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	buildDto "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/dto"
+	notificationDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	projectDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/project/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/provider"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/provider/gitlab"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/service"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/crypto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
+	"github.com/dewisartika8/cicd-status-notifier-bot/tests/mocks"
+)
+
+// Test constants for GitLab tests
+const (
+	gitLabTestProjectSecret  = "gitlab-project-secret"
+	gitLabTestInstanceSecret = "gitlab-instance-secret"
+	gitLabTestProjectURL     = "https://gitlab.example.com/group/repo"
+	gitLabTestDeliveryID     = "gitlab-delivery-id"
+	gitLabTestCommitSHA      = "a1b2c3d4e5f6"
+)
+
+// gitLabTestEnv bundles the mocks used by the GitLab webhook tests
+type gitLabTestEnv struct {
+	webhookRepo         *mocks.MockWebhookEventRepository
+	projectService      *MockProjectServiceTDD
+	buildService        *MockBuildEventServiceTDD
+	notificationService *MockNotificationLogServiceTDD
+	service             port.WebhookService
+}
+
+func newGitLabTestEnv(t *testing.T, projectID value_objects.ID) *gitLabTestEnv {
+	env := &gitLabTestEnv{
+		webhookRepo:         &mocks.MockWebhookEventRepository{},
+		projectService:      &MockProjectServiceTDD{},
+		buildService:        &MockBuildEventServiceTDD{},
+		notificationService: &MockNotificationLogServiceTDD{},
+	}
+	env.service = service.NewWebhookService(service.Dep{
+		WebhookEventRepo:       env.webhookRepo,
+		ProjectService:         env.projectService,
+		BuildService:           env.buildService,
+		NotificationLogService: env.notificationService,
+		Providers: provider.NewRegistry(
+			gitlab.NewProvider(crypto.NewGitLabTokenVerifier(), gitLabTestInstanceSecret),
+		),
+	})
+
+	project, err := projectDomain.NewProject("GitLab Project", gitLabTestProjectURL, gitLabTestProjectSecret, nil)
+	require.NoError(t, err)
+	env.projectService.On("GetProject", mock.Anything, projectID).Return(project, nil)
+
+	return env
+}
+
+// expectStoredAndNotified sets up the repository and notification expectations of a successful run
+func (env *gitLabTestEnv) expectStoredAndNotified(t *testing.T, projectID value_objects.ID, matcher func(req buildDto.CreateBuildEventRequest) bool) {
+	env.webhookRepo.On("ExistsByDeliveryID", mock.Anything, gitLabTestDeliveryID).Return(false, nil).Once()
+	env.webhookRepo.On("Create", mock.Anything, mock.AnythingOfType(pushWebhookEventType)).Return(nil).Once()
+	env.webhookRepo.On("Update", mock.Anything, mock.AnythingOfType(pushWebhookEventType)).Return(nil).Once()
+
+	buildEvent, err := buildDomain.NewBuildEvent(buildDomain.BuildEventParams{
+		ProjectID: projectID,
+		EventType: buildDomain.EventTypeBuildCompleted,
+		Status:    buildDomain.BuildStatusSuccess,
+		Branch:    "main",
+	})
+	require.NoError(t, err)
+	env.buildService.On("CreateBuildEvent", mock.Anything, mock.MatchedBy(matcher)).Return(buildEvent, nil).Once()
+
+	notificationLog, err := notificationDomain.NewNotificationLog(
+		buildEvent.ID(),
+		projectID,
+		notificationDomain.NotificationChannelTelegram,
+		"123456789",
+		"GitLab notification",
+		3,
+	)
+	require.NoError(t, err)
+	env.notificationService.On("CreateNotificationForBuildEvent", mock.Anything, buildEvent.ID(), projectID, mock.AnythingOfType("string")).
+		Return([]*notificationDomain.NotificationLog{notificationLog}, nil).Once()
+	env.notificationService.On("SendNotification", mock.Anything, notificationLog.ID()).Return(nil).Once()
+}
+
+func (env *gitLabTestEnv) assertExpectations(t *testing.T) {
+	env.projectService.AssertExpectations(t)
+	env.webhookRepo.AssertExpectations(t)
+	env.buildService.AssertExpectations(t)
+	env.notificationService.AssertExpectations(t)
+}
+
+func TestProcessGitLabPipelineEvent(t *testing.T) {
+	projectID := value_objects.NewID()
+	env := newGitLabTestEnv(t, projectID)
+
+	duration := 95
+	payload := dto.GitLabPayload{
+		ObjectKind: "pipeline",
+		User:       &dto.GitLabUser{Name: "Jane Dev", Email: "jane@example.com"},
+		Project: dto.GitLabProject{
+			Name:              "repo",
+			PathWithNamespace: "group/repo",
+			WebURL:            gitLabTestProjectURL,
+		},
+		ObjectAttributes: &dto.GitLabObjectAttributes{
+			ID:       1001,
+			Ref:      "main",
+			SHA:      gitLabTestCommitSHA,
+			Status:   "failed",
+			Duration: &duration,
+		},
+		Commit: &dto.GitLabHookCommit{
+			Message: "Fix flaky test",
+			Author:  &dto.GitLabAuthor{Name: "John Dev", Email: "john@example.com"},
+		},
+	}
+
+	env.buildService.On("GetBuildEventByRunID", mock.Anything, projectID, "1001").
+		Return(nil, exception.ErrBuildEventNotFound).Once()
+	env.buildService.On("GetBuildJobs", mock.Anything, mock.Anything).Return([]*buildDomain.BuildJob{}, nil).Once()
+	env.expectStoredAndNotified(t, projectID, func(req buildDto.CreateBuildEventRequest) bool {
+		return req.ProjectID == projectID &&
+			req.RunID == "1001" &&
+			req.EventType == buildDomain.EventTypeBuildCompleted &&
+			req.Status == buildDomain.BuildStatusFailed &&
+			req.Branch == "main" &&
+			req.CommitSHA == gitLabTestCommitSHA &&
+			req.CommitMessage == "Fix flaky test" &&
+			req.AuthorName == "John Dev" &&
+			req.BuildURL == gitLabTestProjectURL+"/-/pipelines/1001" &&
+			req.DurationSeconds != nil && *req.DurationSeconds == duration
+	})
+
+	result, err := env.service.ProcessWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		EventType:  domain.GitLabPipelineEvent,
+		Signature:  gitLabTestProjectSecret,
+		DeliveryID: gitLabTestDeliveryID,
+		Body:       []byte(`{"object_kind":"pipeline"}`),
+		Payload:    payload,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.GitLabPipelineEvent, result.EventType())
+	assert.NotEqual(t, gitLabTestProjectSecret, result.Signature())
+	assert.True(t, result.IsProcessed())
+	env.assertExpectations(t)
+}
+
+func TestProcessGitLabJobEventIsRecordedUnderItsPipeline(t *testing.T) {
+	projectID := value_objects.NewID()
+	env := newGitLabTestEnv(t, projectID)
+
+	pipeline, err := buildDomain.NewBuildEvent(buildDomain.BuildEventParams{
+		ProjectID: projectID,
+		RunID:     "1001",
+		EventType: buildDomain.EventTypeBuildStarted,
+		Status:    buildDomain.BuildStatusInProgress,
+		Branch:    "main",
+	})
+	require.NoError(t, err)
+
+	env.webhookRepo.On("ExistsByDeliveryID", mock.Anything, gitLabTestDeliveryID).Return(false, nil).Once()
+	env.webhookRepo.On("Create", mock.Anything, mock.AnythingOfType(pushWebhookEventType)).Return(nil).Once()
+	env.webhookRepo.On("Update", mock.Anything, mock.AnythingOfType(pushWebhookEventType)).Return(nil).Once()
+	env.buildService.On("GetBuildEventByRunID", mock.Anything, projectID, "1001").Return(pipeline, nil).Once()
+	env.buildService.On("RecordBuildJob", mock.Anything, mock.MatchedBy(func(req buildDto.RecordBuildJobRequest) bool {
+		return req.BuildEventID == pipeline.ID() &&
+			req.JobID == "77" &&
+			req.Name == "unit (test)" &&
+			req.Status == buildDomain.BuildStatusFailed &&
+			req.URL == gitLabTestProjectURL+"/-/jobs/77"
+	})).Return(nil, nil).Once()
+
+	result, err := env.service.ProcessWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		EventType:  domain.GitLabJobEvent,
+		Signature:  gitLabTestProjectSecret,
+		DeliveryID: gitLabTestDeliveryID,
+		Body:       []byte(`{"object_kind":"build"}`),
+		Payload: dto.GitLabPayload{
+			ObjectKind:  "build",
+			BuildID:     77,
+			BuildName:   "unit",
+			BuildStage:  "test",
+			BuildStatus: "failed",
+			PipelineID:  1001,
+			Ref:         "main",
+			Project:     dto.GitLabProject{WebURL: gitLabTestProjectURL},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.IsProcessed())
+	env.assertExpectations(t)
+	env.buildService.AssertNotCalled(t, "CreateBuildEvent", mock.Anything, mock.Anything)
+	env.notificationService.AssertNotCalled(t, "CreateNotificationForBuildEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestProcessGitLabPushEventWithInstanceSecret(t *testing.T) {
+	projectID := value_objects.NewID()
+	env := newGitLabTestEnv(t, projectID)
+
+	payload := dto.GitLabPayload{
+		ObjectKind:  "push",
+		Ref:         "refs/heads/develop",
+		CheckoutSHA: gitLabTestCommitSHA,
+		UserName:    "Jane Dev",
+		Project:     dto.GitLabProject{PathWithNamespace: "group/repo", WebURL: gitLabTestProjectURL},
+		Commits: []dto.GitLabCommit{
+			{ID: "000111", Message: "Older commit", Author: dto.GitLabAuthor{Name: "Someone"}},
+			{
+				ID:      gitLabTestCommitSHA,
+				Message: "Add feature",
+				URL:     gitLabTestProjectURL + "/-/commit/" + gitLabTestCommitSHA,
+				Author:  dto.GitLabAuthor{Name: "Jane Dev", Email: "jane@example.com"},
+			},
+		},
+	}
+
+	env.expectStoredAndNotified(t, projectID, func(req buildDto.CreateBuildEventRequest) bool {
+		return req.EventType == buildDomain.EventTypePush &&
+			req.Status == buildDomain.BuildStatusSuccess &&
+			req.Branch == "develop" &&
+			req.CommitSHA == gitLabTestCommitSHA &&
+			req.CommitMessage == "Add feature" &&
+			req.AuthorEmail == "jane@example.com"
+	})
+
+	result, err := env.service.ProcessWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		EventType:  domain.GitLabPushEvent,
+		Signature:  gitLabTestInstanceSecret,
+		DeliveryID: gitLabTestDeliveryID,
+		Body:       []byte(`{"object_kind":"push"}`),
+		Payload:    payload,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.IsProcessed())
+	env.assertExpectations(t)
+}
+
+func TestProcessGitLabMergeRequestEvent(t *testing.T) {
+	projectID := value_objects.NewID()
+	env := newGitLabTestEnv(t, projectID)
+
+	payload := dto.GitLabPayload{
+		ObjectKind: "merge_request",
+		User:       &dto.GitLabUser{Name: "Jane Dev", Email: "jane@example.com"},
+		Project:    dto.GitLabProject{PathWithNamespace: "group/repo"},
+		ObjectAttributes: &dto.GitLabObjectAttributes{
+			IID:          7,
+			Title:        "Add GitLab support",
+			State:        "merged",
+			Action:       "merge",
+			SourceBranch: "feature/gitlab",
+			TargetBranch: "main",
+			URL:          gitLabTestProjectURL + "/-/merge_requests/7",
+			LastCommit:   &dto.GitLabCommit{ID: gitLabTestCommitSHA},
+		},
+	}
+
+	env.expectStoredAndNotified(t, projectID, func(req buildDto.CreateBuildEventRequest) bool {
+		return req.EventType == buildDomain.EventTypePullRequest &&
+			req.Status == buildDomain.BuildStatusSuccess &&
+			req.Branch == "feature/gitlab" &&
+			req.CommitSHA == gitLabTestCommitSHA &&
+			req.BuildURL == gitLabTestProjectURL+"/-/merge_requests/7"
+	})
+
+	result, err := env.service.ProcessWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		EventType:  domain.GitLabMergeRequestEvent,
+		Signature:  gitLabTestProjectSecret,
+		DeliveryID: gitLabTestDeliveryID,
+		Body:       []byte(`{"object_kind":"merge_request"}`),
+		Payload:    payload,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.IsProcessed())
+	env.assertExpectations(t)
+}
+
+func TestProcessGitLabWebhookInvalidToken(t *testing.T) {
+	projectID := value_objects.NewID()
+	env := newGitLabTestEnv(t, projectID)
+
+	result, err := env.service.ProcessWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID: projectID,
+		EventType: domain.GitLabPipelineEvent,
+		Signature: "wrong-token",
+		Body:      []byte(`{}`),
+	})
+
+	assert.Nil(t, result)
+	assert.Equal(t, domain.ErrWebhookInvalidSignature, err)
+	env.webhookRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	env.buildService.AssertNotCalled(t, "CreateBuildEvent", mock.Anything, mock.Anything)
+}
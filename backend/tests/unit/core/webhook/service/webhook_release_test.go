@@ -0,0 +1,156 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	buildDto "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/dto"
+	notificationDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
+)
+
+const releaseTestURL = "https://github.com/test/repo/releases/tag/v2.0.0"
+
+// releaseDelivery builds a published release payload for the release tests
+func releaseDelivery() dto.GitHubActionsPayload {
+	payload := dto.GitHubActionsPayload{
+		Action: "published",
+		Release: &dto.Release{
+			ID:              2001,
+			TagName:         "v2.0.0",
+			Name:            "Version 2",
+			HTMLURL:         releaseTestURL,
+			TargetCommitish: "main",
+			Author:          &dto.User{Login: "octocat"},
+		},
+	}
+	payload.Repository.FullName = "test/repo"
+	return payload
+}
+
+// storedPushEvent builds a recorded push build event carrying the given commits
+func storedPushEvent(t *testing.T, projectID value_objects.ID, commits ...dto.Commit) *buildDomain.BuildEvent {
+	body, err := json.Marshal(dto.GitHubActionsPayload{Ref: "refs/heads/main", Commits: commits})
+	require.NoError(t, err)
+
+	buildEvent, err := buildDomain.NewBuildEvent(buildDomain.BuildEventParams{
+		ProjectID:      projectID,
+		EventType:      buildDomain.EventTypePush,
+		Status:         buildDomain.BuildStatusSuccess,
+		Branch:         "main",
+		WebhookPayload: body,
+	})
+	require.NoError(t, err)
+	return buildEvent
+}
+
+func TestProcessReleaseEventSendsChangelogSincePreviousRelease(t *testing.T) {
+	projectID := value_objects.NewID()
+	webhookService, buildService, notificationService := newLifecycleTestService(t, projectID)
+
+	previousRelease, err := buildDomain.NewBuildEvent(buildDomain.BuildEventParams{
+		ProjectID: projectID,
+		RunID:     "release-1999",
+		EventType: buildDomain.EventTypeRelease,
+		Status:    buildDomain.BuildStatusSuccess,
+		Branch:    "main",
+	})
+	require.NoError(t, err)
+	previousAt := previousRelease.CreatedAt().ToTime()
+
+	// Pushes are listed newest first
+	pushes := []*buildDomain.BuildEvent{
+		storedPushEvent(t, projectID,
+			dto.Commit{ID: "ccc3333333", Message: "Fix login redirect\n\nDetails follow"},
+			dto.Commit{ID: "bbb2222222", Message: "Add search"}),
+		storedPushEvent(t, projectID,
+			dto.Commit{ID: "aaa1111111", Message: "Add search"},
+			dto.Commit{ID: "bbb2222222", Message: "Add search"}),
+	}
+
+	buildService.On("GetBuildEventByRunID", mock.Anything, projectID, "release-2001").
+		Return(nil, exception.ErrBuildEventNotFound).Once()
+	buildService.On("GetBuildEventsByProject", mock.Anything, projectID,
+		mock.MatchedBy(func(filters buildDto.ListBuildEventFilters) bool {
+			return *filters.EventType == buildDomain.EventTypeRelease && filters.Limit == 1
+		})).Return([]*buildDomain.BuildEvent{previousRelease}, nil).Once()
+	buildService.On("GetBuildEventsByProject", mock.Anything, projectID,
+		mock.MatchedBy(func(filters buildDto.ListBuildEventFilters) bool {
+			return *filters.EventType == buildDomain.EventTypePush &&
+				filters.DateFrom != nil && filters.DateFrom.Equal(previousAt)
+		})).Return(pushes, nil).Once()
+
+	buildEvent, err := buildDomain.NewBuildEvent(buildDomain.BuildEventParams{
+		ProjectID: projectID,
+		RunID:     "release-2001",
+		EventType: buildDomain.EventTypeRelease,
+		Status:    buildDomain.BuildStatusSuccess,
+		Branch:    "main",
+	})
+	require.NoError(t, err)
+	buildService.On("CreateBuildEvent", mock.Anything, mock.MatchedBy(func(req buildDto.CreateBuildEventRequest) bool {
+		return req.RunID == "release-2001" &&
+			req.EventType == buildDomain.EventTypeRelease &&
+			req.Status == buildDomain.BuildStatusSuccess &&
+			req.BuildURL == releaseTestURL &&
+			req.AuthorName == "octocat"
+	})).Return(buildEvent, nil).Once()
+
+	var capturedMessage string
+	notificationService.On("CreateNotificationForBuildEvent", mock.Anything, buildEvent.ID(), projectID,
+		mock.MatchedBy(func(message string) bool {
+			capturedMessage = message
+			return true
+		})).
+		Return([]*notificationDomain.NotificationLog{}, nil).Once()
+
+	result, err := webhookService.ProcessWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		EventType:  domain.ReleaseEvent,
+		Payload:    releaseDelivery(),
+		Signature:  workflowTestSignature,
+		DeliveryID: workflowTestDeliveryID,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.IsProcessed())
+	assert.Contains(t, capturedMessage, "🏷️ <b>Release Version 2</b>")
+	assert.Contains(t, capturedMessage, "<b>Tag:</b> v2.0.0")
+	assert.Contains(t, capturedMessage, "<b>Author:</b> octocat")
+	assert.Contains(t, capturedMessage, "<b>Release notes:</b> "+releaseTestURL)
+	assert.Contains(t, capturedMessage,
+		"<b>Changelog:</b>\n• Add search (aaa1111)\n• Add search (bbb2222)\n• Fix login redirect (ccc3333)")
+	assert.NotContains(t, capturedMessage, "Details follow")
+	buildService.AssertExpectations(t)
+	notificationService.AssertExpectations(t)
+}
+
+func TestProcessReleaseEventIgnoresUnpublishedActions(t *testing.T) {
+	projectID := value_objects.NewID()
+	webhookService, buildService, notificationService := newLifecycleTestService(t, projectID)
+
+	payload := releaseDelivery()
+	payload.Action = "edited"
+
+	result, err := webhookService.ProcessWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		EventType:  domain.ReleaseEvent,
+		Payload:    payload,
+		Signature:  workflowTestSignature,
+		DeliveryID: workflowTestDeliveryID,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.IsProcessed())
+	buildService.AssertNotCalled(t, "CreateBuildEvent", mock.Anything, mock.Anything)
+	notificationService.AssertNotCalled(t, "CreateNotificationForBuildEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,184 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	buildDto "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/dto"
+	notificationDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
+)
+
+// workflowJobDelivery builds a completed workflow_job payload of the lifecycle test run
+func workflowJobDelivery(conclusion string, failedStep string) dto.GitHubActionsPayload {
+	steps := []dto.WorkflowStep{{Number: 1, Name: "Checkout", Status: "completed", Conclusion: "success"}}
+	if failedStep != "" {
+		steps = append(steps, dto.WorkflowStep{Number: 2, Name: failedStep, Status: "completed", Conclusion: "failure"})
+	}
+
+	return dto.GitHubActionsPayload{
+		Action: "completed",
+		WorkflowJob: &dto.WorkflowJob{
+			ID:         9001,
+			RunID:      555000111,
+			Name:       "unit-tests",
+			Status:     "completed",
+			Conclusion: conclusion,
+			HeadBranch: "main",
+			Steps:      steps,
+		},
+	}
+}
+
+func TestWorkflowJobIsRecordedUnderItsRun(t *testing.T) {
+	projectID := value_objects.NewID()
+	webhookService, buildService, notificationService := newLifecycleTestService(t, projectID)
+
+	run := trackedRunBuildEvent(t, projectID, buildDomain.BuildStatusInProgress)
+	buildService.On("GetBuildEventByRunID", mock.Anything, projectID, lifecycleTestRunID).Return(run, nil).Once()
+	buildService.On("RecordBuildJob", mock.Anything, mock.MatchedBy(func(req buildDto.RecordBuildJobRequest) bool {
+		return req.BuildEventID == run.ID() &&
+			req.JobID == "9001" &&
+			req.Name == "unit-tests" &&
+			req.Status == buildDomain.BuildStatusFailed &&
+			len(req.FailedSteps) == 1 && req.FailedSteps[0] == "Run tests"
+	})).Return(nil, nil).Once()
+
+	result, err := webhookService.ProcessWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		EventType:  domain.WorkflowJobEvent,
+		Payload:    workflowJobDelivery("failure", "Run tests"),
+		Signature:  workflowTestSignature,
+		DeliveryID: workflowTestDeliveryID,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.IsProcessed())
+	buildService.AssertExpectations(t)
+	buildService.AssertNotCalled(t, "CreateBuildEvent", mock.Anything, mock.Anything)
+	notificationService.AssertNotCalled(t, "CreateNotificationForBuildEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWorkflowJobBeforeItsRunIsLeftForReprocessing(t *testing.T) {
+	projectID := value_objects.NewID()
+	webhookService, buildService, _ := newLifecycleTestService(t, projectID)
+
+	buildService.On("GetBuildEventByRunID", mock.Anything, projectID, lifecycleTestRunID).
+		Return(nil, exception.ErrBuildEventNotFound).Once()
+
+	result, err := webhookService.ProcessWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		EventType:  domain.WorkflowJobEvent,
+		Payload:    workflowJobDelivery("success", ""),
+		Signature:  workflowTestSignature,
+		DeliveryID: workflowTestDeliveryID,
+	})
+
+	require.NoError(t, err)
+	assert.False(t, result.IsProcessed())
+	buildService.AssertExpectations(t)
+	buildService.AssertNotCalled(t, "RecordBuildJob", mock.Anything, mock.Anything)
+}
+
+func TestWorkflowRunFailureListsFailingJobs(t *testing.T) {
+	projectID := value_objects.NewID()
+	webhookService, buildService, notificationService := newLifecycleTestService(t, projectID)
+
+	run := trackedRunBuildEvent(t, projectID, buildDomain.BuildStatusInProgress)
+	buildService.On("GetBuildEventByRunID", mock.Anything, projectID, lifecycleTestRunID).Return(run, nil).Once()
+	buildService.On("UpdateBuildEventStatus", mock.Anything, run.ID(), buildDomain.BuildStatusFailed, mock.Anything).
+		Return(nil).Once()
+
+	lint, err := buildDomain.NewBuildJob(buildDomain.BuildJobParams{
+		BuildEventID: run.ID(), JobID: "1", Name: "lint", Status: buildDomain.BuildStatusSuccess,
+	})
+	require.NoError(t, err)
+	tests, err := buildDomain.NewBuildJob(buildDomain.BuildJobParams{
+		BuildEventID: run.ID(), JobID: "2", Name: "unit-tests", Status: buildDomain.BuildStatusFailed,
+		FailedSteps: []string{"Run tests", "Upload <coverage> & report"},
+	})
+	require.NoError(t, err)
+	deploy, err := buildDomain.NewBuildJob(buildDomain.BuildJobParams{
+		BuildEventID: run.ID(), JobID: "3", Name: "deploy", Status: buildDomain.BuildStatusFailed,
+	})
+	require.NoError(t, err)
+	buildService.On("GetBuildJobs", mock.Anything, run.ID()).
+		Return([]*buildDomain.BuildJob{lint, tests, deploy}, nil).Once()
+
+	var capturedMessage string
+	notificationService.On("CreateNotificationForBuildEvent", mock.Anything, run.ID(), projectID,
+		mock.MatchedBy(func(message string) bool {
+			capturedMessage = message
+			return true
+		})).
+		Return([]*notificationDomain.NotificationLog{}, nil).Once()
+
+	startedAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	_, err = webhookService.ProcessWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		EventType:  domain.WorkflowRunEvent,
+		Payload:    workflowRunDelivery("completed", "failure", startedAt, startedAt.Add(time.Minute)),
+		Signature:  workflowTestSignature,
+		DeliveryID: workflowTestDeliveryID,
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, capturedMessage, "🚨 <b>Build Failed</b>")
+	assert.True(t, strings.HasSuffix(capturedMessage,
+		"\n<b>Failed jobs:</b>\n• unit-tests → Run tests, Upload &lt;coverage&gt; &amp; report\n• deploy"), capturedMessage)
+	assert.NotContains(t, capturedMessage, "lint")
+	buildService.AssertExpectations(t)
+	notificationService.AssertExpectations(t)
+}
+
+func TestRunFirstSeenAsFailedListsItsRecordedJobs(t *testing.T) {
+	projectID := value_objects.NewID()
+	webhookService, buildService, notificationService := newLifecycleTestService(t, projectID)
+
+	// The completed failure is the first delivery of the run, its build event is created by it
+	run := trackedRunBuildEvent(t, projectID, buildDomain.BuildStatusFailed)
+	buildService.On("GetBuildEventByRunID", mock.Anything, projectID, lifecycleTestRunID).
+		Return(nil, exception.ErrBuildEventNotFound).Once()
+	buildService.On("CreateBuildEvent", mock.Anything, mock.MatchedBy(func(req buildDto.CreateBuildEventRequest) bool {
+		return req.RunID == lifecycleTestRunID && req.Status == buildDomain.BuildStatusFailed
+	})).Return(run, nil).Once()
+
+	tests, err := buildDomain.NewBuildJob(buildDomain.BuildJobParams{
+		BuildEventID: run.ID(), JobID: "2", Name: "unit-tests", Status: buildDomain.BuildStatusFailed,
+		FailedSteps: []string{"Run tests"},
+	})
+	require.NoError(t, err)
+	buildService.On("GetBuildJobs", mock.Anything, run.ID()).Return([]*buildDomain.BuildJob{tests}, nil).Once()
+
+	var capturedMessage string
+	notificationService.On("CreateNotificationForBuildEvent", mock.Anything, run.ID(), projectID,
+		mock.MatchedBy(func(message string) bool {
+			capturedMessage = message
+			return true
+		})).
+		Return([]*notificationDomain.NotificationLog{}, nil).Once()
+
+	startedAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	_, err = webhookService.ProcessWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		EventType:  domain.WorkflowRunEvent,
+		Payload:    workflowRunDelivery("completed", "failure", startedAt, startedAt.Add(time.Minute)),
+		Signature:  workflowTestSignature,
+		DeliveryID: workflowTestDeliveryID,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(capturedMessage, "\n<b>Failed jobs:</b>\n• unit-tests → Run tests"), capturedMessage)
+	buildService.AssertExpectations(t)
+	notificationService.AssertExpectations(t)
+}
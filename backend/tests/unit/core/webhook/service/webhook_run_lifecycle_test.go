@@ -0,0 +1,175 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	notificationDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	projectDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/project/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/service"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
+	"github.com/dewisartika8/cicd-status-notifier-bot/tests/mocks"
+)
+
+const lifecycleTestRunID = "555000111"
+
+// workflowRunDelivery builds a workflow_run payload for the lifecycle tests
+func workflowRunDelivery(action, conclusion string, createdAt, updatedAt time.Time) dto.GitHubActionsPayload {
+	return dto.GitHubActionsPayload{
+		Action: action,
+		WorkflowRun: &dto.WorkflowRun{
+			ID:         555000111,
+			Name:       "CI",
+			Conclusion: conclusion,
+			CreatedAt:  createdAt,
+			UpdatedAt:  updatedAt,
+			RunNumber:  7,
+			HeadBranch: "main",
+			HeadSha:    "abc123def456",
+		},
+	}
+}
+
+// newLifecycleTestService wires a webhook service whose project accepts every signature
+func newLifecycleTestService(t *testing.T, projectID value_objects.ID) (port.WebhookService, *MockBuildEventServiceTDD, *MockNotificationLogServiceTDD) {
+	webhookRepo := &mocks.MockWebhookEventRepository{}
+	projectService := &MockProjectServiceTDD{}
+	buildService := &MockBuildEventServiceTDD{}
+	notificationService := &MockNotificationLogServiceTDD{}
+	signatureVerifier := &mocks.MockSignatureVerifier{}
+
+	project, err := projectDomain.NewProject(workflowTestProjectName, workflowTestRepoURL, workflowTestWebhookSecret, nil)
+	require.NoError(t, err)
+
+	projectService.On("GetProject", mock.Anything, projectID).Return(project, nil)
+	signatureVerifier.On("VerifySignature", workflowTestWebhookSecret, workflowTestSignature, mock.Anything).Return(true)
+	webhookRepo.On("ExistsByDeliveryID", mock.Anything, workflowTestDeliveryID).Return(false, nil)
+	webhookRepo.On("Create", mock.Anything, mock.AnythingOfType(workflowWebhookEventType)).Return(nil)
+	webhookRepo.On("Update", mock.Anything, mock.AnythingOfType(workflowWebhookEventType)).Return(nil)
+
+	return service.NewWebhookService(service.Dep{
+		WebhookEventRepo:       webhookRepo,
+		ProjectService:         projectService,
+		BuildService:           buildService,
+		NotificationLogService: notificationService,
+		SignatureVerifier:      signatureVerifier,
+	}), buildService, notificationService
+}
+
+func trackedRunBuildEvent(t *testing.T, projectID value_objects.ID, status buildDomain.BuildStatus) *buildDomain.BuildEvent {
+	buildEvent, err := buildDomain.NewBuildEvent(buildDomain.BuildEventParams{
+		ProjectID: projectID,
+		RunID:     lifecycleTestRunID,
+		EventType: buildDomain.EventTypeBuildStarted,
+		Status:    status,
+		Branch:    "main",
+	})
+	require.NoError(t, err)
+	return buildEvent
+}
+
+func TestWorkflowRunCompletionUpdatesTrackedBuild(t *testing.T) {
+	projectID := value_objects.NewID()
+	webhookService, buildService, notificationService := newLifecycleTestService(t, projectID)
+
+	existing := trackedRunBuildEvent(t, projectID, buildDomain.BuildStatusInProgress)
+	buildService.On("GetBuildEventByRunID", mock.Anything, projectID, lifecycleTestRunID).Return(existing, nil).Once()
+	buildService.On("UpdateBuildEventStatus", mock.Anything, existing.ID(), buildDomain.BuildStatusSuccess,
+		mock.MatchedBy(func(duration *int) bool { return duration != nil && *duration == 150 })).
+		Return(nil).Once()
+
+	notificationLog, err := notificationDomain.NewNotificationLog(
+		existing.ID(),
+		projectID,
+		notificationDomain.NotificationChannelTelegram,
+		"123456789",
+		"CI succeeded",
+		3,
+	)
+	require.NoError(t, err)
+	notificationService.On("CreateNotificationForBuildEvent", mock.Anything, existing.ID(), projectID,
+		mock.MatchedBy(func(message string) bool { return strings.Contains(message, "✅") })).
+		Return([]*notificationDomain.NotificationLog{notificationLog}, nil).Once()
+	notificationService.On("SendNotification", mock.Anything, notificationLog.ID()).Return(nil).Once()
+
+	startedAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	result, err := webhookService.ProcessWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		EventType:  domain.WorkflowRunEvent,
+		Payload:    workflowRunDelivery("completed", "success", startedAt, startedAt.Add(150*time.Second)),
+		Signature:  workflowTestSignature,
+		DeliveryID: workflowTestDeliveryID,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.IsProcessed())
+	buildService.AssertExpectations(t)
+	buildService.AssertNotCalled(t, "CreateBuildEvent", mock.Anything, mock.Anything)
+	notificationService.AssertExpectations(t)
+}
+
+func TestWorkflowRunLateDeliveryDoesNotReopenFinishedBuild(t *testing.T) {
+	projectID := value_objects.NewID()
+	webhookService, buildService, notificationService := newLifecycleTestService(t, projectID)
+
+	existing := trackedRunBuildEvent(t, projectID, buildDomain.BuildStatusSuccess)
+	buildService.On("GetBuildEventByRunID", mock.Anything, projectID, lifecycleTestRunID).Return(existing, nil).Once()
+
+	// The in_progress delivery arrives after the completed one
+	result, err := webhookService.ProcessWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		EventType:  domain.WorkflowRunEvent,
+		Payload:    workflowRunDelivery("in_progress", "", time.Now(), time.Now()),
+		Signature:  workflowTestSignature,
+		DeliveryID: workflowTestDeliveryID,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.IsProcessed())
+	buildService.AssertExpectations(t)
+	buildService.AssertNotCalled(t, "UpdateBuildEventStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	buildService.AssertNotCalled(t, "CreateBuildEvent", mock.Anything, mock.Anything)
+	notificationService.AssertNotCalled(t, "CreateNotificationForBuildEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWorkflowRunCreatedConcurrentlyUpdatesExistingBuild(t *testing.T) {
+	projectID := value_objects.NewID()
+	webhookService, buildService, notificationService := newLifecycleTestService(t, projectID)
+
+	// Another delivery of the run creates its build event between the lookup and the insert
+	existing := trackedRunBuildEvent(t, projectID, buildDomain.BuildStatusInProgress)
+	buildService.On("GetBuildEventByRunID", mock.Anything, projectID, lifecycleTestRunID).
+		Return(nil, exception.ErrBuildEventNotFound).Once()
+	buildService.On("CreateBuildEvent", mock.Anything, mock.Anything).
+		Return(nil, exception.ErrBuildEventRunExists).Once()
+	buildService.On("GetBuildEventByRunID", mock.Anything, projectID, lifecycleTestRunID).Return(existing, nil).Once()
+	buildService.On("UpdateBuildEventStatus", mock.Anything, existing.ID(), buildDomain.BuildStatusSuccess, mock.Anything).
+		Return(nil).Once()
+	notificationService.On("CreateNotificationForBuildEvent", mock.Anything, existing.ID(), projectID, mock.AnythingOfType("string")).
+		Return([]*notificationDomain.NotificationLog{}, nil).Once()
+
+	startedAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	result, err := webhookService.ProcessWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		EventType:  domain.WorkflowRunEvent,
+		Payload:    workflowRunDelivery("completed", "success", startedAt, startedAt.Add(150*time.Second)),
+		Signature:  workflowTestSignature,
+		DeliveryID: workflowTestDeliveryID,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.IsProcessed())
+	buildService.AssertExpectations(t)
+	notificationService.AssertExpectations(t)
+}
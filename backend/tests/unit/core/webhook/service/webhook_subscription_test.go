@@ -0,0 +1,114 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	notificationDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	notificationPort "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	projectDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/project/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/service"
+	"github.com/dewisartika8/cicd-status-notifier-bot/tests/mocks"
+)
+
+// MockWebhookSubscriptionService mocks the subscription lookups of the webhook service
+type MockWebhookSubscriptionService struct {
+	notificationPort.WebhookSubscriptionService
+	mock.Mock
+}
+
+func (m *MockWebhookSubscriptionService) GetActiveWebhookSubscriptions(ctx context.Context, projectID value_objects.ID) ([]*notificationDomain.WebhookSubscription, error) {
+	args := m.Called(ctx, projectID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*notificationDomain.WebhookSubscription), args.Error(1)
+}
+
+func TestWorkflowRunCompletionNotifiesWebhookSubscriptions(t *testing.T) {
+	projectID := value_objects.NewID()
+	webhookRepo := &mocks.MockWebhookEventRepository{}
+	projectService := &MockProjectServiceTDD{}
+	buildService := &MockBuildEventServiceTDD{}
+	notificationService := &MockNotificationLogServiceTDD{}
+	channelService := &MockChannelNotificationService{}
+	subscriptionService := &MockWebhookSubscriptionService{}
+	signatureVerifier := &mocks.MockSignatureVerifier{}
+
+	project, err := projectDomain.NewProject(workflowTestProjectName, workflowTestRepoURL, workflowTestWebhookSecret, nil)
+	require.NoError(t, err)
+
+	projectService.On("GetProject", mock.Anything, projectID).Return(project, nil)
+	signatureVerifier.On("VerifySignature", workflowTestWebhookSecret, workflowTestSignature, mock.Anything).Return(true)
+	webhookRepo.On("ExistsByDeliveryID", mock.Anything, workflowTestDeliveryID).Return(false, nil)
+	webhookRepo.On("Create", mock.Anything, mock.AnythingOfType(workflowWebhookEventType)).Return(nil)
+	webhookRepo.On("Update", mock.Anything, mock.AnythingOfType(workflowWebhookEventType)).Return(nil)
+
+	webhookService := service.NewWebhookService(service.Dep{
+		WebhookEventRepo:       webhookRepo,
+		ProjectService:         projectService,
+		BuildService:           buildService,
+		NotificationLogService: notificationService,
+		SignatureVerifier:      signatureVerifier,
+		ChannelNotifications:   channelService,
+		ProjectChannels:        []notificationDomain.NotificationChannel{notificationDomain.NotificationChannelWebhook},
+		WebhookSubscriptions:   subscriptionService,
+	})
+
+	existing := trackedRunBuildEvent(t, projectID, buildDomain.BuildStatusInProgress)
+	buildService.On("GetBuildEventByRunID", mock.Anything, projectID, lifecycleTestRunID).Return(existing, nil).Once()
+	buildService.On("UpdateBuildEventStatus", mock.Anything, existing.ID(), buildDomain.BuildStatusFailed, mock.Anything).
+		Return(nil).Once()
+	buildService.On("GetBuildJobs", mock.Anything, existing.ID()).Return([]*buildDomain.BuildJob{}, nil)
+	notificationService.On("CreateNotificationForBuildEvent", mock.Anything, existing.ID(), projectID, mock.Anything).
+		Return([]*notificationDomain.NotificationLog{}, nil).Once()
+
+	subscription, err := notificationDomain.NewWebhookSubscription(projectID, "https://hooks.example.com/ci",
+		"whsec_0123456789abcdef", notificationDomain.WebhookPayloadFormatJSON)
+	require.NoError(t, err)
+	subscriptionService.On("GetActiveWebhookSubscriptions", mock.Anything, project.ID()).
+		Return([]*notificationDomain.WebhookSubscription{subscription}, nil).Once()
+
+	webhookLog, err := notificationDomain.NewNotificationLog(existing.ID(), projectID,
+		notificationDomain.NotificationChannelWebhook, subscription.ID().String(), "{}", 3)
+	require.NoError(t, err)
+
+	var body string
+	channelService.On("CreateChannelNotificationsForBuildEvent", mock.Anything, existing.ID(), projectID,
+		notificationDomain.NotificationChannelWebhook, []string{subscription.ID().String()},
+		notificationDomain.WebhookEventBuildFailed, mock.Anything).
+		Run(func(args mock.Arguments) { body = args.String(6) }).
+		Return([]*notificationDomain.NotificationLog{webhookLog}, nil).Once()
+	notificationService.On("SendNotification", mock.Anything, webhookLog.ID()).Return(nil).Once()
+
+	startedAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	_, err = webhookService.ProcessWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		EventType:  domain.WorkflowRunEvent,
+		Payload:    workflowRunDelivery("completed", "failure", startedAt, startedAt.Add(90*time.Second)),
+		Signature:  workflowTestSignature,
+		DeliveryID: workflowTestDeliveryID,
+	})
+	require.NoError(t, err)
+
+	event, err := notificationDomain.DecodeOutgoingWebhookEvent(body)
+	require.NoError(t, err)
+	assert.Equal(t, notificationDomain.WebhookEventBuildFailed, event.Type)
+	assert.Equal(t, workflowTestProjectName, event.Data.Project.Name)
+	assert.Equal(t, existing.ID().String(), event.Data.Build.ID)
+	assert.Equal(t, string(buildDomain.BuildStatusFailed), event.Data.Build.Status)
+	assert.Equal(t, "main", event.Data.Build.Branch)
+	require.NotNil(t, event.Data.Build.DurationSeconds)
+	assert.Equal(t, 90, *event.Data.Build.DurationSeconds)
+	channelService.AssertExpectations(t)
+	notificationService.AssertExpectations(t)
+}
@@ -0,0 +1,197 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	buildDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/domain"
+	buildDto "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/build/dto"
+	notificationDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/domain"
+	notificationPort "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/notification/port"
+	projectDomain "github.com/dewisartika8/cicd-status-notifier-bot/internal/core/project/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/shared/domain/value_objects"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/dto"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/port"
+	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/service"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
+	"github.com/dewisartika8/cicd-status-notifier-bot/tests/mocks"
+)
+
+// stubNotificationFormatter renders the templates stored for the template tests
+type stubNotificationFormatter struct {
+	notificationPort.NotificationFormatterService
+	templates map[notificationDomain.NotificationTemplateType]string
+}
+
+func (f *stubNotificationFormatter) FormatNotification(
+	ctx context.Context,
+	templateType notificationDomain.NotificationTemplateType,
+	channel notificationDomain.NotificationChannel,
+	params notificationDomain.TemplateParams,
+) (string, string, error) {
+	body, ok := f.templates[templateType]
+	if !ok || channel != notificationDomain.NotificationChannelTelegram {
+		return "", "", notificationDomain.ErrTemplateNotFound
+	}
+
+	tmpl, err := notificationDomain.NewNotificationTemplate(templateType, channel, "", body)
+	if err != nil {
+		return "", "", err
+	}
+	return tmpl.RenderTemplate(params)
+}
+
+// newTemplateTestService wires a webhook service rendering notifications through the given stored templates
+func newTemplateTestService(
+	t *testing.T,
+	projectID value_objects.ID,
+	templates map[notificationDomain.NotificationTemplateType]string,
+) (port.WebhookService, *MockBuildEventServiceTDD, *MockNotificationLogServiceTDD) {
+	webhookRepo := &mocks.MockWebhookEventRepository{}
+	projectService := &MockProjectServiceTDD{}
+	buildService := &MockBuildEventServiceTDD{}
+	notificationService := &MockNotificationLogServiceTDD{}
+	signatureVerifier := &mocks.MockSignatureVerifier{}
+
+	project, err := projectDomain.NewProject(workflowTestProjectName, workflowTestRepoURL, workflowTestWebhookSecret, nil)
+	require.NoError(t, err)
+
+	projectService.On("GetProject", mock.Anything, projectID).Return(project, nil)
+	signatureVerifier.On("VerifySignature", workflowTestWebhookSecret, workflowTestSignature, mock.Anything).Return(true)
+	webhookRepo.On("ExistsByDeliveryID", mock.Anything, workflowTestDeliveryID).Return(false, nil)
+	webhookRepo.On("Create", mock.Anything, mock.AnythingOfType(workflowWebhookEventType)).Return(nil)
+	webhookRepo.On("Update", mock.Anything, mock.AnythingOfType(workflowWebhookEventType)).Return(nil)
+
+	return service.NewWebhookService(service.Dep{
+		WebhookEventRepo:       webhookRepo,
+		ProjectService:         projectService,
+		BuildService:           buildService,
+		NotificationLogService: notificationService,
+		SignatureVerifier:      signatureVerifier,
+		NotificationFormatter:  &stubNotificationFormatter{templates: templates},
+	}), buildService, notificationService
+}
+
+// pushDelivery builds a push payload for the template tests
+func pushDelivery() dto.GitHubActionsPayload {
+	payload := dto.GitHubActionsPayload{
+		Ref:   "refs/heads/main",
+		After: "abc123def456",
+		HeadCommit: &dto.Commit{
+			ID:      "abc123def456",
+			Message: "Add search",
+			Author:  dto.User{Name: "octocat"},
+		},
+	}
+	payload.Repository.FullName = "test/repo"
+	return payload
+}
+
+// processTemplateTestPush processes a push delivery and returns the Telegram message it produced
+func processTemplateTestPush(t *testing.T, templates map[notificationDomain.NotificationTemplateType]string) string {
+	projectID := value_objects.NewID()
+	webhookService, buildService, notificationService := newTemplateTestService(t, projectID, templates)
+
+	buildEvent, err := buildDomain.NewBuildEvent(buildDomain.BuildEventParams{
+		ProjectID: projectID,
+		EventType: buildDomain.EventTypePush,
+		Status:    buildDomain.BuildStatusSuccess,
+		Branch:    "main",
+	})
+	require.NoError(t, err)
+	buildService.On("CreateBuildEvent", mock.Anything, mock.AnythingOfType("dto.CreateBuildEventRequest")).
+		Return(buildEvent, nil).Once()
+
+	var capturedMessage string
+	notificationService.On("CreateNotificationForBuildEvent", mock.Anything, buildEvent.ID(), projectID,
+		mock.MatchedBy(func(message string) bool {
+			capturedMessage = message
+			return true
+		})).
+		Return([]*notificationDomain.NotificationLog{}, nil).Once()
+
+	_, err = webhookService.ProcessWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		EventType:  domain.PushEvent,
+		Payload:    pushDelivery(),
+		Signature:  workflowTestSignature,
+		DeliveryID: workflowTestDeliveryID,
+	})
+
+	require.NoError(t, err)
+	buildService.AssertExpectations(t)
+	notificationService.AssertExpectations(t)
+	return capturedMessage
+}
+
+func TestPushNotificationUsesStoredTemplate(t *testing.T) {
+	message := processTemplateTestPush(t, map[notificationDomain.NotificationTemplateType]string{
+		notificationDomain.TemplateTypePush: "{{.BuildAuthor}} pushed {{.CommitMessage}} ({{.BuildCommit}}) to {{.ProjectName}}/{{.BuildBranch}}",
+	})
+
+	assert.Equal(t, "octocat pushed Add search (abc123def456) to test/repo/main", message)
+}
+
+func TestPushNotificationFallsBackToDefaultTemplate(t *testing.T) {
+	message := processTemplateTestPush(t, nil)
+
+	assert.Equal(t, "📤 <b>Push Event</b>\n<b>Project:</b> test/repo\n<b>Branch:</b> main\n<b>Commit:</b> Add search\n<b>Author:</b> octocat", message)
+}
+
+func TestReleaseNotificationUsesStoredTemplate(t *testing.T) {
+	projectID := value_objects.NewID()
+	webhookService, buildService, notificationService := newTemplateTestService(t, projectID,
+		map[notificationDomain.NotificationTemplateType]string{
+			notificationDomain.TemplateTypeRelease: "{{.Tag}} is out!\n{{.Changelog}}",
+		})
+
+	buildService.On("GetBuildEventByRunID", mock.Anything, projectID, "release-2001").
+		Return(nil, exception.ErrBuildEventNotFound).Once()
+	buildService.On("GetBuildEventsByProject", mock.Anything, projectID,
+		mock.MatchedBy(func(filters buildDto.ListBuildEventFilters) bool {
+			return *filters.EventType == buildDomain.EventTypeRelease
+		})).Return([]*buildDomain.BuildEvent{}, nil).Once()
+	buildService.On("GetBuildEventsByProject", mock.Anything, projectID,
+		mock.MatchedBy(func(filters buildDto.ListBuildEventFilters) bool {
+			return *filters.EventType == buildDomain.EventTypePush
+		})).Return([]*buildDomain.BuildEvent{
+		storedPushEvent(t, projectID, dto.Commit{ID: "aaa1111111", Message: "Add search"}),
+	}, nil).Once()
+
+	buildEvent, err := buildDomain.NewBuildEvent(buildDomain.BuildEventParams{
+		ProjectID: projectID,
+		RunID:     "release-2001",
+		EventType: buildDomain.EventTypeRelease,
+		Status:    buildDomain.BuildStatusSuccess,
+		Branch:    "main",
+	})
+	require.NoError(t, err)
+	buildService.On("CreateBuildEvent", mock.Anything, mock.AnythingOfType("dto.CreateBuildEventRequest")).
+		Return(buildEvent, nil).Once()
+
+	var capturedMessage string
+	notificationService.On("CreateNotificationForBuildEvent", mock.Anything, buildEvent.ID(), projectID,
+		mock.MatchedBy(func(message string) bool {
+			capturedMessage = message
+			return true
+		})).
+		Return([]*notificationDomain.NotificationLog{}, nil).Once()
+
+	_, err = webhookService.ProcessWebhook(context.Background(), dto.ProcessWebhookRequest{
+		ProjectID:  projectID,
+		EventType:  domain.ReleaseEvent,
+		Payload:    releaseDelivery(),
+		Signature:  workflowTestSignature,
+		DeliveryID: workflowTestDeliveryID,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "v2.0.0 is out!\n• Add search (aaa1111)", capturedMessage)
+	buildService.AssertExpectations(t)
+	notificationService.AssertExpectations(t)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/domain"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/dto"
 	"github.com/dewisartika8/cicd-status-notifier-bot/internal/core/webhook/service"
+	"github.com/dewisartika8/cicd-status-notifier-bot/pkg/exception"
 	"github.com/dewisartika8/cicd-status-notifier-bot/tests/mocks"
 )
 
@@ -170,6 +171,35 @@ func (m *MockBuildEventServiceTDD) GetBuildEvent(ctx context.Context, id value_o
 	return args.Get(0).(*buildDomain.BuildEvent), args.Error(1)
 }
 
+func (m *MockBuildEventServiceTDD) GetBuildEventByRunID(ctx context.Context, projectID value_objects.ID, runID string) (*buildDomain.BuildEvent, error) {
+	args := m.Called(ctx, projectID, runID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*buildDomain.BuildEvent), args.Error(1)
+}
+
+func (m *MockBuildEventServiceTDD) GetStatusTransitions(ctx context.Context, buildEventID value_objects.ID) ([]*buildDomain.BuildStatusTransition, error) {
+	args := m.Called(ctx, buildEventID)
+	return args.Get(0).([]*buildDomain.BuildStatusTransition), args.Error(1)
+}
+
+func (m *MockBuildEventServiceTDD) RecordBuildJob(ctx context.Context, req buildDto.RecordBuildJobRequest) (*buildDomain.BuildJob, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*buildDomain.BuildJob), args.Error(1)
+}
+
+func (m *MockBuildEventServiceTDD) GetBuildJobs(ctx context.Context, buildEventID value_objects.ID) ([]*buildDomain.BuildJob, error) {
+	args := m.Called(ctx, buildEventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*buildDomain.BuildJob), args.Error(1)
+}
+
 func (m *MockBuildEventServiceTDD) GetBuildEventsByProject(ctx context.Context, projectID value_objects.ID, filters buildDto.ListBuildEventFilters) ([]*buildDomain.BuildEvent, error) {
 	args := m.Called(ctx, projectID, filters)
 	return args.Get(0).([]*buildDomain.BuildEvent), args.Error(1)
@@ -196,6 +226,11 @@ func (m *MockBuildEventServiceTDD) GetBuildMetrics(ctx context.Context, projectI
 	return args.Get(0).(*buildDomain.BuildMetrics), args.Error(1)
 }
 
+func (m *MockBuildEventServiceTDD) GetBuildTransition(ctx context.Context, buildEventID value_objects.ID) (buildDomain.BuildTransition, bool, error) {
+	args := m.Called(ctx, buildEventID)
+	return args.Get(0).(buildDomain.BuildTransition), args.Bool(1), args.Error(2)
+}
+
 func (m *MockBuildEventServiceTDD) ListBuildEvents(ctx context.Context, filters buildDto.ListBuildEventFilters) ([]*buildDomain.BuildEvent, error) {
 	args := m.Called(ctx, filters)
 	return args.Get(0).([]*buildDomain.BuildEvent), args.Error(1)
@@ -286,6 +321,16 @@ func (m *MockNotificationLogServiceTDD) ProcessFailedNotifications(ctx context.C
 	return result.Error(0)
 }
 
+func (m *MockNotificationLogServiceTDD) SendDueDigests(ctx context.Context, limit int) error {
+	args := m.Called(ctx, limit)
+	return args.Error(0)
+}
+
+func (m *MockNotificationLogServiceTDD) SendDeferredNotifications(ctx context.Context, limit int) error {
+	args := m.Called(ctx, limit)
+	return args.Error(0)
+}
+
 func (m *MockNotificationLogServiceTDD) GetNotificationStats(ctx context.Context, projectID value_objects.ID) (map[notificationDomain.NotificationStatus]int64, error) {
 	args := m.Called(ctx, projectID)
 	if args.Get(0) == nil {
@@ -400,6 +445,10 @@ func TestWorkflowRunProcessingTDD(t *testing.T) {
 		mockWebhookRepo.On("Update", mock.Anything, mock.AnythingOfType(workflowWebhookEventType)).
 			Return(nil).Once()
 
+		// Setup mock expectations for run lookup, no build tracked for this run yet
+		mockBuildService.On("GetBuildEventByRunID", mock.Anything, projectID, "123456789").
+			Return(nil, exception.ErrBuildEventNotFound).Once()
+
 		// Setup mock expectations for build service
 		mockBuildService.On("CreateBuildEvent", mock.Anything, mock.MatchedBy(func(req buildDto.CreateBuildEventRequest) bool {
 			return req.EventType == buildDomain.EventTypeBuildCompleted &&
@@ -553,6 +602,10 @@ func TestWorkflowRunProcessingTDD(t *testing.T) {
 		mockWebhookRepo.On("Create", mock.Anything, mock.AnythingOfType(workflowWebhookEventType)).
 			Return(nil).Once()
 
+		// Setup mock expectations for run lookup, no build tracked for this run yet
+		mockBuildService.On("GetBuildEventByRunID", mock.Anything, projectID, "123456789").
+			Return(nil, exception.ErrBuildEventNotFound).Once()
+
 		// Setup mock to return error for build service
 		mockBuildService.On("CreateBuildEvent", mock.Anything, mock.MatchedBy(func(req buildDto.CreateBuildEventRequest) bool {
 			return req.EventType == buildDomain.EventTypeBuildCompleted
@@ -640,6 +693,10 @@ func TestWorkflowRunProcessingTDD(t *testing.T) {
 		mockWebhookRepo.On("Update", mock.Anything, mock.AnythingOfType(workflowWebhookEventType)).
 			Return(nil).Once()
 
+		// Setup mock expectations for run lookup, no build tracked for this run yet
+		mockBuildService.On("GetBuildEventByRunID", mock.Anything, projectID, "123456789").
+			Return(nil, exception.ErrBuildEventNotFound).Once()
+
 		// Setup mock for build event
 		mockBuildService.On("CreateBuildEvent", mock.Anything, mock.MatchedBy(func(req buildDto.CreateBuildEventRequest) bool {
 			return req.EventType == buildDomain.EventTypeBuildCompleted &&